@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"io/fs"
 	"log"
@@ -19,11 +20,20 @@ import (
 	"github.com/a-novel/service-narrative-engine/internal/config"
 	"github.com/a-novel/service-narrative-engine/internal/config/env"
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/models/modules"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 )
 
+var errUnusableLLMSchema = errors.New("schema cannot be used for generation")
+
 func main() {
+	check := flag.Bool(
+		"check", false,
+		"report how the system modules differ from their previous stable version, without loading them",
+	)
+	flag.Parse()
+
 	cfg := config.AppPresetDefault
 	ctx := context.Background()
 
@@ -50,6 +60,30 @@ func main() {
 		repositoryModuleListVersions,
 	)
 
+	serviceModuleCheckCompatibility := services.NewModuleCheckCompatibility(
+		repositoryModuleSelect,
+		repositoryModuleListVersions,
+	)
+
+	if *check {
+		var err error
+
+		for namespace, embedFS := range modules.KnownModules {
+			log.Printf("Checking namespace: %s", namespace)
+			err = errors.Join(err, checkNamespace(ctx, namespace, embedFS, serviceModuleCheckCompatibility))
+		}
+
+		// Exit with a non-zero status, so the check can gate a release pipeline.
+		if err != nil {
+			cfg.Otel.Flush()
+			log.Fatalf("Check failed: %v", err) //nolint:gocritic // Flushed above.
+		}
+
+		log.Println("All namespaces can be published")
+
+		return
+	}
+
 	var err error
 
 	for namespace, embedFS := range modules.KnownModules {
@@ -66,12 +100,7 @@ func main() {
 	log.Println("All namespaces processed successfully")
 }
 
-func processNamespace(
-	ctx context.Context,
-	namespace string,
-	embedFS fs.FS,
-	service *services.ModuleLoadSystem,
-) error {
+func readNamespace(embedFS fs.FS) ([]modules.SystemModule, error) {
 	var systemModules []modules.SystemModule
 
 	err := fs.WalkDir(embedFS, ".", func(path string, d fs.DirEntry, err error) error {
@@ -105,7 +134,88 @@ func processNamespace(
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("walk directory: %w", err)
+		return nil, fmt.Errorf("walk directory: %w", err)
+	}
+
+	return systemModules, nil
+}
+
+// checkNamespace logs the compatibility report of each module in the namespace, against the previous stable
+// version of the module, along with the parts of its schema that are altered for generation. It returns an error if
+// any module cannot be published under the current version, or cannot be used for generation.
+func checkNamespace(
+	ctx context.Context,
+	namespace string,
+	embedFS fs.FS,
+	service *services.ModuleCheckCompatibility,
+) error {
+	systemModules, err := readNamespace(embedFS)
+	if err != nil {
+		return err
+	}
+
+	if len(systemModules) == 0 {
+		log.Printf("No modules found in namespace %s", namespace)
+
+		return nil
+	}
+
+	var errs error
+
+	for _, module := range systemModules {
+		moduleString := lib.DecodedModule{
+			Namespace: module.Namespace,
+			Module:    module.ID,
+			Version:   env.Version,
+		}.String()
+
+		llmSchema := services.LoadModuleLLMSchema(&module.Schema)
+		logLLMReport(moduleString, llmSchema.Report)
+
+		if !llmSchema.Usable() {
+			errs = errors.Join(errs, fmt.Errorf("module %s: %w", moduleString, errUnusableLLMSchema))
+		}
+
+		compatibility, err := service.Exec(ctx, &services.ModuleCheckCompatibilityRequest{
+			Module: moduleString,
+			Schema: module.Schema,
+		})
+		if err != nil {
+			return fmt.Errorf("check module %s: %w", module.ID, err)
+		}
+
+		if compatibility.PreviousVersion == "" {
+			log.Printf("%s: no previous stable version", moduleString)
+
+			continue
+		}
+
+		log.Printf(
+			"%s: %d change(s) since v%s", moduleString, len(compatibility.Changes), compatibility.PreviousVersion,
+		)
+
+		for _, change := range compatibility.Changes {
+			log.Printf("  [%s] %s: %s", change.Kind, lo.CoalesceOrEmpty(change.Path, "/"), change.Message)
+		}
+
+		err = compatibility.Err()
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("module %s: %w", moduleString, err))
+		}
+	}
+
+	return errs
+}
+
+func processNamespace(
+	ctx context.Context,
+	namespace string,
+	embedFS fs.FS,
+	service *services.ModuleLoadSystem,
+) error {
+	systemModules, err := readNamespace(embedFS)
+	if err != nil {
+		return err
 	}
 
 	if len(systemModules) == 0 {
@@ -116,7 +226,7 @@ func processNamespace(
 
 	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
 		for _, module := range systemModules {
-			_, err = service.Exec(ctx, &services.ModuleLoadSystemRequest{
+			loaded, err := service.Exec(ctx, &services.ModuleLoadSystemRequest{
 				Module:  module,
 				Version: env.Version,
 				DevMode: env.DevMode,
@@ -126,6 +236,7 @@ func processNamespace(
 			}
 
 			log.Printf("Loaded module: %s/%s@%s", module.Namespace, module.ID, env.Version)
+			logLLMReport(fmt.Sprintf("%s/%s@%s", module.Namespace, module.ID, env.Version), loaded.LLMReport)
 		}
 
 		return nil
@@ -136,3 +247,16 @@ func processNamespace(
 
 	return nil
 }
+
+// logLLMReport logs the parts of a module schema that are altered or dropped when generating content.
+func logLLMReport(moduleString string, report lib.JSONSchemaLLMReport) {
+	if len(report) == 0 {
+		return
+	}
+
+	log.Printf("%s: %d part(s) of the schema altered for generation", moduleString, len(report))
+
+	for _, change := range report {
+		log.Printf("  [%s] %s: %s", change.Kind, lo.CoalesceOrEmpty(change.Path, "/"), change.Message)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/a-novel/service-narrative-engine/internal/config/env"
 	"github.com/a-novel/service-narrative-engine/internal/dao"
 	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 )
 
@@ -55,6 +56,8 @@ func main() {
 
 	serviceVerifyAccessToken := jkpkg.NewClaimsVerifier[authpkg.Claims](jsonKeysClient)
 
+	completionProvider := lo.Must(lib.NewCompletionProvider(env.LlmProvider))
+
 	// =================================================================================================================
 	// DAO
 	// =================================================================================================================
@@ -63,62 +66,236 @@ func main() {
 	repositoryModuleSelect := dao.NewModuleSelect()
 	repositoryModuleDelete := dao.NewModuleDelete()
 	repositoryModuleListVersions := dao.NewModuleListVersions()
-	repositoryModuleGenerate := dao.NewModuleGenerate()
+	repositoryModuleList := dao.NewModuleList()
+	repositoryModuleGenerate := dao.NewModuleGenerate(completionProvider)
+	repositoryModuleTranslate := dao.NewModuleTranslate(completionProvider)
 
 	repositoryProjectInsert := dao.NewProjectInsert()
 	repositoryProjectSelect := dao.NewProjectSelect()
 	repositoryProjectDelete := dao.NewProjectDelete()
 	repositoryProjectList := dao.NewProjectList()
 	repositoryProjectUpdate := dao.NewProjectUpdate()
+	repositoryProjectUpdateLang := dao.NewProjectUpdateLang()
+
+	repositoryProjectMemberSelect := dao.NewProjectMemberSelect()
+	repositoryProjectMemberList := dao.NewProjectMemberList()
+	repositoryProjectMemberUpsert := dao.NewProjectMemberUpsert()
+	repositoryProjectMemberDelete := dao.NewProjectMemberDelete()
+
+	repositoryProjectShareInsert := dao.NewProjectShareInsert()
+	repositoryProjectShareSelect := dao.NewProjectShareSelect()
+	repositoryProjectShareList := dao.NewProjectShareList()
+	repositoryProjectShareRevoke := dao.NewProjectShareRevoke()
+
+	repositoryProjectSnapshotInsert := dao.NewProjectSnapshotInsert()
+	repositoryProjectSnapshotSelect := dao.NewProjectSnapshotSelect()
+	repositoryProjectSnapshotList := dao.NewProjectSnapshotList()
 
 	repositorySchemaInsert := dao.NewSchemaInsert()
 	repositorySchemaSelect := dao.NewSchemaGet()
 	repositorySchemaUpdate := dao.NewSchemaUpdate()
 	repositorySchemaList := dao.NewSchemaList()
+	repositorySchemaListByIDs := dao.NewSchemaListByIDs()
+	repositorySchemaListAt := dao.NewSchemaListAt()
 	repositorySchemaListVersions := dao.NewSchemaListVersions()
 
+	repositorySchemaGenerationInsert := dao.NewSchemaGenerationInsert()
+	repositorySchemaGenerationSelect := dao.NewSchemaGenerationSelect()
+	repositorySchemaGenerationList := dao.NewSchemaGenerationList()
+
+	repositorySchemaCandidateInsert := dao.NewSchemaCandidateInsert()
+	repositorySchemaCandidateSelect := dao.NewSchemaCandidateSelect()
+	repositorySchemaCandidateList := dao.NewSchemaCandidateList()
+	repositorySchemaCandidateDelete := dao.NewSchemaCandidateDelete()
+
+	repositoryTokenUsageInsert := dao.NewTokenUsageInsert()
+	repositoryTokenUsageList := dao.NewTokenUsageList()
+
+	repositoryGenerationJobInsert := dao.NewGenerationJobInsert()
+	repositoryGenerationJobSelect := dao.NewGenerationJobSelect()
+	repositoryGenerationJobClaim := dao.NewGenerationJobClaim()
+	repositoryGenerationJobFinish := dao.NewGenerationJobFinish()
+
 	// =================================================================================================================
 	// SERVICES
 	// =================================================================================================================
 
-	serviceModuleCreate := services.NewModuleCreate(repositoryModuleInsert, repositoryModuleDelete)
+	serviceModuleCreate := services.NewModuleCreate(
+		repositoryModuleInsert, repositoryModuleDelete, repositoryModuleSelect, repositoryModuleListVersions,
+	)
 	serviceModuleSelect := services.NewModuleSelect(repositoryModuleSelect)
+	serviceModuleSelectLLMSchema := services.NewModuleSelectLLMSchema(repositoryModuleSelect)
+	serviceModuleDelete := services.NewModuleDelete(repositoryModuleDelete)
 	serviceModuleListVersions := services.NewModuleListVersions(repositoryModuleListVersions)
+	serviceModuleList := services.NewModuleList(repositoryModuleList)
 
 	serviceProjectInit := services.NewProjectInit(
-		repositoryProjectInsert, repositorySchemaInsert, repositoryModuleSelect,
+		repositoryProjectInsert, repositorySchemaInsert, repositoryModuleSelect, repositoryModuleListVersions,
+	)
+	serviceProjectDelete := services.NewProjectDelete(
+		repositoryProjectDelete, repositoryProjectSelect, repositoryProjectMemberSelect,
 	)
-	serviceProjectDelete := services.NewProjectDelete(repositoryProjectDelete, repositoryProjectSelect)
 	serviceProjectList := services.NewProjectList(repositoryProjectList)
 	serviceProjectUpdate := services.NewProjectUpdate(
 		repositoryProjectUpdate,
 		repositoryProjectSelect,
 		repositorySchemaInsert,
+		repositorySchemaSelect,
+		repositoryModuleSelect,
+		repositoryModuleListVersions,
+		repositoryProjectMemberSelect,
+	)
+	serviceProjectUpgradeModule := services.NewProjectUpgradeModule(
+		repositoryProjectUpdate,
+		repositoryProjectSelect,
+		repositoryModuleSelect,
+		repositorySchemaSelect,
+		repositorySchemaInsert,
+		repositoryModuleListVersions,
+		repositoryProjectMemberSelect,
+	)
+	serviceProjectTranslate := services.NewProjectTranslate(
+		repositoryModuleTranslate,
+		repositoryProjectSelect,
+		repositoryProjectInsert,
+		repositoryProjectUpdateLang,
+		repositorySchemaList,
+		repositorySchemaInsert,
+		repositorySchemaGenerationInsert,
 		repositoryModuleSelect,
+		repositoryTokenUsageList,
+		repositoryTokenUsageInsert,
+		repositoryProjectMemberSelect,
+		cfg.UsageQuotas,
+	)
+	serviceProjectFork := services.NewProjectFork(
+		repositoryProjectInsert,
+		repositoryProjectSelect,
+		repositorySchemaList,
+		repositorySchemaInsert,
+		repositoryProjectMemberSelect,
+	)
+	serviceProjectState := services.NewProjectState(
+		repositorySchemaListAt, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectMemberList := services.NewProjectMemberList(
+		repositoryProjectMemberList, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectMemberUpsert := services.NewProjectMemberUpsert(
+		repositoryProjectMemberUpsert, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectMemberDelete := services.NewProjectMemberDelete(
+		repositoryProjectMemberDelete, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+
+	serviceProjectShareCreate := services.NewProjectShareCreate(
+		repositoryProjectShareInsert, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectShareList := services.NewProjectShareList(
+		repositoryProjectShareList, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectShareRevoke := services.NewProjectShareRevoke(
+		repositoryProjectShareRevoke, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectShareSelect := services.NewProjectShareSelect(
+		repositoryProjectShareSelect, repositoryProjectSelect, repositorySchemaList,
+	)
+
+	serviceProjectSnapshotCreate := services.NewProjectSnapshotCreate(
+		repositoryProjectSnapshotInsert, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectSnapshotList := services.NewProjectSnapshotList(
+		repositoryProjectSnapshotList, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceProjectSnapshotSelect := services.NewProjectSnapshotSelect(
+		repositoryProjectSnapshotSelect, repositoryProjectSelect, repositorySchemaListByIDs, repositoryProjectMemberSelect,
+	)
+	serviceProjectSnapshotRestore := services.NewProjectSnapshotRestore(
+		repositorySchemaInsert,
+		repositoryProjectSnapshotSelect,
+		repositoryProjectSelect,
+		repositorySchemaListByIDs,
+		repositoryProjectMemberSelect,
 	)
 
 	serviceSchemaCreate := services.NewSchemaCreate(
 		repositorySchemaInsert,
 		repositoryProjectSelect,
 		repositoryModuleSelect,
+		repositoryModuleListVersions,
+		repositoryProjectMemberSelect,
 	)
 	serviceSchemaGenerate := services.NewSchemaGenerate(
 		repositoryModuleGenerate,
 		repositorySchemaList,
 		repositorySchemaInsert,
+		repositorySchemaGenerationInsert,
 		repositoryProjectSelect,
 		repositoryModuleSelect,
+		repositoryModuleListVersions,
+		repositoryTokenUsageList,
+		repositoryTokenUsageInsert,
+		repositoryProjectMemberSelect,
+		cfg.UsageQuotas,
+		cfg.Generation.ContextBudgets,
+	)
+	serviceSchemaSelect := services.NewSchemaSelect(
+		repositorySchemaSelect, repositorySchemaGenerationSelect, repositoryProjectSelect, repositoryProjectMemberSelect,
 	)
-	serviceSchemaSelect := services.NewSchemaSelect(repositorySchemaSelect, repositoryProjectSelect)
 	serviceSchemaRewrite := services.NewSchemaRewrite(
 		repositorySchemaUpdate,
 		repositoryProjectSelect,
 		repositorySchemaSelect,
+		repositoryModuleSelect,
+		repositoryProjectMemberSelect,
+	)
+	serviceSchemaListVersions := services.NewSchemaListVersions(
+		repositorySchemaListVersions,
+		repositorySchemaGenerationList,
+		repositoryProjectSelect,
+		repositoryProjectMemberSelect,
+	)
+	serviceSchemaGenerateEnqueue := services.NewSchemaGenerateEnqueue(
+		repositoryGenerationJobInsert,
+		repositoryProjectSelect,
+		repositoryModuleListVersions,
+		repositoryTokenUsageList,
+		repositoryProjectMemberSelect,
+		cfg.UsageQuotas,
+	)
+	serviceSchemaGenerateCandidates := services.NewSchemaGenerateCandidates(
+		repositoryModuleGenerate,
+		repositorySchemaList,
+		repositorySchemaCandidateInsert,
+		repositorySchemaGenerationInsert,
+		repositoryProjectSelect,
+		repositoryModuleSelect,
+		repositoryModuleListVersions,
+		repositoryTokenUsageList,
+		repositoryTokenUsageInsert,
+		repositoryProjectMemberSelect,
+		cfg.UsageQuotas,
+		cfg.Generation.ContextBudgets,
+	)
+	serviceSchemaCandidateList := services.NewSchemaCandidateList(
+		repositorySchemaCandidateList, repositoryProjectSelect, repositoryProjectMemberSelect,
+	)
+	serviceSchemaCandidatePromote := services.NewSchemaCandidatePromote(
+		repositorySchemaCandidateSelect,
+		repositorySchemaCandidateDelete,
+		repositorySchemaInsert,
+		repositoryProjectSelect,
+		repositoryProjectMemberSelect,
 	)
-	serviceSchemaListVersions := services.NewSchemaListVersions(repositorySchemaListVersions, repositoryProjectSelect)
 
-	// Unused for now, but available for system module loading
-	_ = serviceModuleCreate
+	serviceGenerationJobSelect := services.NewGenerationJobSelect(repositoryGenerationJobSelect)
+	serviceGenerationJobRun := services.NewGenerationJobRun(
+		repositoryGenerationJobClaim,
+		repositoryGenerationJobFinish,
+		serviceSchemaGenerate,
+	)
+
+	serviceUsageSelect := services.NewUsageSelect(repositoryTokenUsageList, cfg.UsageQuotas)
 
 	// =================================================================================================================
 	// MIDDLEWARES
@@ -133,19 +310,47 @@ func main() {
 	handlerPing := handlers.NewPing()
 	handlerHealth := handlers.NewHealth(jsonKeysClient)
 
+	handlerModuleCreate := handlers.NewModuleCreate(serviceModuleCreate, cfg.Logger)
 	handlerModuleSelect := handlers.NewModuleSelect(serviceModuleSelect, cfg.Logger)
+	handlerModuleSelectLLMSchema := handlers.NewModuleSelectLLMSchema(serviceModuleSelectLLMSchema, cfg.Logger)
+	handlerModuleDelete := handlers.NewModuleDelete(serviceModuleDelete, cfg.Logger)
 	handlerModuleListVersions := handlers.NewModuleListVersions(serviceModuleListVersions, cfg.Logger)
+	handlerModuleList := handlers.NewModuleList(serviceModuleList, cfg.Logger)
 
 	handlerProjectInit := handlers.NewProjectInit(serviceProjectInit, cfg.Logger)
 	handlerProjectDelete := handlers.NewProjectDelete(serviceProjectDelete, cfg.Logger)
 	handlerProjectList := handlers.NewProjectList(serviceProjectList, cfg.Logger)
 	handlerProjectUpdate := handlers.NewProjectUpdate(serviceProjectUpdate, cfg.Logger)
+	handlerProjectUpgradeModule := handlers.NewProjectUpgradeModule(serviceProjectUpgradeModule, cfg.Logger)
+	handlerProjectTranslate := handlers.NewProjectTranslate(serviceProjectTranslate, cfg.Logger)
+	handlerProjectFork := handlers.NewProjectFork(serviceProjectFork, cfg.Logger)
+	handlerProjectState := handlers.NewProjectState(serviceProjectState, cfg.Logger)
+	handlerProjectMemberList := handlers.NewProjectMemberList(serviceProjectMemberList, cfg.Logger)
+	handlerProjectMemberUpsert := handlers.NewProjectMemberUpsert(serviceProjectMemberUpsert, cfg.Logger)
+	handlerProjectMemberDelete := handlers.NewProjectMemberDelete(serviceProjectMemberDelete, cfg.Logger)
+	handlerProjectShareCreate := handlers.NewProjectShareCreate(serviceProjectShareCreate, cfg.Logger)
+	handlerProjectShareList := handlers.NewProjectShareList(serviceProjectShareList, cfg.Logger)
+	handlerProjectShareRevoke := handlers.NewProjectShareRevoke(serviceProjectShareRevoke, cfg.Logger)
+	handlerProjectShareSelect := handlers.NewProjectShareSelect(serviceProjectShareSelect, cfg.Logger)
+	handlerProjectSnapshotCreate := handlers.NewProjectSnapshotCreate(serviceProjectSnapshotCreate, cfg.Logger)
+	handlerProjectSnapshotList := handlers.NewProjectSnapshotList(serviceProjectSnapshotList, cfg.Logger)
+	handlerProjectSnapshotSelect := handlers.NewProjectSnapshotSelect(serviceProjectSnapshotSelect, cfg.Logger)
+	handlerProjectSnapshotRestore := handlers.NewProjectSnapshotRestore(serviceProjectSnapshotRestore, cfg.Logger)
 
 	handlerSchemaCreate := handlers.NewSchemaCreate(serviceSchemaCreate, cfg.Logger)
-	handlerSchemaGenerate := handlers.NewSchemaGenerate(serviceSchemaGenerate, cfg.Logger)
+	handlerSchemaGenerate := handlers.NewSchemaGenerate(
+		serviceSchemaGenerate, serviceSchemaGenerateEnqueue, serviceSchemaGenerateCandidates, cfg.Logger,
+	)
+	handlerSchemaGenerateStream := handlers.NewSchemaGenerateStream(serviceSchemaGenerate, cfg.Logger)
 	handlerSchemaSelect := handlers.NewSchemaSelect(serviceSchemaSelect, cfg.Logger)
 	handlerSchemaRewrite := handlers.NewSchemaRewrite(serviceSchemaRewrite, cfg.Logger)
 	handlerSchemaListVersions := handlers.NewSchemaListVersions(serviceSchemaListVersions, cfg.Logger)
+	handlerSchemaCandidateList := handlers.NewSchemaCandidateList(serviceSchemaCandidateList, cfg.Logger)
+	handlerSchemaCandidatePromote := handlers.NewSchemaCandidatePromote(serviceSchemaCandidatePromote, cfg.Logger)
+
+	handlerGenerationJobSelect := handlers.NewGenerationJobSelect(serviceGenerationJobSelect, cfg.Logger)
+
+	handlerUsageSelect := handlers.NewUsageSelect(serviceUsageSelect, cfg.Logger)
 
 	// =================================================================================================================
 	// ROUTER
@@ -155,7 +360,6 @@ func main() {
 
 	router.Use(middleware.Recoverer)
 	router.Use(middleware.RealIP)
-	router.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
 	router.Use(middleware.RequestSize(cfg.Api.MaxRequestSize))
 	router.Use(cfg.Otel.HttpHandler())
 	router.Use(cors.Handler(cors.Options{
@@ -174,29 +378,109 @@ func main() {
 	}))
 	router.Use(cfg.HttpLogger.Logger())
 
-	router.Get("/ping", handlerPing.ServeHTTP)
-	router.Get("/healthcheck", handlerHealth.ServeHTTP)
+	// Request timeouts are set per route group, as streaming endpoints need a longer one.
+	router.Group(func(r chi.Router) {
+		r.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
+
+		r.Get("/ping", handlerPing.ServeHTTP)
+		r.Get("/healthcheck", handlerHealth.ServeHTTP)
+	})
 
 	router.Route("/modules", func(r chi.Router) {
+		r.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
+
 		withAuth(r, "modules:get").Get("/", handlerModuleSelect.ServeHTTP)
+		withAuth(r, "modules:get").Get("/llm-schema", handlerModuleSelectLLMSchema.ServeHTTP)
 		withAuth(r, "modules:versions:list").Get("/versions", handlerModuleListVersions.ServeHTTP)
+		withAuth(r, "modules:list").Get("/catalog", handlerModuleList.ServeHTTP)
+		withAuth(r, "modules:create").Put("/", handlerModuleCreate.ServeHTTP)
+		withAuth(r, "modules:delete").Delete("/", handlerModuleDelete.ServeHTTP)
 	})
 
 	router.Route("/projects", func(r chi.Router) {
-		withAuth(r, "projects:list").Get("/", handlerProjectList.ServeHTTP)
-		withAuth(r, "projects:create").Put("/", handlerProjectInit.ServeHTTP)
-		withAuth(r, "projects:update").Patch("/", handlerProjectUpdate.ServeHTTP)
-		withAuth(r, "projects:delete").Delete("/", handlerProjectDelete.ServeHTTP)
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
+
+			withAuth(r, "projects:list").Get("/", handlerProjectList.ServeHTTP)
+			withAuth(r, "projects:create").Put("/", handlerProjectInit.ServeHTTP)
+			withAuth(r, "projects:update").Patch("/", handlerProjectUpdate.ServeHTTP)
+			withAuth(r, "projects:update").Post("/upgrade-module", handlerProjectUpgradeModule.ServeHTTP)
+			withAuth(r, "projects:fork").Post("/fork", handlerProjectFork.ServeHTTP)
+			withAuth(r, "projects:state:get").Get("/state", handlerProjectState.ServeHTTP)
+			withAuth(r, "projects:delete").Delete("/", handlerProjectDelete.ServeHTTP)
+			withAuth(r, "projects:members:list").Get("/members", handlerProjectMemberList.ServeHTTP)
+			withAuth(r, "projects:members:update").Put("/members", handlerProjectMemberUpsert.ServeHTTP)
+			withAuth(r, "projects:members:delete").Delete("/members", handlerProjectMemberDelete.ServeHTTP)
+			withAuth(r, "projects:shares:list").Get("/shares", handlerProjectShareList.ServeHTTP)
+			withAuth(r, "projects:shares:create").Put("/shares", handlerProjectShareCreate.ServeHTTP)
+			withAuth(r, "projects:shares:delete").Delete("/shares", handlerProjectShareRevoke.ServeHTTP)
+			withAuth(r, "projects:snapshots:list").Get("/snapshots", handlerProjectSnapshotList.ServeHTTP)
+			withAuth(r, "projects:snapshots:create").Put("/snapshots", handlerProjectSnapshotCreate.ServeHTTP)
+			withAuth(r, "projects:snapshots:get").Get("/snapshots/view", handlerProjectSnapshotSelect.ServeHTTP)
+			withAuth(r, "projects:snapshots:restore").Post("/snapshots/restore", handlerProjectSnapshotRestore.ServeHTTP)
+		})
+
+		// Translations prompt the model once per module, and take longer than regular requests.
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Timeout(cfg.Api.Timeouts.Stream))
+
+			withAuth(r, "projects:translate").Post("/translate", handlerProjectTranslate.ServeHTTP)
+		})
+	})
+
+	// Share links are read with their token alone, so the route is open to anonymous users.
+	router.Route("/shares", func(r chi.Router) {
+		r.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
+
+		withAuth(r, "shares:get").Get("/", handlerProjectShareSelect.ServeHTTP)
 	})
 
 	router.Route("/schemas", func(r chi.Router) {
-		withAuth(r, "schemas:get").Get("/", handlerSchemaSelect.ServeHTTP)
-		withAuth(r, "schemas:versions:list").Get("/versions", handlerSchemaListVersions.ServeHTTP)
-		withAuth(r, "schemas:create").Put("/", handlerSchemaCreate.ServeHTTP)
-		withAuth(r, "schemas:generate").Put("/generate", handlerSchemaGenerate.ServeHTTP)
-		withAuth(r, "schemas:rewrite").Patch("/", handlerSchemaRewrite.ServeHTTP)
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
+
+			withAuth(r, "schemas:get").Get("/", handlerSchemaSelect.ServeHTTP)
+			withAuth(r, "schemas:versions:list").Get("/versions", handlerSchemaListVersions.ServeHTTP)
+			withAuth(r, "schemas:create").Put("/", handlerSchemaCreate.ServeHTTP)
+			withAuth(r, "schemas:generate").Put("/generate", handlerSchemaGenerate.ServeHTTP)
+			withAuth(r, "schemas:rewrite").Patch("/", handlerSchemaRewrite.ServeHTTP)
+			withAuth(r, "schemas:candidates:list").Get("/candidates", handlerSchemaCandidateList.ServeHTTP)
+			withAuth(r, "schemas:candidates:promote").Post("/candidates/promote", handlerSchemaCandidatePromote.ServeHTTP)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Timeout(cfg.Api.Timeouts.Stream))
+
+			withAuth(r, "schemas:generate").Put("/generate/stream", handlerSchemaGenerateStream.ServeHTTP)
+		})
 	})
 
+	router.Route("/jobs", func(r chi.Router) {
+		r.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
+
+		withAuth(r, "jobs:get").Get("/", handlerGenerationJobSelect.ServeHTTP)
+	})
+
+	router.Route("/usage", func(r chi.Router) {
+		r.Use(middleware.Timeout(cfg.Api.Timeouts.Request))
+
+		withAuth(r, "usage:get").Get("/", handlerUsageSelect.ServeHTTP)
+	})
+
+	// =================================================================================================================
+	// WORKERS
+	// =================================================================================================================
+
+	// Generation jobs may also be processed by dedicated workers (cmd/worker), in which case in-process workers
+	// can be disabled.
+	for range cfg.GenerationJobs.InProcessWorkers {
+		go services.RunGenerationJobWorker(ctx, serviceGenerationJobRun, services.GenerationJobWorkerConfig{
+			PollInterval: cfg.GenerationJobs.PollInterval,
+			Timeout:      cfg.GenerationJobs.Timeout,
+			MaxAttempts:  cfg.GenerationJobs.MaxAttempts,
+		})
+	}
+
 	// =================================================================================================================
 	// RUN
 	// =================================================================================================================
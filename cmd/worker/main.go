@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/config/env"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+// Processes generation jobs queued through the rest api, until the process is stopped. Any number of worker
+// processes can run alongside each other, and alongside the in-process workers of the rest api.
+func main() {
+	cfg := config.AppPresetDefault
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	otel.SetAppName(cfg.App.Name)
+
+	lo.Must0(otel.Init(cfg.Otel))
+	defer cfg.Otel.Flush()
+
+	if env.GcloudProjectId == "" {
+		log.SetFlags(log.Flags() &^ (log.Ldate | log.Ltime))
+	}
+
+	// =================================================================================================================
+	// DEPENDENCIES
+	// =================================================================================================================
+
+	ctx = lo.Must(postgres.NewContext(ctx, cfg.Postgres))
+
+	completionProvider := lo.Must(lib.NewCompletionProvider(env.LlmProvider))
+
+	// =================================================================================================================
+	// DAO
+	// =================================================================================================================
+
+	repositoryModuleSelect := dao.NewModuleSelect()
+	repositoryModuleListVersions := dao.NewModuleListVersions()
+	repositoryModuleGenerate := dao.NewModuleGenerate(completionProvider)
+
+	repositoryProjectSelect := dao.NewProjectSelect()
+	repositoryProjectMemberSelect := dao.NewProjectMemberSelect()
+
+	repositorySchemaInsert := dao.NewSchemaInsert()
+	repositorySchemaList := dao.NewSchemaList()
+	repositorySchemaGenerationInsert := dao.NewSchemaGenerationInsert()
+
+	repositoryTokenUsageInsert := dao.NewTokenUsageInsert()
+	repositoryTokenUsageList := dao.NewTokenUsageList()
+
+	repositoryGenerationJobClaim := dao.NewGenerationJobClaim()
+	repositoryGenerationJobFinish := dao.NewGenerationJobFinish()
+
+	// =================================================================================================================
+	// SERVICES
+	// =================================================================================================================
+
+	serviceSchemaGenerate := services.NewSchemaGenerate(
+		repositoryModuleGenerate,
+		repositorySchemaList,
+		repositorySchemaInsert,
+		repositorySchemaGenerationInsert,
+		repositoryProjectSelect,
+		repositoryModuleSelect,
+		repositoryModuleListVersions,
+		repositoryTokenUsageList,
+		repositoryTokenUsageInsert,
+		repositoryProjectMemberSelect,
+		cfg.UsageQuotas,
+		cfg.Generation.ContextBudgets,
+	)
+	serviceGenerationJobRun := services.NewGenerationJobRun(
+		repositoryGenerationJobClaim,
+		repositoryGenerationJobFinish,
+		serviceSchemaGenerate,
+	)
+
+	// =================================================================================================================
+	// RUN
+	// =================================================================================================================
+
+	log.Printf("Starting %d generation workers\n", cfg.GenerationJobs.Workers)
+
+	var wg sync.WaitGroup
+
+	for range cfg.GenerationJobs.Workers {
+		wg.Go(func() {
+			services.RunGenerationJobWorker(ctx, serviceGenerationJobRun, services.GenerationJobWorkerConfig{
+				PollInterval: cfg.GenerationJobs.PollInterval,
+				Timeout:      cfg.GenerationJobs.Timeout,
+				MaxAttempts:  cfg.GenerationJobs.MaxAttempts,
+			})
+		})
+	}
+
+	// Running jobs are left as is, and claimed again by other workers once they time out.
+	wg.Wait()
+
+	log.Println("Generation workers stopped")
+}
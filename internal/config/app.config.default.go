@@ -43,6 +43,7 @@ var AppPresetDefault = App{
 			Write:      env.ApiTimeoutWrite,
 			Idle:       env.ApiTimeoutIdle,
 			Request:    env.ApiTimeoutRequest,
+			Stream:     env.ApiTimeoutStream,
 		},
 		Cors: Cors{
 			AllowedOrigins:   env.CorsAllowedOrigins,
@@ -52,6 +53,15 @@ var AppPresetDefault = App{
 		},
 	},
 
+	Generation: GenerationConfigDefault,
+	GenerationJobs: GenerationJobs{
+		Workers:          env.GenerationWorkers,
+		InProcessWorkers: env.GenerationInProcessWorkers,
+		PollInterval:     env.GenerationPollInterval,
+		Timeout:          env.GenerationJobTimeout,
+		MaxAttempts:      env.GenerationJobMaxAttempts,
+	},
+
 	DependenciesConfig: Dependencies{
 		ServiceJsonKeysPort: env.ServiceJsonKeysPort,
 		ServiceJsonKeysHost: env.ServiceJsonKeysHost,
@@ -64,6 +74,7 @@ var AppPresetDefault = App{
 		),
 	},
 	Permissions: PermissionsConfigDefault,
+	UsageQuotas: UsageQuotasConfigDefault,
 
 	Otel: lo.If[otel.Config](!env.Otel, &otelpresets.Disabled{}).
 		ElseIf(env.GcloudProjectId == "", &otelpresets.Local{
@@ -27,6 +27,7 @@ type APITimeouts struct {
 	Write      time.Duration `json:"write"      yaml:"write"`
 	Idle       time.Duration `json:"idle"       yaml:"idle"`
 	Request    time.Duration `json:"request"    yaml:"request"`
+	Stream     time.Duration `json:"stream"     yaml:"stream"`
 }
 
 type Cors struct {
@@ -43,12 +44,66 @@ type API struct {
 	Cors           Cors        `json:"cors"           yaml:"cors"`
 }
 
+type GenerationJobs struct {
+	// Workers is the number of workers run by dedicated worker processes.
+	Workers int `json:"workers"          yaml:"workers"`
+	// InProcessWorkers is the number of workers run alongside the rest api.
+	InProcessWorkers int           `json:"inProcessWorkers" yaml:"inProcessWorkers"`
+	PollInterval     time.Duration `json:"pollInterval"     yaml:"pollInterval"`
+	Timeout          time.Duration `json:"timeout"          yaml:"timeout"`
+	MaxAttempts      int           `json:"maxAttempts"      yaml:"maxAttempts"`
+}
+
+// GenerationContextBudgets sets the number of tokens the context of a generation can use, for each model.
+type GenerationContextBudgets struct {
+	// Default is the budget of models without a budget of their own. Zero means no limit.
+	Default int64            `json:"default" yaml:"default"`
+	Models  map[string]int64 `json:"models"  yaml:"models"`
+}
+
+// For returns the context budget of a model.
+func (budgets GenerationContextBudgets) For(model string) int64 {
+	budget, ok := budgets.Models[model]
+	if !ok {
+		return budgets.Default
+	}
+
+	return budget
+}
+
+type Generation struct {
+	ContextBudgets GenerationContextBudgets `json:"contextBudgets" yaml:"contextBudgets"`
+}
+
+// UsageQuota limits the number of tokens a user can spend on AI generation.
+type UsageQuota struct {
+	// Daily is the maximum number of tokens per day (UTC). Zero means no limit.
+	Daily int64 `json:"daily"   yaml:"daily"`
+	// Monthly is the maximum number of tokens per calendar month (UTC). Zero means no limit.
+	Monthly int64 `json:"monthly" yaml:"monthly"`
+}
+
+type UsageQuotasRole struct {
+	Inherits []string `json:"inherits" yaml:"inherits"`
+	// Quota of the role. Roles without a quota use the one of the roles they inherit from.
+	Quota *UsageQuota `json:"quota" yaml:"quota"`
+}
+
+// UsageQuotas sets a token quota for each role. It is read from the same file as the permissions, which it extends.
+type UsageQuotas struct {
+	Roles map[string]UsageQuotasRole `json:"roles" yaml:"roles"`
+}
+
 type App struct {
 	App Main `json:"app" yaml:"app"`
 	Api API  `json:"api" yaml:"api"`
 
+	Generation     Generation     `json:"generation"     yaml:"generation"`
+	GenerationJobs GenerationJobs `json:"generationJobs" yaml:"generationJobs"`
+
 	DependenciesConfig Dependencies        `json:"dependencies" yaml:"dependencies"`
 	Permissions        authpkg.Permissions `json:"permissions"  yaml:"permissions"`
+	UsageQuotas        UsageQuotas         `json:"usageQuotas"  yaml:"usageQuotas"`
 
 	Otel       otel.Config        `json:"otel"       yaml:"otel"`
 	Logger     logging.Log        `json:"logger"     yaml:"logger"`
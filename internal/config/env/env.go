@@ -29,9 +29,18 @@ const (
 	ApiTimeoutWriteDefault      = 30 * time.Second
 	ApiTimeoutIdleDefault       = 60 * time.Second
 	ApiTimeoutRequestDefault    = 60 * time.Second
+	ApiTimeoutStreamDefault     = 5 * time.Minute
 	ApiMaxRequestSizeDefault    = 2 << 20 // 2 MiB
 	CorsAllowCredentialsDefault = false
 	CorsMaxAgeDefault           = 3600
+
+	GenerationWorkersDefault          = 4
+	GenerationInProcessWorkersDefault = 1
+	GenerationPollIntervalDefault     = 2 * time.Second
+	GenerationJobTimeoutDefault       = 5 * time.Minute
+	GenerationJobMaxAttemptsDefault   = 3
+
+	LlmProviderDefault = "openai"
 )
 
 // Default values for environment variables, if applicable.
@@ -58,13 +67,22 @@ var (
 	apiTimeoutWrite      = getEnv("API_TIMEOUT_WRITE")
 	apiTimeoutIdle       = getEnv("API_TIMEOUT_IDLE")
 	apiTimeoutRequest    = getEnv("API_TIMEOUT_REQUEST")
+	apiTimeoutStream     = getEnv("API_TIMEOUT_STREAM")
 	corsAllowedOrigins   = getEnv("API_CORS_ALLOWED_ORIGINS")
 	corsAllowedHeaders   = getEnv("API_CORS_ALLOWED_HEADERS")
 	corsAllowCredentials = getEnv("API_CORS_ALLOW_CREDENTIALS")
 	corsMaxAge           = getEnv("API_CORS_MAX_AGE")
 
+	generationWorkers          = getEnv("GENERATION_WORKERS")
+	generationInProcessWorkers = getEnv("GENERATION_IN_PROCESS_WORKERS")
+	generationPollInterval     = getEnv("GENERATION_POLL_INTERVAL")
+	generationJobTimeout       = getEnv("GENERATION_JOB_TIMEOUT")
+	generationJobMaxAttempts   = getEnv("GENERATION_JOB_MAX_ATTEMPTS")
+
 	gcloudProjectId = getEnv("GCLOUD_PROJECT_ID")
 
+	llmProvider = getEnv("LLM_PROVIDER")
+
 	openAiToken   = getEnv("OPENAI_API_KEY")
 	openAiBaseUrl = getEnv("OPENAI_BASE_URL")
 	openAiModel   = getEnv("OPENAI_MODEL")
@@ -107,6 +125,7 @@ var (
 	ApiTimeoutWrite      = config.LoadEnv(apiTimeoutWrite, ApiTimeoutWriteDefault, config.DurationParser)
 	ApiTimeoutIdle       = config.LoadEnv(apiTimeoutIdle, ApiTimeoutIdleDefault, config.DurationParser)
 	ApiTimeoutRequest    = config.LoadEnv(apiTimeoutRequest, ApiTimeoutRequestDefault, config.DurationParser)
+	ApiTimeoutStream     = config.LoadEnv(apiTimeoutStream, ApiTimeoutStreamDefault, config.DurationParser)
 	CorsAllowedOrigins   = config.LoadEnv(
 		corsAllowedOrigins, CorsAllowedOriginsDefault, config.SliceParser(config.StringParser),
 	)
@@ -116,11 +135,32 @@ var (
 	CorsAllowCredentials = config.LoadEnv(corsAllowCredentials, CorsAllowCredentialsDefault, config.BoolParser)
 	CorsMaxAge           = config.LoadEnv(corsMaxAge, CorsMaxAgeDefault, config.IntParser)
 
+	// GenerationWorkers is the number of generation job workers run by the worker binary.
+	GenerationWorkers = config.LoadEnv(generationWorkers, GenerationWorkersDefault, config.IntParser)
+	// GenerationInProcessWorkers is the number of generation job workers run by the rest api server. Set it to 0
+	// when jobs are processed by dedicated workers.
+	GenerationInProcessWorkers = config.LoadEnv(
+		generationInProcessWorkers, GenerationInProcessWorkersDefault, config.IntParser,
+	)
+	GenerationPollInterval = config.LoadEnv(
+		generationPollInterval, GenerationPollIntervalDefault, config.DurationParser,
+	)
+	GenerationJobTimeout = config.LoadEnv(
+		generationJobTimeout, GenerationJobTimeoutDefault, config.DurationParser,
+	)
+	GenerationJobMaxAttempts = config.LoadEnv(
+		generationJobMaxAttempts, GenerationJobMaxAttemptsDefault, config.IntParser,
+	)
+
 	// GcloudProjectId configures the server for Google Cloud environment.
 	//
 	// See: https://docs.cloud.google.com/resource-manager/docs/creating-managing-projects
 	GcloudProjectId = gcloudProjectId
 
+	// LlmProvider selects the provider used to generate content. Supported values are "openai", and "fake", which
+	// generates deterministic content locally, without network access.
+	LlmProvider = config.LoadEnv(llmProvider, LlmProviderDefault, config.StringParser)
+
 	OpenAiBaseUrl = openAiBaseUrl
 	OpenAiModel   = openAiModel
 	OpenAiApiKey  = openAiToken
@@ -0,0 +1,14 @@
+package config
+
+import (
+	_ "embed"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/a-novel-kit/golib/config"
+)
+
+//go:embed generation.config.yaml
+var defaultGenerationFile []byte
+
+var GenerationConfigDefault = config.MustUnmarshal[Generation](yaml.Unmarshal, defaultGenerationFile)
@@ -14,3 +14,5 @@ import (
 var defaultPermissionsFile []byte
 
 var PermissionsConfigDefault = config.MustUnmarshal[authpkg.Permissions](yaml.Unmarshal, defaultPermissionsFile)
+
+var UsageQuotasConfigDefault = config.MustUnmarshal[UsageQuotas](yaml.Unmarshal, defaultPermissionsFile)
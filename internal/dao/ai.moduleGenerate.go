@@ -7,8 +7,8 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+	"time"
 
-	"github.com/openai/openai-go/v3"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -22,6 +22,22 @@ var moduleGeneratePrompt string
 
 var moduleGeneratePromptTemplate = template.Must(template.New("").Parse(moduleGeneratePrompt))
 
+//go:embed ai.moduleGenerateCorrection.prompt
+var moduleGenerateCorrectionPrompt string
+
+var moduleGenerateCorrectionPromptTemplate = template.Must(template.New("").Parse(moduleGenerateCorrectionPrompt))
+
+//go:embed ai.moduleGenerateInstructions.prompt
+var moduleGenerateInstructionsPrompt string
+
+var moduleGenerateInstructionsPromptTemplate = template.Must(template.New("").Parse(moduleGenerateInstructionsPrompt))
+
+// Prefixes of template versions, telling whether the default prompt or the one of the module was used.
+const (
+	ModuleGenerateTemplateDefault = "default"
+	ModuleGenerateTemplateModule  = "module"
+)
+
 type ModuleGenerateRequest struct {
 	Module *Module
 
@@ -29,15 +45,48 @@ type ModuleGenerateRequest struct {
 
 	Context   any
 	Prefilled map[string]any
+	// Instructions are free-text instructions from the user, to steer the generation. They are sent in their own
+	// message after the prompt, so they reach the model whatever the prompt template of the module.
+	Instructions string
+	// Corrections are the previous outputs of the model that were rejected, in order. Each of them is sent back to
+	// the model along with its errors, so it can fix them.
+	Corrections []*ModuleGenerateCorrection
+
+	// OnDelta, when set, streams the completion from the model. It receives each chunk of the raw JSON output, as
+	// soon as it is generated.
+	OnDelta func(delta string)
 }
 
-type ModuleGenerate struct{}
+// ModuleGenerateCorrection is a rejected output of the model.
+type ModuleGenerateCorrection struct {
+	Output map[string]any
+	Errors lib.JSONSchemaValidationErrors
+}
+
+// ModuleGeneration is the output of the model, along with what is needed to reproduce it.
+type ModuleGeneration struct {
+	Data map[string]any
+
+	// Model that generated the data.
+	Model string
+	// Messages sent to the model.
+	Messages []lib.CompletionMessage
+	// TemplateVersion identifies the prompt template used to build the messages, as "<default|module>:<hash>".
+	TemplateVersion string
+	Usage           lib.CompletionUsage
+	// Latency is the time spent waiting for the model.
+	Latency time.Duration
+}
 
-func NewModuleGenerate() *ModuleGenerate {
-	return new(ModuleGenerate)
+type ModuleGenerate struct {
+	provider lib.CompletionProvider
 }
 
-func (repository *ModuleGenerate) Exec(ctx context.Context, request *ModuleGenerateRequest) (map[string]any, error) {
+func NewModuleGenerate(provider lib.CompletionProvider) *ModuleGenerate {
+	return &ModuleGenerate{provider: provider}
+}
+
+func (repository *ModuleGenerate) Exec(ctx context.Context, request *ModuleGenerateRequest) (*ModuleGeneration, error) {
 	ctx, span := otel.Tracer().Start(ctx, "dao.ModuleGenerate")
 	defer span.End()
 
@@ -58,9 +107,22 @@ func (repository *ModuleGenerate) Exec(ctx context.Context, request *ModuleGener
 		return nil, otel.ReportError(span, fmt.Errorf("marshal prefilled: %w", err))
 	}
 
+	promptTemplate := moduleGeneratePromptTemplate
+	templateVersion := ModuleGenerateTemplateDefault + ":" + lib.ModulePromptVersion(moduleGeneratePrompt)
+	generation := lo.FromPtr(request.Module.Generation)
+
+	if generation.Prompt != "" {
+		promptTemplate, err = lib.ParseModulePrompt(generation.Prompt)
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("parse module prompt template: %w", err))
+		}
+
+		templateVersion = ModuleGenerateTemplateModule + ":" + lib.ModulePromptVersion(generation.Prompt)
+	}
+
 	userPrompt := new(strings.Builder)
 
-	err = moduleGeneratePromptTemplate.Execute(userPrompt, map[string]any{
+	err = promptTemplate.Execute(userPrompt, map[string]any{
 		"context":   string(strContext),
 		"prefilled": lo.Ternary[any](len(request.Prefilled) == 0, nil, string(strPrefilled)),
 	})
@@ -68,31 +130,138 @@ func (repository *ModuleGenerate) Exec(ctx context.Context, request *ModuleGener
 		return nil, otel.ReportError(span, fmt.Errorf("execute prompt template: %w", err))
 	}
 
-	res, err := lib.NewCompletion(ctx, request.Lang, openai.ChatCompletionNewParams{
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			openai.UserMessage(userPrompt.String()),
-		},
-		ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
-			OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
-				JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
-					Name:        request.Module.ID,
-					Schema:      request.Module.Schema,
-					Description: openai.String(request.Module.Description),
-					Strict:      openai.Bool(true),
-				},
-			},
-		},
+	systemMessage, err := lib.CompletionSystemMessage(request.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	messages := []lib.CompletionMessage{systemMessage}
+
+	if generation.Instructions != "" {
+		messages = append(messages, lib.CompletionMessage{
+			Role:    lib.CompletionRoleSystem,
+			Content: generation.Instructions,
+		})
+	}
+
+	messages = append(messages, lib.CompletionMessage{
+		Role:    lib.CompletionRoleUser,
+		Content: userPrompt.String(),
 	})
+
+	if request.Instructions != "" {
+		instructionsPrompt := new(strings.Builder)
+
+		err = moduleGenerateInstructionsPromptTemplate.Execute(instructionsPrompt, map[string]any{
+			"instructions": request.Instructions,
+		})
+		if err != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("execute instructions prompt template: %w", err))
+		}
+
+		messages = append(messages, lib.CompletionMessage{
+			Role:    lib.CompletionRoleUser,
+			Content: instructionsPrompt.String(),
+		})
+	}
+
+	for _, correction := range request.Corrections {
+		correctionMessages, err := moduleGenerateCorrectionMessages(correction)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		messages = append(messages, correctionMessages...)
+	}
+
+	completionRequest := &lib.CompletionRequest{
+		Model:             generation.Model,
+		Messages:          messages,
+		SchemaName:        request.Module.ID,
+		SchemaDescription: request.Module.Description,
+		Schema:            &request.Module.Schema,
+		Temperature:       generation.Temperature,
+		MaxTokens:         generation.MaxTokens,
+	}
+
+	span.SetAttributes(
+		attribute.Bool("request.module.generation.prompt", generation.Prompt != ""),
+		attribute.String("request.module.generation.model", generation.Model),
+		attribute.Bool("request.stream", request.OnDelta != nil),
+		attribute.Bool("request.instructions", request.Instructions != ""),
+		attribute.Int("request.corrections", len(request.Corrections)),
+	)
+
+	var res *lib.Completion
+
+	start := time.Now()
+
+	if request.OnDelta != nil {
+		res, err = repository.provider.CompleteStream(ctx, completionRequest, request.OnDelta)
+	} else {
+		res, err = repository.provider.Complete(ctx, completionRequest)
+	}
+
+	latency := time.Since(start)
+
 	if err != nil {
-		return nil, otel.ReportError(span, fmt.Errorf("generate completion: %w", err))
+		err = otel.ReportError(span, fmt.Errorf("generate completion: %w", err))
+
+		// Interrupted streams may still have spent tokens.
+		if res == nil {
+			return nil, err
+		}
+
+		return &ModuleGeneration{
+			Model:           res.Model,
+			Messages:        completionRequest.Messages,
+			TemplateVersion: templateVersion,
+			Usage:           res.Usage,
+			Latency:         latency,
+		}, err
 	}
 
+	span.SetAttributes(
+		attribute.String("response.model", res.Model),
+		attribute.Int64("response.usage.prompt_tokens", res.Usage.PromptTokens),
+		attribute.Int64("response.usage.completion_tokens", res.Usage.CompletionTokens),
+	)
+
 	var result map[string]any
 
-	err = json.Unmarshal([]byte(res.Choices[0].Message.Content), &result)
+	err = json.Unmarshal([]byte(res.Content), &result)
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("unmarshal completion result: %w", err))
 	}
 
-	return result, nil
+	return &ModuleGeneration{
+		Data:            result,
+		Model:           res.Model,
+		Messages:        completionRequest.Messages,
+		TemplateVersion: templateVersion,
+		Usage:           res.Usage,
+		Latency:         latency,
+	}, nil
+}
+
+// moduleGenerateCorrectionMessages replays a rejected output, followed by the errors the model must fix.
+func moduleGenerateCorrectionMessages(correction *ModuleGenerateCorrection) ([]lib.CompletionMessage, error) {
+	output, err := json.Marshal(correction.Output)
+	if err != nil {
+		return nil, fmt.Errorf("marshal rejected output: %w", err)
+	}
+
+	prompt := new(strings.Builder)
+
+	err = moduleGenerateCorrectionPromptTemplate.Execute(prompt, map[string]any{
+		"errors": correction.Errors,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute correction prompt template: %w", err)
+	}
+
+	return []lib.CompletionMessage{
+		{Role: lib.CompletionRoleAssistant, Content: string(output)},
+		{Role: lib.CompletionRoleUser, Content: prompt.String()},
+	}, nil
 }
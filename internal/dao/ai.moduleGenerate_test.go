@@ -14,6 +14,8 @@ import (
 
 	"github.com/a-novel/service-narrative-engine/internal/config"
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
 )
 
 // detectLanguage uses AI to detect the language of the given text.
@@ -160,9 +162,70 @@ func TestModuleGenerate(t *testing.T) {
 				require.Greater(t, len(premise), 10, "premise should be a meaningful description")
 			},
 		},
+		{
+			name: "Success/WithGenerationSettings",
+
+			request: &dao.ModuleGenerateRequest{
+				Module: &dao.Module{
+					ID:          testModule.ID,
+					Namespace:   testModule.Namespace,
+					Version:     testModule.Version,
+					Description: testModule.Description,
+					Schema:      testModule.Schema,
+					Generation: &models.ModuleGeneration{
+						Prompt:       "Write a story idea inspired by the following notes: {{.context}}",
+						Instructions: "The genre of the story must be exactly \"western\".",
+						Temperature:  lo.ToPtr(0.2),
+						MaxTokens:    lo.ToPtr[int64](1024),
+					},
+				},
+				Lang: "en",
+				Context: map[string]any{
+					"theme": "a lost gold mine",
+				},
+			},
+
+			validateResult: func(t *testing.T, result map[string]any) {
+				t.Helper()
+
+				require.NotNil(t, result)
+				require.Contains(t, result, "title")
+				require.Contains(t, result, "premise")
+
+				// Extra system instructions are taken into account.
+				genre, ok := result["genre"].(string)
+				require.True(t, ok, "genre should be a string")
+				require.Equal(t, "western", strings.ToLower(genre))
+			},
+		},
+		{
+			name: "Success/WithInstructions",
+
+			request: &dao.ModuleGenerateRequest{
+				Module: &testModule,
+				Lang:   "en",
+				Context: map[string]any{
+					"setting": "a haunted lighthouse",
+				},
+				Instructions: "The genre of the story must be exactly \"horror\".",
+			},
+
+			validateResult: func(t *testing.T, result map[string]any) {
+				t.Helper()
+
+				require.NotNil(t, result)
+				require.Contains(t, result, "title")
+				require.Contains(t, result, "premise")
+
+				// Instructions from the user are taken into account.
+				genre, ok := result["genre"].(string)
+				require.True(t, ok, "genre should be a string")
+				require.Equal(t, "horror", strings.ToLower(genre))
+			},
+		},
 	}
 
-	repository := dao.NewModuleGenerate()
+	repository := dao.NewModuleGenerate(lo.Must(lib.NewCompletionProvider(lib.CompletionProviderOpenAI)))
 
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -171,11 +234,89 @@ func TestModuleGenerate(t *testing.T) {
 			result, err := repository.Exec(ctx, testCase.request)
 
 			require.NoError(t, err)
-			testCase.validateResult(t, result)
+			testCase.validateResult(t, result.Data)
 		})
 	}
 }
 
+func TestModuleGenerate_Stream(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping AI-based tests in short mode")
+
+		return
+	}
+
+	testModule := dao.Module{
+		ID:          "test-idea",
+		Namespace:   "test",
+		Version:     "1.0.0",
+		Description: "A test module that generates a story idea.",
+		Schema: jsonschema.Schema{
+			Type:                 "object",
+			AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+			Properties: map[string]*jsonschema.Schema{
+				"title": {
+					Type:        "string",
+					Description: "A creative title for the story",
+					MaxLength:   lo.ToPtr(128),
+				},
+				"premise": {
+					Type:        "string",
+					Description: "A short premise for the story",
+					MaxLength:   lo.ToPtr(1024),
+				},
+			},
+			Required: []string{"title", "premise"},
+		},
+	}
+
+	repository := dao.NewModuleGenerate(lo.Must(lib.NewCompletionProvider(lib.CompletionProviderOpenAI)))
+
+	t.Run("Success", func(t *testing.T) {
+		ctx := context.Background()
+
+		var deltas []string
+
+		result, err := repository.Exec(ctx, &dao.ModuleGenerateRequest{
+			Module:  &testModule,
+			Lang:    "en",
+			Context: map[string]any{"theme": "space exploration"},
+			OnDelta: func(delta string) {
+				deltas = append(deltas, delta)
+			},
+		})
+		require.NoError(t, err)
+
+		// The output is streamed in multiple chunks, that add up to the final result.
+		require.Greater(t, len(deltas), 1)
+
+		var streamed map[string]any
+
+		require.NoError(t, json.Unmarshal([]byte(strings.Join(deltas, "")), &streamed))
+		require.Equal(t, result.Data, streamed)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		result, err := repository.Exec(ctx, &dao.ModuleGenerateRequest{
+			Module:  &testModule,
+			Lang:    "en",
+			Context: map[string]any{"theme": "space exploration"},
+			OnDelta: func(_ string) {
+				// Simulate a client that disconnects as soon as the stream starts.
+				cancel()
+			},
+		})
+		require.ErrorIs(t, err, context.Canceled)
+
+		// The tokens spent before the disconnection are reported, so they can be billed.
+		require.NotNil(t, result)
+		require.Nil(t, result.Data)
+		require.Positive(t, result.Usage.PromptTokens)
+	})
+}
+
 func TestModuleGenerate_Language(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping AI-based tests in short mode")
@@ -306,7 +447,7 @@ func TestModuleGenerate_Language(t *testing.T) {
 			"filename should remain in ASCII format without translation: %s", filename)
 	}
 
-	repository := dao.NewModuleGenerate()
+	repository := dao.NewModuleGenerate(lo.Must(lib.NewCompletionProvider(lib.CompletionProviderOpenAI)))
 
 	// Automatically test all known languages
 	for _, lang := range config.KnownLangs {
@@ -332,11 +473,152 @@ func TestModuleGenerate_Language(t *testing.T) {
 			require.NotNil(t, result)
 
 			// Log the result for debugging
-			resultJSON, _ := json.MarshalIndent(result, "", "  ")
+			resultJSON, _ := json.MarshalIndent(result.Data, "", "  ")
 			t.Logf("Generated result for lang=%s:\n%s", lang, string(resultJSON))
 
 			// Run validation
-			validateResult(t, result, lang)
+			validateResult(t, result.Data, lang)
 		})
 	}
 }
+
+func TestModuleGenerate_Fake(t *testing.T) {
+	t.Parallel()
+
+	testModule := dao.Module{
+		ID:          "test-idea",
+		Namespace:   "test",
+		Version:     "1.0.0",
+		Description: "A test module that generates a story idea.",
+		Schema: jsonschema.Schema{
+			Type:                 "object",
+			AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+			Properties: map[string]*jsonschema.Schema{
+				"title": {Type: "string", MaxLength: lo.ToPtr(128)},
+				"medium": {
+					Type: "string",
+					Enum: []any{"FILM", "NOVEL"},
+				},
+			},
+			Required: []string{"title", "medium"},
+		},
+	}
+
+	repository := dao.NewModuleGenerate(lib.NewFakeProvider())
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := repository.Exec(t.Context(), &dao.ModuleGenerateRequest{
+			Module:  &testModule,
+			Lang:    config.LangEN,
+			Context: map[string]any{"theme": "space exploration"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"title": "Example title", "medium": "FILM"}, result.Data)
+
+		// The generation can be traced back to the model and the prompt that produced it.
+		require.Equal(t, lib.CompletionProviderFake, result.Model)
+		require.Regexp(t, "^default:[0-9a-f]{12}$", result.TemplateVersion)
+		require.Len(t, result.Messages, 2)
+		require.Equal(t, lib.CompletionRoleSystem, result.Messages[0].Role)
+		require.Equal(t, lib.CompletionRoleUser, result.Messages[1].Role)
+		require.Contains(t, result.Messages[1].Content, "space exploration")
+		require.Positive(t, result.Usage.PromptTokens)
+		require.Positive(t, result.Usage.CompletionTokens)
+	})
+
+	t.Run("ModulePrompt", func(t *testing.T) {
+		t.Parallel()
+
+		module := testModule
+		module.Generation = &models.ModuleGeneration{Prompt: "Theme: {{.context}}"}
+
+		result, err := repository.Exec(t.Context(), &dao.ModuleGenerateRequest{
+			Module:  &module,
+			Lang:    config.LangEN,
+			Context: map[string]any{"theme": "space exploration"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "module:"+lib.ModulePromptVersion("Theme: {{.context}}"), result.TemplateVersion)
+		require.Equal(t, `Theme: {"theme":"space exploration"}`, result.Messages[1].Content)
+	})
+
+	t.Run("ModulePrompt/Instructions", func(t *testing.T) {
+		t.Parallel()
+
+		module := testModule
+		module.Generation = &models.ModuleGeneration{Prompt: "Theme: {{.context}}"}
+
+		result, err := repository.Exec(t.Context(), &dao.ModuleGenerateRequest{
+			Module:       &module,
+			Lang:         config.LangEN,
+			Context:      map[string]any{"theme": "space exploration"},
+			Instructions: "Make it about a lighthouse.",
+		})
+		require.NoError(t, err)
+
+		// The prompt of the module does not reference the instructions, so they are sent in their own message.
+		require.Len(t, result.Messages, 3)
+		require.Equal(t, `Theme: {"theme":"space exploration"}`, result.Messages[1].Content)
+		require.Equal(t, lib.CompletionRoleUser, result.Messages[2].Role)
+		require.Contains(t, result.Messages[2].Content, "Make it about a lighthouse.")
+	})
+
+	t.Run("Corrections", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := repository.Exec(t.Context(), &dao.ModuleGenerateRequest{
+			Module:  &testModule,
+			Lang:    config.LangEN,
+			Context: map[string]any{"theme": "space exploration"},
+			Corrections: []*dao.ModuleGenerateCorrection{
+				{
+					Output: map[string]any{"title": "Example title", "medium": "PAINTING"},
+					Errors: lib.JSONSchemaValidationErrors{
+						{Path: "/medium", Message: "enum: PAINTING does not equal any of: [FILM NOVEL]"},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// Rejected outputs are replayed, followed by the errors to fix.
+		require.Len(t, result.Messages, 4)
+		require.Equal(t, lib.CompletionRoleAssistant, result.Messages[2].Role)
+		require.JSONEq(t, `{"title":"Example title","medium":"PAINTING"}`, result.Messages[2].Content)
+		require.Equal(t, lib.CompletionRoleUser, result.Messages[3].Role)
+		require.Contains(t, result.Messages[3].Content, "- /medium: enum: PAINTING does not equal any of: [FILM NOVEL]")
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		t.Parallel()
+
+		var deltas []string
+
+		result, err := repository.Exec(t.Context(), &dao.ModuleGenerateRequest{
+			Module:  &testModule,
+			Lang:    config.LangEN,
+			Context: map[string]any{"theme": "space exploration"},
+			OnDelta: func(delta string) {
+				deltas = append(deltas, delta)
+			},
+		})
+		require.NoError(t, err)
+
+		var streamed map[string]any
+
+		require.NoError(t, json.Unmarshal([]byte(strings.Join(deltas, "")), &streamed))
+		require.Equal(t, result.Data, streamed)
+	})
+
+	t.Run("UnknownLang", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := repository.Exec(t.Context(), &dao.ModuleGenerateRequest{
+			Module: &testModule,
+			Lang:   "xx",
+		})
+		require.ErrorIs(t, err, lib.ErrUnknownChatCompletionLang)
+	})
+}
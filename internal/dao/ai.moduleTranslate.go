@@ -0,0 +1,142 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+//go:embed ai.moduleTranslate.prompt
+var moduleTranslatePrompt string
+
+var moduleTranslatePromptTemplate = template.Must(template.New("").Parse(moduleTranslatePrompt))
+
+// ModuleTranslateTemplate prefixes the template version of translations.
+const ModuleTranslateTemplate = "translate"
+
+type ModuleTranslateRequest struct {
+	// Module whose schema the output must conform to. It must be limited to the subset supported by structured
+	// outputs (see lib.JSONSchemaLLM).
+	Module *Module
+	// Lang is the language to translate the data into.
+	Lang string
+	Data map[string]any
+}
+
+// ModuleTranslate translates the data of a module into another language. The output is constrained by the schema
+// of the module, so its shape is the same as the source data.
+type ModuleTranslate struct {
+	provider lib.CompletionProvider
+}
+
+func NewModuleTranslate(provider lib.CompletionProvider) *ModuleTranslate {
+	return &ModuleTranslate{provider: provider}
+}
+
+func (repository *ModuleTranslate) Exec(
+	ctx context.Context, request *ModuleTranslateRequest,
+) (*ModuleGeneration, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ModuleTranslate")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("request.module.id", request.Module.ID),
+		attribute.String("request.module.namespace", request.Module.Namespace),
+		attribute.String("request.module.version", request.Module.Version),
+		attribute.String("request.lang", request.Lang),
+	)
+
+	strData, err := json.Marshal(request.Data)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("marshal data: %w", err))
+	}
+
+	userPrompt := new(strings.Builder)
+
+	err = moduleTranslatePromptTemplate.Execute(userPrompt, map[string]any{
+		"data": string(strData),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute prompt template: %w", err))
+	}
+
+	// The system message of the target language sets the language of the output.
+	systemMessage, err := lib.CompletionSystemMessage(request.Lang)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	generation := lo.FromPtr(request.Module.Generation)
+
+	// Generation instructions and temperature of the module are meant to write new content, so they are left out.
+	completionRequest := &lib.CompletionRequest{
+		Model: generation.Model,
+		Messages: []lib.CompletionMessage{
+			systemMessage,
+			{Role: lib.CompletionRoleUser, Content: userPrompt.String()},
+		},
+		SchemaName:        request.Module.ID,
+		SchemaDescription: request.Module.Description,
+		Schema:            &request.Module.Schema,
+		MaxTokens:         generation.MaxTokens,
+	}
+
+	span.SetAttributes(attribute.String("request.module.generation.model", generation.Model))
+
+	start := time.Now()
+
+	templateVersion := ModuleTranslateTemplate + ":" + lib.ModulePromptVersion(moduleTranslatePrompt)
+
+	res, err := repository.provider.Complete(ctx, completionRequest)
+	latency := time.Since(start)
+
+	if err != nil {
+		err = otel.ReportError(span, fmt.Errorf("generate completion: %w", err))
+
+		// Interrupted completions may still have spent tokens.
+		if res == nil {
+			return nil, err
+		}
+
+		return &ModuleGeneration{
+			Model:           res.Model,
+			Messages:        completionRequest.Messages,
+			TemplateVersion: templateVersion,
+			Usage:           res.Usage,
+			Latency:         latency,
+		}, err
+	}
+
+	span.SetAttributes(
+		attribute.String("response.model", res.Model),
+		attribute.Int64("response.usage.prompt_tokens", res.Usage.PromptTokens),
+		attribute.Int64("response.usage.completion_tokens", res.Usage.CompletionTokens),
+	)
+
+	var result map[string]any
+
+	err = json.Unmarshal([]byte(res.Content), &result)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("unmarshal completion result: %w", err))
+	}
+
+	return &ModuleGeneration{
+		Data:            result,
+		Model:           res.Model,
+		Messages:        completionRequest.Messages,
+		TemplateVersion: templateVersion,
+		Usage:           res.Usage,
+		Latency:         latency,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+)
+
+func TestModuleTranslate_Fake(t *testing.T) {
+	t.Parallel()
+
+	testModule := dao.Module{
+		ID:          "test-idea",
+		Namespace:   "test",
+		Version:     "1.0.0",
+		Description: "A test module that generates a story idea.",
+		Schema: jsonschema.Schema{
+			Type:                 "object",
+			AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+			Properties: map[string]*jsonschema.Schema{
+				"title": {Type: "string", MaxLength: lo.ToPtr(128)},
+			},
+			Required: []string{"title"},
+		},
+		Generation: &models.ModuleGeneration{
+			Instructions: "Write in a gloomy tone.",
+		},
+	}
+
+	repository := dao.NewModuleTranslate(lib.NewFakeProvider())
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := repository.Exec(t.Context(), &dao.ModuleTranslateRequest{
+			Module: &testModule,
+			Lang:   config.LangFR,
+			Data:   map[string]any{"title": "The lighthouse keeper"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"title": "Example title"}, result.Data)
+
+		require.Equal(t, lib.CompletionProviderFake, result.Model)
+		require.Regexp(t, "^translate:[0-9a-f]{12}$", result.TemplateVersion)
+
+		// Generation instructions of the module are not sent.
+		require.Len(t, result.Messages, 2)
+		require.Equal(t, lib.CompletionRoleSystem, result.Messages[0].Role)
+		require.Equal(t, lib.CompletionRoleUser, result.Messages[1].Role)
+		require.Contains(t, result.Messages[1].Content, `{"title":"The lighthouse keeper"}`)
+		require.Positive(t, result.Usage.PromptTokens)
+		require.Positive(t, result.Usage.CompletionTokens)
+	})
+
+	t.Run("UnknownLang", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := repository.Exec(t.Context(), &dao.ModuleTranslateRequest{
+			Module: &testModule,
+			Lang:   "xx",
+		})
+		require.ErrorIs(t, err, lib.ErrUnknownChatCompletionLang)
+	})
+}
@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// GenerationJobStatus represents the progress of a generation job.
+type GenerationJobStatus string
+
+const (
+	// GenerationJobStatusQueued jobs wait for a worker to claim them.
+	GenerationJobStatusQueued GenerationJobStatus = "QUEUED"
+	// GenerationJobStatusRunning jobs are being processed by a worker.
+	GenerationJobStatusRunning GenerationJobStatus = "RUNNING"
+	// GenerationJobStatusSucceeded jobs have created a schema.
+	GenerationJobStatusSucceeded GenerationJobStatus = "SUCCEEDED"
+	// GenerationJobStatusFailed jobs have stopped without creating a schema.
+	GenerationJobStatusFailed GenerationJobStatus = "FAILED"
+)
+
+func (status GenerationJobStatus) String() string {
+	return string(status)
+}
+
+// GenerationJob is a request to generate a schema, processed asynchronously by workers.
+type GenerationJob struct {
+	bun.BaseModel `bun:"table:generation_jobs"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// ProjectID is the project the schema is generated for.
+	ProjectID uuid.UUID `bun:"project_id,type:uuid"`
+	// Owner is the ID of the user who requested the generation.
+	Owner uuid.UUID `bun:"owner,type:uuid"`
+	// Module to generate, as requested. It may be a version range, which is resolved when the job runs.
+	Module string `bun:"module"`
+	// Lang of the generated content (ISO 639-1).
+	Lang string `bun:"lang"`
+	// Paths restricts the generation to the values they target (as JSON Pointers).
+	Paths []string `bun:"paths,array"`
+	// Instructions are free-text instructions from the user, to steer the generation.
+	Instructions string `bun:"instructions,nullzero"`
+	// Roles of the owner when the job was queued. They set the token quota enforced when the job runs.
+	Roles []string `bun:"roles,array"`
+
+	Status GenerationJobStatus `bun:"status,type:generation_job_status"`
+	// Attempts is the number of times the job was claimed by a worker.
+	Attempts int `bun:"attempts"`
+	// SchemaID is the schema created by a successful job.
+	SchemaID *uuid.UUID `bun:"schema_id,type:uuid"`
+	// Error is the reason a job failed.
+	Error string `bun:"error,nullzero"`
+
+	CreatedAt  time.Time  `bun:"created_at"`
+	UpdatedAt  time.Time  `bun:"updated_at"`
+	StartedAt  *time.Time `bun:"started_at"`
+	FinishedAt *time.Time `bun:"finished_at"`
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.generationJobClaim.sql
+var generationJobClaimQuery string
+
+var ErrGenerationJobClaimEmpty = errors.New("no generation job to claim")
+
+type GenerationJobClaimRequest struct {
+	// StaleBefore allows claiming running jobs that started before this date. Those jobs are considered abandoned
+	// by their worker.
+	StaleBefore time.Time
+	Now         time.Time
+}
+
+type GenerationJobClaim struct{}
+
+func NewGenerationJobClaim() *GenerationJobClaim {
+	return new(GenerationJobClaim)
+}
+
+// Exec marks the oldest pending job as running, and returns it. Jobs are locked while they are claimed, so
+// concurrent workers never claim the same job.
+func (repository *GenerationJobClaim) Exec(
+	ctx context.Context, request *GenerationJobClaimRequest,
+) (*GenerationJob, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.GenerationJobClaim")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("stale_before", request.StaleBefore.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(GenerationJob)
+
+	err = tx.NewRaw(generationJobClaimQuery, request.Now, request.StaleBefore).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrGenerationJobClaimEmpty)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("id", entity.ID.String()))
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,29 @@
+UPDATE generation_jobs
+SET
+  status = 'RUNNING',
+  attempts = attempts + 1,
+  started_at = ?0,
+  updated_at = ?0
+WHERE
+  -- Rows locked by another worker are being claimed. They are skipped, so workers never wait on each other.
+  id = (
+    SELECT
+      id
+    FROM
+      generation_jobs
+    WHERE
+      status = 'QUEUED'
+      -- Jobs that run for too long were abandoned by their worker.
+      OR (
+        status = 'RUNNING'
+        AND started_at < ?1
+      )
+    ORDER BY
+      created_at
+    LIMIT
+      1
+    FOR UPDATE
+      SKIP LOCKED
+  )
+RETURNING
+  *;
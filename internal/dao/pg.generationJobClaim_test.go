@@ -0,0 +1,160 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestGenerationJobClaim(t *testing.T) {
+	finishedJob := &dao.GenerationJob{
+		ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ProjectID:  uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+		Owner:      uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+		Module:     "agora:idea@v1.0.0",
+		Lang:       "en",
+		Status:     dao.GenerationJobStatusFailed,
+		Attempts:   1,
+		Error:      "foo",
+		CreatedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+		StartedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)),
+		FinishedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC)),
+	}
+
+	runningJob := &dao.GenerationJob{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+		Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+		Module:    "agora:idea@v1.0.0",
+		Lang:      "en",
+		Status:    dao.GenerationJobStatusRunning,
+		Attempts:  1,
+		CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		StartedAt: lo.ToPtr(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)),
+	}
+
+	queuedJob := &dao.GenerationJob{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+		Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+		Module:    "agora:idea@v1.0.0",
+		Lang:      "en",
+		Status:    dao.GenerationJobStatusQueued,
+		CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+
+	newerQueuedJob := &dao.GenerationJob{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+		Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+		Module:    "agora:idea@v1.0.0",
+		Lang:      "fr",
+		Status:    dao.GenerationJobStatusQueued,
+		CreatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.GenerationJob
+
+		request *dao.GenerationJobClaimRequest
+
+		expect    *dao.GenerationJob
+		expectErr error
+	}{
+		{
+			name: "Success/OldestQueued",
+
+			fixtures: []*dao.GenerationJob{finishedJob, runningJob, queuedJob, newerQueuedJob},
+
+			request: &dao.GenerationJobClaimRequest{
+				StaleBefore: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Now:         time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@v1.0.0",
+				Lang:      "en",
+				Status:    dao.GenerationJobStatusRunning,
+				Attempts:  1,
+				CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Success/StaleRunning",
+
+			fixtures: []*dao.GenerationJob{finishedJob, runningJob, queuedJob},
+
+			request: &dao.GenerationJobClaimRequest{
+				StaleBefore: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+				Now:         time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@v1.0.0",
+				Lang:      "en",
+				Status:    dao.GenerationJobStatusRunning,
+				Attempts:  2,
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+				StartedAt: lo.ToPtr(time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Error/Empty",
+
+			fixtures: []*dao.GenerationJob{finishedJob, runningJob},
+
+			request: &dao.GenerationJobClaimRequest{
+				StaleBefore: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Now:         time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrGenerationJobClaimEmpty,
+		},
+	}
+
+	repository := dao.NewGenerationJobClaim()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
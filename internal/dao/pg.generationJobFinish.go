@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.generationJobFinish.sql
+var generationJobFinishQuery string
+
+// ErrGenerationJobFinishNotFound is returned when the job does not exist, is not running, or was claimed again
+// by another worker.
+var ErrGenerationJobFinishNotFound = errors.New("running generation job not found")
+
+type GenerationJobFinishRequest struct {
+	ID uuid.UUID
+	// Attempts must match the attempts of the job, as returned when it was claimed.
+	Attempts int
+	// Status must be either GenerationJobStatusSucceeded or GenerationJobStatusFailed.
+	Status   GenerationJobStatus
+	SchemaID *uuid.UUID
+	Error    string
+	Now      time.Time
+}
+
+type GenerationJobFinish struct{}
+
+func NewGenerationJobFinish() *GenerationJobFinish {
+	return new(GenerationJobFinish)
+}
+
+func (repository *GenerationJobFinish) Exec(
+	ctx context.Context, request *GenerationJobFinishRequest,
+) (*GenerationJob, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.GenerationJobFinish")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.Int("attempts", request.Attempts),
+		attribute.String("status", request.Status.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(GenerationJob)
+
+	err = tx.NewRaw(
+		generationJobFinishQuery,
+		request.ID,
+		request.Attempts,
+		request.Status,
+		request.SchemaID,
+		bun.NullZero(request.Error),
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrGenerationJobFinishNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
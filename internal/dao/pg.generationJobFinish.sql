@@ -0,0 +1,14 @@
+UPDATE generation_jobs
+SET
+  status = ?2,
+  schema_id = ?3,
+  error = ?4,
+  finished_at = ?5,
+  updated_at = ?5
+WHERE
+  id = ?0
+  -- Only the last worker to claim the job can finish it.
+  AND attempts = ?1
+  AND status = 'RUNNING'
+RETURNING
+  *;
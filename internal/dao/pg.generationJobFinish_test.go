@@ -0,0 +1,173 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestGenerationJobFinish(t *testing.T) {
+	runningJob := &dao.GenerationJob{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+		Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+		Module:    "agora:idea@v1.0.0",
+		Lang:      "en",
+		Status:    dao.GenerationJobStatusRunning,
+		Attempts:  2,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC),
+		StartedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)),
+	}
+
+	queuedJob := &dao.GenerationJob{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+		Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+		Module:    "agora:idea@v1.0.0",
+		Lang:      "en",
+		Status:    dao.GenerationJobStatusQueued,
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.GenerationJob
+
+		request *dao.GenerationJobFinishRequest
+
+		expect    *dao.GenerationJob
+		expectErr error
+	}{
+		{
+			name: "Success/Succeeded",
+
+			fixtures: []*dao.GenerationJob{runningJob},
+
+			request: &dao.GenerationJobFinishRequest{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Attempts: 2,
+				Status:   dao.GenerationJobStatusSucceeded,
+				SchemaID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000001000")),
+				Now:      time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:  uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:      uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:     "agora:idea@v1.0.0",
+				Lang:       "en",
+				Status:     dao.GenerationJobStatusSucceeded,
+				Attempts:   2,
+				SchemaID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000001000")),
+				CreatedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+				StartedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)),
+				FinishedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Success/Failed",
+
+			fixtures: []*dao.GenerationJob{runningJob},
+
+			request: &dao.GenerationJobFinishRequest{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Attempts: 2,
+				Status:   dao.GenerationJobStatusFailed,
+				Error:    "foo",
+				Now:      time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:  uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:      uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:     "agora:idea@v1.0.0",
+				Lang:       "en",
+				Status:     dao.GenerationJobStatusFailed,
+				Attempts:   2,
+				Error:      "foo",
+				CreatedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+				StartedAt:  lo.ToPtr(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)),
+				FinishedAt: lo.ToPtr(time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "Error/ClaimedAgain",
+
+			fixtures: []*dao.GenerationJob{runningJob},
+
+			request: &dao.GenerationJobFinishRequest{
+				ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Attempts: 1,
+				Status:   dao.GenerationJobStatusSucceeded,
+				SchemaID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000001000")),
+				Now:      time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrGenerationJobFinishNotFound,
+		},
+		{
+			name: "Error/NotRunning",
+
+			fixtures: []*dao.GenerationJob{queuedJob},
+
+			request: &dao.GenerationJobFinishRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				Status: dao.GenerationJobStatusFailed,
+				Error:  "foo",
+				Now:    time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrGenerationJobFinishNotFound,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.GenerationJobFinishRequest{
+				ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Status: dao.GenerationJobStatusFailed,
+				Error:  "foo",
+				Now:    time.Date(2021, 1, 1, 0, 2, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrGenerationJobFinishNotFound,
+		},
+	}
+
+	repository := dao.NewGenerationJobFinish()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
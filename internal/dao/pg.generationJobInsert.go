@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.generationJobInsert.sql
+var generationJobInsertQuery string
+
+var ErrGenerationJobInsertAlreadyExists = errors.New("generation job already exists")
+
+type GenerationJobInsertRequest struct {
+	ID           uuid.UUID
+	ProjectID    uuid.UUID
+	Owner        uuid.UUID
+	Module       string
+	Lang         string
+	Paths        []string
+	Instructions string
+	Roles        []string
+	Now          time.Time
+}
+
+type GenerationJobInsert struct{}
+
+func NewGenerationJobInsert() *GenerationJobInsert {
+	return new(GenerationJobInsert)
+}
+
+// Exec queues a new generation job.
+func (repository *GenerationJobInsert) Exec(
+	ctx context.Context, request *GenerationJobInsertRequest,
+) (*GenerationJob, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.GenerationJobInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("owner", request.Owner.String()),
+		attribute.String("module", request.Module),
+		attribute.String("lang", request.Lang),
+		attribute.StringSlice("paths", request.Paths),
+		attribute.StringSlice("roles", request.Roles),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(GenerationJob)
+
+	err = tx.NewRaw(
+		generationJobInsertQuery,
+		request.ID,
+		request.ProjectID,
+		request.Owner,
+		request.Module,
+		request.Lang,
+		pgdialect.Array(request.Paths),
+		bun.NullZero(request.Instructions),
+		request.Now,
+		pgdialect.Array(request.Roles),
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrGenerationJobInsertAlreadyExists)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
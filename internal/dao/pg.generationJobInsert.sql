@@ -0,0 +1,18 @@
+INSERT INTO
+  generation_jobs (
+    id,
+    project_id,
+    owner,
+    module,
+    lang,
+    paths,
+    instructions,
+    roles,
+    status,
+    created_at,
+    updated_at
+  )
+VALUES
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?8, 'QUEUED', ?7, ?7)
+RETURNING
+  *;
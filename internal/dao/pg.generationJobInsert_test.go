@@ -0,0 +1,176 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestGenerationJobInsert(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.GenerationJob
+
+		request *dao.GenerationJobInsertRequest
+
+		expect    *dao.GenerationJob
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.GenerationJobInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@^1",
+				Lang:      "en",
+				Now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@^1",
+				Lang:      "en",
+				Status:    dao.GenerationJobStatusQueued,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Paths",
+
+			request: &dao.GenerationJobInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@^1",
+				Lang:      "en",
+				Paths:     []string{"/exploration/what_if", "/title"},
+				Now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@^1",
+				Lang:      "en",
+				Paths:     []string{"/exploration/what_if", "/title"},
+				Status:    dao.GenerationJobStatusQueued,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Instructions",
+
+			request: &dao.GenerationJobInsertRequest{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:    uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:        uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:       "agora:idea@^1",
+				Lang:         "en",
+				Instructions: "Keep it short.",
+				Now:          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:           uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:    uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:        uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:       "agora:idea@^1",
+				Lang:         "en",
+				Instructions: "Keep it short.",
+				Status:       dao.GenerationJobStatusQueued,
+				CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Roles",
+
+			request: &dao.GenerationJobInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@^1",
+				Lang:      "en",
+				Roles:     []string{"auth:user"},
+				Now:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.GenerationJob{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@^1",
+				Lang:      "en",
+				Roles:     []string{"auth:user"},
+				Status:    dao.GenerationJobStatusQueued,
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			fixtures: []*dao.GenerationJob{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					Module:    "agora:idea@v1.0.0",
+					Lang:      "en",
+					Status:    dao.GenerationJobStatusQueued,
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.GenerationJobInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Module:    "agora:idea@v1.0.0",
+				Lang:      "en",
+				Now:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrGenerationJobInsertAlreadyExists,
+		},
+	}
+
+	repository := dao.NewGenerationJobInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.generationJobSelect.sql
+var generationJobSelectQuery string
+
+var ErrGenerationJobSelectNotFound = errors.New("generation job not found")
+
+type GenerationJobSelectRequest struct {
+	ID uuid.UUID
+}
+
+type GenerationJobSelect struct{}
+
+func NewGenerationJobSelect() *GenerationJobSelect {
+	return new(GenerationJobSelect)
+}
+
+func (repository *GenerationJobSelect) Exec(
+	ctx context.Context, request *GenerationJobSelectRequest,
+) (*GenerationJob, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.GenerationJobSelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", request.ID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(GenerationJob)
+
+	err = tx.NewRaw(generationJobSelectQuery, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrGenerationJobSelectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  generation_jobs
+WHERE
+  id = ?0;
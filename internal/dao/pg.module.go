@@ -30,6 +30,13 @@ type Module struct {
 	Schema jsonschema.Schema `bun:"schema,type:json"`
 	// UI definition to interact with the module.
 	UI models.ModuleUi `bun:"ui,type:json"`
+	// Migrations carry data written for earlier versions of the module forward, when a project upgrades to this
+	// version.
+	Migrations []models.ModuleMigration `bun:"migrations,type:json"`
+	// DependsOn lists the modules whose data is sent as context when generating content for the module.
+	DependsOn []models.ModuleDependency `bun:"depends_on,type:json"`
+	// Generation overrides the default settings used to generate content for the module.
+	Generation *models.ModuleGeneration `bun:"generation,type:json"`
 
 	CreatedAt time.Time `bun:"created_at"`
 }
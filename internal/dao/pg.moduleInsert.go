@@ -30,6 +30,9 @@ type ModuleInsertRequest struct {
 	Description string
 	Schema      jsonschema.Schema
 	UI          models.ModuleUi
+	Migrations  []models.ModuleMigration
+	DependsOn   []models.ModuleDependency
+	Generation  *models.ModuleGeneration
 	Now         time.Time
 }
 
@@ -66,6 +69,9 @@ func (repository *ModuleInsert) Exec(ctx context.Context, request *ModuleInsertR
 		request.Description,
 		request.Schema,
 		request.UI,
+		request.Migrations,
+		request.DependsOn,
+		request.Generation,
 		request.Now,
 	).Scan(ctx, entity)
 	if err != nil {
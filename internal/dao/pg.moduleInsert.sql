@@ -7,9 +7,12 @@ INSERT INTO
     description,
     schema,
     ui,
+    migrations,
+    depends_on,
+    generation,
     created_at
   )
 VALUES
-  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7)
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10)
 RETURNING
   *;
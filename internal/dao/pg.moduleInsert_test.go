@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -94,6 +95,111 @@ func TestModuleInsert(t *testing.T) {
 				CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "Success/WithMigrations",
+
+			request: &dao.ModuleInsertRequest{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "2.0.0",
+				Preversion:  "",
+				Description: "A test module",
+				Schema:      testSchema,
+				UI:          testUi,
+				Migrations: []models.ModuleMigration{
+					{Op: models.ModuleMigrationOpRename, Path: "/name", To: "field1"},
+					{Op: models.ModuleMigrationOpDefault, Path: "/field1", Value: "default"},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "2.0.0",
+				Preversion:  "",
+				Description: "A test module",
+				Schema:      testSchema,
+				UI:          testUi,
+				Migrations: []models.ModuleMigration{
+					{Op: models.ModuleMigrationOpRename, Path: "/name", To: "field1"},
+					{Op: models.ModuleMigrationOpDefault, Path: "/field1", Value: "default"},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/WithDependencies",
+
+			request: &dao.ModuleInsertRequest{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.1.0",
+				Preversion:  "",
+				Description: "A test module",
+				Schema:      testSchema,
+				UI:          testUi,
+				DependsOn: []models.ModuleDependency{
+					{Module: "test-namespace:other-module", Paths: []string{"/field1"}},
+					{Module: "test-namespace:third-module"},
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.1.0",
+				Preversion:  "",
+				Description: "A test module",
+				Schema:      testSchema,
+				UI:          testUi,
+				DependsOn: []models.ModuleDependency{
+					{Module: "test-namespace:other-module", Paths: []string{"/field1"}},
+					{Module: "test-namespace:third-module"},
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/WithGeneration",
+
+			request: &dao.ModuleInsertRequest{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.1.0",
+				Preversion:  "",
+				Description: "A test module",
+				Schema:      testSchema,
+				UI:          testUi,
+				Generation: &models.ModuleGeneration{
+					Prompt:       "Context: {{.context}}",
+					Instructions: "Keep it short.",
+					Model:        "gpt-4o-mini",
+					Temperature:  lo.ToPtr(0.5),
+					MaxTokens:    lo.ToPtr[int64](1024),
+				},
+				Now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.1.0",
+				Preversion:  "",
+				Description: "A test module",
+				Schema:      testSchema,
+				UI:          testUi,
+				Generation: &models.ModuleGeneration{
+					Prompt:       "Context: {{.context}}",
+					Instructions: "Keep it short.",
+					Model:        "gpt-4o-mini",
+					Temperature:  lo.ToPtr(0.5),
+					MaxTokens:    lo.ToPtr[int64](1024),
+				},
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
 		{
 			name: "Success/DifferentVersionSameID",
 
@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.moduleList.sql
+var moduleListQuery string
+
+type ModuleListRequest struct {
+	// Namespace restricts the results to a single namespace. When empty, all namespaces are listed.
+	Namespace string
+	// Query performs a case-insensitive search over the description of each module.
+	Query  string
+	Limit  int
+	Offset int
+}
+
+// ModuleSummary is a lightweight representation of the latest stable version of a module.
+type ModuleSummary struct {
+	ID          string    `bun:"id"`
+	Namespace   string    `bun:"namespace"`
+	Version     string    `bun:"version"`
+	Description string    `bun:"description"`
+	CreatedAt   time.Time `bun:"created_at"`
+}
+
+type ModuleList struct{}
+
+func NewModuleList() *ModuleList {
+	return new(ModuleList)
+}
+
+func (repository *ModuleList) Exec(ctx context.Context, request *ModuleListRequest) ([]*ModuleSummary, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ModuleList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("namespace", request.Namespace),
+		attribute.String("data.query", request.Query),
+		attribute.Int("data.limit", request.Limit),
+		attribute.Int("data.offset", request.Offset),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var modules []*ModuleSummary
+
+	err = tx.NewRaw(
+		moduleListQuery,
+		request.Namespace,
+		request.Query,
+		bun.NullZero(request.Limit),
+		request.Offset,
+	).Scan(ctx, &modules)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	if modules == nil {
+		modules = []*ModuleSummary{}
+	}
+
+	return otel.ReportSuccess(span, modules), nil
+}
@@ -0,0 +1,37 @@
+SELECT
+  *
+FROM
+  (
+    SELECT DISTINCT
+      ON (namespace, id) id,
+      namespace,
+      version,
+      description,
+      created_at
+    FROM
+      modules
+    WHERE
+      -- Only stable versions are listed in the catalog.
+      preversion = ''
+    ORDER BY
+      namespace,
+      id,
+      string_to_array(version, '.')::int[] DESC
+  ) AS latest_modules
+WHERE
+  (
+    namespace = ?0
+    OR ?0 = ''
+  )
+  -- Case-insensitive search over the description of the latest version.
+  AND (
+    strpos(lower(description), lower(?1)) > 0
+    OR ?1 = ''
+  )
+ORDER BY
+  namespace,
+  id
+LIMIT
+  ?2
+OFFSET
+  ?3;
@@ -0,0 +1,256 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+)
+
+func TestModuleList(t *testing.T) {
+	testSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"field1": {
+				Type: "string",
+			},
+		},
+		Required: []string{"field1"},
+	}
+
+	testUi := models.ModuleUi{
+		Component: "input",
+		Params: models.ModuleUiParams{
+			"placeholder": "Enter value",
+		},
+		Target: "field1",
+	}
+
+	fixtures := []*dao.Module{
+		{
+			ID:          "idea",
+			Namespace:   "agora",
+			Version:     "1.0.0",
+			Description: "Generate an idea for a story",
+			Schema:      testSchema,
+			UI:          testUi,
+			CreatedAt:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "idea",
+			Namespace:   "agora",
+			Version:     "1.10.0",
+			Description: "Generate a creative idea for a story",
+			Schema:      testSchema,
+			UI:          testUi,
+			CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "idea",
+			Namespace:   "agora",
+			Version:     "1.2.0",
+			Description: "Outdated description",
+			Schema:      testSchema,
+			UI:          testUi,
+			CreatedAt:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "idea",
+			Namespace:   "agora",
+			Version:     "2.0.0",
+			Preversion:  "-beta-1",
+			Description: "Preversions are not listed",
+			Schema:      testSchema,
+			UI:          testUi,
+			CreatedAt:   time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "character",
+			Namespace:   "agora",
+			Version:     "1.0.0",
+			Description: "Describe the main CHARACTER of a story",
+			Schema:      testSchema,
+			UI:          testUi,
+			CreatedAt:   time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "draft",
+			Namespace:   "agora",
+			Version:     "0.1.0",
+			Preversion:  "-alpha",
+			Description: "Modules with only preversions are not listed",
+			Schema:      testSchema,
+			UI:          testUi,
+			CreatedAt:   time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "idea",
+			Namespace:   "custom",
+			Version:     "3.0.0",
+			Description: "A custom idea module",
+			Schema:      testSchema,
+			UI:          testUi,
+			CreatedAt:   time.Date(2021, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Module
+
+		request *dao.ModuleListRequest
+
+		expect    []*dao.ModuleSummary
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+
+			request: &dao.ModuleListRequest{},
+
+			expect: []*dao.ModuleSummary{
+				{
+					ID:          "character",
+					Namespace:   "agora",
+					Version:     "1.0.0",
+					Description: "Describe the main CHARACTER of a story",
+					CreatedAt:   time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:          "idea",
+					Namespace:   "agora",
+					Version:     "1.10.0",
+					Description: "Generate a creative idea for a story",
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:          "idea",
+					Namespace:   "custom",
+					Version:     "3.0.0",
+					Description: "A custom idea module",
+					CreatedAt:   time.Date(2021, 1, 7, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/Namespace",
+
+			fixtures: fixtures,
+
+			request: &dao.ModuleListRequest{
+				Namespace: "custom",
+			},
+
+			expect: []*dao.ModuleSummary{
+				{
+					ID:          "idea",
+					Namespace:   "custom",
+					Version:     "3.0.0",
+					Description: "A custom idea module",
+					CreatedAt:   time.Date(2021, 1, 7, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/Query",
+
+			fixtures: fixtures,
+
+			request: &dao.ModuleListRequest{
+				Query: "Character",
+			},
+
+			expect: []*dao.ModuleSummary{
+				{
+					ID:          "character",
+					Namespace:   "agora",
+					Version:     "1.0.0",
+					Description: "Describe the main CHARACTER of a story",
+					CreatedAt:   time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/QueryOnlyMatchesLatestDescription",
+
+			fixtures: fixtures,
+
+			request: &dao.ModuleListRequest{
+				Query: "outdated",
+			},
+
+			expect: []*dao.ModuleSummary{},
+		},
+		{
+			name: "Success/QueryWildcardsAreLiteral",
+
+			fixtures: fixtures,
+
+			request: &dao.ModuleListRequest{
+				Query: "%",
+			},
+
+			expect: []*dao.ModuleSummary{},
+		},
+		{
+			name: "Success/Pagination",
+
+			fixtures: fixtures,
+
+			request: &dao.ModuleListRequest{
+				Limit:  1,
+				Offset: 1,
+			},
+
+			expect: []*dao.ModuleSummary{
+				{
+					ID:          "idea",
+					Namespace:   "agora",
+					Version:     "1.10.0",
+					Description: "Generate a creative idea for a story",
+					CreatedAt:   time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &dao.ModuleListRequest{},
+
+			expect: []*dao.ModuleSummary{},
+		},
+	}
+
+	repository := dao.NewModuleList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				modules, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, modules)
+			})
+		})
+	}
+}
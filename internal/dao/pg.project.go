@@ -21,6 +21,8 @@ type Project struct {
 	Title string `bun:"title"`
 	// Workflow is a list of module strings that define the project's workflow.
 	Workflow []string `bun:"workflow,array"`
+	// ForkedFrom is the ID of the project this one was forked from, if any.
+	ForkedFrom *uuid.UUID `bun:"forked_from,type:uuid"`
 
 	CreatedAt time.Time `bun:"created_at"`
 	UpdatedAt time.Time `bun:"updated_at"`
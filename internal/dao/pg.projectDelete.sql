@@ -3,6 +3,31 @@ DELETE FROM schemas
 WHERE
   project_id = ?0;
 
+-- Delete the pending schema candidates of this project
+DELETE FROM schema_candidates
+WHERE
+  project_id = ?0;
+
+-- Delete the generation records of this project
+DELETE FROM schema_generations
+WHERE
+  project_id = ?0;
+
+-- Revoke the access of the members of this project
+DELETE FROM project_members
+WHERE
+  project_id = ?0;
+
+-- Revoke the share links of this project
+DELETE FROM project_shares
+WHERE
+  project_id = ?0;
+
+-- Delete the snapshots of this project
+DELETE FROM project_snapshots
+WHERE
+  project_id = ?0;
+
 -- Delete the project and return it
 DELETE FROM projects
 WHERE
@@ -27,7 +27,9 @@ type ProjectInsertRequest struct {
 	Lang     string
 	Title    string
 	Workflow []string
-	Now      time.Time
+	// ForkedFrom is the ID of the project this one is forked from, if any.
+	ForkedFrom *uuid.UUID
+	Now        time.Time
 }
 
 type ProjectInsert struct{}
@@ -63,6 +65,7 @@ func (repository *ProjectInsert) Exec(ctx context.Context, request *ProjectInser
 		pgdialect.Array(request.Workflow),
 		request.Now,
 		request.Now,
+		request.ForkedFrom,
 	).Scan(ctx, entity)
 	if err != nil {
 		var pgErr pgdriver.Error
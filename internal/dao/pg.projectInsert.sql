@@ -6,9 +6,10 @@ INSERT INTO
     title,
     workflow,
     created_at,
-    updated_at
+    updated_at,
+    forked_from
   )
 VALUES
-  (?0, ?1, ?2, ?3, ?4::text[], ?5, ?6)
+  (?0, ?1, ?2, ?3, ?4::text[], ?5, ?6, ?7)
 RETURNING
   *;
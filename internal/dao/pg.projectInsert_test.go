@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -47,6 +48,30 @@ func TestProjectInsert(t *testing.T) {
 				UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "Success/Fork",
+
+			request: &dao.ProjectInsertRequest{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Owner:      uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Lang:       "en",
+				Title:      "Test Project",
+				Workflow:   []string{"agora:idea@v1.0.0"},
+				ForkedFrom: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Now:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Project{
+				ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				Owner:      uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Lang:       "en",
+				Title:      "Test Project",
+				Workflow:   []string{"agora:idea@v1.0.0"},
+				ForkedFrom: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				CreatedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
 		{
 			name: "Error/AlreadyExists",
 
@@ -17,7 +17,8 @@ import (
 var projectListQuery string
 
 type ProjectListRequest struct {
-	Owner  uuid.UUID
+	// UserID lists the projects owned by this user, and those shared with them.
+	UserID uuid.UUID
 	Limit  int
 	Offset int
 }
@@ -33,7 +34,7 @@ func (repository *ProjectList) Exec(ctx context.Context, request *ProjectListReq
 	defer span.End()
 
 	span.SetAttributes(
-		attribute.String("owner", request.Owner.String()),
+		attribute.String("user_id", request.UserID.String()),
 		attribute.Int("data.limit", request.Limit),
 		attribute.Int("data.offset", request.Offset),
 	)
@@ -47,7 +48,7 @@ func (repository *ProjectList) Exec(ctx context.Context, request *ProjectListReq
 
 	err = tx.NewRaw(
 		projectListQuery,
-		request.Owner,
+		request.UserID,
 		bun.NullZero(request.Limit),
 		request.Offset,
 	).Scan(ctx, &projects)
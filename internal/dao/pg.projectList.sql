@@ -4,6 +4,14 @@ FROM
   projects
 WHERE
   owner = ?0
+  OR id IN (
+    SELECT
+      project_id
+    FROM
+      project_members
+    WHERE
+      user_id = ?0
+  )
 ORDER BY
   created_at DESC
 LIMIT
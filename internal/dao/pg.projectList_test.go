@@ -22,6 +22,7 @@ func TestProjectList(t *testing.T) {
 		name string
 
 		fixtures []*dao.Project
+		members  []*dao.ProjectMember
 
 		request *dao.ProjectListRequest
 
@@ -62,7 +63,7 @@ func TestProjectList(t *testing.T) {
 			},
 
 			request: &dao.ProjectListRequest{
-				Owner: owner1,
+				UserID: owner1,
 			},
 
 			expect: []*dao.Project{
@@ -111,8 +112,8 @@ func TestProjectList(t *testing.T) {
 			},
 
 			request: &dao.ProjectListRequest{
-				Owner: owner1,
-				Limit: 1,
+				UserID: owner1,
+				Limit:  1,
 			},
 
 			expect: []*dao.Project{
@@ -152,7 +153,7 @@ func TestProjectList(t *testing.T) {
 			},
 
 			request: &dao.ProjectListRequest{
-				Owner:  owner1,
+				UserID: owner1,
 				Offset: 1,
 			},
 
@@ -168,11 +169,78 @@ func TestProjectList(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success/Shared",
+
+			fixtures: []*dao.Project{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Owner:     owner1,
+					Lang:      "en",
+					Title:     "Project 1",
+					Workflow:  []string{},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:     owner2,
+					Lang:      "fr",
+					Title:     "Project 2",
+					Workflow:  []string{},
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Owner:     owner2,
+					Lang:      "fr",
+					Title:     "Project 3",
+					Workflow:  []string{},
+					CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			members: []*dao.ProjectMember{
+				{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    owner1,
+					Role:      dao.ProjectMemberRoleViewer,
+					CreatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.ProjectListRequest{
+				UserID: owner1,
+			},
+
+			expect: []*dao.Project{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:     owner2,
+					Lang:      "fr",
+					Title:     "Project 2",
+					Workflow:  []string{},
+					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Owner:     owner1,
+					Lang:      "en",
+					Title:     "Project 1",
+					Workflow:  []string{},
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
 		{
 			name: "Success/EmptyResult",
 
 			request: &dao.ProjectListRequest{
-				Owner: owner1,
+				UserID: owner1,
 			},
 
 			expect: []*dao.Project{},
@@ -194,6 +262,11 @@ func TestProjectList(t *testing.T) {
 					require.NoError(t, err)
 				}
 
+				if len(testCase.members) > 0 {
+					_, err = db.NewInsert().Model(&testCase.members).Exec(ctx)
+					require.NoError(t, err)
+				}
+
 				res, err := repository.Exec(ctx, testCase.request)
 				require.ErrorIs(t, err, testCase.expectErr)
 				require.Equal(t, testCase.expect, res)
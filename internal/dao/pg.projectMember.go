@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ProjectMemberRole is the access level of a member on a project. Each role includes the permissions of the roles
+// before it.
+type ProjectMemberRole string
+
+const (
+	// ProjectMemberRoleViewer members can read the project.
+	ProjectMemberRoleViewer ProjectMemberRole = "VIEWER"
+	// ProjectMemberRoleEditor members can also write schemas.
+	ProjectMemberRoleEditor ProjectMemberRole = "EDITOR"
+	// ProjectMemberRoleGenerator members can also generate schemas with AI, using their own token quota.
+	ProjectMemberRoleGenerator ProjectMemberRole = "GENERATOR"
+	// ProjectMemberRoleAdmin members can also update the project, and manage its members.
+	ProjectMemberRoleAdmin ProjectMemberRole = "ADMIN"
+)
+
+func (role ProjectMemberRole) String() string {
+	return string(role)
+}
+
+// ProjectMember is a user a project is shared with.
+type ProjectMember struct {
+	bun.BaseModel `bun:"table:project_members"`
+
+	ProjectID uuid.UUID `bun:"project_id,pk,type:uuid"`
+	UserID    uuid.UUID `bun:"user_id,pk,type:uuid"`
+
+	Role ProjectMemberRole `bun:"role,type:project_member_role"`
+
+	CreatedAt time.Time `bun:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at"`
+}
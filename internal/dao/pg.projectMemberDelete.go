@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectMemberDelete.sql
+var projectMemberDeleteQuery string
+
+var ErrProjectMemberDeleteNotFound = errors.New("project member not found")
+
+type ProjectMemberDeleteRequest struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+}
+
+type ProjectMemberDelete struct{}
+
+func NewProjectMemberDelete() *ProjectMemberDelete {
+	return new(ProjectMemberDelete)
+}
+
+// Exec revokes the access of a member to a project, and returns the removed member.
+func (repository *ProjectMemberDelete) Exec(
+	ctx context.Context, request *ProjectMemberDeleteRequest,
+) (*ProjectMember, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectMemberDelete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("user_id", request.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectMember)
+
+	err = tx.NewRaw(projectMemberDeleteQuery, request.ProjectID, request.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrProjectMemberDeleteNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
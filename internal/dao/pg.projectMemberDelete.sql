@@ -0,0 +1,6 @@
+DELETE FROM project_members
+WHERE
+  project_id = ?0
+  AND user_id = ?1
+RETURNING
+  *;
@@ -0,0 +1,105 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestProjectMemberDelete(t *testing.T) {
+	fixtures := []*dao.ProjectMember{
+		{
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			Role:      dao.ProjectMemberRoleEditor,
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000102"),
+			Role:      dao.ProjectMemberRoleViewer,
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000020"),
+			UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000101"),
+			Role:      dao.ProjectMemberRoleAdmin,
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			UpdatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.ProjectMember
+
+		request *dao.ProjectMemberDeleteRequest
+
+		expect    *dao.ProjectMember
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectMemberDeleteRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000102"),
+			},
+
+			expect: &dao.ProjectMember{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000102"),
+				Role:      dao.ProjectMemberRoleViewer,
+				CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectMemberDeleteRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000020"),
+				UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000102"),
+			},
+
+			expectErr: dao.ErrProjectMemberDeleteNotFound,
+		},
+	}
+
+	repository := dao.NewProjectMemberDelete()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
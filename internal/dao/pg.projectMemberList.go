@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectMemberList.sql
+var projectMemberListQuery string
+
+type ProjectMemberListRequest struct {
+	ProjectID uuid.UUID
+}
+
+type ProjectMemberList struct{}
+
+func NewProjectMemberList() *ProjectMemberList {
+	return new(ProjectMemberList)
+}
+
+// Exec lists the members of a project, in the order they joined it. The owner of the project is not listed.
+func (repository *ProjectMemberList) Exec(
+	ctx context.Context, request *ProjectMemberListRequest,
+) ([]*ProjectMember, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectMemberList")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("project_id", request.ProjectID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var members []*ProjectMember
+
+	err = tx.NewRaw(projectMemberListQuery, request.ProjectID).Scan(ctx, &members)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	if members == nil {
+		members = []*ProjectMember{}
+	}
+
+	return otel.ReportSuccess(span, members), nil
+}
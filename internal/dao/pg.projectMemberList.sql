@@ -0,0 +1,9 @@
+SELECT
+  *
+FROM
+  project_members
+WHERE
+  project_id = ?0
+ORDER BY
+  created_at,
+  user_id;
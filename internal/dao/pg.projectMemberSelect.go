@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectMemberSelect.sql
+var projectMemberSelectQuery string
+
+var ErrProjectMemberSelectNotFound = errors.New("project member not found")
+
+type ProjectMemberSelectRequest struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+}
+
+type ProjectMemberSelect struct{}
+
+func NewProjectMemberSelect() *ProjectMemberSelect {
+	return new(ProjectMemberSelect)
+}
+
+func (repository *ProjectMemberSelect) Exec(
+	ctx context.Context, request *ProjectMemberSelectRequest,
+) (*ProjectMember, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectMemberSelect")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("user_id", request.UserID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectMember)
+
+	err = tx.NewRaw(projectMemberSelectQuery, request.ProjectID, request.UserID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrProjectMemberSelectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
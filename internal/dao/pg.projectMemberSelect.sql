@@ -0,0 +1,7 @@
+SELECT
+  *
+FROM
+  project_members
+WHERE
+  project_id = ?0
+  AND user_id = ?1;
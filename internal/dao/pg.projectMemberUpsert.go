@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectMemberUpsert.sql
+var projectMemberUpsertQuery string
+
+type ProjectMemberUpsertRequest struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+	Role      ProjectMemberRole
+	Now       time.Time
+}
+
+type ProjectMemberUpsert struct{}
+
+func NewProjectMemberUpsert() *ProjectMemberUpsert {
+	return new(ProjectMemberUpsert)
+}
+
+// Exec shares a project with a user, or changes the role of an existing member.
+func (repository *ProjectMemberUpsert) Exec(
+	ctx context.Context, request *ProjectMemberUpsertRequest,
+) (*ProjectMember, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectMemberUpsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("user_id", request.UserID.String()),
+		attribute.String("role", request.Role.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectMember)
+
+	err = tx.NewRaw(
+		projectMemberUpsertQuery,
+		request.ProjectID,
+		request.UserID,
+		request.Role,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
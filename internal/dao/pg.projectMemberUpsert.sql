@@ -0,0 +1,10 @@
+INSERT INTO
+  project_members (project_id, user_id, role, created_at, updated_at)
+VALUES
+  (?0, ?1, ?2, ?3, ?3)
+ON CONFLICT (project_id, user_id) DO UPDATE
+SET
+  role = excluded.role,
+  updated_at = excluded.updated_at
+RETURNING
+  *;
@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ProjectShare is a read-only link to a project, for users without an account.
+type ProjectShare struct {
+	bun.BaseModel `bun:"table:project_shares"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid"`
+	ProjectID uuid.UUID `bun:"project_id,type:uuid"`
+
+	// TokenHash is the SHA-256 hash of the share token. The token itself is never stored.
+	TokenHash string `bun:"token_hash"`
+	// Modules restricts the share to some modules of the project, in the "namespace:module" format. The whole
+	// project is shared when empty.
+	Modules []string `bun:"modules,array"`
+
+	ExpiresAt *time.Time `bun:"expires_at"`
+	RevokedAt *time.Time `bun:"revoked_at"`
+	CreatedAt time.Time  `bun:"created_at"`
+}
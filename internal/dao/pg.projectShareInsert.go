@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectShareInsert.sql
+var projectShareInsertQuery string
+
+var ErrProjectShareInsertAlreadyExists = errors.New("project share already exists")
+
+type ProjectShareInsertRequest struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	TokenHash string
+	Modules   []string
+	ExpiresAt *time.Time
+	Now       time.Time
+}
+
+type ProjectShareInsert struct{}
+
+func NewProjectShareInsert() *ProjectShareInsert {
+	return new(ProjectShareInsert)
+}
+
+// Exec creates a new share link for a project.
+func (repository *ProjectShareInsert) Exec(
+	ctx context.Context, request *ProjectShareInsertRequest,
+) (*ProjectShare, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectShareInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.StringSlice("modules", request.Modules),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectShare)
+
+	err = tx.NewRaw(
+		projectShareInsertQuery,
+		request.ID,
+		request.ProjectID,
+		request.TokenHash,
+		pgdialect.Array(request.Modules),
+		request.ExpiresAt,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrProjectShareInsertAlreadyExists)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
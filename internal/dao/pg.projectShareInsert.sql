@@ -0,0 +1,6 @@
+INSERT INTO
+  project_shares (id, project_id, token_hash, modules, expires_at, created_at)
+VALUES
+  (?0, ?1, ?2, ?3, ?4, ?5)
+RETURNING
+  *;
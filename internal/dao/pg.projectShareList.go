@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectShareList.sql
+var projectShareListQuery string
+
+type ProjectShareListRequest struct {
+	ProjectID uuid.UUID
+}
+
+type ProjectShareList struct{}
+
+func NewProjectShareList() *ProjectShareList {
+	return new(ProjectShareList)
+}
+
+// Exec lists the share links of a project, in the order they were created. Revoked and expired links are included.
+func (repository *ProjectShareList) Exec(
+	ctx context.Context, request *ProjectShareListRequest,
+) ([]*ProjectShare, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectShareList")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("project_id", request.ProjectID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var shares []*ProjectShare
+
+	err = tx.NewRaw(projectShareListQuery, request.ProjectID).Scan(ctx, &shares)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	if shares == nil {
+		shares = []*ProjectShare{}
+	}
+
+	return otel.ReportSuccess(span, shares), nil
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectShareRevoke.sql
+var projectShareRevokeQuery string
+
+var ErrProjectShareRevokeNotFound = errors.New("project share not found")
+
+type ProjectShareRevokeRequest struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	Now       time.Time
+}
+
+type ProjectShareRevoke struct{}
+
+func NewProjectShareRevoke() *ProjectShareRevoke {
+	return new(ProjectShareRevoke)
+}
+
+// Exec revokes a share link of a project, and returns the revoked link. Links that are already revoked are not
+// found.
+func (repository *ProjectShareRevoke) Exec(
+	ctx context.Context, request *ProjectShareRevokeRequest,
+) (*ProjectShare, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectShareRevoke")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.String("project_id", request.ProjectID.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectShare)
+
+	err = tx.NewRaw(projectShareRevokeQuery, request.ID, request.ProjectID, request.Now).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrProjectShareRevokeNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,9 @@
+UPDATE project_shares
+SET
+  revoked_at = ?2
+WHERE
+  id = ?0
+  AND project_id = ?1
+  AND revoked_at IS NULL
+RETURNING
+  *;
@@ -0,0 +1,121 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestProjectShareRevoke(t *testing.T) {
+	fixtures := []*dao.ProjectShare{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			TokenHash: "hash-1",
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			TokenHash: "hash-2",
+			Modules:   []string{"agora:idea"},
+			ExpiresAt: lo.ToPtr(time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)),
+			RevokedAt: lo.ToPtr(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)),
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000020"),
+			TokenHash: "hash-3",
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.ProjectShare
+
+		request *dao.ProjectShareRevokeRequest
+
+		expect    *dao.ProjectShare
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectShareRevokeRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Now:       time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.ProjectShare{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				TokenHash: "hash-1",
+				RevokedAt: lo.ToPtr(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)),
+				CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyRevoked",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectShareRevokeRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Now:       time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrProjectShareRevokeNotFound,
+		},
+		{
+			name: "Error/WrongProject",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectShareRevokeRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Now:       time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrProjectShareRevokeNotFound,
+		},
+	}
+
+	repository := dao.NewProjectShareRevoke()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
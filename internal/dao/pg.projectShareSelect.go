@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectShareSelect.sql
+var projectShareSelectQuery string
+
+var ErrProjectShareSelectNotFound = errors.New("project share not found")
+
+type ProjectShareSelectRequest struct {
+	TokenHash string
+}
+
+type ProjectShareSelect struct{}
+
+func NewProjectShareSelect() *ProjectShareSelect {
+	return new(ProjectShareSelect)
+}
+
+// Exec retrieves a share link from the hash of its token. Revoked and expired links are returned as well.
+func (repository *ProjectShareSelect) Exec(
+	ctx context.Context, request *ProjectShareSelectRequest,
+) (*ProjectShare, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectShareSelect")
+	defer span.End()
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectShare)
+
+	err = tx.NewRaw(projectShareSelectQuery, request.TokenHash).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrProjectShareSelectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
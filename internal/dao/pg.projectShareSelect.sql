@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  project_shares
+WHERE
+  token_hash = ?0;
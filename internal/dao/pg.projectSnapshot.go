@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ProjectSnapshot is a named capture of a project, that references the latest version of each of its schemas at
+// the time it was taken.
+type ProjectSnapshot struct {
+	bun.BaseModel `bun:"table:project_snapshots"`
+
+	ID        uuid.UUID `bun:"id,pk,type:uuid"`
+	ProjectID uuid.UUID `bun:"project_id,type:uuid"`
+	// Owner is the user who took the snapshot.
+	Owner uuid.UUID `bun:"owner,type:uuid"`
+	Name  string    `bun:"name"`
+
+	// SchemaIDs are the schema versions captured by the snapshot, one for each module of the project.
+	SchemaIDs []uuid.UUID `bun:"schema_ids,type:jsonb"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
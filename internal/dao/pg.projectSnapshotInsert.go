@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectSnapshotInsert.sql
+var projectSnapshotInsertQuery string
+
+var ErrProjectSnapshotInsertAlreadyExists = errors.New("project snapshot already exists")
+
+type ProjectSnapshotInsertRequest struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	Owner     uuid.UUID
+	Name      string
+	Now       time.Time
+}
+
+type ProjectSnapshotInsert struct{}
+
+func NewProjectSnapshotInsert() *ProjectSnapshotInsert {
+	return new(ProjectSnapshotInsert)
+}
+
+// Exec takes a snapshot of a project. The snapshot captures the latest schema version of each module of the
+// project, in the same query, so it cannot miss a version saved concurrently.
+func (repository *ProjectSnapshotInsert) Exec(
+	ctx context.Context, request *ProjectSnapshotInsertRequest,
+) (*ProjectSnapshot, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectSnapshotInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("name", request.Name),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectSnapshot)
+
+	err = tx.NewRaw(
+		projectSnapshotInsertQuery,
+		request.ID,
+		request.ProjectID,
+		request.Owner,
+		request.Name,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrProjectSnapshotInsertAlreadyExists)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
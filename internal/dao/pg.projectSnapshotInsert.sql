@@ -0,0 +1,38 @@
+INSERT INTO
+  project_snapshots (id, project_id, owner, name, schema_ids, created_at)
+SELECT
+  ?0::uuid,
+  ?1::uuid,
+  ?2::uuid,
+  ?3::text,
+  COALESCE(
+    jsonb_agg(
+      latest_schemas.id
+      ORDER BY
+        latest_schemas.module_namespace,
+        latest_schemas.module_id
+    ),
+    '[]'::jsonb
+  ),
+  ?4::timestamptz
+FROM
+  (
+    SELECT DISTINCT
+      ON (module_id, module_namespace) id,
+      module_id,
+      module_namespace,
+      data
+    FROM
+      schemas
+    WHERE
+      project_id = ?1
+    ORDER BY
+      module_id,
+      module_namespace,
+      created_at DESC
+  ) AS latest_schemas
+WHERE
+  -- Modules removed from the project end with a version without data, and are left out.
+  latest_schemas.data IS NOT NULL
+RETURNING
+  *;
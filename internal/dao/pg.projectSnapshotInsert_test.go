@@ -0,0 +1,206 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestProjectSnapshotInsert(t *testing.T) {
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000001000")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+
+	schemaFixtures := []*dao.Schema{
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "idea",
+			ModuleNamespace: "agora",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"title": "Draft 1"},
+			CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "idea",
+			ModuleNamespace: "agora",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"title": "Draft 2"},
+			CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "characters",
+			ModuleNamespace: "agora",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"name": "Lia"},
+			CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		// The pitch module was removed from the project.
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "pitch",
+			ModuleNamespace: "agora",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"pitch": "A story."},
+			CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "pitch",
+			ModuleNamespace: "agora",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		// Another project.
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000200"),
+			Owner:           &ownerID,
+			ModuleID:        "idea",
+			ModuleNamespace: "agora",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"title": "Other"},
+			CreatedAt:       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	fixtures := []*dao.ProjectSnapshot{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			ProjectID: projectID,
+			Owner:     ownerID,
+			Name:      "Draft 1",
+			SchemaIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+			CreatedAt: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		schemaFixtures []*dao.Schema
+		fixtures       []*dao.ProjectSnapshot
+
+		request *dao.ProjectSnapshotInsertRequest
+
+		expect    *dao.ProjectSnapshot
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			schemaFixtures: schemaFixtures,
+			fixtures:       fixtures,
+
+			request: &dao.ProjectSnapshotInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Name:      "Draft 2",
+				Now:       time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.ProjectSnapshot{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Name:      "Draft 2",
+				// Sorted by module.
+				SchemaIDs: []uuid.UUID{
+					uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				},
+				CreatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectSnapshotInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Name:      "Draft 2",
+				Now:       time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.ProjectSnapshot{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Name:      "Draft 2",
+				SchemaIDs: []uuid.UUID{},
+				CreatedAt: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			schemaFixtures: schemaFixtures,
+			fixtures:       fixtures,
+
+			request: &dao.ProjectSnapshotInsertRequest{
+				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Name:      "Draft 1",
+				Now:       time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrProjectSnapshotInsertAlreadyExists,
+		},
+	}
+
+	repository := dao.NewProjectSnapshotInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.schemaFixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.schemaFixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
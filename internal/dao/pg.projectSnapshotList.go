@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectSnapshotList.sql
+var projectSnapshotListQuery string
+
+type ProjectSnapshotListRequest struct {
+	ProjectID uuid.UUID
+}
+
+type ProjectSnapshotList struct{}
+
+func NewProjectSnapshotList() *ProjectSnapshotList {
+	return new(ProjectSnapshotList)
+}
+
+// Exec lists the snapshots of a project, in the order they were taken.
+func (repository *ProjectSnapshotList) Exec(
+	ctx context.Context, request *ProjectSnapshotListRequest,
+) ([]*ProjectSnapshot, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectSnapshotList")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("project_id", request.ProjectID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var snapshots []*ProjectSnapshot
+
+	err = tx.NewRaw(projectSnapshotListQuery, request.ProjectID).Scan(ctx, &snapshots)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	if snapshots == nil {
+		snapshots = []*ProjectSnapshot{}
+	}
+
+	return otel.ReportSuccess(span, snapshots), nil
+}
@@ -0,0 +1,8 @@
+SELECT
+  *
+FROM
+  project_snapshots
+WHERE
+  project_id = ?0
+ORDER BY
+  created_at;
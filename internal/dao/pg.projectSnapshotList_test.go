@@ -0,0 +1,102 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestProjectSnapshotList(t *testing.T) {
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000001000")
+
+	fixtures := []*dao.ProjectSnapshot{
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			Owner:     ownerID,
+			Name:      "Draft 2",
+			SchemaIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000101")},
+			CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			Owner:     ownerID,
+			Name:      "Draft 1",
+			SchemaIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000100")},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000020"),
+			Owner:     ownerID,
+			Name:      "Draft 1",
+			SchemaIDs: []uuid.UUID{},
+			CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.ProjectSnapshot
+
+		request *dao.ProjectSnapshotListRequest
+
+		expect    []*dao.ProjectSnapshot
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectSnapshotListRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			},
+
+			expect: []*dao.ProjectSnapshot{fixtures[1], fixtures[0]},
+		},
+		{
+			name: "Success/Empty",
+
+			fixtures: fixtures,
+
+			request: &dao.ProjectSnapshotListRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000030"),
+			},
+
+			expect: []*dao.ProjectSnapshot{},
+		},
+	}
+
+	repository := dao.NewProjectSnapshotList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectSnapshotSelect.sql
+var projectSnapshotSelectQuery string
+
+var ErrProjectSnapshotSelectNotFound = errors.New("project snapshot not found")
+
+type ProjectSnapshotSelectRequest struct {
+	ID uuid.UUID
+}
+
+type ProjectSnapshotSelect struct{}
+
+func NewProjectSnapshotSelect() *ProjectSnapshotSelect {
+	return new(ProjectSnapshotSelect)
+}
+
+// Exec retrieves a snapshot from its ID.
+func (repository *ProjectSnapshotSelect) Exec(
+	ctx context.Context, request *ProjectSnapshotSelectRequest,
+) (*ProjectSnapshot, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectSnapshotSelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", request.ID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(ProjectSnapshot)
+
+	err = tx.NewRaw(projectSnapshotSelectQuery, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrProjectSnapshotSelectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
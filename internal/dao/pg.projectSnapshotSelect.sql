@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  project_snapshots
+WHERE
+  id = ?0;
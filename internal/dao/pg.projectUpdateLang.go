@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.projectUpdateLang.sql
+var projectUpdateLangQuery string
+
+var ErrProjectUpdateLangNotFound = errors.New("project not found")
+
+type ProjectUpdateLangRequest struct {
+	ID   uuid.UUID
+	Lang string
+	Now  time.Time
+}
+
+// ProjectUpdateLang changes the language of a project. It does not touch the schemas of the project, which must be
+// translated separately.
+type ProjectUpdateLang struct{}
+
+func NewProjectUpdateLang() *ProjectUpdateLang {
+	return new(ProjectUpdateLang)
+}
+
+func (repository *ProjectUpdateLang) Exec(ctx context.Context, request *ProjectUpdateLangRequest) (*Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.ProjectUpdateLang")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.String("lang", request.Lang),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(Project)
+
+	err = tx.NewRaw(
+		projectUpdateLangQuery,
+		request.ID,
+		request.Lang,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrProjectUpdateLangNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
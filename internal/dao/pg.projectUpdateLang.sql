@@ -0,0 +1,8 @@
+UPDATE projects
+SET
+  lang = ?1,
+  updated_at = ?2
+WHERE
+  id = ?0
+RETURNING
+  *;
@@ -44,9 +44,14 @@ type Schema struct {
 
 	// Source is the source of this schema version.
 	Source SchemaSource `bun:"source,type:schema_source"`
+	// Instructions are the free-text instructions the user gave to steer the generation of this version.
+	Instructions string `bun:"instructions,nullzero"`
 
 	// Data is the content of the story. It can be left empty to indicate the module has been cleared in the history.
 	Data map[string]any `bun:"data,type:jsonb,nullzero"`
 
+	// ForkedFrom is the ID of the schema this version was copied from, when the project was forked.
+	ForkedFrom *uuid.UUID `bun:"forked_from,type:uuid"`
+
 	CreatedAt time.Time `bun:"created_at"`
 }
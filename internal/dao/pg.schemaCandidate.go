@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SchemaCandidate is an alternative content generated for a module, pending selection. Unlike a schema, it is not
+// part of the history of the project until it is promoted.
+type SchemaCandidate struct {
+	bun.BaseModel `bun:"table:schema_candidates"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// ProjectID is the project the candidate was generated for.
+	ProjectID uuid.UUID `bun:"project_id,type:uuid"`
+	// Owner is the ID of the user who requested the generation.
+	Owner uuid.UUID `bun:"owner,type:uuid"`
+
+	// ModuleID is the ID of the module used to generate the candidate.
+	ModuleID string `bun:"module_id"`
+	// ModuleNamespace is the namespace of the module used to generate the candidate.
+	ModuleNamespace string `bun:"module_namespace"`
+	// ModuleVersion is the version of the module used to generate the candidate.
+	ModuleVersion string `bun:"module_version"`
+	// ModulePreversion is the preversion of the module used to generate the candidate.
+	ModulePreversion string `bun:"module_preversion"`
+
+	// Instructions are the free-text instructions the user gave to steer the generation.
+	Instructions string `bun:"instructions,nullzero"`
+
+	// Data is the generated content.
+	Data map[string]any `bun:"data,type:jsonb"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
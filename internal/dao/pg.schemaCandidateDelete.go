@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaCandidateDelete.sql
+var schemaCandidateDeleteQuery string
+
+type SchemaCandidateDeleteRequest struct {
+	ProjectID       uuid.UUID
+	ModuleID        string
+	ModuleNamespace string
+}
+
+type SchemaCandidateDelete struct{}
+
+func NewSchemaCandidateDelete() *SchemaCandidateDelete {
+	return new(SchemaCandidateDelete)
+}
+
+// Exec discards every pending candidate of a module of a project, and returns them.
+func (repository *SchemaCandidateDelete) Exec(
+	ctx context.Context, request *SchemaCandidateDeleteRequest,
+) ([]*SchemaCandidate, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaCandidateDelete")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("module_id", request.ModuleID),
+		attribute.String("module_namespace", request.ModuleNamespace),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var candidates []*SchemaCandidate
+
+	err = tx.
+		NewRaw(schemaCandidateDeleteQuery, request.ProjectID, request.ModuleID, request.ModuleNamespace).
+		Scan(ctx, &candidates)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, candidates), nil
+}
@@ -0,0 +1,7 @@
+DELETE FROM schema_candidates
+WHERE
+  project_id = ?0
+  AND module_id = ?1
+  AND module_namespace = ?2
+RETURNING
+  *;
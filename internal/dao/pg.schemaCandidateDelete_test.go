@@ -0,0 +1,122 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaCandidateDelete(t *testing.T) {
+	candidate := func(id, projectID, moduleID string, createdAt time.Time) *dao.SchemaCandidate {
+		return &dao.SchemaCandidate{
+			ID:              uuid.MustParse(id),
+			ProjectID:       uuid.MustParse(projectID),
+			Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			ModuleID:        moduleID,
+			ModuleNamespace: "test-namespace",
+			ModuleVersion:   "1.0.0",
+			Data:            map[string]any{"title": id},
+			CreatedAt:       createdAt,
+		}
+	}
+
+	fixtures := []*dao.SchemaCandidate{
+		candidate(
+			"00000000-0000-0000-0000-000000000001",
+			"00000000-0000-0000-0000-000000000010",
+			"test-module",
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+		candidate(
+			"00000000-0000-0000-0000-000000000002",
+			"00000000-0000-0000-0000-000000000010",
+			"test-module",
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		),
+		// Other module.
+		candidate(
+			"00000000-0000-0000-0000-000000000003",
+			"00000000-0000-0000-0000-000000000010",
+			"other-module",
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		),
+		// Other project.
+		candidate(
+			"00000000-0000-0000-0000-000000000004",
+			"00000000-0000-0000-0000-000000000020",
+			"test-module",
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		),
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.SchemaCandidateDeleteRequest
+
+		expect          []*dao.SchemaCandidate
+		expectRemaining []*dao.SchemaCandidate
+		expectErr       error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SchemaCandidateDeleteRequest{
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			expect: []*dao.SchemaCandidate{fixtures[0], fixtures[1]},
+
+			expectRemaining: []*dao.SchemaCandidate{fixtures[2], fixtures[3]},
+		},
+		{
+			name: "Success/NoCandidates",
+
+			request: &dao.SchemaCandidateDeleteRequest{
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000020"),
+				ModuleID:        "other-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			expectRemaining: []*dao.SchemaCandidate{fixtures[0], fixtures[1], fixtures[2], fixtures[3]},
+		},
+	}
+
+	repository := dao.NewSchemaCandidateDelete()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				// Deleted rows are returned in no particular order.
+				require.ElementsMatch(t, testCase.expect, res)
+
+				// Candidates of the module are gone, the others are kept.
+				var remaining []*dao.SchemaCandidate
+
+				err = db.NewSelect().Model(&remaining).Order("id").Scan(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectRemaining, remaining)
+			})
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaCandidateInsert.sql
+var schemaCandidateInsertQuery string
+
+var ErrSchemaCandidateInsertAlreadyExists = errors.New("schema candidate already exists")
+
+type SchemaCandidateInsertRequest struct {
+	ID               uuid.UUID
+	ProjectID        uuid.UUID
+	Owner            uuid.UUID
+	ModuleID         string
+	ModuleNamespace  string
+	ModuleVersion    string
+	ModulePreversion string
+	Instructions     string
+	Data             map[string]any
+	Now              time.Time
+}
+
+type SchemaCandidateInsert struct{}
+
+func NewSchemaCandidateInsert() *SchemaCandidateInsert {
+	return new(SchemaCandidateInsert)
+}
+
+func (repository *SchemaCandidateInsert) Exec(
+	ctx context.Context, request *SchemaCandidateInsertRequest,
+) (*SchemaCandidate, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaCandidateInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("module_id", request.ModuleID),
+		attribute.String("module_namespace", request.ModuleNamespace),
+		attribute.String("module_version", request.ModuleVersion),
+		attribute.String("module_preversion", request.ModulePreversion),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(SchemaCandidate)
+
+	err = tx.NewRaw(
+		schemaCandidateInsertQuery,
+		request.ID,
+		request.ProjectID,
+		request.Owner,
+		request.ModuleID,
+		request.ModuleNamespace,
+		request.ModuleVersion,
+		request.ModulePreversion,
+		bun.NullZero(request.Instructions),
+		request.Data,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrSchemaCandidateInsertAlreadyExists)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,138 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaCandidateInsert(t *testing.T) {
+	testData := map[string]any{
+		"title": "Test Story",
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.SchemaCandidate
+
+		request *dao.SchemaCandidateInsertRequest
+
+		expect    *dao.SchemaCandidate
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SchemaCandidateInsertRequest{
+				ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:            uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ModuleID:         "test-module",
+				ModuleNamespace:  "test-namespace",
+				ModuleVersion:    "1.0.0",
+				ModulePreversion: "-beta-1",
+				Data:             testData,
+				Now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.SchemaCandidate{
+				ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:            uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ModuleID:         "test-module",
+				ModuleNamespace:  "test-namespace",
+				ModuleVersion:    "1.0.0",
+				ModulePreversion: "-beta-1",
+				Data:             testData,
+				CreatedAt:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/WithInstructions",
+
+			request: &dao.SchemaCandidateInsertRequest{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Instructions:    "Keep it short.",
+				Data:            testData,
+				Now:             time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.SchemaCandidate{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Instructions:    "Keep it short.",
+				Data:            testData,
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			fixtures: []*dao.SchemaCandidate{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Data:            testData,
+					CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.SchemaCandidateInsertRequest{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Data:            testData,
+				Now:             time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrSchemaCandidateInsertAlreadyExists,
+		},
+	}
+
+	repository := dao.NewSchemaCandidateInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaCandidateList.sql
+var schemaCandidateListQuery string
+
+type SchemaCandidateListRequest struct {
+	ProjectID       uuid.UUID
+	ModuleID        string
+	ModuleNamespace string
+}
+
+type SchemaCandidateList struct{}
+
+func NewSchemaCandidateList() *SchemaCandidateList {
+	return new(SchemaCandidateList)
+}
+
+// Exec lists the pending candidates of a module of a project, oldest first.
+func (repository *SchemaCandidateList) Exec(
+	ctx context.Context, request *SchemaCandidateListRequest,
+) ([]*SchemaCandidate, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaCandidateList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("module_id", request.ModuleID),
+		attribute.String("module_namespace", request.ModuleNamespace),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var candidates []*SchemaCandidate
+
+	err = tx.
+		NewRaw(schemaCandidateListQuery, request.ProjectID, request.ModuleID, request.ModuleNamespace).
+		Scan(ctx, &candidates)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, candidates), nil
+}
@@ -0,0 +1,109 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaCandidateList(t *testing.T) {
+	candidate := func(id, projectID, moduleID string, createdAt time.Time) *dao.SchemaCandidate {
+		return &dao.SchemaCandidate{
+			ID:              uuid.MustParse(id),
+			ProjectID:       uuid.MustParse(projectID),
+			Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			ModuleID:        moduleID,
+			ModuleNamespace: "test-namespace",
+			ModuleVersion:   "1.0.0",
+			Data:            map[string]any{"title": id},
+			CreatedAt:       createdAt,
+		}
+	}
+
+	fixtures := []*dao.SchemaCandidate{
+		candidate(
+			"00000000-0000-0000-0000-000000000001",
+			"00000000-0000-0000-0000-000000000010",
+			"test-module",
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		),
+		candidate(
+			"00000000-0000-0000-0000-000000000002",
+			"00000000-0000-0000-0000-000000000010",
+			"test-module",
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		),
+		// Other module.
+		candidate(
+			"00000000-0000-0000-0000-000000000003",
+			"00000000-0000-0000-0000-000000000010",
+			"other-module",
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		),
+		// Other project.
+		candidate(
+			"00000000-0000-0000-0000-000000000004",
+			"00000000-0000-0000-0000-000000000020",
+			"test-module",
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		),
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.SchemaCandidateListRequest
+
+		expect    []*dao.SchemaCandidate
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SchemaCandidateListRequest{
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			expect: []*dao.SchemaCandidate{fixtures[1], fixtures[0]},
+		},
+		{
+			name: "Success/NoCandidates",
+
+			request: &dao.SchemaCandidateListRequest{
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000020"),
+				ModuleID:        "other-module",
+				ModuleNamespace: "test-namespace",
+			},
+		},
+	}
+
+	repository := dao.NewSchemaCandidateList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
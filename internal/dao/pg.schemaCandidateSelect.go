@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaCandidateSelect.sql
+var schemaCandidateSelectQuery string
+
+var ErrSchemaCandidateSelectNotFound = errors.New("schema candidate not found")
+
+type SchemaCandidateSelectRequest struct {
+	ID uuid.UUID
+}
+
+type SchemaCandidateSelect struct{}
+
+func NewSchemaCandidateSelect() *SchemaCandidateSelect {
+	return new(SchemaCandidateSelect)
+}
+
+func (repository *SchemaCandidateSelect) Exec(
+	ctx context.Context, request *SchemaCandidateSelectRequest,
+) (*SchemaCandidate, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaCandidateSelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("id", request.ID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(SchemaCandidate)
+
+	err = tx.NewRaw(schemaCandidateSelectQuery, request.ID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrSchemaCandidateSelectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  schema_candidates
+WHERE
+  id = ?0;
@@ -0,0 +1,108 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaCandidateSelect(t *testing.T) {
+	fixtures := []*dao.SchemaCandidate{
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			ModuleID:        "test-module",
+			ModuleNamespace: "test-namespace",
+			ModuleVersion:   "1.0.0",
+			Instructions:    "Keep it short.",
+			Data:            map[string]any{"title": "Candidate 1"},
+			CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			ModuleID:        "test-module",
+			ModuleNamespace: "test-namespace",
+			ModuleVersion:   "1.0.0",
+			Instructions:    "Keep it short.",
+			Data:            map[string]any{"title": "Candidate 2"},
+			CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.SchemaCandidate
+
+		request *dao.SchemaCandidateSelectRequest
+
+		expect    *dao.SchemaCandidate
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+
+			request: &dao.SchemaCandidateSelectRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			expect: &dao.SchemaCandidate{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Instructions:    "Keep it short.",
+				Data:            map[string]any{"title": "Candidate 2"},
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/NotFound",
+
+			fixtures: fixtures,
+
+			request: &dao.SchemaCandidateSelectRequest{
+				ID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			},
+
+			expectErr: dao.ErrSchemaCandidateSelectNotFound,
+		},
+	}
+
+	repository := dao.NewSchemaCandidateSelect()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
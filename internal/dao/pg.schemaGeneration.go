@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SchemaGenerationMessage is a message sent to the model during a generation.
+type SchemaGenerationMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Actions taken on the context of a generation, to fit the budget of the model.
+const (
+	// SchemaGenerationContextCutTruncated data was sent with shortened strings.
+	SchemaGenerationContextCutTruncated = "truncated"
+	// SchemaGenerationContextCutDropped data was not sent at all.
+	SchemaGenerationContextCutDropped = "dropped"
+)
+
+// SchemaGenerationContextCut describes a schema of the context that could not be sent to the model in full.
+type SchemaGenerationContextCut struct {
+	SchemaID uuid.UUID `json:"schemaID"`
+	// Module is the module of the schema, as "<namespace>:<id>".
+	Module string `json:"module"`
+	Action string `json:"action"`
+	// Tokens is the estimated number of tokens of the full schema.
+	Tokens int64 `json:"tokens"`
+	// KeptTokens is the estimated number of tokens actually sent.
+	KeptTokens int64 `json:"keptTokens"`
+}
+
+// SchemaGeneration records how an AI output was produced, so it can be debugged, reproduced, and its cost
+// attributed.
+type SchemaGeneration struct {
+	bun.BaseModel `bun:"table:schema_generations"`
+
+	// SchemaID is the ID of the schema version the output was saved as. Outputs saved as candidates use the ID of
+	// the candidate, which is kept by the schema version once promoted.
+	SchemaID uuid.UUID `bun:"schema_id,pk,type:uuid"`
+	// ProjectID is the project the output was generated for.
+	ProjectID uuid.UUID `bun:"project_id,type:uuid"`
+
+	// Model that generated the output.
+	Model string `bun:"model"`
+	// Messages sent to the model.
+	Messages []SchemaGenerationMessage `bun:"messages,type:jsonb"`
+	// TemplateVersion identifies the prompt template used to build the messages.
+	TemplateVersion string `bun:"template_version"`
+
+	PromptTokens     int64 `bun:"prompt_tokens"`
+	CompletionTokens int64 `bun:"completion_tokens"`
+	// LatencyMs is the time spent waiting for the model, in milliseconds.
+	LatencyMs int64 `bun:"latency_ms"`
+
+	// ContextSchemaIDs are the schema versions given to the model as context.
+	ContextSchemaIDs []uuid.UUID `bun:"context_schema_ids,type:jsonb"`
+	// ContextCuts lists the schemas of the context that were truncated or dropped to fit the budget of the model.
+	ContextCuts []SchemaGenerationContextCut `bun:"context_cuts,type:jsonb"`
+	// BaseSchemaID is the schema version whose data was given to the model to complete, if any.
+	BaseSchemaID *uuid.UUID `bun:"base_schema_id,type:uuid"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaGenerationInsert.sql
+var schemaGenerationInsertQuery string
+
+var ErrSchemaGenerationInsertAlreadyExists = errors.New("schema generation already exists")
+
+type SchemaGenerationInsertRequest struct {
+	SchemaID         uuid.UUID
+	ProjectID        uuid.UUID
+	Model            string
+	Messages         []SchemaGenerationMessage
+	TemplateVersion  string
+	PromptTokens     int64
+	CompletionTokens int64
+	Latency          time.Duration
+	ContextSchemaIDs []uuid.UUID
+	ContextCuts      []SchemaGenerationContextCut
+	BaseSchemaID     *uuid.UUID
+	Now              time.Time
+}
+
+type SchemaGenerationInsert struct{}
+
+func NewSchemaGenerationInsert() *SchemaGenerationInsert {
+	return new(SchemaGenerationInsert)
+}
+
+func (repository *SchemaGenerationInsert) Exec(
+	ctx context.Context, request *SchemaGenerationInsertRequest,
+) (*SchemaGeneration, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaGenerationInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("schema_id", request.SchemaID.String()),
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("model", request.Model),
+		attribute.String("template_version", request.TemplateVersion),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	messages := request.Messages
+	if messages == nil {
+		messages = []SchemaGenerationMessage{}
+	}
+
+	contextSchemaIDs := request.ContextSchemaIDs
+	if contextSchemaIDs == nil {
+		contextSchemaIDs = []uuid.UUID{}
+	}
+
+	contextCuts := request.ContextCuts
+	if contextCuts == nil {
+		contextCuts = []SchemaGenerationContextCut{}
+	}
+
+	entity := new(SchemaGeneration)
+
+	err = tx.NewRaw(
+		schemaGenerationInsertQuery,
+		request.SchemaID,
+		request.ProjectID,
+		request.Model,
+		messages,
+		request.TemplateVersion,
+		request.PromptTokens,
+		request.CompletionTokens,
+		request.Latency.Milliseconds(),
+		contextSchemaIDs,
+		contextCuts,
+		request.BaseSchemaID,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrSchemaGenerationInsertAlreadyExists)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
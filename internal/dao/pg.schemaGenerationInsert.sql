@@ -0,0 +1,19 @@
+INSERT INTO
+  schema_generations (
+    schema_id,
+    project_id,
+    model,
+    messages,
+    template_version,
+    prompt_tokens,
+    completion_tokens,
+    latency_ms,
+    context_schema_ids,
+    context_cuts,
+    base_schema_id,
+    created_at
+  )
+VALUES
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
+RETURNING
+  *;
@@ -0,0 +1,168 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaGenerationInsert(t *testing.T) {
+	testMessages := []dao.SchemaGenerationMessage{
+		{Role: "system", Content: "You are a writing assistant."},
+		{Role: "user", Content: "Generate the structure."},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.SchemaGeneration
+
+		request *dao.SchemaGenerationInsertRequest
+
+		expect    *dao.SchemaGeneration
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SchemaGenerationInsertRequest{
+				SchemaID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:            "gpt-4o-mini",
+				Messages:         testMessages,
+				TemplateVersion:  "default:0123456789ab",
+				PromptTokens:     120,
+				CompletionTokens: 40,
+				Latency:          1500 * time.Millisecond,
+				ContextSchemaIDs: []uuid.UUID{
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				ContextCuts: []dao.SchemaGenerationContextCut{
+					{
+						SchemaID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						Module:     "test-namespace:test-module",
+						Action:     dao.SchemaGenerationContextCutTruncated,
+						Tokens:     2000,
+						KeptTokens: 800,
+					},
+				},
+				BaseSchemaID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+				Now:          time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.SchemaGeneration{
+				SchemaID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:            "gpt-4o-mini",
+				Messages:         testMessages,
+				TemplateVersion:  "default:0123456789ab",
+				PromptTokens:     120,
+				CompletionTokens: 40,
+				LatencyMs:        1500,
+				ContextSchemaIDs: []uuid.UUID{
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				ContextCuts: []dao.SchemaGenerationContextCut{
+					{
+						SchemaID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						Module:     "test-namespace:test-module",
+						Action:     dao.SchemaGenerationContextCutTruncated,
+						Tokens:     2000,
+						KeptTokens: 800,
+					},
+				},
+				BaseSchemaID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+				CreatedAt:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/NoContext",
+
+			request: &dao.SchemaGenerationInsertRequest{
+				SchemaID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:            "gpt-4o-mini",
+				Messages:         testMessages,
+				TemplateVersion:  "module:0123456789ab",
+				PromptTokens:     120,
+				CompletionTokens: 40,
+				Latency:          time.Second,
+				Now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.SchemaGeneration{
+				SchemaID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:            "gpt-4o-mini",
+				Messages:         testMessages,
+				TemplateVersion:  "module:0123456789ab",
+				PromptTokens:     120,
+				CompletionTokens: 40,
+				LatencyMs:        1000,
+				ContextSchemaIDs: []uuid.UUID{},
+				ContextCuts:      []dao.SchemaGenerationContextCut{},
+				CreatedAt:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			fixtures: []*dao.SchemaGeneration{
+				{
+					SchemaID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					Model:            "gpt-4o-mini",
+					Messages:         testMessages,
+					TemplateVersion:  "default:0123456789ab",
+					ContextSchemaIDs: []uuid.UUID{},
+					ContextCuts:      []dao.SchemaGenerationContextCut{},
+					CreatedAt:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.SchemaGenerationInsertRequest{
+				SchemaID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:           "gpt-4o-mini",
+				Messages:        testMessages,
+				TemplateVersion: "default:0123456789ab",
+				Now:             time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrSchemaGenerationInsertAlreadyExists,
+		},
+	}
+
+	repository := dao.NewSchemaGenerationInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaGenerationList.sql
+var schemaGenerationListQuery string
+
+type SchemaGenerationListRequest struct {
+	SchemaIDs []uuid.UUID
+}
+
+type SchemaGenerationList struct{}
+
+func NewSchemaGenerationList() *SchemaGenerationList {
+	return new(SchemaGenerationList)
+}
+
+// Exec returns the generation records of a list of schema versions. Versions that were not generated are skipped.
+// Messages are not loaded.
+func (repository *SchemaGenerationList) Exec(
+	ctx context.Context, request *SchemaGenerationListRequest,
+) ([]*SchemaGeneration, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaGenerationList")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("schema_ids.count", len(request.SchemaIDs)))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var generations []*SchemaGeneration
+
+	err = tx.
+		NewRaw(schemaGenerationListQuery, pgdialect.Array(lo.Map(request.SchemaIDs, uuidString))).
+		Scan(ctx, &generations)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	if generations == nil {
+		generations = []*SchemaGeneration{}
+	}
+
+	return otel.ReportSuccess(span, generations), nil
+}
+
+func uuidString(item uuid.UUID, _ int) string {
+	return item.String()
+}
@@ -0,0 +1,20 @@
+-- Messages are left out, as they can be large. They are only returned when selecting a single generation.
+SELECT
+  schema_id,
+  project_id,
+  model,
+  template_version,
+  prompt_tokens,
+  completion_tokens,
+  latency_ms,
+  context_schema_ids,
+  context_cuts,
+  base_schema_id,
+  created_at
+FROM
+  schema_generations
+WHERE
+  schema_id = ANY (?0::uuid[])
+ORDER BY
+  created_at DESC,
+  schema_id;
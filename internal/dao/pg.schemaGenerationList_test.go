@@ -0,0 +1,98 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaGenerationList(t *testing.T) {
+	generation := func(
+		schemaID string, createdAt time.Time, messages []dao.SchemaGenerationMessage,
+	) *dao.SchemaGeneration {
+		return &dao.SchemaGeneration{
+			SchemaID:         uuid.MustParse(schemaID),
+			ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			Model:            "gpt-4o-mini",
+			Messages:         messages,
+			TemplateVersion:  "default:0123456789ab",
+			PromptTokens:     120,
+			CompletionTokens: 40,
+			LatencyMs:        1500,
+			ContextSchemaIDs: []uuid.UUID{},
+			ContextCuts:      []dao.SchemaGenerationContextCut{},
+			CreatedAt:        createdAt,
+		}
+	}
+
+	testMessages := []dao.SchemaGenerationMessage{{Role: "user", Content: "Generate the structure."}}
+
+	fixtures := []*dao.SchemaGeneration{
+		generation("00000000-0000-0000-0000-000000000001", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), testMessages),
+		generation("00000000-0000-0000-0000-000000000002", time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), testMessages),
+		generation("00000000-0000-0000-0000-000000000003", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), testMessages),
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.SchemaGenerationListRequest
+
+		expect    []*dao.SchemaGeneration
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SchemaGenerationListRequest{
+				SchemaIDs: []uuid.UUID{
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					// Not generated.
+					uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+				},
+			},
+
+			// Messages are not loaded.
+			expect: []*dao.SchemaGeneration{
+				generation("00000000-0000-0000-0000-000000000002", time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), nil),
+				generation("00000000-0000-0000-0000-000000000001", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), nil),
+			},
+		},
+		{
+			name: "Success/NoIDs",
+
+			request: &dao.SchemaGenerationListRequest{},
+
+			expect: []*dao.SchemaGeneration{},
+		},
+	}
+
+	repository := dao.NewSchemaGenerationList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaGenerationSelect.sql
+var schemaGenerationSelectQuery string
+
+var ErrSchemaGenerationSelectNotFound = errors.New("schema generation not found")
+
+type SchemaGenerationSelectRequest struct {
+	SchemaID uuid.UUID
+}
+
+type SchemaGenerationSelect struct{}
+
+func NewSchemaGenerationSelect() *SchemaGenerationSelect {
+	return new(SchemaGenerationSelect)
+}
+
+func (repository *SchemaGenerationSelect) Exec(
+	ctx context.Context, request *SchemaGenerationSelectRequest,
+) (*SchemaGeneration, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaGenerationSelect")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("schema_id", request.SchemaID.String()))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(SchemaGeneration)
+
+	err = tx.NewRaw(schemaGenerationSelectQuery, request.SchemaID).Scan(ctx, entity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Join(err, ErrSchemaGenerationSelectNotFound)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
@@ -0,0 +1,6 @@
+SELECT
+  *
+FROM
+  schema_generations
+WHERE
+  schema_id = ?0;
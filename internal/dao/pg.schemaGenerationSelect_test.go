@@ -0,0 +1,91 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaGenerationSelect(t *testing.T) {
+	fixtures := []*dao.SchemaGeneration{
+		{
+			SchemaID:  uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+			Model:     "gpt-4o-mini",
+			Messages: []dao.SchemaGenerationMessage{
+				{Role: "user", Content: "Generate the structure."},
+			},
+			TemplateVersion:  "default:0123456789ab",
+			PromptTokens:     120,
+			CompletionTokens: 40,
+			LatencyMs:        1500,
+			ContextSchemaIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+			ContextCuts: []dao.SchemaGenerationContextCut{
+				{
+					SchemaID:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:     "test-namespace:test-module",
+					Action:     dao.SchemaGenerationContextCutDropped,
+					Tokens:     2000,
+					KeptTokens: 0,
+				},
+			},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.SchemaGenerationSelectRequest
+
+		expect    *dao.SchemaGeneration
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.SchemaGenerationSelectRequest{
+				SchemaID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			},
+
+			expect: fixtures[0],
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &dao.SchemaGenerationSelectRequest{
+				SchemaID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			},
+
+			expectErr: dao.ErrSchemaGenerationSelectNotFound,
+		},
+	}
+
+	repository := dao.NewSchemaGenerationSelect()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
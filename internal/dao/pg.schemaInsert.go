@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/driver/pgdriver"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -29,8 +30,11 @@ type SchemaInsertRequest struct {
 	ModuleVersion    string
 	ModulePreversion string
 	Source           SchemaSource
+	Instructions     string
 	Data             map[string]any
-	Now              time.Time
+	// ForkedFrom is the ID of the schema this version is copied from, if any.
+	ForkedFrom *uuid.UUID
+	Now        time.Time
 }
 
 type SchemaInsert struct{}
@@ -70,8 +74,10 @@ func (repository *SchemaInsert) Exec(ctx context.Context, request *SchemaInsertR
 		request.ModuleVersion,
 		request.ModulePreversion,
 		request.Source,
+		bun.NullZero(request.Instructions),
 		request.Data,
 		request.Now,
+		request.ForkedFrom,
 	).Scan(ctx, entity)
 	if err != nil {
 		var pgErr pgdriver.Error
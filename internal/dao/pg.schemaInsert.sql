@@ -8,10 +8,12 @@ INSERT INTO
     module_version,
     module_preversion,
     source,
+    instructions,
     data,
-    created_at
+    created_at,
+    forked_from
   )
 VALUES
-  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9)
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
 RETURNING
   *;
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel-kit/golib/postgres"
@@ -92,6 +93,66 @@ func TestSchemaInsert(t *testing.T) {
 				CreatedAt:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
 			},
 		},
+		{
+			name: "Success/WithInstructions",
+
+			request: &dao.SchemaInsertRequest{
+				ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Owner:            &ownerID,
+				ModuleID:         "test-module",
+				ModuleNamespace:  "test-namespace",
+				ModuleVersion:    "1.0.0",
+				ModulePreversion: "",
+				Source:           dao.SchemaSourceAI,
+				Instructions:     "Keep it short.",
+				Data:             testData,
+				Now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Schema{
+				ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Owner:            &ownerID,
+				ModuleID:         "test-module",
+				ModuleNamespace:  "test-namespace",
+				ModuleVersion:    "1.0.0",
+				ModulePreversion: "",
+				Source:           dao.SchemaSourceAI,
+				Instructions:     "Keep it short.",
+				Data:             testData,
+				CreatedAt:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Success/Fork",
+
+			request: &dao.SchemaInsertRequest{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          dao.SchemaSourceFork,
+				Data:            testData,
+				ForkedFrom:      lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				Now:             time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.Schema{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          dao.SchemaSourceFork,
+				Data:            testData,
+				ForkedFrom:      lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+				CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
 		{
 			name: "Success/Empty",
 
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaListAt.sql
+var schemaListAtQuery string
+
+type SchemaListAtRequest struct {
+	ProjectID uuid.UUID
+	At        time.Time
+}
+
+// SchemaListAt returns the latest schema of each module of a project, as it was at a given time. Unlike SchemaList,
+// a module whose latest version at that time has no data was removed from the project, and is left out rather than
+// falling back to an older version.
+type SchemaListAt struct{}
+
+func NewSchemaListAt() *SchemaListAt {
+	return new(SchemaListAt)
+}
+
+func (repository *SchemaListAt) Exec(ctx context.Context, request *SchemaListAtRequest) ([]*Schema, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaListAt")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("at", request.At.Format(time.RFC3339)),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var schemas []*Schema
+
+	err = tx.NewRaw(schemaListAtQuery, request.ProjectID, request.At).Scan(ctx, &schemas)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, schemas), nil
+}
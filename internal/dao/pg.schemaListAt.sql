@@ -0,0 +1,21 @@
+SELECT
+  *
+FROM
+  (
+    SELECT DISTINCT
+      ON (module_id, module_namespace) *
+    FROM
+      schemas
+    WHERE
+      project_id = ?0
+      AND created_at <= ?1
+    ORDER BY
+      module_id,
+      module_namespace,
+      created_at DESC
+  ) AS latest_schemas
+WHERE
+  -- Modules removed from the project at that time end with a version without data, and are left out.
+  latest_schemas.data IS NOT NULL
+ORDER BY
+  created_at;
@@ -0,0 +1,238 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaListAt(t *testing.T) {
+	testData1 := map[string]any{
+		"title": "Test Story 1",
+	}
+	testData2 := map[string]any{
+		"title": "Test Story 2",
+	}
+	testData3 := map[string]any{
+		"title": "Test Story 3",
+	}
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000001000")
+
+	// module-a is updated on day 3, module-b is removed from the project on day 4.
+	fixtures := []*dao.Schema{
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			Owner:           &ownerID,
+			ModuleID:        "module-a",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            testData1,
+			CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			Owner:           &ownerID,
+			ModuleID:        "module-a",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceAI,
+			Data:            testData2,
+			CreatedAt:       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			Owner:           &ownerID,
+			ModuleID:        "module-b",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            testData3,
+			CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			Owner:           &ownerID,
+			ModuleID:        "module-b",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            nil,
+			CreatedAt:       time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000200"),
+			Owner:           &ownerID,
+			ModuleID:        "module-a",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            testData3,
+			CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *dao.SchemaListAtRequest
+
+		expect    []*dao.Schema
+		expectErr error
+	}{
+		{
+			name: "Success/BeforeUpdate",
+
+			request: &dao.SchemaListAtRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				At:        time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: []*dao.Schema{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					Owner:           &ownerID,
+					ModuleID:        "module-a",
+					ModuleNamespace: "namespace-1",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            testData1,
+					CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					Owner:           &ownerID,
+					ModuleID:        "module-b",
+					ModuleNamespace: "namespace-1",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            testData3,
+					CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/AfterUpdate",
+
+			request: &dao.SchemaListAtRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				At:        time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: []*dao.Schema{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					Owner:           &ownerID,
+					ModuleID:        "module-b",
+					ModuleNamespace: "namespace-1",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            testData3,
+					CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					Owner:           &ownerID,
+					ModuleID:        "module-a",
+					ModuleNamespace: "namespace-1",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            testData2,
+					CreatedAt:       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/AfterRemoval",
+
+			request: &dao.SchemaListAtRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				At:        time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: []*dao.Schema{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					Owner:           &ownerID,
+					ModuleID:        "module-a",
+					ModuleNamespace: "namespace-1",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            testData2,
+					CreatedAt:       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "Success/BeforeCreation",
+
+			request: &dao.SchemaListAtRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				At:        time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: nil,
+		},
+		{
+			name: "Success/FiltersByProject",
+
+			request: &dao.SchemaListAtRequest{
+				ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000200"),
+				At:        time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: []*dao.Schema{
+				{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000200"),
+					Owner:           &ownerID,
+					ModuleID:        "module-a",
+					ModuleNamespace: "namespace-1",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            testData3,
+					CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	repository := dao.NewSchemaListAt()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				_, err = db.NewInsert().Model(&fixtures).Exec(ctx)
+				require.NoError(t, err)
+
+				schemas, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, schemas)
+			})
+		})
+	}
+}
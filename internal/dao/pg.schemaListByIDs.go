@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.schemaListByIDs.sql
+var schemaListByIDsQuery string
+
+type SchemaListByIDsRequest struct {
+	IDs []uuid.UUID
+}
+
+type SchemaListByIDs struct{}
+
+func NewSchemaListByIDs() *SchemaListByIDs {
+	return new(SchemaListByIDs)
+}
+
+// Exec returns the given schema versions, in the order they were created. Unknown IDs are skipped.
+func (repository *SchemaListByIDs) Exec(ctx context.Context, request *SchemaListByIDsRequest) ([]*Schema, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.SchemaListByIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("ids.count", len(request.IDs)))
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var schemas []*Schema
+
+	err = tx.
+		NewRaw(schemaListByIDsQuery, pgdialect.Array(lo.Map(request.IDs, uuidString))).
+		Scan(ctx, &schemas)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	if schemas == nil {
+		schemas = []*Schema{}
+	}
+
+	return otel.ReportSuccess(span, schemas), nil
+}
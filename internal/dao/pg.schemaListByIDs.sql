@@ -0,0 +1,8 @@
+SELECT
+  *
+FROM
+  schemas
+WHERE
+  id = ANY (?0::uuid[])
+ORDER BY
+  created_at;
@@ -0,0 +1,116 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestSchemaListByIDs(t *testing.T) {
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000001000")
+
+	fixtures := []*dao.Schema{
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			Owner:           &ownerID,
+			ModuleID:        "module-a",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"title": "Test Story 1"},
+			CreatedAt:       time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			Owner:           &ownerID,
+			ModuleID:        "module-a",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceAI,
+			Data:            map[string]any{"title": "Test Story 2"},
+			CreatedAt:       time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+			ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+			Owner:           &ownerID,
+			ModuleID:        "module-b",
+			ModuleNamespace: "namespace-1",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"title": "Test Story 3"},
+			CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.Schema
+
+		request *dao.SchemaListByIDsRequest
+
+		expect    []*dao.Schema
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: fixtures,
+
+			request: &dao.SchemaListByIDsRequest{
+				IDs: []uuid.UUID{
+					uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					// Unknown IDs are skipped.
+					uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+				},
+			},
+
+			expect: []*dao.Schema{fixtures[2], fixtures[0]},
+		},
+		{
+			name: "Success/Empty",
+
+			fixtures: fixtures,
+
+			request: &dao.SchemaListByIDsRequest{
+				IDs: []uuid.UUID{},
+			},
+
+			expect: []*dao.Schema{},
+		},
+	}
+
+	repository := dao.NewSchemaListByIDs()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -26,8 +26,10 @@ type SchemaListVersionsRequest struct {
 }
 
 type SchemaVersion struct {
-	ID        uuid.UUID `bun:"id"`
-	CreatedAt time.Time `bun:"created_at"`
+	ID uuid.UUID `bun:"id"`
+	// Instructions the user gave to steer the generation of the version, if any.
+	Instructions string    `bun:"instructions,nullzero"`
+	CreatedAt    time.Time `bun:"created_at"`
 }
 
 type SchemaListVersions struct{}
@@ -1,5 +1,6 @@
 SELECT
   id,
+  instructions,
   created_at
 FROM
   schemas
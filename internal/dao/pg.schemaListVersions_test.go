@@ -52,6 +52,7 @@ func TestSchemaListVersions(t *testing.T) {
 					ModuleVersion:    "2.0.0",
 					ModulePreversion: "",
 					Source:           dao.SchemaSourceAI,
+					Instructions:     "Keep it short.",
 					Data:             testData,
 					CreatedAt:        time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
@@ -82,8 +83,9 @@ func TestSchemaListVersions(t *testing.T) {
 					CreatedAt: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
 				},
 				{
-					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
-					CreatedAt: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Instructions: "Keep it short.",
+					CreatedAt:    time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 				},
 				{
 					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
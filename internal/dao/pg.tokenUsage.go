@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// TokenUsage records the tokens spent by a single call to a model.
+type TokenUsage struct {
+	bun.BaseModel `bun:"table:token_usages"`
+
+	ID uuid.UUID `bun:"id,pk,type:uuid"`
+	// UserID is the user the tokens are billed to.
+	UserID uuid.UUID `bun:"user_id,type:uuid"`
+	// ProjectID is the project the tokens were spent for. It may point to a deleted project.
+	ProjectID uuid.UUID `bun:"project_id,type:uuid"`
+	// Model that consumed the tokens.
+	Model string `bun:"model"`
+
+	PromptTokens     int64 `bun:"prompt_tokens"`
+	CompletionTokens int64 `bun:"completion_tokens"`
+
+	CreatedAt time.Time `bun:"created_at"`
+}
+
+// TokenUsageProject sums the tokens spent by a user for a project.
+type TokenUsageProject struct {
+	ProjectID        uuid.UUID `bun:"project_id,type:uuid"`
+	PromptTokens     int64     `bun:"prompt_tokens"`
+	CompletionTokens int64     `bun:"completion_tokens"`
+}
+
+// Total returns the number of tokens spent, both sent and generated.
+func (usage *TokenUsageProject) Total() int64 {
+	return usage.PromptTokens + usage.CompletionTokens
+}
@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.tokenUsageInsert.sql
+var tokenUsageInsertQuery string
+
+var ErrTokenUsageInsertAlreadyExists = errors.New("token usage already exists")
+
+type TokenUsageInsertRequest struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	ProjectID        uuid.UUID
+	Model            string
+	PromptTokens     int64
+	CompletionTokens int64
+	Now              time.Time
+}
+
+type TokenUsageInsert struct{}
+
+func NewTokenUsageInsert() *TokenUsageInsert {
+	return new(TokenUsageInsert)
+}
+
+func (repository *TokenUsageInsert) Exec(ctx context.Context, request *TokenUsageInsertRequest) (*TokenUsage, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.TokenUsageInsert")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("id", request.ID.String()),
+		attribute.String("user_id", request.UserID.String()),
+		attribute.String("project_id", request.ProjectID.String()),
+		attribute.String("model", request.Model),
+		attribute.Int64("prompt_tokens", request.PromptTokens),
+		attribute.Int64("completion_tokens", request.CompletionTokens),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	entity := new(TokenUsage)
+
+	err = tx.NewRaw(
+		tokenUsageInsertQuery,
+		request.ID,
+		request.UserID,
+		request.ProjectID,
+		request.Model,
+		request.PromptTokens,
+		request.CompletionTokens,
+		request.Now,
+	).Scan(ctx, entity)
+	if err != nil {
+		var pgErr pgdriver.Error
+		if errors.As(err, &pgErr) && pgErr.Field('C') == "23505" {
+			err = errors.Join(err, ErrTokenUsageInsertAlreadyExists)
+		}
+
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	return otel.ReportSuccess(span, entity), nil
+}
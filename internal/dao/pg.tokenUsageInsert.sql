@@ -0,0 +1,14 @@
+INSERT INTO
+  token_usages (
+    id,
+    user_id,
+    project_id,
+    model,
+    prompt_tokens,
+    completion_tokens,
+    created_at
+  )
+VALUES
+  (?0, ?1, ?2, ?3, ?4, ?5, ?6)
+RETURNING
+  *;
@@ -0,0 +1,99 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestTokenUsageInsert(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.TokenUsage
+
+		request *dao.TokenUsageInsertRequest
+
+		expect    *dao.TokenUsage
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &dao.TokenUsageInsertRequest{
+				ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:            "gpt-4o-mini",
+				PromptTokens:     120,
+				CompletionTokens: 40,
+				Now:              time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: &dao.TokenUsage{
+				ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:            "gpt-4o-mini",
+				PromptTokens:     120,
+				CompletionTokens: 40,
+				CreatedAt:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			fixtures: []*dao.TokenUsage{
+				{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+					Model:     "gpt-4o-mini",
+					CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.TokenUsageInsertRequest{
+				ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				UserID:           uuid.MustParse("00000000-0000-0000-0000-000000000100"),
+				ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+				Model:            "gpt-4o-mini",
+				PromptTokens:     120,
+				CompletionTokens: 40,
+				Now:              time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+
+			expectErr: dao.ErrTokenUsageInsertAlreadyExists,
+		},
+	}
+
+	repository := dao.NewTokenUsageInsert()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
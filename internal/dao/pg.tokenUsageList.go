@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+)
+
+//go:embed pg.tokenUsageList.sql
+var tokenUsageListQuery string
+
+type TokenUsageListRequest struct {
+	UserID uuid.UUID
+	// Since only counts the tokens spent from this time onwards.
+	Since time.Time
+}
+
+type TokenUsageList struct{}
+
+func NewTokenUsageList() *TokenUsageList {
+	return new(TokenUsageList)
+}
+
+// Exec returns the tokens spent by a user over a period, for each project. Projects are sorted by decreasing usage.
+func (repository *TokenUsageList) Exec(
+	ctx context.Context, request *TokenUsageListRequest,
+) ([]*TokenUsageProject, error) {
+	ctx, span := otel.Tracer().Start(ctx, "dao.TokenUsageList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", request.UserID.String()),
+		attribute.String("since", request.Since.String()),
+	)
+
+	tx, err := postgres.GetContext(ctx)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("get transaction: %w", err))
+	}
+
+	var usages []*TokenUsageProject
+
+	err = tx.NewRaw(tokenUsageListQuery, request.UserID, request.Since).Scan(ctx, &usages)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("execute query: %w", err))
+	}
+
+	if usages == nil {
+		usages = []*TokenUsageProject{}
+	}
+
+	return otel.ReportSuccess(span, usages), nil
+}
@@ -0,0 +1,14 @@
+SELECT
+  project_id,
+  SUM(prompt_tokens)::bigint AS prompt_tokens,
+  SUM(completion_tokens)::bigint AS completion_tokens
+FROM
+  token_usages
+WHERE
+  user_id = ?0
+  AND created_at >= ?1
+GROUP BY
+  project_id
+ORDER BY
+  SUM(prompt_tokens + completion_tokens) DESC,
+  project_id;
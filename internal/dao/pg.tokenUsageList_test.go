@@ -0,0 +1,129 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+func TestTokenUsageList(t *testing.T) {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	projectA := uuid.MustParse("00000000-0000-0000-0000-000000000010")
+	projectB := uuid.MustParse("00000000-0000-0000-0000-000000000020")
+
+	testCases := []struct {
+		name string
+
+		fixtures []*dao.TokenUsage
+
+		request *dao.TokenUsageListRequest
+
+		expect    []*dao.TokenUsageProject
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			fixtures: []*dao.TokenUsage{
+				{
+					ID:               uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:           userID,
+					ProjectID:        projectA,
+					Model:            "gpt-4o-mini",
+					PromptTokens:     100,
+					CompletionTokens: 10,
+					CreatedAt:        time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:               uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:           userID,
+					ProjectID:        projectB,
+					Model:            "gpt-4o-mini",
+					PromptTokens:     200,
+					CompletionTokens: 20,
+					CreatedAt:        time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:               uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					UserID:           userID,
+					ProjectID:        projectA,
+					Model:            "gpt-4o",
+					PromptTokens:     300,
+					CompletionTokens: 30,
+					CreatedAt:        time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+				},
+				// Before the period.
+				{
+					ID:               uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					UserID:           userID,
+					ProjectID:        projectB,
+					Model:            "gpt-4o-mini",
+					PromptTokens:     1000,
+					CompletionTokens: 100,
+					CreatedAt:        time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC),
+				},
+				// Other user.
+				{
+					ID:               uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					UserID:           otherUserID,
+					ProjectID:        projectB,
+					Model:            "gpt-4o-mini",
+					PromptTokens:     1000,
+					CompletionTokens: 100,
+					CreatedAt:        time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			request: &dao.TokenUsageListRequest{
+				UserID: userID,
+				Since:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: []*dao.TokenUsageProject{
+				{ProjectID: projectA, PromptTokens: 400, CompletionTokens: 40},
+				{ProjectID: projectB, PromptTokens: 200, CompletionTokens: 20},
+			},
+		},
+		{
+			name: "Success/NoUsage",
+
+			request: &dao.TokenUsageListRequest{
+				UserID: userID,
+				Since:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+
+			expect: []*dao.TokenUsageProject{},
+		},
+	}
+
+	repository := dao.NewTokenUsageList()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				db, err := postgres.GetContext(ctx)
+				require.NoError(t, err)
+
+				if len(testCase.fixtures) > 0 {
+					_, err = db.NewInsert().Model(&testCase.fixtures).Exec(ctx)
+					require.NoError(t, err)
+				}
+
+				res, err := repository.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, res)
+			})
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type GenerationJob struct {
+	ID           uuid.UUID  `json:"id"`
+	ProjectID    uuid.UUID  `json:"projectID"`
+	Owner        uuid.UUID  `json:"owner"`
+	Module       string     `json:"module"`
+	Lang         string     `json:"lang"`
+	Paths        []string   `json:"paths,omitempty"`
+	Instructions string     `json:"instructions,omitempty"`
+	Status       string     `json:"status"`
+	Attempts     int        `json:"attempts"`
+	SchemaID     *uuid.UUID `json:"schemaID"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+	StartedAt    *time.Time `json:"startedAt"`
+	FinishedAt   *time.Time `json:"finishedAt"`
+}
+
+// loadGenerationJob converts a job for the api. The error of failed jobs is internal, and never sent to clients.
+func loadGenerationJob(job *services.GenerationJob) GenerationJob {
+	return GenerationJob{
+		ID:           job.ID,
+		ProjectID:    job.ProjectID,
+		Owner:        job.Owner,
+		Module:       job.Module,
+		Lang:         job.Lang,
+		Paths:        job.Paths,
+		Instructions: job.Instructions,
+		Status:       job.Status,
+		Attempts:     job.Attempts,
+		SchemaID:     job.SchemaID,
+		CreatedAt:    job.CreatedAt,
+		UpdatedAt:    job.UpdatedAt,
+		StartedAt:    job.StartedAt,
+		FinishedAt:   job.FinishedAt,
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type GenerationJobSelectService interface {
+	Exec(ctx context.Context, request *services.GenerationJobSelectRequest) (*services.GenerationJob, error)
+}
+
+type GenerationJobSelectRequest struct {
+	ID uuid.UUID `schema:"id"`
+}
+
+type GenerationJobSelect struct {
+	service GenerationJobSelectService
+	logger  logging.Log
+}
+
+func NewGenerationJobSelect(service GenerationJobSelectService, logger logging.Log) *GenerationJobSelect {
+	return &GenerationJobSelect{service: service, logger: logger}
+}
+
+func (handler *GenerationJobSelect) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.GenerationJobSelect")
+	defer span.End()
+
+	var request GenerationJobSelectRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.GenerationJobSelectRequest{
+		ID:     request.ID,
+		UserID: lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:              http.StatusUnprocessableEntity,
+			services.ErrUserDoesNotOwnGenerationJob: http.StatusForbidden,
+			dao.ErrGenerationJobSelectNotFound:      http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadGenerationJob(res))
+}
@@ -0,0 +1,297 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestGenerationJobSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *services.GenerationJobSelectRequest
+		resp *services.GenerationJob
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *authpkg.Claims
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.GenerationJobSelectRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				resp: &services.GenerationJob{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:     "namespace:module@v1.0.0",
+					Lang:       "en",
+					Status:     "SUCCEEDED",
+					Attempts:   1,
+					SchemaID:   lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000004")),
+					CreatedAt:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC),
+					StartedAt:  lo.ToPtr(time.Date(2026, 1, 1, 0, 0, 1, 0, time.UTC)),
+					FinishedAt: lo.ToPtr(time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC)),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":         "00000000-0000-0000-0000-000000000001",
+				"projectID":  "00000000-0000-0000-0000-000000000002",
+				"owner":      "00000000-0000-0000-0000-000000000003",
+				"module":     "namespace:module@v1.0.0",
+				"lang":       "en",
+				"status":     "SUCCEEDED",
+				"attempts":   float64(1),
+				"schemaID":   "00000000-0000-0000-0000-000000000004",
+				"createdAt":  "2026-01-01T00:00:00Z",
+				"updatedAt":  "2026-01-01T00:01:00Z",
+				"startedAt":  "2026-01-01T00:00:01Z",
+				"finishedAt": "2026-01-01T00:01:00Z",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/FailedJobHidesError",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.GenerationJobSelectRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				resp: &services.GenerationJob{
+					ID:         uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:  uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:      uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:     "namespace:module@v1.0.0",
+					Lang:       "en",
+					Status:     "FAILED",
+					Attempts:   1,
+					Error:      "internal details",
+					CreatedAt:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC),
+					StartedAt:  lo.ToPtr(time.Date(2026, 1, 1, 0, 0, 1, 0, time.UTC)),
+					FinishedAt: lo.ToPtr(time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC)),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":         "00000000-0000-0000-0000-000000000001",
+				"projectID":  "00000000-0000-0000-0000-000000000002",
+				"owner":      "00000000-0000-0000-0000-000000000003",
+				"module":     "namespace:module@v1.0.0",
+				"lang":       "en",
+				"status":     "FAILED",
+				"attempts":   float64(1),
+				"schemaID":   nil,
+				"createdAt":  "2026-01-01T00:00:00Z",
+				"updatedAt":  "2026-01-01T00:01:00Z",
+				"startedAt":  "2026-01-01T00:00:01Z",
+				"finishedAt": "2026-01-01T00:01:00Z",
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/NoClaims",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidQuery",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=not-a-uuid",
+				nil,
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.GenerationJobSelectRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/GenerationJobNotFound",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.GenerationJobSelectRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				err: dao.ErrGenerationJobSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/UserDoesNotOwnGenerationJob",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.GenerationJobSelectRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				err: services.ErrUserDoesNotOwnGenerationJob,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InternalError",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?id=00000000-0000-0000-0000-000000000001",
+				nil,
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.GenerationJobSelectRequest{
+					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockGenerationJobSelectService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewGenerationJobSelect(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = authpkg.SetClaimsContext(rCtx, testCase.claims)
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
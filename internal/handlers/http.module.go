@@ -1,23 +1,36 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/models"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 )
 
 type Module struct {
-	ID          string            `json:"id"`
-	Namespace   string            `json:"namespace"`
-	Version     string            `json:"version"`
-	Preversion  string            `json:"preversion,omitempty"`
-	Description string            `json:"description"`
-	Schema      jsonschema.Schema `json:"schema"`
-	UI          models.ModuleUi   `json:"ui"`
-	CreatedAt   time.Time         `json:"createdAt"`
+	ID          string                    `json:"id"`
+	Namespace   string                    `json:"namespace"`
+	Version     string                    `json:"version"`
+	Preversion  string                    `json:"preversion,omitempty"`
+	Description string                    `json:"description"`
+	Schema      jsonschema.Schema         `json:"schema"`
+	UI          models.ModuleUi           `json:"ui"`
+	Migrations  []models.ModuleMigration  `json:"migrations,omitempty"`
+	DependsOn   []models.ModuleDependency `json:"dependsOn,omitempty"`
+	Generation  *models.ModuleGeneration  `json:"generation,omitempty"`
+	CreatedAt   time.Time                 `json:"createdAt"`
+	LLMReport   []ModuleSchemaChange      `json:"llmReport,omitempty"`
 }
 
 func loadModule(s *services.Module) Module {
@@ -29,7 +42,11 @@ func loadModule(s *services.Module) Module {
 		Description: s.Description,
 		Schema:      s.Schema,
 		UI:          s.UI,
+		Migrations:  s.Migrations,
+		DependsOn:   s.DependsOn,
+		Generation:  s.Generation,
 		CreatedAt:   s.CreatedAt,
+		LLMReport:   loadModuleLLMChanges(s.LLMReport),
 	}
 }
 
@@ -50,3 +67,79 @@ func loadModuleVersion(s *services.ModuleVersion) ModuleVersion {
 func loadModuleVersionsMap(item *services.ModuleVersion, _ int) ModuleVersion {
 	return loadModuleVersion(item)
 }
+
+type ModuleSummary struct {
+	ID          string    `json:"id"`
+	Namespace   string    `json:"namespace"`
+	Version     string    `json:"version"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func loadModuleSummary(s *services.ModuleSummary) ModuleSummary {
+	return ModuleSummary{
+		ID:          s.ID,
+		Namespace:   s.Namespace,
+		Version:     s.Version,
+		Description: s.Description,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+func loadModuleSummariesMap(item *services.ModuleSummary, _ int) ModuleSummary {
+	return loadModuleSummary(item)
+}
+
+type ModuleSchemaChange struct {
+	Path    string `json:"path"`
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+type ModuleSchemaChanges struct {
+	Changes []ModuleSchemaChange `json:"changes"`
+}
+
+func loadModuleSchemaChanges(report lib.JSONSchemaCompatibilityReport) ModuleSchemaChanges {
+	return ModuleSchemaChanges{
+		Changes: lo.Map(report, func(item *lib.JSONSchemaChange, _ int) ModuleSchemaChange {
+			return ModuleSchemaChange{Path: item.Path, Kind: string(item.Kind), Message: item.Message}
+		}),
+	}
+}
+
+func loadModuleLLMChanges(report lib.JSONSchemaLLMReport) []ModuleSchemaChange {
+	return lo.Map(report, func(item *lib.JSONSchemaLLMChange, _ int) ModuleSchemaChange {
+		return ModuleSchemaChange{Path: item.Path, Kind: string(item.Kind), Message: item.Message}
+	})
+}
+
+type ModuleLLMSchema struct {
+	Schema *jsonschema.Schema   `json:"schema"`
+	Usable bool                 `json:"usable"`
+	Report []ModuleSchemaChange `json:"report"`
+}
+
+func loadModuleLLMSchema(s *services.ModuleLLMSchema) ModuleLLMSchema {
+	return ModuleLLMSchema{
+		Schema: s.Schema,
+		Usable: s.Usable(),
+		Report: loadModuleLLMChanges(s.Report),
+	}
+}
+
+// handleModuleBreakingChanges sends the breaking schema changes that prevented a module version from being
+// published to the client, if the error contains any. It returns false if the error has not been handled.
+func handleModuleBreakingChanges(ctx context.Context, w http.ResponseWriter, span trace.Span, err error) bool {
+	var report lib.JSONSchemaCompatibilityReport
+	if !errors.As(err, &report) {
+		return false
+	}
+
+	_ = otel.ReportError(span, err)
+
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	httpf.SendJSON(ctx, w, span, loadModuleSchemaChanges(report))
+
+	return true
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ModuleCreateService interface {
+	Exec(ctx context.Context, request *services.ModuleCreateRequest) (*services.Module, error)
+}
+
+type ModuleCreateRequest struct {
+	Module      string                    `json:"module"`
+	Description string                    `json:"description"`
+	Schema      jsonschema.Schema         `json:"schema"`
+	UI          models.ModuleUi           `json:"ui"`
+	Migrations  []models.ModuleMigration  `json:"migrations"`
+	DependsOn   []models.ModuleDependency `json:"dependsOn"`
+	Generation  *models.ModuleGeneration  `json:"generation"`
+	Overwrite   bool                      `json:"overwrite"`
+}
+
+type ModuleCreate struct {
+	service ModuleCreateService
+	logger  logging.Log
+}
+
+func NewModuleCreate(service ModuleCreateService, logger logging.Log) *ModuleCreate {
+	return &ModuleCreate{service: service, logger: logger}
+}
+
+func (handler *ModuleCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ModuleCreate")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ModuleCreateRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ModuleCreateRequest{
+		Module:      request.Module,
+		Description: request.Description,
+		Schema:      request.Schema,
+		UI:          request.UI,
+		Migrations:  request.Migrations,
+		DependsOn:   request.DependsOn,
+		Generation:  request.Generation,
+		Overwrite:   request.Overwrite,
+	})
+	if err != nil {
+		if handleModuleBreakingChanges(ctx, w, span, err) {
+			return
+		}
+
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:       http.StatusUnprocessableEntity,
+			dao.ErrModuleInsertAlreadyExists: http.StatusConflict,
+		}, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	httpf.SendJSON(ctx, w, span, loadModule(res))
+}
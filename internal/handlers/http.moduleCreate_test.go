@@ -0,0 +1,476 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestModuleCreate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *services.ModuleCreateRequest
+		resp *services.Module
+		err  error
+	}
+
+	requestBody := `{
+		"module":"my-namespace:my-module@v1.0.0",
+		"description":"A test module",
+		"schema":{"type":"object"},
+		"ui":{"component":"test-component","target":"test-target"}
+	}`
+
+	expectServiceRequest := &services.ModuleCreateRequest{
+		Module:      "my-namespace:my-module@v1.0.0",
+		Description: "A test module",
+		Schema: jsonschema.Schema{
+			Type: "object",
+		},
+		UI: models.ModuleUi{
+			Component: "test-component",
+			Target:    "test-target",
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequest(http.MethodPut, "/", strings.NewReader(requestBody)),
+
+			serviceMock: &serviceMock{
+				req: expectServiceRequest,
+				resp: &services.Module{
+					ID:          "my-module",
+					Namespace:   "my-namespace",
+					Version:     "1.0.0",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":          "my-module",
+				"namespace":   "my-namespace",
+				"version":     "1.0.0",
+				"description": "A test module",
+				"schema": map[string]any{
+					"type": "object",
+				},
+				"ui": map[string]any{
+					"component": "test-component",
+					"params":    nil,
+					"target":    "test-target",
+				},
+				"createdAt": "2026-01-01T00:00:00Z",
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "Success/Overwrite",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{
+					"module":"my-namespace:my-module@v1.0.0-beta",
+					"description":"A test module",
+					"schema":{"type":"object"},
+					"ui":{"component":"test-component","target":"test-target"},
+					"overwrite":true
+				}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleCreateRequest{
+					Module:      "my-namespace:my-module@v1.0.0-beta",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					Overwrite: true,
+				},
+				resp: &services.Module{
+					ID:          "my-module",
+					Namespace:   "my-namespace",
+					Version:     "1.0.0",
+					Preversion:  "-beta",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":          "my-module",
+				"namespace":   "my-namespace",
+				"version":     "1.0.0",
+				"preversion":  "-beta",
+				"description": "A test module",
+				"schema": map[string]any{
+					"type": "object",
+				},
+				"ui": map[string]any{
+					"component": "test-component",
+					"params":    nil,
+					"target":    "test-target",
+				},
+				"createdAt": "2026-01-01T00:00:00Z",
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "Success/WithDependencies",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{
+					"module":"my-namespace:my-module@v1.0.0",
+					"description":"A test module",
+					"schema":{"type":"object"},
+					"ui":{"component":"test-component","target":"test-target"},
+					"dependsOn":[{"module":"my-namespace:other-module","paths":["/intent"]}]
+				}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleCreateRequest{
+					Module:      "my-namespace:my-module@v1.0.0",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					DependsOn: []models.ModuleDependency{
+						{Module: "my-namespace:other-module", Paths: []string{"/intent"}},
+					},
+				},
+				resp: &services.Module{
+					ID:          "my-module",
+					Namespace:   "my-namespace",
+					Version:     "1.0.0",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					DependsOn: []models.ModuleDependency{
+						{Module: "my-namespace:other-module", Paths: []string{"/intent"}},
+					},
+					CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":          "my-module",
+				"namespace":   "my-namespace",
+				"version":     "1.0.0",
+				"description": "A test module",
+				"schema": map[string]any{
+					"type": "object",
+				},
+				"ui": map[string]any{
+					"component": "test-component",
+					"params":    nil,
+					"target":    "test-target",
+				},
+				"dependsOn": []any{
+					map[string]any{"module": "my-namespace:other-module", "paths": []any{"/intent"}},
+				},
+				"createdAt": "2026-01-01T00:00:00Z",
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "Success/WithGeneration",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{
+					"module":"my-namespace:my-module@v1.0.0",
+					"description":"A test module",
+					"schema":{"type":"object"},
+					"ui":{"component":"test-component","target":"test-target"},
+					"generation":{"instructions":"Keep it short.","temperature":0.5,"maxTokens":512}
+				}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleCreateRequest{
+					Module:      "my-namespace:my-module@v1.0.0",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					Generation: &models.ModuleGeneration{
+						Instructions: "Keep it short.",
+						Temperature:  lo.ToPtr(0.5),
+						MaxTokens:    lo.ToPtr[int64](512),
+					},
+				},
+				resp: &services.Module{
+					ID:          "my-module",
+					Namespace:   "my-namespace",
+					Version:     "1.0.0",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					Generation: &models.ModuleGeneration{
+						Instructions: "Keep it short.",
+						Temperature:  lo.ToPtr(0.5),
+						MaxTokens:    lo.ToPtr[int64](512),
+					},
+					CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":          "my-module",
+				"namespace":   "my-namespace",
+				"version":     "1.0.0",
+				"description": "A test module",
+				"schema": map[string]any{
+					"type": "object",
+				},
+				"ui": map[string]any{
+					"component": "test-component",
+					"params":    nil,
+					"target":    "test-target",
+				},
+				"generation": map[string]any{
+					"instructions": "Keep it short.",
+					"temperature":  0.5,
+					"maxTokens":    float64(512),
+				},
+				"createdAt": "2026-01-01T00:00:00Z",
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "Success/WithLLMReport",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{
+					"module":"my-namespace:my-module@v1.0.0",
+					"description":"A test module",
+					"schema":{"type":"object"},
+					"ui":{"component":"test-component","target":"test-target"}
+				}`),
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleCreateRequest{
+					Module:      "my-namespace:my-module@v1.0.0",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+				},
+				resp: &services.Module{
+					ID:          "my-module",
+					Namespace:   "my-namespace",
+					Version:     "1.0.0",
+					Description: "A test module",
+					Schema: jsonschema.Schema{
+						Type: "object",
+					},
+					UI: models.ModuleUi{
+						Component: "test-component",
+						Target:    "test-target",
+					},
+					CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					LLMReport: lib.JSONSchemaLLMReport{
+						{Path: "", Kind: lib.JSONSchemaLLMChangeDropped, Message: "object has no supported properties"},
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":          "my-module",
+				"namespace":   "my-namespace",
+				"version":     "1.0.0",
+				"description": "A test module",
+				"schema": map[string]any{
+					"type": "object",
+				},
+				"ui": map[string]any{
+					"component": "test-component",
+					"params":    nil,
+					"target":    "test-target",
+				},
+				"createdAt": "2026-01-01T00:00:00Z",
+				"llmReport": []any{
+					map[string]any{"path": "", "kind": "dropped", "message": "object has no supported properties"},
+				},
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "Error/InvalidJSON",
+
+			request: httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{invalid`)),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequest(http.MethodPut, "/", strings.NewReader(requestBody)),
+
+			serviceMock: &serviceMock{
+				req: expectServiceRequest,
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/BreakingChanges",
+
+			request: httptest.NewRequest(http.MethodPut, "/", strings.NewReader(requestBody)),
+
+			serviceMock: &serviceMock{
+				req: expectServiceRequest,
+				err: errors.Join(services.ErrBreakingModuleChange, lib.JSONSchemaCompatibilityReport{
+					{Path: "/title", Kind: lib.JSONSchemaChangeBreaking, Message: "required property removed"},
+				}),
+			},
+
+			expectResponse: map[string]any{
+				"changes": []any{
+					map[string]any{"path": "/title", "kind": "breaking", "message": "required property removed"},
+				},
+			},
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			request: httptest.NewRequest(http.MethodPut, "/", strings.NewReader(requestBody)),
+
+			serviceMock: &serviceMock{
+				req: expectServiceRequest,
+				err: dao.ErrModuleInsertAlreadyExists,
+			},
+
+			expectStatus: http.StatusConflict,
+		},
+		{
+			name: "Error/InternalError",
+
+			request: httptest.NewRequest(http.MethodPut, "/", strings.NewReader(requestBody)),
+
+			serviceMock: &serviceMock{
+				req: expectServiceRequest,
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockModuleCreateService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewModuleCreate(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ModuleDeleteService interface {
+	Exec(ctx context.Context, request *services.ModuleDeleteRequest) (*services.Module, error)
+}
+
+type ModuleDeleteRequest struct {
+	Module string `json:"module"`
+}
+
+type ModuleDelete struct {
+	service ModuleDeleteService
+	logger  logging.Log
+}
+
+func NewModuleDelete(service ModuleDeleteService, logger logging.Log) *ModuleDelete {
+	return &ModuleDelete{service: service, logger: logger}
+}
+
+func (handler *ModuleDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ModuleDelete")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ModuleDeleteRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ModuleDeleteRequest{
+		Module: request.Module,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:  http.StatusUnprocessableEntity,
+			dao.ErrModuleDeleteNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadModule(res))
+}
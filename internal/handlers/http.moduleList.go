@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ModuleListService interface {
+	Exec(ctx context.Context, request *services.ModuleListRequest) ([]*services.ModuleSummary, error)
+}
+
+type ModuleListRequest struct {
+	Namespace string `schema:"namespace"`
+	Query     string `schema:"query"`
+	Limit     int    `schema:"limit"`
+	Offset    int    `schema:"offset"`
+}
+
+type ModuleList struct {
+	service ModuleListService
+	logger  logging.Log
+}
+
+func NewModuleList(service ModuleListService, logger logging.Log) *ModuleList {
+	return &ModuleList{service: service, logger: logger}
+}
+
+func (handler *ModuleList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ModuleList")
+	defer span.End()
+
+	var request ModuleListRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ModuleListRequest{
+		Namespace: request.Namespace,
+		Query:     request.Query,
+		Limit:     request.Limit,
+		Offset:    request.Offset,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, lo.Map(res, loadModuleSummariesMap))
+}
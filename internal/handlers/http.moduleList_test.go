@@ -0,0 +1,173 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestModuleList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *services.ModuleListRequest
+		resp []*services.ModuleSummary
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequest(http.MethodGet, "/?limit=10&offset=0", nil),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleListRequest{
+					Limit:  10,
+					Offset: 0,
+				},
+				resp: []*services.ModuleSummary{
+					{
+						ID:          "character",
+						Namespace:   "agora",
+						Version:     "1.2.0",
+						Description: "Describe the main character of a story",
+						CreatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:          "idea",
+						Namespace:   "agora",
+						Version:     "1.0.0",
+						Description: "Generate an idea for a story",
+						CreatedAt:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expectResponse: []any{
+				map[string]any{
+					"id":          "character",
+					"namespace":   "agora",
+					"version":     "1.2.0",
+					"description": "Describe the main character of a story",
+					"createdAt":   "2026-01-01T00:00:00Z",
+				},
+				map[string]any{
+					"id":          "idea",
+					"namespace":   "agora",
+					"version":     "1.0.0",
+					"description": "Generate an idea for a story",
+					"createdAt":   "2026-01-02T00:00:00Z",
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/WithFilters",
+
+			request: httptest.NewRequest(http.MethodGet, "/?namespace=agora&query=story%20idea&limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleListRequest{
+					Namespace: "agora",
+					Query:     "story idea",
+					Limit:     10,
+				},
+				resp: []*services.ModuleSummary{},
+			},
+
+			expectResponse: []any{},
+			expectStatus:   http.StatusOK,
+		},
+		{
+			name: "Error/BadRequest",
+
+			request: httptest.NewRequest(http.MethodGet, "/?limit=foo", nil),
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequest(http.MethodGet, "/", nil),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleListRequest{},
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/InternalError",
+
+			request: httptest.NewRequest(http.MethodGet, "/?limit=10", nil),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleListRequest{
+					Limit: 10,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockModuleListService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewModuleList(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ModuleSelectLLMSchemaService interface {
+	Exec(ctx context.Context, request *services.ModuleSelectLLMSchemaRequest) (*services.ModuleLLMSchema, error)
+}
+
+type ModuleSelectLLMSchemaRequest struct {
+	Module string `schema:"module"`
+}
+
+type ModuleSelectLLMSchema struct {
+	service ModuleSelectLLMSchemaService
+	logger  logging.Log
+}
+
+func NewModuleSelectLLMSchema(service ModuleSelectLLMSchemaService, logger logging.Log) *ModuleSelectLLMSchema {
+	return &ModuleSelectLLMSchema{service: service, logger: logger}
+}
+
+func (handler *ModuleSelectLLMSchema) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ModuleSelectLLMSchema")
+	defer span.End()
+
+	var request ModuleSelectLLMSchemaRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ModuleSelectLLMSchemaRequest{
+		Module: request.Module,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:  http.StatusUnprocessableEntity,
+			dao.ErrModuleSelectNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadModuleLLMSchema(res))
+}
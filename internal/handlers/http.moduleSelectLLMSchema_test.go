@@ -0,0 +1,200 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestModuleSelectLLMSchema(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *services.ModuleSelectLLMSchemaRequest
+		resp *services.ModuleLLMSchema
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?module=my-namespace:my-module@1.0.0",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleSelectLLMSchemaRequest{
+					Module: "my-namespace:my-module@1.0.0",
+				},
+				resp: &services.ModuleLLMSchema{
+					Schema: &jsonschema.Schema{
+						Type:       "object",
+						Properties: map[string]*jsonschema.Schema{"title": {Type: "string"}},
+						Required:   []string{"title"},
+					},
+					Report: lib.JSONSchemaLLMReport{
+						{Path: "/website", Kind: lib.JSONSchemaLLMChangeDropped, Message: `unsupported format "uri"`},
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"schema": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"title": map[string]any{"type": "string"}},
+					"required":   []any{"title"},
+				},
+				"usable": true,
+				"report": []any{
+					map[string]any{"path": "/website", "kind": "dropped", "message": `unsupported format "uri"`},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Success/Unusable",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?module=my-namespace:my-module@1.0.0",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleSelectLLMSchemaRequest{
+					Module: "my-namespace:my-module@1.0.0",
+				},
+				resp: &services.ModuleLLMSchema{
+					Report: lib.JSONSchemaLLMReport{
+						{Path: "", Kind: lib.JSONSchemaLLMChangeDropped, Message: "object has no supported properties"},
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"schema": nil,
+				"usable": false,
+				"report": []any{
+					map[string]any{"path": "", "kind": "dropped", "message": "object has no supported properties"},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?module=my-namespace:my-module@1.0.0",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleSelectLLMSchemaRequest{
+					Module: "my-namespace:my-module@1.0.0",
+				},
+				err: dao.ErrModuleSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?module=my-namespace:my-module@1.0.0",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleSelectLLMSchemaRequest{
+					Module: "my-namespace:my-module@1.0.0",
+				},
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/InternalError",
+
+			request: httptest.NewRequest(
+				http.MethodGet,
+				"/?module=my-namespace:my-module@1.0.0",
+				nil,
+			),
+
+			serviceMock: &serviceMock{
+				req: &services.ModuleSelectLLMSchemaRequest{
+					Module: "my-namespace:my-module@1.0.0",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockModuleSelectLLMSchemaService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewModuleSelectLLMSchema(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, testCase.request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
@@ -16,6 +16,8 @@ type Project struct {
 	Workflow  []string  `json:"workflow"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// ForkedFrom is the ID of the project this one was forked from, if any.
+	ForkedFrom *uuid.UUID `json:"forkedFrom,omitempty"`
 }
 
 func loadProject(s *services.Project) Project {
@@ -27,6 +29,8 @@ func loadProject(s *services.Project) Project {
 		Workflow:  s.Workflow,
 		CreatedAt: s.CreatedAt,
 		UpdatedAt: s.UpdatedAt,
+
+		ForkedFrom: s.ForkedFrom,
 	}
 }
 
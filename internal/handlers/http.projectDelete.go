@@ -63,10 +63,10 @@ func (handler *ProjectDelete) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
-			services.ErrUserDoesNotOwnProject: http.StatusForbidden,
-			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
-			dao.ErrProjectDeleteNotFound:      http.StatusNotFound,
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
+			dao.ErrProjectDeleteNotFound:    http.StatusNotFound,
 		}, err)
 
 		return
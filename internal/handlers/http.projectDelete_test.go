@@ -171,7 +171,7 @@ func TestProjectDelete(t *testing.T) {
 					ID:     uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 				},
-				err: services.ErrUserDoesNotOwnProject,
+				err: services.ErrProjectAccessDenied,
 			},
 
 			expectStatus: http.StatusForbidden,
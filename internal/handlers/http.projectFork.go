@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectForkService interface {
+	Exec(ctx context.Context, request *services.ProjectForkRequest) (*services.Project, error)
+}
+
+type ProjectForkRequest struct {
+	ProjectID uuid.UUID `json:"projectID"`
+}
+
+type ProjectFork struct {
+	service ProjectForkService
+	logger  logging.Log
+}
+
+func NewProjectFork(service ProjectForkService, logger logging.Log) *ProjectFork {
+	return &ProjectFork{service: service, logger: logger}
+}
+
+func (handler *ProjectFork) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectFork")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectForkRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectForkRequest{
+		ProjectID: request.ProjectID,
+		UserID:    lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProject(res))
+}
@@ -66,9 +66,10 @@ func (handler *ProjectInit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
-			dao.ErrModuleSelectNotFound:       http.StatusNotFound,
-			dao.ErrProjectInsertAlreadyExists: http.StatusConflict,
+			services.ErrInvalidRequest:          http.StatusUnprocessableEntity,
+			dao.ErrModuleSelectNotFound:         http.StatusNotFound,
+			services.ErrModuleRangeNotSatisfied: http.StatusNotFound,
+			dao.ErrProjectInsertAlreadyExists:   http.StatusConflict,
 		}, err)
 
 		return
@@ -160,6 +160,30 @@ func TestProjectInit(t *testing.T) {
 
 			expectStatus: http.StatusNotFound,
 		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"lang":"en","title":"Test Project","workflow":["agora:idea@^1.2","step2"]}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectInitRequest{
+					Owner:    uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Lang:     "en",
+					Title:    "Test Project",
+					Workflow: []string{"agora:idea@^1.2", "step2"},
+				},
+				err: services.ErrModuleRangeNotSatisfied,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
 		{
 			name: "Error/ProjectAlreadyExists",
 
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectMember struct {
+	ProjectID uuid.UUID `json:"projectID"`
+	UserID    uuid.UUID `json:"userID"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func loadProjectMember(s *services.ProjectMember) ProjectMember {
+	return ProjectMember{
+		ProjectID: s.ProjectID,
+		UserID:    s.UserID,
+		Role:      s.Role,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func loadProjectMembersMap(m *services.ProjectMember, _ int) ProjectMember {
+	return loadProjectMember(m)
+}
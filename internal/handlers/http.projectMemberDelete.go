@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectMemberDeleteService interface {
+	Exec(ctx context.Context, request *services.ProjectMemberDeleteRequest) (*services.ProjectMember, error)
+}
+
+type ProjectMemberDeleteRequest struct {
+	ProjectID uuid.UUID `json:"projectID"`
+	UserID    uuid.UUID `json:"userID"`
+}
+
+type ProjectMemberDelete struct {
+	service ProjectMemberDeleteService
+	logger  logging.Log
+}
+
+func NewProjectMemberDelete(service ProjectMemberDeleteService, logger logging.Log) *ProjectMemberDelete {
+	return &ProjectMemberDelete{service: service, logger: logger}
+}
+
+func (handler *ProjectMemberDelete) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectMemberDelete")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectMemberDeleteRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectMemberDeleteRequest{
+		ProjectID: request.ProjectID,
+		MemberID:  request.UserID,
+		UserID:    lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:         http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:    http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:       http.StatusNotFound,
+			dao.ErrProjectMemberDeleteNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProjectMember(res))
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectMemberUpsertService interface {
+	Exec(ctx context.Context, request *services.ProjectMemberUpsertRequest) (*services.ProjectMember, error)
+}
+
+type ProjectMemberUpsertRequest struct {
+	ProjectID uuid.UUID `json:"projectID"`
+	UserID    uuid.UUID `json:"userID"`
+	Role      string    `json:"role"`
+}
+
+type ProjectMemberUpsert struct {
+	service ProjectMemberUpsertService
+	logger  logging.Log
+}
+
+func NewProjectMemberUpsert(service ProjectMemberUpsertService, logger logging.Log) *ProjectMemberUpsert {
+	return &ProjectMemberUpsert{service: service, logger: logger}
+}
+
+func (handler *ProjectMemberUpsert) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectMemberUpsert")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectMemberUpsertRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectMemberUpsertRequest{
+		ProjectID: request.ProjectID,
+		MemberID:  request.UserID,
+		Role:      request.Role,
+		UserID:    lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:       http.StatusUnprocessableEntity,
+			services.ErrProjectMemberIsOwner: http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:  http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:     http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProjectMember(res))
+}
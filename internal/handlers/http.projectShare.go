@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectShare struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectID"`
+	// Token is only returned when the share is created.
+	Token     string     `json:"token,omitempty"`
+	Modules   []string   `json:"modules,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func loadProjectShare(s *services.ProjectShare) ProjectShare {
+	return ProjectShare{
+		ID:        s.ID,
+		ProjectID: s.ProjectID,
+		Token:     s.Token,
+		Modules:   s.Modules,
+		ExpiresAt: s.ExpiresAt,
+		RevokedAt: s.RevokedAt,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func loadProjectSharesMap(s *services.ProjectShare, _ int) ProjectShare {
+	return loadProjectShare(s)
+}
+
+// SharedProjectInfo is a project, as seen by anonymous readers of a share link. It does not expose who owns the
+// project, or where it was forked from.
+type SharedProjectInfo struct {
+	ID        uuid.UUID `json:"id"`
+	Lang      string    `json:"lang"`
+	Title     string    `json:"title"`
+	Workflow  []string  `json:"workflow"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func loadSharedProjectInfo(s *services.Project) SharedProjectInfo {
+	return SharedProjectInfo{
+		ID:        s.ID,
+		Lang:      s.Lang,
+		Title:     s.Title,
+		Workflow:  s.Workflow,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+// SharedSchema is a schema, as seen by anonymous readers of a share link. It only exposes the content of the
+// schema, without its authors or the instructions they gave.
+type SharedSchema struct {
+	ID        uuid.UUID      `json:"id"`
+	Module    string         `json:"module"`
+	Source    string         `json:"source"`
+	Data      map[string]any `json:"data"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+func loadSharedSchema(s *services.Schema) SharedSchema {
+	return SharedSchema{
+		ID: s.ID,
+		Module: (lib.DecodedModule{
+			Namespace:  s.ModuleNamespace,
+			Module:     s.ModuleID,
+			Version:    s.ModuleVersion,
+			Preversion: s.ModulePreversion,
+		}).String(),
+		Source:    s.Source,
+		Data:      s.Data,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func loadSharedSchemasMap(item *services.Schema, _ int) SharedSchema {
+	return loadSharedSchema(item)
+}
+
+type SharedProject struct {
+	Project SharedProjectInfo `json:"project"`
+	Schemas []SharedSchema    `json:"schemas"`
+}
+
+func loadSharedProject(s *services.SharedProject) SharedProject {
+	return SharedProject{
+		Project: loadSharedProjectInfo(s.Project),
+		Schemas: lo.Map(s.Schemas, loadSharedSchemasMap),
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectShareCreateService interface {
+	Exec(ctx context.Context, request *services.ProjectShareCreateRequest) (*services.ProjectShare, error)
+}
+
+type ProjectShareCreateRequest struct {
+	ProjectID uuid.UUID  `json:"projectID"`
+	Modules   []string   `json:"modules"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type ProjectShareCreate struct {
+	service ProjectShareCreateService
+	logger  logging.Log
+}
+
+func NewProjectShareCreate(service ProjectShareCreateService, logger logging.Log) *ProjectShareCreate {
+	return &ProjectShareCreate{service: service, logger: logger}
+}
+
+func (handler *ProjectShareCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectShareCreate")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectShareCreateRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectShareCreateRequest{
+		ProjectID: request.ProjectID,
+		UserID:    lo.FromPtr(claims.UserID),
+		Modules:   request.Modules,
+		ExpiresAt: request.ExpiresAt,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrModuleNotInProject:  http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProjectShare(res))
+}
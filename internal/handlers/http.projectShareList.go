@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectShareListService interface {
+	Exec(ctx context.Context, request *services.ProjectShareListRequest) ([]*services.ProjectShare, error)
+}
+
+type ProjectShareListRequest struct {
+	ProjectID uuid.UUID `schema:"projectID"`
+}
+
+type ProjectShareList struct {
+	service ProjectShareListService
+	logger  logging.Log
+}
+
+func NewProjectShareList(service ProjectShareListService, logger logging.Log) *ProjectShareList {
+	return &ProjectShareList{service: service, logger: logger}
+}
+
+func (handler *ProjectShareList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectShareList")
+	defer span.End()
+
+	var request ProjectShareListRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectShareListRequest{
+		ProjectID: request.ProjectID,
+		UserID:    lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, lo.Map(res, loadProjectSharesMap))
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectShareRevokeService interface {
+	Exec(ctx context.Context, request *services.ProjectShareRevokeRequest) (*services.ProjectShare, error)
+}
+
+type ProjectShareRevokeRequest struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectID"`
+}
+
+type ProjectShareRevoke struct {
+	service ProjectShareRevokeService
+	logger  logging.Log
+}
+
+func NewProjectShareRevoke(service ProjectShareRevokeService, logger logging.Log) *ProjectShareRevoke {
+	return &ProjectShareRevoke{service: service, logger: logger}
+}
+
+func (handler *ProjectShareRevoke) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectShareRevoke")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectShareRevokeRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectShareRevokeRequest{
+		ID:        request.ID,
+		ProjectID: request.ProjectID,
+		UserID:    lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:   http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
+			dao.ErrProjectShareRevokeNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProjectShare(res))
+}
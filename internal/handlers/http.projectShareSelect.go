@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectShareSelectService interface {
+	Exec(ctx context.Context, request *services.ProjectShareSelectRequest) (*services.SharedProject, error)
+}
+
+// ShareTokenHeader carries the token of a share link. Tokens are not sent in the URL, so they do not end up in
+// access logs, browser history or referrers.
+const ShareTokenHeader = "X-Share-Token"
+
+type ProjectShareSelect struct {
+	service ProjectShareSelectService
+	logger  logging.Log
+}
+
+func NewProjectShareSelect(service ProjectShareSelectService, logger logging.Log) *ProjectShareSelect {
+	return &ProjectShareSelect{service: service, logger: logger}
+}
+
+// ServeHTTP reads a project through a share link. The token grants access on its own, so anonymous users can call
+// this endpoint.
+func (handler *ProjectShareSelect) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectShareSelect")
+	defer span.End()
+
+	res, err := handler.service.Exec(ctx, &services.ProjectShareSelectRequest{
+		Token: r.Header.Get(ShareTokenHeader),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
+			services.ErrProjectShareInactive:  http.StatusGone,
+			dao.ErrProjectShareSelectNotFound: http.StatusNotFound,
+			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadSharedProject(res))
+}
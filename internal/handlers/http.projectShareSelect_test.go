@@ -0,0 +1,210 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestProjectShareSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *services.ProjectShareSelectRequest
+		resp *services.SharedProject
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		// token is sent in the share token header, when set.
+		token string
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			token: "share-token",
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectShareSelectRequest{
+					Token: "share-token",
+				},
+				resp: &services.SharedProject{
+					Project: &services.Project{
+						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Lang:      config.LangEN,
+						Title:     "Test Project",
+						Workflow:  []string{"agora:idea@v1.0.0"},
+						CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Schemas: []*services.Schema{
+						{
+							ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+							ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+							Owner:           lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+							ModuleID:        "idea",
+							ModuleNamespace: "agora",
+							ModuleVersion:   "1.0.0",
+							Source:          "USER",
+							Instructions:    "Make it dark.",
+							Data:            map[string]any{"idea": "a story"},
+							CreatedAt:       time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+
+			// Owners and instructions are not shared.
+			expectResponse: map[string]any{
+				"project": map[string]any{
+					"id":        "00000000-0000-0000-0000-000000000001",
+					"lang":      "en",
+					"title":     "Test Project",
+					"workflow":  []any{"agora:idea@v1.0.0"},
+					"createdAt": "2026-01-01T00:00:00Z",
+					"updatedAt": "2026-01-01T00:00:00Z",
+				},
+				"schemas": []any{
+					map[string]any{
+						"id":        "00000000-0000-0000-0000-000000000003",
+						"module":    "agora:idea@v1.0.0",
+						"source":    "USER",
+						"data":      map[string]any{"idea": "a story"},
+						"createdAt": "2026-01-02T00:00:00Z",
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/MissingToken",
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectShareSelectRequest{},
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/ShareNotFound",
+
+			token: "share-token",
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectShareSelectRequest{
+					Token: "share-token",
+				},
+				err: dao.ErrProjectShareSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/ShareInactive",
+
+			token: "share-token",
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectShareSelectRequest{
+					Token: "share-token",
+				},
+				err: services.ErrProjectShareInactive,
+			},
+
+			expectStatus: http.StatusGone,
+		},
+		{
+			name: "Error/ProjectNotFound",
+
+			token: "share-token",
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectShareSelectRequest{
+					Token: "share-token",
+				},
+				err: dao.ErrProjectSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/InternalError",
+
+			token: "share-token",
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectShareSelectRequest{
+					Token: "share-token",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockProjectShareSelectService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			if testCase.token != "" {
+				request.Header.Set(handlers.ShareTokenHeader, testCase.token)
+			}
+
+			handler := handlers.NewProjectShareSelect(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, request)
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
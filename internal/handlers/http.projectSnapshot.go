@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectSnapshot struct {
+	ID        uuid.UUID   `json:"id"`
+	ProjectID uuid.UUID   `json:"projectID"`
+	Owner     uuid.UUID   `json:"owner"`
+	Name      string      `json:"name"`
+	SchemaIDs []uuid.UUID `json:"schemaIDs"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+func loadProjectSnapshot(s *services.ProjectSnapshot) ProjectSnapshot {
+	return ProjectSnapshot{
+		ID:        s.ID,
+		ProjectID: s.ProjectID,
+		Owner:     s.Owner,
+		Name:      s.Name,
+		SchemaIDs: s.SchemaIDs,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func loadProjectSnapshotsMap(s *services.ProjectSnapshot, _ int) ProjectSnapshot {
+	return loadProjectSnapshot(s)
+}
+
+type ProjectSnapshotContent struct {
+	Snapshot ProjectSnapshot `json:"snapshot"`
+	Schemas  []Schema        `json:"schemas"`
+}
+
+func loadProjectSnapshotContent(s *services.ProjectSnapshotContent) ProjectSnapshotContent {
+	return ProjectSnapshotContent{
+		Snapshot: loadProjectSnapshot(s.Snapshot),
+		Schemas:  lo.Map(s.Schemas, loadSchemasMap),
+	}
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectSnapshotCreateService interface {
+	Exec(ctx context.Context, request *services.ProjectSnapshotCreateRequest) (*services.ProjectSnapshot, error)
+}
+
+type ProjectSnapshotCreateRequest struct {
+	ProjectID uuid.UUID `json:"projectID"`
+	Name      string    `json:"name"`
+}
+
+type ProjectSnapshotCreate struct {
+	service ProjectSnapshotCreateService
+	logger  logging.Log
+}
+
+func NewProjectSnapshotCreate(service ProjectSnapshotCreateService, logger logging.Log) *ProjectSnapshotCreate {
+	return &ProjectSnapshotCreate{service: service, logger: logger}
+}
+
+func (handler *ProjectSnapshotCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectSnapshotCreate")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectSnapshotCreateRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectSnapshotCreateRequest{
+		ProjectID: request.ProjectID,
+		UserID:    lo.FromPtr(claims.UserID),
+		Name:      request.Name,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:                http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:           http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:              http.StatusNotFound,
+			dao.ErrProjectSnapshotInsertAlreadyExists: http.StatusConflict,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProjectSnapshot(res))
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectSnapshotRestoreService interface {
+	Exec(ctx context.Context, request *services.ProjectSnapshotRestoreRequest) ([]*services.Schema, error)
+}
+
+type ProjectSnapshotRestoreRequest struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type ProjectSnapshotRestore struct {
+	service ProjectSnapshotRestoreService
+	logger  logging.Log
+}
+
+func NewProjectSnapshotRestore(service ProjectSnapshotRestoreService, logger logging.Log) *ProjectSnapshotRestore {
+	return &ProjectSnapshotRestore{service: service, logger: logger}
+}
+
+func (handler *ProjectSnapshotRestore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectSnapshotRestore")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectSnapshotRestoreRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectSnapshotRestoreRequest{
+		ID:     request.ID,
+		UserID: lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:           http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:      http.StatusForbidden,
+			services.ErrProjectSnapshotOutdated:  http.StatusConflict,
+			dao.ErrProjectSelectNotFound:         http.StatusNotFound,
+			dao.ErrProjectSnapshotSelectNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, lo.Map(res, loadSchemasMap))
+}
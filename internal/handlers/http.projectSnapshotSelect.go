@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectSnapshotSelectService interface {
+	Exec(
+		ctx context.Context, request *services.ProjectSnapshotSelectRequest,
+	) (*services.ProjectSnapshotContent, error)
+}
+
+type ProjectSnapshotSelectRequest struct {
+	ID uuid.UUID `schema:"id"`
+}
+
+type ProjectSnapshotSelect struct {
+	service ProjectSnapshotSelectService
+	logger  logging.Log
+}
+
+func NewProjectSnapshotSelect(service ProjectSnapshotSelectService, logger logging.Log) *ProjectSnapshotSelect {
+	return &ProjectSnapshotSelect{service: service, logger: logger}
+}
+
+func (handler *ProjectSnapshotSelect) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectSnapshotSelect")
+	defer span.End()
+
+	var request ProjectSnapshotSelectRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectSnapshotSelectRequest{
+		ID:     request.ID,
+		UserID: lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:           http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:      http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:         http.StatusNotFound,
+			dao.ErrProjectSnapshotSelectNotFound: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProjectSnapshotContent(res))
+}
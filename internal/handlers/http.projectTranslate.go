@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectTranslateService interface {
+	Exec(ctx context.Context, request *services.ProjectTranslateRequest) (*services.Project, error)
+}
+
+type ProjectTranslateRequest struct {
+	ProjectID uuid.UUID `json:"projectID"`
+	Lang      string    `json:"lang"`
+	Copy      bool      `json:"copy"`
+}
+
+type ProjectTranslate struct {
+	service ProjectTranslateService
+	logger  logging.Log
+}
+
+func NewProjectTranslate(service ProjectTranslateService, logger logging.Log) *ProjectTranslate {
+	return &ProjectTranslate{service: service, logger: logger}
+}
+
+func (handler *ProjectTranslate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectTranslate")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectTranslateRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	controller := http.NewResponseController(w)
+	// Translations outlive the write timeout of the server. The duration of the request is still limited by the
+	// timeout middleware.
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	res, err := handler.service.Exec(ctx, &services.ProjectTranslateRequest{
+		ProjectID: request.ProjectID,
+		UserID:    lo.FromPtr(claims.UserID),
+		Roles:     claims.Roles,
+		Lang:      request.Lang,
+		Copy:      request.Copy,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:       http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:  http.StatusForbidden,
+			services.ErrProjectAlreadyInLang: http.StatusUnprocessableEntity,
+			services.ErrUsageQuotaExceeded:   http.StatusTooManyRequests,
+			services.ErrInvalidGeneration:    http.StatusBadGateway,
+			dao.ErrProjectSelectNotFound:     http.StatusNotFound,
+			dao.ErrModuleSelectNotFound:      http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProject(res))
+}
@@ -67,11 +67,12 @@ func (handler *ProjectUpdate) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:         http.StatusUnprocessableEntity,
-			services.ErrUserDoesNotOwnProject:  http.StatusForbidden,
-			services.ErrForbiddenModuleUpgrade: http.StatusUnprocessableEntity,
-			dao.ErrProjectSelectNotFound:       http.StatusNotFound,
-			dao.ErrModuleSelectNotFound:        http.StatusNotFound,
+			services.ErrInvalidRequest:          http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:     http.StatusForbidden,
+			services.ErrForbiddenModuleUpgrade:  http.StatusUnprocessableEntity,
+			dao.ErrProjectSelectNotFound:        http.StatusNotFound,
+			dao.ErrModuleSelectNotFound:         http.StatusNotFound,
+			services.ErrModuleRangeNotSatisfied: http.StatusNotFound,
 		}, err)
 
 		return
@@ -179,7 +179,7 @@ func TestProjectUpdate(t *testing.T) {
 					Title:    "Updated Project",
 					Workflow: []string{"module1@namespace:1.0.0"},
 				},
-				err: services.ErrUserDoesNotOwnProject,
+				err: services.ErrProjectAccessDenied,
 			},
 
 			expectStatus: http.StatusForbidden,
@@ -232,6 +232,30 @@ func TestProjectUpdate(t *testing.T) {
 
 			expectStatus: http.StatusNotFound,
 		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"id":"00000000-0000-0000-0000-000000000001","title":"Updated Project","workflow":["agora:idea@^1.2"]}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.ProjectUpdateRequest{
+					ID:       uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					UserID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Title:    "Updated Project",
+					Workflow: []string{"agora:idea@^1.2"},
+				},
+				err: services.ErrModuleRangeNotSatisfied,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
 		{
 			name: "Error/InternalError",
 
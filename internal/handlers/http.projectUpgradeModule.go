@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type ProjectUpgradeModuleService interface {
+	Exec(ctx context.Context, request *services.ProjectUpgradeModuleRequest) (*services.Project, error)
+}
+
+type ProjectUpgradeModuleRequest struct {
+	ProjectID uuid.UUID `json:"projectID"`
+	Module    string    `json:"module"`
+}
+
+type ProjectUpgradeModule struct {
+	service ProjectUpgradeModuleService
+	logger  logging.Log
+}
+
+func NewProjectUpgradeModule(service ProjectUpgradeModuleService, logger logging.Log) *ProjectUpgradeModule {
+	return &ProjectUpgradeModule{service: service, logger: logger}
+}
+
+func (handler *ProjectUpgradeModule) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.ProjectUpgradeModule")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request ProjectUpgradeModuleRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.ProjectUpgradeModuleRequest{
+		ProjectID: request.ProjectID,
+		UserID:    lo.FromPtr(claims.UserID),
+		Module:    request.Module,
+	})
+	if err != nil {
+		if handleSchemaValidationErrors(ctx, w, span, err) {
+			return
+		}
+
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:          http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:     http.StatusForbidden,
+			services.ErrModuleNotInProject:      http.StatusUnprocessableEntity,
+			services.ErrModuleUpgradeNotNewer:   http.StatusUnprocessableEntity,
+			services.ErrInvalidData:             http.StatusUnprocessableEntity,
+			dao.ErrProjectSelectNotFound:        http.StatusNotFound,
+			dao.ErrModuleSelectNotFound:         http.StatusNotFound,
+			services.ErrModuleRangeNotSatisfied: http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadProject(res))
+}
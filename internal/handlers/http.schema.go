@@ -1,22 +1,36 @@
 package handlers
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/otel"
 
 	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 )
 
 type Schema struct {
-	ID        uuid.UUID      `json:"id"`
-	ProjectID uuid.UUID      `json:"projectID"`
-	Owner     *uuid.UUID     `json:"owner"`
-	Module    string         `json:"module"`
-	Source    string         `json:"source"`
-	Data      map[string]any `json:"data"`
-	CreatedAt time.Time      `json:"createdAt"`
+	ID        uuid.UUID  `json:"id"`
+	ProjectID uuid.UUID  `json:"projectID"`
+	Owner     *uuid.UUID `json:"owner"`
+	Module    string     `json:"module"`
+	Source    string     `json:"source"`
+	// Instructions the user gave to steer the generation of this version, if any.
+	Instructions string         `json:"instructions,omitempty"`
+	Data         map[string]any `json:"data"`
+	// ForkedFrom is the ID of the schema this version was copied from, when the project was forked.
+	ForkedFrom *uuid.UUID `json:"forkedFrom,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	// Generation describes how the data was generated, for schemas generated by AI.
+	Generation *SchemaGeneration `json:"generation,omitempty"`
 }
 
 func loadSchema(s *services.Schema) Schema {
@@ -30,24 +44,159 @@ func loadSchema(s *services.Schema) Schema {
 			Version:    s.ModuleVersion,
 			Preversion: s.ModulePreversion,
 		}).String(),
-		Source:    s.Source,
-		Data:      s.Data,
-		CreatedAt: s.CreatedAt,
+		Source:       s.Source,
+		Instructions: s.Instructions,
+		Data:         s.Data,
+		ForkedFrom:   s.ForkedFrom,
+		CreatedAt:    s.CreatedAt,
+		Generation:   loadSchemaGeneration(s.Generation),
+	}
+}
+
+func loadSchemasMap(item *services.Schema, _ int) Schema {
+	return loadSchema(item)
+}
+
+type SchemaGenerationMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// SchemaGenerationContextCut explains why a schema of the context was not sent to the model in full.
+type SchemaGenerationContextCut struct {
+	SchemaID   uuid.UUID `json:"schemaID"`
+	Module     string    `json:"module"`
+	Action     string    `json:"action"`
+	Tokens     int64     `json:"tokens"`
+	KeptTokens int64     `json:"keptTokens"`
+}
+
+func loadSchemaGenerationContextCut(s *services.SchemaGenerationContextCut, _ int) SchemaGenerationContextCut {
+	return SchemaGenerationContextCut{
+		SchemaID:   s.SchemaID,
+		Module:     s.Module,
+		Action:     s.Action,
+		Tokens:     s.Tokens,
+		KeptTokens: s.KeptTokens,
+	}
+}
+
+// SchemaGeneration records the inputs and cost of an AI generation.
+type SchemaGeneration struct {
+	Model string `json:"model"`
+	// Messages sent to the model. They are omitted from version listings.
+	Messages         []SchemaGenerationMessage `json:"messages,omitempty"`
+	TemplateVersion  string                    `json:"templateVersion"`
+	PromptTokens     int64                     `json:"promptTokens"`
+	CompletionTokens int64                     `json:"completionTokens"`
+	LatencyMs        int64                     `json:"latencyMs"`
+	ContextSchemaIDs []uuid.UUID               `json:"contextSchemaIDs"`
+	// ContextCuts lists the schemas of the context that were truncated or dropped to fit the budget of the model.
+	ContextCuts  []SchemaGenerationContextCut `json:"contextCuts,omitempty"`
+	BaseSchemaID *uuid.UUID                   `json:"baseSchemaID,omitempty"`
+}
+
+func loadSchemaGeneration(s *services.SchemaGeneration) *SchemaGeneration {
+	if s == nil {
+		return nil
 	}
+
+	return &SchemaGeneration{
+		Model: s.Model,
+		Messages: lo.Map(s.Messages, func(item lib.CompletionMessage, _ int) SchemaGenerationMessage {
+			return SchemaGenerationMessage{Role: string(item.Role), Content: item.Content}
+		}),
+		TemplateVersion:  s.TemplateVersion,
+		PromptTokens:     s.PromptTokens,
+		CompletionTokens: s.CompletionTokens,
+		LatencyMs:        s.Latency.Milliseconds(),
+		ContextSchemaIDs: s.ContextSchemaIDs,
+		ContextCuts:      lo.Map(s.ContextCuts, loadSchemaGenerationContextCut),
+		BaseSchemaID:     s.BaseSchemaID,
+	}
+}
+
+// SchemaCandidate is an alternative content generated for a module, pending promotion.
+type SchemaCandidate struct {
+	ID           uuid.UUID      `json:"id"`
+	ProjectID    uuid.UUID      `json:"projectID"`
+	Owner        uuid.UUID      `json:"owner"`
+	Module       string         `json:"module"`
+	Instructions string         `json:"instructions,omitempty"`
+	Data         map[string]any `json:"data"`
+	CreatedAt    time.Time      `json:"createdAt"`
+}
+
+func loadSchemaCandidate(s *services.SchemaCandidate) SchemaCandidate {
+	return SchemaCandidate{
+		ID:        s.ID,
+		ProjectID: s.ProjectID,
+		Owner:     s.Owner,
+		Module: (lib.DecodedModule{
+			Namespace:  s.ModuleNamespace,
+			Module:     s.ModuleID,
+			Version:    s.ModuleVersion,
+			Preversion: s.ModulePreversion,
+		}).String(),
+		Instructions: s.Instructions,
+		Data:         s.Data,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+func loadSchemaCandidatesMap(item *services.SchemaCandidate, _ int) SchemaCandidate {
+	return loadSchemaCandidate(item)
 }
 
 type SchemaVersion struct {
-	ID        uuid.UUID `json:"id"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID           uuid.UUID         `json:"id"`
+	Instructions string            `json:"instructions,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	Generation   *SchemaGeneration `json:"generation,omitempty"`
 }
 
 func loadSchemaVersion(s *services.SchemaVersion) SchemaVersion {
 	return SchemaVersion{
-		ID:        s.ID,
-		CreatedAt: s.CreatedAt,
+		ID:           s.ID,
+		Instructions: s.Instructions,
+		CreatedAt:    s.CreatedAt,
+		Generation:   loadSchemaGeneration(s.Generation),
 	}
 }
 
 func loadSchemaVersionsMap(item *services.SchemaVersion, _ int) SchemaVersion {
 	return loadSchemaVersion(item)
 }
+
+type SchemaValidationError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+type SchemaValidationErrors struct {
+	Errors []SchemaValidationError `json:"errors"`
+}
+
+func loadSchemaValidationErrors(errs lib.JSONSchemaValidationErrors) SchemaValidationErrors {
+	return SchemaValidationErrors{
+		Errors: lo.Map(errs, func(item *lib.JSONSchemaValidationError, _ int) SchemaValidationError {
+			return SchemaValidationError{Path: item.Path, Message: item.Message}
+		}),
+	}
+}
+
+// handleSchemaValidationErrors sends the details of the data validation errors to the client, if the error
+// contains any. It returns false if the error has not been handled.
+func handleSchemaValidationErrors(ctx context.Context, w http.ResponseWriter, span trace.Span, err error) bool {
+	var validationErrors lib.JSONSchemaValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return false
+	}
+
+	_ = otel.ReportError(span, err)
+
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	httpf.SendJSON(ctx, w, span, loadSchemaValidationErrors(validationErrors))
+
+	return true
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type SchemaCandidateListService interface {
+	Exec(ctx context.Context, request *services.SchemaCandidateListRequest) ([]*services.SchemaCandidate, error)
+}
+
+type SchemaCandidateListRequest struct {
+	ProjectID       uuid.UUID `schema:"projectID"`
+	ModuleID        string    `schema:"moduleID"`
+	ModuleNamespace string    `schema:"moduleNamespace"`
+}
+
+type SchemaCandidateList struct {
+	service SchemaCandidateListService
+	logger  logging.Log
+}
+
+func NewSchemaCandidateList(service SchemaCandidateListService, logger logging.Log) *SchemaCandidateList {
+	return &SchemaCandidateList{service: service, logger: logger}
+}
+
+func (handler *SchemaCandidateList) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.SchemaCandidateList")
+	defer span.End()
+
+	var request SchemaCandidateListRequest
+
+	err := muxDecoder.Decode(&request, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.SchemaCandidateListRequest{
+		ProjectID:       request.ProjectID,
+		UserID:          lo.FromPtr(claims.UserID),
+		ModuleID:        request.ModuleID,
+		ModuleNamespace: request.ModuleNamespace,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, lo.Map(res, loadSchemaCandidatesMap))
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type SchemaCandidatePromoteService interface {
+	Exec(ctx context.Context, request *services.SchemaCandidatePromoteRequest) (*services.Schema, error)
+}
+
+type SchemaCandidatePromoteRequest struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type SchemaCandidatePromote struct {
+	service SchemaCandidatePromoteService
+	logger  logging.Log
+}
+
+func NewSchemaCandidatePromote(service SchemaCandidatePromoteService, logger logging.Log) *SchemaCandidatePromote {
+	return &SchemaCandidatePromote{service: service, logger: logger}
+}
+
+func (handler *SchemaCandidatePromote) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.SchemaCandidatePromote")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request SchemaCandidatePromoteRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.SchemaCandidatePromoteRequest{
+		ID:     request.ID,
+		UserID: lo.FromPtr(claims.UserID),
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:           http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:      http.StatusForbidden,
+			services.ErrModuleNotInProject:       http.StatusUnprocessableEntity,
+			dao.ErrSchemaCandidateSelectNotFound: http.StatusNotFound,
+			dao.ErrProjectSelectNotFound:         http.StatusNotFound,
+			dao.ErrSchemaInsertAlreadyExists:     http.StatusConflict,
+		}, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	httpf.SendJSON(ctx, w, span, loadSchema(res))
+}
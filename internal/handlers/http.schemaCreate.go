@@ -28,6 +28,7 @@ type SchemaCreateRequest struct {
 	Module    string         `json:"module"`
 	Source    string         `json:"source"`
 	Data      map[string]any `json:"data"`
+	Draft     bool           `json:"draft"`
 }
 
 type SchemaCreate struct {
@@ -68,15 +69,22 @@ func (handler *SchemaCreate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Module:    request.Module,
 		Source:    request.Source,
 		Data:      request.Data,
+		Draft:     request.Draft,
 	})
 	if err != nil {
+		if handleSchemaValidationErrors(ctx, w, span, err) {
+			return
+		}
+
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
-			services.ErrUserDoesNotOwnProject: http.StatusForbidden,
-			services.ErrModuleNotInProject:    http.StatusUnprocessableEntity,
-			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
-			dao.ErrModuleSelectNotFound:       http.StatusNotFound,
-			dao.ErrSchemaInsertAlreadyExists:  http.StatusConflict,
+			services.ErrInvalidRequest:          http.StatusUnprocessableEntity,
+			services.ErrInvalidData:             http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:     http.StatusForbidden,
+			services.ErrModuleNotInProject:      http.StatusUnprocessableEntity,
+			dao.ErrProjectSelectNotFound:        http.StatusNotFound,
+			dao.ErrModuleSelectNotFound:         http.StatusNotFound,
+			services.ErrModuleRangeNotSatisfied: http.StatusNotFound,
+			dao.ErrSchemaInsertAlreadyExists:    http.StatusConflict,
 		}, err)
 
 		return
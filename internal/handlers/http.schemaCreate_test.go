@@ -21,6 +21,7 @@ import (
 	"github.com/a-novel/service-narrative-engine/internal/dao"
 	"github.com/a-novel/service-narrative-engine/internal/handlers"
 	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 )
 
@@ -214,6 +215,32 @@ func TestSchemaCreate(t *testing.T) {
 
 			expectStatus: http.StatusNotFound,
 		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"id":"00000000-0000-0000-0000-000000000001","projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1.2","source":"USER","data":{}}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaCreateRequest{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1.2",
+					Source:    "USER",
+					Data:      map[string]any{},
+				},
+				err: services.ErrModuleRangeNotSatisfied,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
 		{
 			name: "Error/InvalidRequest",
 
@@ -240,6 +267,79 @@ func TestSchemaCreate(t *testing.T) {
 
 			expectStatus: http.StatusUnprocessableEntity,
 		},
+		{
+			name: "Error/InvalidData",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"id":"00000000-0000-0000-0000-000000000001","projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","source":"USER","data":{}}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaCreateRequest{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Source:    "USER",
+					Data:      map[string]any{},
+				},
+				err: errors.Join(
+					lib.JSONSchemaValidationErrors{
+						{Path: "/title", Message: "missing required property"},
+					},
+					services.ErrInvalidData,
+				),
+			},
+
+			expectResponse: map[string]any{
+				"errors": []any{
+					map[string]any{"path": "/title", "message": "missing required property"},
+				},
+			},
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Success/Draft",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"id":"00000000-0000-0000-0000-000000000001","projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","source":"USER","data":{},"draft":true}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaCreateRequest{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Source:    "USER",
+					Data:      map[string]any{},
+					Draft:     true,
+				},
+				resp: &services.Schema{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:           lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+					ModuleID:        "module",
+					ModuleNamespace: "namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          "USER",
+					Data:            map[string]any{},
+					CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectStatus: http.StatusCreated,
+		},
 		{
 			name: "Error/UserDoesNotOwnProject",
 
@@ -261,7 +361,7 @@ func TestSchemaCreate(t *testing.T) {
 					Source:    "USER",
 					Data:      map[string]any{},
 				},
-				err: services.ErrUserDoesNotOwnProject,
+				err: services.ErrProjectAccessDenied,
 			},
 
 			expectStatus: http.StatusForbidden,
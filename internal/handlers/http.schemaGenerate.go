@@ -3,10 +3,12 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
 
 	authpkg "github.com/a-novel/service-authentication/v2/pkg"
 
@@ -22,30 +24,75 @@ type SchemaGenerateService interface {
 	Exec(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error)
 }
 
+type SchemaGenerateEnqueueService interface {
+	Exec(ctx context.Context, request *services.SchemaGenerateEnqueueRequest) (*services.GenerationJob, error)
+}
+
+type SchemaGenerateCandidatesService interface {
+	Exec(ctx context.Context, request *services.SchemaGenerateCandidatesRequest) ([]*services.SchemaCandidate, error)
+}
+
+var ErrAsyncCandidates = errors.New("candidates cannot be generated asynchronously")
+
+type SchemaGenerateQuery struct {
+	// Async queues the generation instead of waiting for it. The response is a generation job, whose progress
+	// can be followed through the jobs api.
+	Async bool `schema:"async"`
+}
+
 type SchemaGenerateRequest struct {
 	ProjectID uuid.UUID `json:"projectID"`
 	Module    string    `json:"module"`
 	Lang      string    `json:"lang"`
+	// Paths restricts the generation to the values they target, as JSON Pointers. Every other value of the latest
+	// data of the module is kept as is.
+	Paths []string `json:"paths,omitempty"`
+	// Instructions are free-text instructions to steer the generation. They are saved with the generated schema.
+	Instructions string `json:"instructions,omitempty"`
+	// Candidates, when set, generates this number of alternatives instead of a new version of the module. They are
+	// saved as pending candidates, until one of them is promoted.
+	Candidates int `json:"candidates,omitempty"`
 }
 
 type SchemaGenerate struct {
-	service SchemaGenerateService
-	logger  logging.Log
+	service           SchemaGenerateService
+	enqueueService    SchemaGenerateEnqueueService
+	candidatesService SchemaGenerateCandidatesService
+	logger            logging.Log
 }
 
-func NewSchemaGenerate(service SchemaGenerateService, logger logging.Log) *SchemaGenerate {
-	return &SchemaGenerate{service: service, logger: logger}
+func NewSchemaGenerate(
+	service SchemaGenerateService,
+	enqueueService SchemaGenerateEnqueueService,
+	candidatesService SchemaGenerateCandidatesService,
+	logger logging.Log,
+) *SchemaGenerate {
+	return &SchemaGenerate{
+		service:           service,
+		enqueueService:    enqueueService,
+		candidatesService: candidatesService,
+		logger:            logger,
+	}
 }
 
 func (handler *SchemaGenerate) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx, span := otel.Tracer().Start(r.Context(), "handler.SchemaGenerate")
 	defer span.End()
 
+	var query SchemaGenerateQuery
+
+	err := muxDecoder.Decode(&query, r.URL.Query())
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
 	decoder := json.NewDecoder(r.Body)
 
 	var request SchemaGenerateRequest
 
-	err := decoder.Decode(&request)
+	err = decoder.Decode(&request)
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
 
@@ -59,20 +106,47 @@ func (handler *SchemaGenerate) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if query.Async && request.Candidates > 0 {
+		httpf.HandleError(
+			ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusUnprocessableEntity}, ErrAsyncCandidates,
+		)
+
+		return
+	}
+
+	if query.Async {
+		handler.enqueue(ctx, w, span, &request, claims)
+
+		return
+	}
+
+	if request.Candidates > 0 {
+		handler.generateCandidates(ctx, w, span, &request, claims)
+
+		return
+	}
+
 	res, err := handler.service.Exec(ctx, &services.SchemaGenerateRequest{
-		ProjectID: request.ProjectID,
-		UserID:    lo.FromPtr(claims.UserID),
-		Module:    request.Module,
-		Lang:      request.Lang,
+		ProjectID:    request.ProjectID,
+		UserID:       lo.FromPtr(claims.UserID),
+		Roles:        claims.Roles,
+		Module:       request.Module,
+		Lang:         request.Lang,
+		Paths:        request.Paths,
+		Instructions: request.Instructions,
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
-			services.ErrUserDoesNotOwnProject: http.StatusForbidden,
-			services.ErrModuleNotInProject:    http.StatusUnprocessableEntity,
-			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
-			dao.ErrModuleSelectNotFound:       http.StatusNotFound,
-			dao.ErrSchemaInsertAlreadyExists:  http.StatusConflict,
+			services.ErrInvalidRequest:          http.StatusUnprocessableEntity,
+			services.ErrNoSchemaToRegenerate:    http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:     http.StatusForbidden,
+			services.ErrModuleNotInProject:      http.StatusUnprocessableEntity,
+			dao.ErrProjectSelectNotFound:        http.StatusNotFound,
+			dao.ErrModuleSelectNotFound:         http.StatusNotFound,
+			services.ErrModuleRangeNotSatisfied: http.StatusNotFound,
+			dao.ErrSchemaInsertAlreadyExists:    http.StatusConflict,
+			services.ErrUsageQuotaExceeded:      http.StatusTooManyRequests,
+			services.ErrInvalidGeneration:       http.StatusBadGateway,
 		}, err)
 
 		return
@@ -81,3 +155,67 @@ func (handler *SchemaGenerate) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusCreated)
 	httpf.SendJSON(ctx, w, span, loadSchema(res))
 }
+
+func (handler *SchemaGenerate) enqueue(
+	ctx context.Context, w http.ResponseWriter, span trace.Span, request *SchemaGenerateRequest, claims *authpkg.Claims,
+) {
+	res, err := handler.enqueueService.Exec(ctx, &services.SchemaGenerateEnqueueRequest{
+		ProjectID:    request.ProjectID,
+		UserID:       lo.FromPtr(claims.UserID),
+		Roles:        claims.Roles,
+		Module:       request.Module,
+		Lang:         request.Lang,
+		Paths:        request.Paths,
+		Instructions: request.Instructions,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:              http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:         http.StatusForbidden,
+			services.ErrModuleNotInProject:          http.StatusUnprocessableEntity,
+			dao.ErrProjectSelectNotFound:            http.StatusNotFound,
+			services.ErrModuleRangeNotSatisfied:     http.StatusNotFound,
+			dao.ErrGenerationJobInsertAlreadyExists: http.StatusConflict,
+			services.ErrUsageQuotaExceeded:          http.StatusTooManyRequests,
+		}, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	httpf.SendJSON(ctx, w, span, loadGenerationJob(res))
+}
+
+func (handler *SchemaGenerate) generateCandidates(
+	ctx context.Context, w http.ResponseWriter, span trace.Span, request *SchemaGenerateRequest, claims *authpkg.Claims,
+) {
+	res, err := handler.candidatesService.Exec(ctx, &services.SchemaGenerateCandidatesRequest{
+		ProjectID:    request.ProjectID,
+		UserID:       lo.FromPtr(claims.UserID),
+		Roles:        claims.Roles,
+		Module:       request.Module,
+		Lang:         request.Lang,
+		Paths:        request.Paths,
+		Instructions: request.Instructions,
+		Count:        request.Candidates,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest:                http.StatusUnprocessableEntity,
+			services.ErrNoSchemaToRegenerate:          http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied:           http.StatusForbidden,
+			services.ErrModuleNotInProject:            http.StatusUnprocessableEntity,
+			dao.ErrProjectSelectNotFound:              http.StatusNotFound,
+			dao.ErrModuleSelectNotFound:               http.StatusNotFound,
+			services.ErrModuleRangeNotSatisfied:       http.StatusNotFound,
+			dao.ErrSchemaCandidateInsertAlreadyExists: http.StatusConflict,
+			services.ErrUsageQuotaExceeded:            http.StatusTooManyRequests,
+			services.ErrInvalidGeneration:             http.StatusBadGateway,
+		}, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	httpf.SendJSON(ctx, w, span, lo.Map(res, loadSchemaCandidatesMap))
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+// Events sent by the SchemaGenerateStream handler.
+const (
+	// SchemaGenerateStreamEventDelta carries a chunk of the raw JSON output of the model.
+	SchemaGenerateStreamEventDelta = "delta"
+	// SchemaGenerateStreamEventSchema carries the saved schema, and ends the stream.
+	SchemaGenerateStreamEventSchema = "schema"
+	// SchemaGenerateStreamEventError is sent when the generation fails after the stream started, and ends the stream.
+	SchemaGenerateStreamEventError = "error"
+)
+
+var schemaGenerateStreamErrMap = httpf.ErrMap{
+	services.ErrInvalidRequest:          http.StatusUnprocessableEntity,
+	services.ErrNoSchemaToRegenerate:    http.StatusUnprocessableEntity,
+	services.ErrProjectAccessDenied:     http.StatusForbidden,
+	services.ErrModuleNotInProject:      http.StatusUnprocessableEntity,
+	dao.ErrProjectSelectNotFound:        http.StatusNotFound,
+	dao.ErrModuleSelectNotFound:         http.StatusNotFound,
+	services.ErrModuleRangeNotSatisfied: http.StatusNotFound,
+	dao.ErrSchemaInsertAlreadyExists:    http.StatusConflict,
+	services.ErrUsageQuotaExceeded:      http.StatusTooManyRequests,
+	services.ErrInvalidGeneration:       http.StatusBadGateway,
+	context.DeadlineExceeded:            http.StatusGatewayTimeout,
+}
+
+var ErrStreamCandidates = errors.New("candidates cannot be streamed")
+
+type SchemaGenerateStreamService interface {
+	Exec(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error)
+}
+
+type SchemaGenerateStreamDelta struct {
+	Delta string `json:"delta"`
+}
+
+type SchemaGenerateStreamError struct {
+	Status int `json:"status"`
+}
+
+type SchemaGenerateStream struct {
+	service SchemaGenerateStreamService
+	logger  logging.Log
+}
+
+func NewSchemaGenerateStream(service SchemaGenerateStreamService, logger logging.Log) *SchemaGenerateStream {
+	return &SchemaGenerateStream{service: service, logger: logger}
+}
+
+// ServeHTTP generates a schema like SchemaGenerate, but streams the output of the model as Server-Sent Events.
+//
+// Errors that occur before the model starts generating are returned as regular HTTP errors. Once the stream has
+// started, they are sent as an error event instead. Generation stops as soon as the client disconnects.
+func (handler *SchemaGenerateStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.SchemaGenerateStream")
+	defer span.End()
+
+	decoder := json.NewDecoder(r.Body)
+
+	var request SchemaGenerateRequest
+
+	err := decoder.Decode(&request)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusBadRequest}, err)
+
+		return
+	}
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	if request.Candidates > 0 {
+		httpf.HandleError(
+			ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusUnprocessableEntity}, ErrStreamCandidates,
+		)
+
+		return
+	}
+
+	controller := http.NewResponseController(w)
+	// The stream outlives the write timeout of the server. The duration of the request is still limited by
+	// the timeout middleware.
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	var (
+		started   bool
+		streamErr error
+	)
+
+	onDelta := func(delta string) {
+		// Once writing fails, the client is gone. Generation stops with the request context.
+		if streamErr != nil {
+			return
+		}
+
+		if !started {
+			startSSE(w)
+
+			started = true
+		}
+
+		streamErr = sendSSE(w, controller, SchemaGenerateStreamEventDelta, SchemaGenerateStreamDelta{Delta: delta})
+	}
+
+	res, err := handler.service.Exec(ctx, &services.SchemaGenerateRequest{
+		ProjectID:    request.ProjectID,
+		UserID:       lo.FromPtr(claims.UserID),
+		Roles:        claims.Roles,
+		Module:       request.Module,
+		Lang:         request.Lang,
+		Paths:        request.Paths,
+		Instructions: request.Instructions,
+		OnDelta:      onDelta,
+	})
+
+	// Nobody is listening anymore.
+	if errors.Is(ctx.Err(), context.Canceled) {
+		_ = otel.ReportError(span, errors.Join(err, ctx.Err()))
+
+		return
+	}
+
+	if err != nil && !started {
+		httpf.HandleError(ctx, handler.logger, w, span, schemaGenerateStreamErrMap, err)
+
+		return
+	}
+
+	if !started {
+		startSSE(w)
+	}
+
+	if err != nil {
+		_ = otel.ReportError(span, err)
+		_ = sendSSE(w, controller, SchemaGenerateStreamEventError, SchemaGenerateStreamError{
+			Status: errMapStatus(schemaGenerateStreamErrMap, err),
+		})
+
+		return
+	}
+
+	_ = sendSSE(w, controller, SchemaGenerateStreamEventSchema, loadSchema(res))
+}
+
+func startSSE(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Prevent reverse proxies from buffering the stream.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+}
+
+func sendSSE(w http.ResponseWriter, controller *http.ResponseController, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	if err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+
+	err = controller.Flush()
+	if err != nil {
+		return fmt.Errorf("flush event: %w", err)
+	}
+
+	return nil
+}
+
+// errMapStatus returns the status code httpf.HandleError would send for an error.
+func errMapStatus(errMap httpf.ErrMap, err error) int {
+	for target, status := range errMap {
+		if target != nil && errors.Is(err, target) {
+			return status
+		}
+	}
+
+	if status, ok := errMap[nil]; ok {
+		return status
+	}
+
+	return http.StatusInternalServerError
+}
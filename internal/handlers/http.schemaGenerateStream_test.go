@@ -0,0 +1,487 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type sseEvent struct {
+	Event string
+	Data  any
+}
+
+func parseSSEEvents(t *testing.T, body string) []sseEvent {
+	t.Helper()
+
+	var events []sseEvent
+
+	for _, block := range strings.Split(strings.TrimSpace(body), "\n\n") {
+		var event sseEvent
+
+		for _, line := range strings.Split(block, "\n") {
+			switch {
+			case strings.HasPrefix(line, "event: "):
+				event.Event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event.Data))
+			}
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func TestSchemaGenerateStream(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	testSchema := &services.Schema{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		Owner:           lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+		ModuleID:        "module",
+		ModuleNamespace: "namespace",
+		ModuleVersion:   "1.0.0",
+		Source:          "AI",
+		Data:            map[string]any{"key": "value"},
+		CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testSchemaResponse := map[string]any{
+		"id":        "00000000-0000-0000-0000-000000000001",
+		"projectID": "00000000-0000-0000-0000-000000000002",
+		"owner":     "00000000-0000-0000-0000-000000000003",
+		"module":    "namespace:module@v1.0.0",
+		"source":    "AI",
+		"data":      map[string]any{"key": "value"},
+		"createdAt": "2026-01-01T00:00:00Z",
+	}
+
+	type serviceMock struct {
+		req    *services.SchemaGenerateRequest
+		deltas []string
+		resp   *services.Schema
+		err    error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *authpkg.Claims
+
+		serviceMock *serviceMock
+
+		expectStatus int
+		expectEvents []sseEvent
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+				},
+				deltas: []string{`{"key":`, "\"val\nue\"}"},
+				resp:   testSchema,
+			},
+
+			expectStatus: http.StatusOK,
+			expectEvents: []sseEvent{
+				{Event: handlers.SchemaGenerateStreamEventDelta, Data: map[string]any{"delta": `{"key":`}},
+				{Event: handlers.SchemaGenerateStreamEventDelta, Data: map[string]any{"delta": "\"val\nue\"}"}},
+				{Event: handlers.SchemaGenerateStreamEventSchema, Data: testSchemaResponse},
+			},
+		},
+		{
+			name: "Success/NoDelta",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+				},
+				resp: testSchema,
+			},
+
+			expectStatus: http.StatusOK,
+			expectEvents: []sseEvent{
+				{Event: handlers.SchemaGenerateStreamEventSchema, Data: testSchemaResponse},
+			},
+		},
+		{
+			name: "Success/Paths",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en","paths":["/key"]}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+					Paths:     []string{"/key"},
+				},
+				deltas: []string{`{"key":"value"}`},
+				resp:   testSchema,
+			},
+
+			expectStatus: http.StatusOK,
+			expectEvents: []sseEvent{
+				{Event: handlers.SchemaGenerateStreamEventDelta, Data: map[string]any{"delta": `{"key":"value"}`}},
+				{Event: handlers.SchemaGenerateStreamEventSchema, Data: testSchemaResponse},
+			},
+		},
+		{
+			name: "Success/Instructions",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en","instructions":"Keep it short."}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:       "namespace:module@v1.0.0",
+					Lang:         "en",
+					Instructions: "Keep it short.",
+				},
+				deltas: []string{`{"key":"value"}`},
+				resp:   testSchema,
+			},
+
+			expectStatus: http.StatusOK,
+			expectEvents: []sseEvent{
+				{Event: handlers.SchemaGenerateStreamEventDelta, Data: map[string]any{"delta": `{"key":"value"}`}},
+				{Event: handlers.SchemaGenerateStreamEventSchema, Data: testSchemaResponse},
+			},
+		},
+		{
+			name: "Error/NoSchemaToRegenerate",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en","paths":["/key"]}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+					Paths:     []string{"/key"},
+				},
+				err: services.ErrNoSchemaToRegenerate,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/NoClaims",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+			),
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidJSON",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{invalid`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/Candidates",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(
+					`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en",`+
+						`"candidates":2}`,
+				),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/ProjectNotFound",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+				},
+				err: dao.ErrProjectSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+				},
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/AfterStreamStarted",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+				},
+				deltas: []string{`{"key":`},
+				err:    dao.ErrSchemaInsertAlreadyExists,
+			},
+
+			expectStatus: http.StatusOK,
+			expectEvents: []sseEvent{
+				{Event: handlers.SchemaGenerateStreamEventDelta, Data: map[string]any{"delta": `{"key":`}},
+				{Event: handlers.SchemaGenerateStreamEventError, Data: map[string]any{"status": float64(http.StatusConflict)}},
+			},
+		},
+		{
+			name: "Error/InternalErrorAfterStreamStarted",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+				},
+				deltas: []string{`{"key":`},
+				err:    errFoo,
+			},
+
+			expectStatus: http.StatusOK,
+			expectEvents: []sseEvent{
+				{Event: handlers.SchemaGenerateStreamEventDelta, Data: map[string]any{"delta": `{"key":`}},
+				{
+					Event: handlers.SchemaGenerateStreamEventError,
+					Data:  map[string]any{"status": float64(http.StatusInternalServerError)},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockSchemaGenerateStreamService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(req *services.SchemaGenerateRequest) bool {
+						return req.ProjectID == testCase.serviceMock.req.ProjectID &&
+							req.UserID == testCase.serviceMock.req.UserID &&
+							req.Module == testCase.serviceMock.req.Module &&
+							req.Lang == testCase.serviceMock.req.Lang &&
+							slices.Equal(req.Paths, testCase.serviceMock.req.Paths) &&
+							req.OnDelta != nil
+					})).
+					Run(func(_ context.Context, req *services.SchemaGenerateRequest) {
+						for _, delta := range testCase.serviceMock.deltas {
+							req.OnDelta(delta)
+						}
+					}).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewSchemaGenerateStream(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = authpkg.SetClaimsContext(rCtx, testCase.claims)
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectEvents != nil {
+				require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				require.Equal(t, testCase.expectEvents, parseSSEEvents(t, string(data)))
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSchemaGenerateStreamCanceled(t *testing.T) {
+	t.Parallel()
+
+	service := handlersmocks.NewMockSchemaGenerateStreamService(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	service.EXPECT().
+		Exec(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, req *services.SchemaGenerateRequest) {
+			req.OnDelta(`{"key":`)
+			// Simulate a client disconnecting mid-stream.
+			cancel()
+		}).
+		Return(nil, context.Canceled)
+
+	handler := handlers.NewSchemaGenerateStream(service, config.LoggerDev)
+	w := httptest.NewRecorder()
+
+	request := httptest.NewRequest(
+		http.MethodPut,
+		"/",
+		strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en"}`),
+	)
+
+	rCtx := authpkg.SetClaimsContext(ctx, &authpkg.Claims{
+		UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+	})
+
+	handler.ServeHTTP(w, request.WithContext(rCtx))
+
+	data, err := io.ReadAll(w.Result().Body)
+	require.NoError(t, err)
+
+	// No event is sent once the client is gone.
+	require.Equal(t, []sseEvent{
+		{Event: handlers.SchemaGenerateStreamEventDelta, Data: map[string]any{"delta": `{"key":`}},
+	}, parseSSEEvents(t, string(data)))
+
+	service.AssertExpectations(t)
+}
@@ -35,13 +35,27 @@ func TestSchemaGenerate(t *testing.T) {
 		err  error
 	}
 
+	type enqueueServiceMock struct {
+		req  *services.SchemaGenerateEnqueueRequest
+		resp *services.GenerationJob
+		err  error
+	}
+
+	type candidatesServiceMock struct {
+		req  *services.SchemaGenerateCandidatesRequest
+		resp []*services.SchemaCandidate
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *http.Request
 		claims  *authpkg.Claims
 
-		serviceMock *serviceMock
+		serviceMock           *serviceMock
+		enqueueServiceMock    *enqueueServiceMock
+		candidatesServiceMock *candidatesServiceMock
 
 		expectStatus   int
 		expectResponse any
@@ -133,6 +147,121 @@ func TestSchemaGenerate(t *testing.T) {
 			},
 			expectStatus: http.StatusCreated,
 		},
+		{
+			name: "Success/Paths",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en","paths":["/key"]}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+					Paths:     []string{"/key"},
+				},
+				resp: &services.Schema{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:           lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+					ModuleID:        "module",
+					ModuleNamespace: "namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          "AI",
+					Data:            map[string]any{"key": "new value", "other": "value"},
+					CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":        "00000000-0000-0000-0000-000000000001",
+				"projectID": "00000000-0000-0000-0000-000000000002",
+				"owner":     "00000000-0000-0000-0000-000000000003",
+				"module":    "namespace:module@v1.0.0",
+				"source":    "AI",
+				"data":      map[string]any{"key": "new value", "other": "value"},
+				"createdAt": "2026-01-01T00:00:00Z",
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "Success/Instructions",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en","instructions":"Keep it short."}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:       "namespace:module@v1.0.0",
+					Lang:         "en",
+					Instructions: "Keep it short.",
+				},
+				resp: &services.Schema{
+					ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:           lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+					ModuleID:        "module",
+					ModuleNamespace: "namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          "AI",
+					Instructions:    "Keep it short.",
+					Data:            map[string]any{"key": "new value", "other": "value"},
+					CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":           "00000000-0000-0000-0000-000000000001",
+				"projectID":    "00000000-0000-0000-0000-000000000002",
+				"owner":        "00000000-0000-0000-0000-000000000003",
+				"module":       "namespace:module@v1.0.0",
+				"source":       "AI",
+				"instructions": "Keep it short.",
+				"data":         map[string]any{"key": "new value", "other": "value"},
+				"createdAt":    "2026-01-01T00:00:00Z",
+			},
+			expectStatus: http.StatusCreated,
+		},
+		{
+			name: "Error/NoSchemaToRegenerate",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@v1.0.0","lang":"en","paths":["/key"]}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@v1.0.0",
+					Lang:      "en",
+					Paths:     []string{"/key"},
+				},
+				err: services.ErrNoSchemaToRegenerate,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name: "Error/NoClaims",
 
@@ -206,6 +335,30 @@ func TestSchemaGenerate(t *testing.T) {
 
 			expectStatus: http.StatusNotFound,
 		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1.2","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.SchemaGenerateRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1.2",
+					Lang:      "en",
+				},
+				err: services.ErrModuleRangeNotSatisfied,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
 		{
 			name: "Error/InvalidRequest",
 
@@ -249,7 +402,7 @@ func TestSchemaGenerate(t *testing.T) {
 					Module:    "namespace:module@v1.0.0",
 					Lang:      "en",
 				},
-				err: services.ErrUserDoesNotOwnProject,
+				err: services.ErrProjectAccessDenied,
 			},
 
 			expectStatus: http.StatusForbidden,
@@ -302,6 +455,388 @@ func TestSchemaGenerate(t *testing.T) {
 
 			expectStatus: http.StatusInternalServerError,
 		},
+		{
+			name: "Success/Async",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/?async=true",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			enqueueServiceMock: &enqueueServiceMock{
+				req: &services.SchemaGenerateEnqueueRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+				},
+				resp: &services.GenerationJob{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+					Status:    "QUEUED",
+					CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":         "00000000-0000-0000-0000-000000000004",
+				"projectID":  "00000000-0000-0000-0000-000000000002",
+				"owner":      "00000000-0000-0000-0000-000000000003",
+				"module":     "namespace:module@^1",
+				"lang":       "en",
+				"status":     "QUEUED",
+				"attempts":   float64(0),
+				"schemaID":   nil,
+				"createdAt":  "2026-01-01T00:00:00Z",
+				"updatedAt":  "2026-01-01T00:00:00Z",
+				"startedAt":  nil,
+				"finishedAt": nil,
+			},
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Success/Async/Paths",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/?async=true",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en","paths":["/key"]}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			enqueueServiceMock: &enqueueServiceMock{
+				req: &services.SchemaGenerateEnqueueRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+					Paths:     []string{"/key"},
+				},
+				resp: &services.GenerationJob{
+					ID:        uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:     uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+					Paths:     []string{"/key"},
+					Status:    "QUEUED",
+					CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":         "00000000-0000-0000-0000-000000000004",
+				"projectID":  "00000000-0000-0000-0000-000000000002",
+				"owner":      "00000000-0000-0000-0000-000000000003",
+				"module":     "namespace:module@^1",
+				"lang":       "en",
+				"paths":      []any{"/key"},
+				"status":     "QUEUED",
+				"attempts":   float64(0),
+				"schemaID":   nil,
+				"createdAt":  "2026-01-01T00:00:00Z",
+				"updatedAt":  "2026-01-01T00:00:00Z",
+				"startedAt":  nil,
+				"finishedAt": nil,
+			},
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Success/Async/Instructions",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/?async=true",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en","instructions":"Keep it short."}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			enqueueServiceMock: &enqueueServiceMock{
+				req: &services.SchemaGenerateEnqueueRequest{
+					ProjectID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:       "namespace:module@^1",
+					Lang:         "en",
+					Instructions: "Keep it short.",
+				},
+				resp: &services.GenerationJob{
+					ID:           uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					ProjectID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					Owner:        uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:       "namespace:module@^1",
+					Lang:         "en",
+					Instructions: "Keep it short.",
+					Status:       "QUEUED",
+					CreatedAt:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+
+			expectResponse: map[string]any{
+				"id":           "00000000-0000-0000-0000-000000000004",
+				"projectID":    "00000000-0000-0000-0000-000000000002",
+				"owner":        "00000000-0000-0000-0000-000000000003",
+				"module":       "namespace:module@^1",
+				"lang":         "en",
+				"instructions": "Keep it short.",
+				"status":       "QUEUED",
+				"attempts":     float64(0),
+				"schemaID":     nil,
+				"createdAt":    "2026-01-01T00:00:00Z",
+				"updatedAt":    "2026-01-01T00:00:00Z",
+				"startedAt":    nil,
+				"finishedAt":   nil,
+			},
+			expectStatus: http.StatusAccepted,
+		},
+		{
+			name: "Error/Async/InvalidQuery",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/?async=maybe",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			expectStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error/Async/UserDoesNotOwnProject",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/?async=true",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			enqueueServiceMock: &enqueueServiceMock{
+				req: &services.SchemaGenerateEnqueueRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+				},
+				err: services.ErrProjectAccessDenied,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/Async/InternalError",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/?async=true",
+				strings.NewReader(`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en"}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			enqueueServiceMock: &enqueueServiceMock{
+				req: &services.SchemaGenerateEnqueueRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Success/Candidates",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(
+					`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en",`+
+						`"instructions":"Make it darker.","candidates":2}`,
+				),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			candidatesServiceMock: &candidatesServiceMock{
+				req: &services.SchemaGenerateCandidatesRequest{
+					ProjectID:    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:       uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:       "namespace:module@^1",
+					Lang:         "en",
+					Instructions: "Make it darker.",
+					Count:        2,
+				},
+				resp: []*services.SchemaCandidate{
+					{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+						ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						ModuleID:        "module",
+						ModuleNamespace: "namespace",
+						ModuleVersion:   "1.0.0",
+						Instructions:    "Make it darker.",
+						Data:            map[string]any{"key": "value-1"},
+						CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+						ProjectID:       uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+						Owner:           uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+						ModuleID:        "module",
+						ModuleNamespace: "namespace",
+						ModuleVersion:   "1.0.0",
+						Instructions:    "Make it darker.",
+						Data:            map[string]any{"key": "value-2"},
+						CreatedAt:       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+
+			expectStatus: http.StatusCreated,
+			expectResponse: []any{
+				map[string]any{
+					"id":           "00000000-0000-0000-0000-000000000001",
+					"projectID":    "00000000-0000-0000-0000-000000000002",
+					"owner":        "00000000-0000-0000-0000-000000000003",
+					"module":       "namespace:module@v1.0.0",
+					"instructions": "Make it darker.",
+					"data":         map[string]any{"key": "value-1"},
+					"createdAt":    "2021-01-01T00:00:00Z",
+				},
+				map[string]any{
+					"id":           "00000000-0000-0000-0000-000000000004",
+					"projectID":    "00000000-0000-0000-0000-000000000002",
+					"owner":        "00000000-0000-0000-0000-000000000003",
+					"module":       "namespace:module@v1.0.0",
+					"instructions": "Make it darker.",
+					"data":         map[string]any{"key": "value-2"},
+					"createdAt":    "2021-01-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			name: "Error/Candidates/InvalidRequest",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(
+					`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en",`+
+						`"candidates":10}`,
+				),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			candidatesServiceMock: &candidatesServiceMock{
+				req: &services.SchemaGenerateCandidatesRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+					Count:     10,
+				},
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/Candidates/UserDoesNotOwnProject",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(
+					`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en",`+
+						`"candidates":2}`,
+				),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			candidatesServiceMock: &candidatesServiceMock{
+				req: &services.SchemaGenerateCandidatesRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+					Count:     2,
+				},
+				err: services.ErrProjectAccessDenied,
+			},
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/Candidates/InternalError",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/",
+				strings.NewReader(
+					`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en",`+
+						`"candidates":2}`,
+				),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			candidatesServiceMock: &candidatesServiceMock{
+				req: &services.SchemaGenerateCandidatesRequest{
+					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Module:    "namespace:module@^1",
+					Lang:      "en",
+					Count:     2,
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "Error/Candidates/Async",
+
+			request: httptest.NewRequest(
+				http.MethodPost,
+				"/?async=true",
+				strings.NewReader(
+					`{"projectID":"00000000-0000-0000-0000-000000000002","module":"namespace:module@^1","lang":"en",`+
+						`"candidates":2}`,
+				),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000003")),
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -309,6 +844,8 @@ func TestSchemaGenerate(t *testing.T) {
 			t.Parallel()
 
 			service := handlersmocks.NewMockSchemaGenerateService(t)
+			enqueueService := handlersmocks.NewMockSchemaGenerateEnqueueService(t)
+			candidatesService := handlersmocks.NewMockSchemaGenerateCandidatesService(t)
 
 			if testCase.serviceMock != nil {
 				service.EXPECT().
@@ -316,7 +853,19 @@ func TestSchemaGenerate(t *testing.T) {
 					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
 			}
 
-			handler := handlers.NewSchemaGenerate(service, config.LoggerDev)
+			if testCase.enqueueServiceMock != nil {
+				enqueueService.EXPECT().
+					Exec(mock.Anything, testCase.enqueueServiceMock.req).
+					Return(testCase.enqueueServiceMock.resp, testCase.enqueueServiceMock.err)
+			}
+
+			if testCase.candidatesServiceMock != nil {
+				candidatesService.EXPECT().
+					Exec(mock.Anything, testCase.candidatesServiceMock.req).
+					Return(testCase.candidatesServiceMock.resp, testCase.candidatesServiceMock.err)
+			}
+
+			handler := handlers.NewSchemaGenerate(service, enqueueService, candidatesService, config.LoggerDev)
 			w := httptest.NewRecorder()
 
 			rCtx := testCase.request.Context()
@@ -338,6 +887,8 @@ func TestSchemaGenerate(t *testing.T) {
 			}
 
 			service.AssertExpectations(t)
+			enqueueService.AssertExpectations(t)
+			candidatesService.AssertExpectations(t)
 		})
 	}
 }
@@ -74,9 +74,9 @@ func (handler *SchemaListVersions) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
-			services.ErrUserDoesNotOwnProject: http.StatusForbidden,
-			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
 		}, err)
 
 		return
@@ -72,8 +72,17 @@ func TestSchemaListVersions(t *testing.T) {
 						CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
 					},
 					{
-						ID:        uuid.MustParse("00000000-0000-0000-0000-000000000011"),
-						CreatedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+						ID:           uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+						Instructions: "Keep it short.",
+						CreatedAt:    time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+						Generation: &services.SchemaGeneration{
+							Model:            "test-model",
+							TemplateVersion:  "default:0123456789ab",
+							PromptTokens:     120,
+							CompletionTokens: 30,
+							Latency:          1500 * time.Millisecond,
+							ContextSchemaIDs: []uuid.UUID{},
+						},
 					},
 				},
 			},
@@ -84,8 +93,17 @@ func TestSchemaListVersions(t *testing.T) {
 					"createdAt": "2026-01-01T00:00:00Z",
 				},
 				map[string]any{
-					"id":        "00000000-0000-0000-0000-000000000011",
-					"createdAt": "2026-01-02T00:00:00Z",
+					"id":           "00000000-0000-0000-0000-000000000011",
+					"instructions": "Keep it short.",
+					"createdAt":    "2026-01-02T00:00:00Z",
+					"generation": map[string]any{
+						"model":            "test-model",
+						"templateVersion":  "default:0123456789ab",
+						"promptTokens":     float64(120),
+						"completionTokens": float64(30),
+						"latencyMs":        float64(1500),
+						"contextSchemaIDs": []any{},
+					},
 				},
 			},
 			expectStatus: http.StatusOK,
@@ -234,7 +252,7 @@ func TestSchemaListVersions(t *testing.T) {
 					ModuleNamespace: "my-namespace",
 					Limit:           10,
 				},
-				err: services.ErrUserDoesNotOwnProject,
+				err: services.ErrProjectAccessDenied,
 			},
 
 			expectStatus: http.StatusForbidden,
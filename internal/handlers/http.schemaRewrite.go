@@ -24,8 +24,9 @@ type SchemaRewriteService interface {
 }
 
 type SchemaRewriteRequest struct {
-	ID   uuid.UUID      `json:"id"`
-	Data map[string]any `json:"data"`
+	ID    uuid.UUID      `json:"id"`
+	Data  map[string]any `json:"data"`
+	Draft bool           `json:"draft"`
 }
 
 type SchemaRewrite struct {
@@ -63,14 +64,21 @@ func (handler *SchemaRewrite) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		ID:     request.ID,
 		UserID: lo.FromPtr(claims.UserID),
 		Data:   request.Data,
+		Draft:  request.Draft,
 		Now:    time.Now(),
 	})
 	if err != nil {
+		if handleSchemaValidationErrors(ctx, w, span, err) {
+			return
+		}
+
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
-			services.ErrUserDoesNotOwnProject: http.StatusForbidden,
-			dao.ErrSchemaSelectNotFound:       http.StatusNotFound,
-			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrInvalidData:         http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrSchemaSelectNotFound:     http.StatusNotFound,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
+			dao.ErrModuleSelectNotFound:     http.StatusNotFound,
 		}, err)
 
 		return
@@ -21,6 +21,7 @@ import (
 	"github.com/a-novel/service-narrative-engine/internal/dao"
 	"github.com/a-novel/service-narrative-engine/internal/handlers"
 	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 )
 
@@ -199,6 +200,52 @@ func TestSchemaRewrite(t *testing.T) {
 
 			expectStatus: http.StatusUnprocessableEntity,
 		},
+		{
+			name: "Error/InvalidData",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"id":"00000000-0000-0000-0000-000000000001","data":{"title":42}}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			serviceMock: &serviceMock{
+				err: errors.Join(
+					lib.JSONSchemaValidationErrors{
+						{Path: "/title", Message: `type: 42 has type "integer", want "string"`},
+					},
+					services.ErrInvalidData,
+				),
+			},
+
+			expectResponse: map[string]any{
+				"errors": []any{
+					map[string]any{"path": "/title", "message": `type: 42 has type "integer", want "string"`},
+				},
+			},
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/ModuleNotFound",
+
+			request: httptest.NewRequest(
+				http.MethodPut,
+				"/",
+				strings.NewReader(`{"id":"00000000-0000-0000-0000-000000000001","data":{"key":"value"}}`),
+			),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000002")),
+			},
+
+			serviceMock: &serviceMock{
+				err: dao.ErrModuleSelectNotFound,
+			},
+
+			expectStatus: http.StatusNotFound,
+		},
 		{
 			name: "Error/UserDoesNotOwnProject",
 
@@ -212,7 +259,7 @@ func TestSchemaRewrite(t *testing.T) {
 			},
 
 			serviceMock: &serviceMock{
-				err: services.ErrUserDoesNotOwnProject,
+				err: services.ErrProjectAccessDenied,
 			},
 
 			expectStatus: http.StatusForbidden,
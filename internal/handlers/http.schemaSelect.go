@@ -64,10 +64,10 @@ func (handler *SchemaSelect) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	})
 	if err != nil {
 		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
-			services.ErrInvalidRequest:        http.StatusUnprocessableEntity,
-			services.ErrUserDoesNotOwnProject: http.StatusForbidden,
-			dao.ErrSchemaSelectNotFound:       http.StatusNotFound,
-			dao.ErrProjectSelectNotFound:      http.StatusNotFound,
+			services.ErrInvalidRequest:      http.StatusUnprocessableEntity,
+			services.ErrProjectAccessDenied: http.StatusForbidden,
+			dao.ErrSchemaSelectNotFound:     http.StatusNotFound,
+			dao.ErrProjectSelectNotFound:    http.StatusNotFound,
 		}, err)
 
 		return
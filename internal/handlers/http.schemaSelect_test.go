@@ -20,6 +20,7 @@ import (
 	"github.com/a-novel/service-narrative-engine/internal/dao"
 	"github.com/a-novel/service-narrative-engine/internal/handlers"
 	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 )
 
@@ -113,19 +114,59 @@ func TestSchemaSelect(t *testing.T) {
 					ModuleNamespace: "namespace",
 					ModuleVersion:   "1.0.0",
 					Source:          "AI",
+					Instructions:    "Keep it short.",
 					Data:            map[string]any{"foo": "bar"},
 					CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					Generation: &services.SchemaGeneration{
+						Model:            "test-model",
+						Messages:         []lib.CompletionMessage{{Role: lib.CompletionRoleUser, Content: "Generate."}},
+						TemplateVersion:  "default:0123456789ab",
+						PromptTokens:     120,
+						CompletionTokens: 30,
+						Latency:          1500 * time.Millisecond,
+						ContextSchemaIDs: []uuid.UUID{uuid.MustParse("00000000-0000-0000-0000-000000000004")},
+						ContextCuts: []*services.SchemaGenerationContextCut{
+							{
+								SchemaID:   uuid.MustParse("00000000-0000-0000-0000-000000000006"),
+								Module:     "agora:character",
+								Action:     "dropped",
+								Tokens:     18000,
+								KeptTokens: 0,
+							},
+						},
+						BaseSchemaID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000005")),
+					},
 				},
 			},
 
 			expectResponse: map[string]any{
-				"id":        "00000000-0000-0000-0000-000000000001",
-				"projectID": "00000000-0000-0000-0000-000000000002",
-				"owner":     "00000000-0000-0000-0000-000000000003",
-				"module":    "namespace:module@v1.0.0",
-				"source":    "AI",
-				"data":      map[string]any{"foo": "bar"},
-				"createdAt": "2026-01-01T00:00:00Z",
+				"id":           "00000000-0000-0000-0000-000000000001",
+				"projectID":    "00000000-0000-0000-0000-000000000002",
+				"owner":        "00000000-0000-0000-0000-000000000003",
+				"module":       "namespace:module@v1.0.0",
+				"source":       "AI",
+				"instructions": "Keep it short.",
+				"data":         map[string]any{"foo": "bar"},
+				"createdAt":    "2026-01-01T00:00:00Z",
+				"generation": map[string]any{
+					"model":            "test-model",
+					"messages":         []any{map[string]any{"role": "user", "content": "Generate."}},
+					"templateVersion":  "default:0123456789ab",
+					"promptTokens":     float64(120),
+					"completionTokens": float64(30),
+					"latencyMs":        float64(1500),
+					"contextSchemaIDs": []any{"00000000-0000-0000-0000-000000000004"},
+					"contextCuts": []any{
+						map[string]any{
+							"schemaID":   "00000000-0000-0000-0000-000000000006",
+							"module":     "agora:character",
+							"action":     "dropped",
+							"tokens":     float64(18000),
+							"keptTokens": float64(0),
+						},
+					},
+					"baseSchemaID": "00000000-0000-0000-0000-000000000005",
+				},
 			},
 			expectStatus: http.StatusOK,
 		},
@@ -270,7 +311,7 @@ func TestSchemaSelect(t *testing.T) {
 					ProjectID: uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 					UserID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 				},
-				err: services.ErrUserDoesNotOwnProject,
+				err: services.ErrProjectAccessDenied,
 			},
 
 			expectStatus: http.StatusForbidden,
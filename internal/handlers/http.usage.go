@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type UsageWindow struct {
+	// Limit is null when there is no limit.
+	Limit *int64 `json:"limit"`
+	Used  int64  `json:"used"`
+	// Remaining is null when there is no limit.
+	Remaining *int64    `json:"remaining"`
+	ResetsAt  time.Time `json:"resetsAt"`
+}
+
+type UsageProject struct {
+	ProjectID        uuid.UUID `json:"projectID"`
+	PromptTokens     int64     `json:"promptTokens"`
+	CompletionTokens int64     `json:"completionTokens"`
+}
+
+type Usage struct {
+	Daily    UsageWindow    `json:"daily"`
+	Monthly  UsageWindow    `json:"monthly"`
+	Projects []UsageProject `json:"projects"`
+}
+
+func loadUsageWindow(window services.UsageWindow) UsageWindow {
+	return UsageWindow{
+		Limit:     window.Limit,
+		Used:      window.Used,
+		Remaining: window.Remaining,
+		ResetsAt:  window.ResetsAt,
+	}
+}
+
+func loadUsageProject(usage *services.UsageProject, _ int) UsageProject {
+	return UsageProject{
+		ProjectID:        usage.ProjectID,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+	}
+}
+
+func loadUsage(usage *services.Usage) Usage {
+	return Usage{
+		Daily:    loadUsageWindow(usage.Daily),
+		Monthly:  loadUsageWindow(usage.Monthly),
+		Projects: lo.Map(usage.Projects, loadUsageProject),
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel-kit/golib/httpf"
+	"github.com/a-novel-kit/golib/logging"
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+type UsageSelectService interface {
+	Exec(ctx context.Context, request *services.UsageSelectRequest) (*services.Usage, error)
+}
+
+type UsageSelect struct {
+	service UsageSelectService
+	logger  logging.Log
+}
+
+func NewUsageSelect(service UsageSelectService, logger logging.Log) *UsageSelect {
+	return &UsageSelect{service: service, logger: logger}
+}
+
+// ServeHTTP returns the tokens the current user spent on AI generation, along with what is left of their quota.
+func (handler *UsageSelect) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := otel.Tracer().Start(r.Context(), "handler.UsageSelect")
+	defer span.End()
+
+	claims, err := authpkg.MustGetClaimsContext(ctx)
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{nil: http.StatusForbidden}, err)
+
+		return
+	}
+
+	res, err := handler.service.Exec(ctx, &services.UsageSelectRequest{
+		UserID: lo.FromPtr(claims.UserID),
+		Roles:  claims.Roles,
+	})
+	if err != nil {
+		httpf.HandleError(ctx, handler.logger, w, span, httpf.ErrMap{
+			services.ErrInvalidRequest: http.StatusUnprocessableEntity,
+		}, err)
+
+		return
+	}
+
+	httpf.SendJSON(ctx, w, span, loadUsage(res))
+}
@@ -0,0 +1,184 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	authpkg "github.com/a-novel/service-authentication/v2/pkg"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/handlers"
+	handlersmocks "github.com/a-novel/service-narrative-engine/internal/handlers/mocks"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestUsageSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	type serviceMock struct {
+		req  *services.UsageSelectRequest
+		resp *services.Usage
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *http.Request
+		claims  *authpkg.Claims
+
+		serviceMock *serviceMock
+
+		expectStatus   int
+		expectResponse any
+	}{
+		{
+			name: "Success",
+
+			request: httptest.NewRequest(http.MethodGet, "/", nil),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+				Roles:  []string{"auth:user"},
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.UsageSelectRequest{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+					Roles:  []string{"auth:user"},
+				},
+				resp: &services.Usage{
+					Daily: services.UsageWindow{
+						Limit:     lo.ToPtr[int64](1000),
+						Used:      300,
+						Remaining: lo.ToPtr[int64](700),
+						ResetsAt:  time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+					Monthly: services.UsageWindow{
+						Used:     500,
+						ResetsAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+					},
+					Projects: []*services.UsageProject{
+						{
+							ProjectID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+							PromptTokens:     400,
+							CompletionTokens: 100,
+						},
+					},
+				},
+			},
+
+			expectResponse: map[string]any{
+				"daily": map[string]any{
+					"limit":     float64(1000),
+					"used":      float64(300),
+					"remaining": float64(700),
+					"resetsAt":  "2026-01-02T00:00:00Z",
+				},
+				"monthly": map[string]any{
+					"limit":     nil,
+					"used":      float64(500),
+					"remaining": nil,
+					"resetsAt":  "2026-02-01T00:00:00Z",
+				},
+				"projects": []any{
+					map[string]any{
+						"projectID":        "00000000-0000-0000-0000-000000000002",
+						"promptTokens":     float64(400),
+						"completionTokens": float64(100),
+					},
+				},
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "Error/NoClaims",
+
+			request: httptest.NewRequest(http.MethodGet, "/", nil),
+
+			expectStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: httptest.NewRequest(http.MethodGet, "/", nil),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.UsageSelectRequest{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: services.ErrInvalidRequest,
+			},
+
+			expectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error/InternalError",
+
+			request: httptest.NewRequest(http.MethodGet, "/", nil),
+			claims: &authpkg.Claims{
+				UserID: lo.ToPtr(uuid.MustParse("00000000-0000-0000-0000-000000000001")),
+			},
+
+			serviceMock: &serviceMock{
+				req: &services.UsageSelectRequest{
+					UserID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+				},
+				err: errFoo,
+			},
+
+			expectStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := handlersmocks.NewMockUsageSelectService(t)
+
+			if testCase.serviceMock != nil {
+				service.EXPECT().
+					Exec(mock.Anything, testCase.serviceMock.req).
+					Return(testCase.serviceMock.resp, testCase.serviceMock.err)
+			}
+
+			handler := handlers.NewUsageSelect(service, config.LoggerDev)
+			w := httptest.NewRecorder()
+
+			rCtx := testCase.request.Context()
+			rCtx = authpkg.SetClaimsContext(rCtx, testCase.claims)
+
+			handler.ServeHTTP(w, testCase.request.WithContext(rCtx))
+
+			res := w.Result()
+
+			require.Equal(t, testCase.expectStatus, res.StatusCode)
+
+			if testCase.expectResponse != nil {
+				data, err := io.ReadAll(res.Body)
+				require.NoError(t, errors.Join(err, res.Body.Close()))
+
+				var jsonRes any
+				require.NoError(t, json.Unmarshal(data, &jsonRes))
+				require.Equal(t, testCase.expectResponse, jsonRes)
+			}
+
+			service.AssertExpectations(t)
+		})
+	}
+}
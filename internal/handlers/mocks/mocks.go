@@ -13,6 +13,101 @@ import (
 	"google.golang.org/grpc"
 )
 
+// NewMockGenerationJobSelectService creates a new instance of MockGenerationJobSelectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerationJobSelectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerationJobSelectService {
+	mock := &MockGenerationJobSelectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerationJobSelectService is an autogenerated mock type for the GenerationJobSelectService type
+type MockGenerationJobSelectService struct {
+	mock.Mock
+}
+
+type MockGenerationJobSelectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerationJobSelectService) EXPECT() *MockGenerationJobSelectService_Expecter {
+	return &MockGenerationJobSelectService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockGenerationJobSelectService
+func (_mock *MockGenerationJobSelectService) Exec(ctx context.Context, request *services.GenerationJobSelectRequest) (*services.GenerationJob, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.GenerationJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.GenerationJobSelectRequest) (*services.GenerationJob, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.GenerationJobSelectRequest) *services.GenerationJob); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.GenerationJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.GenerationJobSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGenerationJobSelectService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGenerationJobSelectService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.GenerationJobSelectRequest
+func (_e *MockGenerationJobSelectService_Expecter) Exec(ctx interface{}, request interface{}) *MockGenerationJobSelectService_Exec_Call {
+	return &MockGenerationJobSelectService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockGenerationJobSelectService_Exec_Call) Run(run func(ctx context.Context, request *services.GenerationJobSelectRequest)) *MockGenerationJobSelectService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.GenerationJobSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.GenerationJobSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerationJobSelectService_Exec_Call) Return(generationJob *services.GenerationJob, err error) *MockGenerationJobSelectService_Exec_Call {
+	_c.Call.Return(generationJob, err)
+	return _c
+}
+
+func (_c *MockGenerationJobSelectService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.GenerationJobSelectRequest) (*services.GenerationJob, error)) *MockGenerationJobSelectService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockHealthApiJsonkeys creates a new instance of MockHealthApiJsonkeys. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockHealthApiJsonkeys(t interface {
@@ -123,13 +218,13 @@ func (_c *MockHealthApiJsonkeys_Status_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
-// NewMockModuleListVersionsService creates a new instance of MockModuleListVersionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockModuleCreateService creates a new instance of MockModuleCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleListVersionsService(t interface {
+func NewMockModuleCreateService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleListVersionsService {
-	mock := &MockModuleListVersionsService{}
+}) *MockModuleCreateService {
+	mock := &MockModuleCreateService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -137,40 +232,40 @@ func NewMockModuleListVersionsService(t interface {
 	return mock
 }
 
-// MockModuleListVersionsService is an autogenerated mock type for the ModuleListVersionsService type
-type MockModuleListVersionsService struct {
+// MockModuleCreateService is an autogenerated mock type for the ModuleCreateService type
+type MockModuleCreateService struct {
 	mock.Mock
 }
 
-type MockModuleListVersionsService_Expecter struct {
+type MockModuleCreateService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleListVersionsService) EXPECT() *MockModuleListVersionsService_Expecter {
-	return &MockModuleListVersionsService_Expecter{mock: &_m.Mock}
+func (_m *MockModuleCreateService) EXPECT() *MockModuleCreateService_Expecter {
+	return &MockModuleCreateService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleListVersionsService
-func (_mock *MockModuleListVersionsService) Exec(ctx context.Context, request *services.ModuleListVersionsRequest) ([]*services.ModuleVersion, error) {
+// Exec provides a mock function for the type MockModuleCreateService
+func (_mock *MockModuleCreateService) Exec(ctx context.Context, request *services.ModuleCreateRequest) (*services.Module, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*services.ModuleVersion
+	var r0 *services.Module
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleListVersionsRequest) ([]*services.ModuleVersion, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleCreateRequest) (*services.Module, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleListVersionsRequest) []*services.ModuleVersion); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleCreateRequest) *services.Module); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*services.ModuleVersion)
+			r0 = ret.Get(0).(*services.Module)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleListVersionsRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleCreateRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -178,27 +273,27 @@ func (_mock *MockModuleListVersionsService) Exec(ctx context.Context, request *s
 	return r0, r1
 }
 
-// MockModuleListVersionsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleListVersionsService_Exec_Call struct {
+// MockModuleCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleCreateService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *services.ModuleListVersionsRequest
-func (_e *MockModuleListVersionsService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleListVersionsService_Exec_Call {
-	return &MockModuleListVersionsService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.ModuleCreateRequest
+func (_e *MockModuleCreateService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCreateService_Exec_Call {
+	return &MockModuleCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleListVersionsService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleListVersionsRequest)) *MockModuleListVersionsService_Exec_Call {
+func (_c *MockModuleCreateService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleCreateRequest)) *MockModuleCreateService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *services.ModuleListVersionsRequest
+		var arg1 *services.ModuleCreateRequest
 		if args[1] != nil {
-			arg1 = args[1].(*services.ModuleListVersionsRequest)
+			arg1 = args[1].(*services.ModuleCreateRequest)
 		}
 		run(
 			arg0,
@@ -208,23 +303,23 @@ func (_c *MockModuleListVersionsService_Exec_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockModuleListVersionsService_Exec_Call) Return(moduleVersions []*services.ModuleVersion, err error) *MockModuleListVersionsService_Exec_Call {
-	_c.Call.Return(moduleVersions, err)
+func (_c *MockModuleCreateService_Exec_Call) Return(module *services.Module, err error) *MockModuleCreateService_Exec_Call {
+	_c.Call.Return(module, err)
 	return _c
 }
 
-func (_c *MockModuleListVersionsService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleListVersionsRequest) ([]*services.ModuleVersion, error)) *MockModuleListVersionsService_Exec_Call {
+func (_c *MockModuleCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleCreateRequest) (*services.Module, error)) *MockModuleCreateService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleSelectService creates a new instance of MockModuleSelectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockModuleDeleteService creates a new instance of MockModuleDeleteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleSelectService(t interface {
+func NewMockModuleDeleteService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleSelectService {
-	mock := &MockModuleSelectService{}
+}) *MockModuleDeleteService {
+	mock := &MockModuleDeleteService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -232,21 +327,21 @@ func NewMockModuleSelectService(t interface {
 	return mock
 }
 
-// MockModuleSelectService is an autogenerated mock type for the ModuleSelectService type
-type MockModuleSelectService struct {
+// MockModuleDeleteService is an autogenerated mock type for the ModuleDeleteService type
+type MockModuleDeleteService struct {
 	mock.Mock
 }
 
-type MockModuleSelectService_Expecter struct {
+type MockModuleDeleteService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleSelectService) EXPECT() *MockModuleSelectService_Expecter {
-	return &MockModuleSelectService_Expecter{mock: &_m.Mock}
+func (_m *MockModuleDeleteService) EXPECT() *MockModuleDeleteService_Expecter {
+	return &MockModuleDeleteService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleSelectService
-func (_mock *MockModuleSelectService) Exec(ctx context.Context, request *services.ModuleSelectRequest) (*services.Module, error) {
+// Exec provides a mock function for the type MockModuleDeleteService
+func (_mock *MockModuleDeleteService) Exec(ctx context.Context, request *services.ModuleDeleteRequest) (*services.Module, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -255,17 +350,17 @@ func (_mock *MockModuleSelectService) Exec(ctx context.Context, request *service
 
 	var r0 *services.Module
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleSelectRequest) (*services.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleDeleteRequest) (*services.Module, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleSelectRequest) *services.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleDeleteRequest) *services.Module); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*services.Module)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleDeleteRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -273,27 +368,27 @@ func (_mock *MockModuleSelectService) Exec(ctx context.Context, request *service
 	return r0, r1
 }
 
-// MockModuleSelectService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleSelectService_Exec_Call struct {
+// MockModuleDeleteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleDeleteService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *services.ModuleSelectRequest
-func (_e *MockModuleSelectService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleSelectService_Exec_Call {
-	return &MockModuleSelectService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.ModuleDeleteRequest
+func (_e *MockModuleDeleteService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleDeleteService_Exec_Call {
+	return &MockModuleDeleteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleSelectService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleSelectRequest)) *MockModuleSelectService_Exec_Call {
+func (_c *MockModuleDeleteService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleDeleteRequest)) *MockModuleDeleteService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *services.ModuleSelectRequest
+		var arg1 *services.ModuleDeleteRequest
 		if args[1] != nil {
-			arg1 = args[1].(*services.ModuleSelectRequest)
+			arg1 = args[1].(*services.ModuleDeleteRequest)
 		}
 		run(
 			arg0,
@@ -303,23 +398,23 @@ func (_c *MockModuleSelectService_Exec_Call) Run(run func(ctx context.Context, r
 	return _c
 }
 
-func (_c *MockModuleSelectService_Exec_Call) Return(module *services.Module, err error) *MockModuleSelectService_Exec_Call {
+func (_c *MockModuleDeleteService_Exec_Call) Return(module *services.Module, err error) *MockModuleDeleteService_Exec_Call {
 	_c.Call.Return(module, err)
 	return _c
 }
 
-func (_c *MockModuleSelectService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleSelectRequest) (*services.Module, error)) *MockModuleSelectService_Exec_Call {
+func (_c *MockModuleDeleteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleDeleteRequest) (*services.Module, error)) *MockModuleDeleteService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectDeleteService creates a new instance of MockProjectDeleteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockModuleListService creates a new instance of MockModuleListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectDeleteService(t interface {
+func NewMockModuleListService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectDeleteService {
-	mock := &MockProjectDeleteService{}
+}) *MockModuleListService {
+	mock := &MockModuleListService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -327,40 +422,40 @@ func NewMockProjectDeleteService(t interface {
 	return mock
 }
 
-// MockProjectDeleteService is an autogenerated mock type for the ProjectDeleteService type
-type MockProjectDeleteService struct {
+// MockModuleListService is an autogenerated mock type for the ModuleListService type
+type MockModuleListService struct {
 	mock.Mock
 }
 
-type MockProjectDeleteService_Expecter struct {
+type MockModuleListService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectDeleteService) EXPECT() *MockProjectDeleteService_Expecter {
-	return &MockProjectDeleteService_Expecter{mock: &_m.Mock}
+func (_m *MockModuleListService) EXPECT() *MockModuleListService_Expecter {
+	return &MockModuleListService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectDeleteService
-func (_mock *MockProjectDeleteService) Exec(ctx context.Context, request *services.ProjectDeleteRequest) (*services.Project, error) {
+// Exec provides a mock function for the type MockModuleListService
+func (_mock *MockModuleListService) Exec(ctx context.Context, request *services.ModuleListRequest) ([]*services.ModuleSummary, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *services.Project
+	var r0 []*services.ModuleSummary
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectDeleteRequest) (*services.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleListRequest) ([]*services.ModuleSummary, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectDeleteRequest) *services.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleListRequest) []*services.ModuleSummary); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*services.Project)
+			r0 = ret.Get(0).([]*services.ModuleSummary)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectDeleteRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -368,27 +463,2307 @@ func (_mock *MockProjectDeleteService) Exec(ctx context.Context, request *servic
 	return r0, r1
 }
 
-// MockProjectDeleteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectDeleteService_Exec_Call struct {
+// MockModuleListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleListService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *services.ProjectDeleteRequest
-func (_e *MockProjectDeleteService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectDeleteService_Exec_Call {
-	return &MockProjectDeleteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.ModuleListRequest
+func (_e *MockModuleListService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleListService_Exec_Call {
+	return &MockModuleListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleListService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleListRequest)) *MockModuleListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ModuleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ModuleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleListService_Exec_Call) Return(moduleSummarys []*services.ModuleSummary, err error) *MockModuleListService_Exec_Call {
+	_c.Call.Return(moduleSummarys, err)
+	return _c
+}
+
+func (_c *MockModuleListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleListRequest) ([]*services.ModuleSummary, error)) *MockModuleListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleListVersionsService creates a new instance of MockModuleListVersionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleListVersionsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleListVersionsService {
+	mock := &MockModuleListVersionsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleListVersionsService is an autogenerated mock type for the ModuleListVersionsService type
+type MockModuleListVersionsService struct {
+	mock.Mock
+}
+
+type MockModuleListVersionsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleListVersionsService) EXPECT() *MockModuleListVersionsService_Expecter {
+	return &MockModuleListVersionsService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleListVersionsService
+func (_mock *MockModuleListVersionsService) Exec(ctx context.Context, request *services.ModuleListVersionsRequest) ([]*services.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleListVersionsRequest) ([]*services.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleListVersionsRequest) []*services.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleListVersionsService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleListVersionsService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ModuleListVersionsRequest
+func (_e *MockModuleListVersionsService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleListVersionsService_Exec_Call {
+	return &MockModuleListVersionsService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleListVersionsService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleListVersionsRequest)) *MockModuleListVersionsService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleListVersionsService_Exec_Call) Return(moduleVersions []*services.ModuleVersion, err error) *MockModuleListVersionsService_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockModuleListVersionsService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleListVersionsRequest) ([]*services.ModuleVersion, error)) *MockModuleListVersionsService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleSelectService creates a new instance of MockModuleSelectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleSelectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleSelectService {
+	mock := &MockModuleSelectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleSelectService is an autogenerated mock type for the ModuleSelectService type
+type MockModuleSelectService struct {
+	mock.Mock
+}
+
+type MockModuleSelectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleSelectService) EXPECT() *MockModuleSelectService_Expecter {
+	return &MockModuleSelectService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleSelectService
+func (_mock *MockModuleSelectService) Exec(ctx context.Context, request *services.ModuleSelectRequest) (*services.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleSelectRequest) (*services.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleSelectRequest) *services.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleSelectService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleSelectService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ModuleSelectRequest
+func (_e *MockModuleSelectService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleSelectService_Exec_Call {
+	return &MockModuleSelectService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleSelectService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleSelectRequest)) *MockModuleSelectService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleSelectService_Exec_Call) Return(module *services.Module, err error) *MockModuleSelectService_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleSelectService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleSelectRequest) (*services.Module, error)) *MockModuleSelectService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleSelectLLMSchemaService creates a new instance of MockModuleSelectLLMSchemaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleSelectLLMSchemaService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleSelectLLMSchemaService {
+	mock := &MockModuleSelectLLMSchemaService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleSelectLLMSchemaService is an autogenerated mock type for the ModuleSelectLLMSchemaService type
+type MockModuleSelectLLMSchemaService struct {
+	mock.Mock
+}
+
+type MockModuleSelectLLMSchemaService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleSelectLLMSchemaService) EXPECT() *MockModuleSelectLLMSchemaService_Expecter {
+	return &MockModuleSelectLLMSchemaService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleSelectLLMSchemaService
+func (_mock *MockModuleSelectLLMSchemaService) Exec(ctx context.Context, request *services.ModuleSelectLLMSchemaRequest) (*services.ModuleLLMSchema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.ModuleLLMSchema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleSelectLLMSchemaRequest) (*services.ModuleLLMSchema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ModuleSelectLLMSchemaRequest) *services.ModuleLLMSchema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ModuleLLMSchema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ModuleSelectLLMSchemaRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleSelectLLMSchemaService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleSelectLLMSchemaService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ModuleSelectLLMSchemaRequest
+func (_e *MockModuleSelectLLMSchemaService_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleSelectLLMSchemaService_Exec_Call {
+	return &MockModuleSelectLLMSchemaService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleSelectLLMSchemaService_Exec_Call) Run(run func(ctx context.Context, request *services.ModuleSelectLLMSchemaRequest)) *MockModuleSelectLLMSchemaService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ModuleSelectLLMSchemaRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ModuleSelectLLMSchemaRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleSelectLLMSchemaService_Exec_Call) Return(moduleLLMSchema *services.ModuleLLMSchema, err error) *MockModuleSelectLLMSchemaService_Exec_Call {
+	_c.Call.Return(moduleLLMSchema, err)
+	return _c
+}
+
+func (_c *MockModuleSelectLLMSchemaService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ModuleSelectLLMSchemaRequest) (*services.ModuleLLMSchema, error)) *MockModuleSelectLLMSchemaService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectDeleteService creates a new instance of MockProjectDeleteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectDeleteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectDeleteService {
+	mock := &MockProjectDeleteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectDeleteService is an autogenerated mock type for the ProjectDeleteService type
+type MockProjectDeleteService struct {
+	mock.Mock
+}
+
+type MockProjectDeleteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectDeleteService) EXPECT() *MockProjectDeleteService_Expecter {
+	return &MockProjectDeleteService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectDeleteService
+func (_mock *MockProjectDeleteService) Exec(ctx context.Context, request *services.ProjectDeleteRequest) (*services.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectDeleteRequest) (*services.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectDeleteRequest) *services.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectDeleteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectDeleteService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectDeleteRequest
+func (_e *MockProjectDeleteService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectDeleteService_Exec_Call {
+	return &MockProjectDeleteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectDeleteService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectDeleteRequest)) *MockProjectDeleteService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectDeleteService_Exec_Call) Return(project *services.Project, err error) *MockProjectDeleteService_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectDeleteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectDeleteRequest) (*services.Project, error)) *MockProjectDeleteService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectForkService creates a new instance of MockProjectForkService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectForkService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectForkService {
+	mock := &MockProjectForkService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectForkService is an autogenerated mock type for the ProjectForkService type
+type MockProjectForkService struct {
+	mock.Mock
+}
+
+type MockProjectForkService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectForkService) EXPECT() *MockProjectForkService_Expecter {
+	return &MockProjectForkService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectForkService
+func (_mock *MockProjectForkService) Exec(ctx context.Context, request *services.ProjectForkRequest) (*services.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectForkRequest) (*services.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectForkRequest) *services.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectForkRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectForkService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectForkService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectForkRequest
+func (_e *MockProjectForkService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectForkService_Exec_Call {
+	return &MockProjectForkService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectForkService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectForkRequest)) *MockProjectForkService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectForkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectForkRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectForkService_Exec_Call) Return(project *services.Project, err error) *MockProjectForkService_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectForkService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectForkRequest) (*services.Project, error)) *MockProjectForkService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectInitService creates a new instance of MockProjectInitService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectInitService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectInitService {
+	mock := &MockProjectInitService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectInitService is an autogenerated mock type for the ProjectInitService type
+type MockProjectInitService struct {
+	mock.Mock
+}
+
+type MockProjectInitService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectInitService) EXPECT() *MockProjectInitService_Expecter {
+	return &MockProjectInitService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectInitService
+func (_mock *MockProjectInitService) Exec(ctx context.Context, request *services.ProjectInitRequest) (*services.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectInitRequest) (*services.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectInitRequest) *services.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectInitRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectInitService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectInitService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectInitRequest
+func (_e *MockProjectInitService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInitService_Exec_Call {
+	return &MockProjectInitService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectInitService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectInitRequest)) *MockProjectInitService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectInitRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectInitRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectInitService_Exec_Call) Return(project *services.Project, err error) *MockProjectInitService_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectInitService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectInitRequest) (*services.Project, error)) *MockProjectInitService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectListService creates a new instance of MockProjectListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectListService {
+	mock := &MockProjectListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectListService is an autogenerated mock type for the ProjectListService type
+type MockProjectListService struct {
+	mock.Mock
+}
+
+type MockProjectListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectListService) EXPECT() *MockProjectListService_Expecter {
+	return &MockProjectListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectListService
+func (_mock *MockProjectListService) Exec(ctx context.Context, request *services.ProjectListRequest) ([]*services.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectListRequest) ([]*services.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectListRequest) []*services.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectListRequest
+func (_e *MockProjectListService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectListService_Exec_Call {
+	return &MockProjectListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectListService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectListRequest)) *MockProjectListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectListService_Exec_Call) Return(projects []*services.Project, err error) *MockProjectListService_Exec_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockProjectListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectListRequest) ([]*services.Project, error)) *MockProjectListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberDeleteService creates a new instance of MockProjectMemberDeleteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberDeleteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberDeleteService {
+	mock := &MockProjectMemberDeleteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberDeleteService is an autogenerated mock type for the ProjectMemberDeleteService type
+type MockProjectMemberDeleteService struct {
+	mock.Mock
+}
+
+type MockProjectMemberDeleteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberDeleteService) EXPECT() *MockProjectMemberDeleteService_Expecter {
+	return &MockProjectMemberDeleteService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberDeleteService
+func (_mock *MockProjectMemberDeleteService) Exec(ctx context.Context, request *services.ProjectMemberDeleteRequest) (*services.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectMemberDeleteRequest) (*services.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectMemberDeleteRequest) *services.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectMemberDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberDeleteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberDeleteService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectMemberDeleteRequest
+func (_e *MockProjectMemberDeleteService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberDeleteService_Exec_Call {
+	return &MockProjectMemberDeleteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberDeleteService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectMemberDeleteRequest)) *MockProjectMemberDeleteService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectMemberDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectMemberDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteService_Exec_Call) Return(projectMember *services.ProjectMember, err error) *MockProjectMemberDeleteService_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectMemberDeleteRequest) (*services.ProjectMember, error)) *MockProjectMemberDeleteService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberListService creates a new instance of MockProjectMemberListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberListService {
+	mock := &MockProjectMemberListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberListService is an autogenerated mock type for the ProjectMemberListService type
+type MockProjectMemberListService struct {
+	mock.Mock
+}
+
+type MockProjectMemberListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberListService) EXPECT() *MockProjectMemberListService_Expecter {
+	return &MockProjectMemberListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberListService
+func (_mock *MockProjectMemberListService) Exec(ctx context.Context, request *services.ProjectMemberListRequest) ([]*services.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectMemberListRequest) ([]*services.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectMemberListRequest) []*services.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectMemberListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectMemberListRequest
+func (_e *MockProjectMemberListService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberListService_Exec_Call {
+	return &MockProjectMemberListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberListService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectMemberListRequest)) *MockProjectMemberListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectMemberListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectMemberListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberListService_Exec_Call) Return(projectMembers []*services.ProjectMember, err error) *MockProjectMemberListService_Exec_Call {
+	_c.Call.Return(projectMembers, err)
+	return _c
+}
+
+func (_c *MockProjectMemberListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectMemberListRequest) ([]*services.ProjectMember, error)) *MockProjectMemberListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberUpsertService creates a new instance of MockProjectMemberUpsertService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberUpsertService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberUpsertService {
+	mock := &MockProjectMemberUpsertService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberUpsertService is an autogenerated mock type for the ProjectMemberUpsertService type
+type MockProjectMemberUpsertService struct {
+	mock.Mock
+}
+
+type MockProjectMemberUpsertService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberUpsertService) EXPECT() *MockProjectMemberUpsertService_Expecter {
+	return &MockProjectMemberUpsertService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberUpsertService
+func (_mock *MockProjectMemberUpsertService) Exec(ctx context.Context, request *services.ProjectMemberUpsertRequest) (*services.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectMemberUpsertRequest) (*services.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectMemberUpsertRequest) *services.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectMemberUpsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberUpsertService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberUpsertService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectMemberUpsertRequest
+func (_e *MockProjectMemberUpsertService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberUpsertService_Exec_Call {
+	return &MockProjectMemberUpsertService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberUpsertService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectMemberUpsertRequest)) *MockProjectMemberUpsertService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectMemberUpsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectMemberUpsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertService_Exec_Call) Return(projectMember *services.ProjectMember, err error) *MockProjectMemberUpsertService_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectMemberUpsertRequest) (*services.ProjectMember, error)) *MockProjectMemberUpsertService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareCreateService creates a new instance of MockProjectShareCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareCreateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareCreateService {
+	mock := &MockProjectShareCreateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareCreateService is an autogenerated mock type for the ProjectShareCreateService type
+type MockProjectShareCreateService struct {
+	mock.Mock
+}
+
+type MockProjectShareCreateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareCreateService) EXPECT() *MockProjectShareCreateService_Expecter {
+	return &MockProjectShareCreateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareCreateService
+func (_mock *MockProjectShareCreateService) Exec(ctx context.Context, request *services.ProjectShareCreateRequest) (*services.ProjectShare, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.ProjectShare
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareCreateRequest) (*services.ProjectShare, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareCreateRequest) *services.ProjectShare); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ProjectShare)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectShareCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareCreateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectShareCreateRequest
+func (_e *MockProjectShareCreateService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareCreateService_Exec_Call {
+	return &MockProjectShareCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareCreateService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectShareCreateRequest)) *MockProjectShareCreateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectShareCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectShareCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareCreateService_Exec_Call) Return(projectShare *services.ProjectShare, err error) *MockProjectShareCreateService_Exec_Call {
+	_c.Call.Return(projectShare, err)
+	return _c
+}
+
+func (_c *MockProjectShareCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectShareCreateRequest) (*services.ProjectShare, error)) *MockProjectShareCreateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareListService creates a new instance of MockProjectShareListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareListService {
+	mock := &MockProjectShareListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareListService is an autogenerated mock type for the ProjectShareListService type
+type MockProjectShareListService struct {
+	mock.Mock
+}
+
+type MockProjectShareListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareListService) EXPECT() *MockProjectShareListService_Expecter {
+	return &MockProjectShareListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareListService
+func (_mock *MockProjectShareListService) Exec(ctx context.Context, request *services.ProjectShareListRequest) ([]*services.ProjectShare, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.ProjectShare
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareListRequest) ([]*services.ProjectShare, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareListRequest) []*services.ProjectShare); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.ProjectShare)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectShareListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectShareListRequest
+func (_e *MockProjectShareListService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareListService_Exec_Call {
+	return &MockProjectShareListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareListService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectShareListRequest)) *MockProjectShareListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectShareListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectShareListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareListService_Exec_Call) Return(projectShares []*services.ProjectShare, err error) *MockProjectShareListService_Exec_Call {
+	_c.Call.Return(projectShares, err)
+	return _c
+}
+
+func (_c *MockProjectShareListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectShareListRequest) ([]*services.ProjectShare, error)) *MockProjectShareListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareRevokeService creates a new instance of MockProjectShareRevokeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareRevokeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareRevokeService {
+	mock := &MockProjectShareRevokeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareRevokeService is an autogenerated mock type for the ProjectShareRevokeService type
+type MockProjectShareRevokeService struct {
+	mock.Mock
+}
+
+type MockProjectShareRevokeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareRevokeService) EXPECT() *MockProjectShareRevokeService_Expecter {
+	return &MockProjectShareRevokeService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareRevokeService
+func (_mock *MockProjectShareRevokeService) Exec(ctx context.Context, request *services.ProjectShareRevokeRequest) (*services.ProjectShare, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.ProjectShare
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareRevokeRequest) (*services.ProjectShare, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareRevokeRequest) *services.ProjectShare); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ProjectShare)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectShareRevokeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareRevokeService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareRevokeService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectShareRevokeRequest
+func (_e *MockProjectShareRevokeService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareRevokeService_Exec_Call {
+	return &MockProjectShareRevokeService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareRevokeService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectShareRevokeRequest)) *MockProjectShareRevokeService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectShareRevokeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectShareRevokeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareRevokeService_Exec_Call) Return(projectShare *services.ProjectShare, err error) *MockProjectShareRevokeService_Exec_Call {
+	_c.Call.Return(projectShare, err)
+	return _c
+}
+
+func (_c *MockProjectShareRevokeService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectShareRevokeRequest) (*services.ProjectShare, error)) *MockProjectShareRevokeService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareSelectService creates a new instance of MockProjectShareSelectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareSelectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareSelectService {
+	mock := &MockProjectShareSelectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareSelectService is an autogenerated mock type for the ProjectShareSelectService type
+type MockProjectShareSelectService struct {
+	mock.Mock
+}
+
+type MockProjectShareSelectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareSelectService) EXPECT() *MockProjectShareSelectService_Expecter {
+	return &MockProjectShareSelectService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareSelectService
+func (_mock *MockProjectShareSelectService) Exec(ctx context.Context, request *services.ProjectShareSelectRequest) (*services.SharedProject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.SharedProject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareSelectRequest) (*services.SharedProject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectShareSelectRequest) *services.SharedProject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.SharedProject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectShareSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareSelectService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareSelectService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectShareSelectRequest
+func (_e *MockProjectShareSelectService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareSelectService_Exec_Call {
+	return &MockProjectShareSelectService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareSelectService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectShareSelectRequest)) *MockProjectShareSelectService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectShareSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectShareSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareSelectService_Exec_Call) Return(sharedProject *services.SharedProject, err error) *MockProjectShareSelectService_Exec_Call {
+	_c.Call.Return(sharedProject, err)
+	return _c
+}
+
+func (_c *MockProjectShareSelectService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectShareSelectRequest) (*services.SharedProject, error)) *MockProjectShareSelectService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotCreateService creates a new instance of MockProjectSnapshotCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotCreateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotCreateService {
+	mock := &MockProjectSnapshotCreateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotCreateService is an autogenerated mock type for the ProjectSnapshotCreateService type
+type MockProjectSnapshotCreateService struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotCreateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotCreateService) EXPECT() *MockProjectSnapshotCreateService_Expecter {
+	return &MockProjectSnapshotCreateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotCreateService
+func (_mock *MockProjectSnapshotCreateService) Exec(ctx context.Context, request *services.ProjectSnapshotCreateRequest) (*services.ProjectSnapshot, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.ProjectSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotCreateRequest) (*services.ProjectSnapshot, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotCreateRequest) *services.ProjectSnapshot); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ProjectSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectSnapshotCreateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotCreateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectSnapshotCreateRequest
+func (_e *MockProjectSnapshotCreateService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotCreateService_Exec_Call {
+	return &MockProjectSnapshotCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotCreateService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectSnapshotCreateRequest)) *MockProjectSnapshotCreateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectSnapshotCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectSnapshotCreateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateService_Exec_Call) Return(projectSnapshot *services.ProjectSnapshot, err error) *MockProjectSnapshotCreateService_Exec_Call {
+	_c.Call.Return(projectSnapshot, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectSnapshotCreateRequest) (*services.ProjectSnapshot, error)) *MockProjectSnapshotCreateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotListService creates a new instance of MockProjectSnapshotListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotListService {
+	mock := &MockProjectSnapshotListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotListService is an autogenerated mock type for the ProjectSnapshotListService type
+type MockProjectSnapshotListService struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotListService) EXPECT() *MockProjectSnapshotListService_Expecter {
+	return &MockProjectSnapshotListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotListService
+func (_mock *MockProjectSnapshotListService) Exec(ctx context.Context, request *services.ProjectSnapshotListRequest) ([]*services.ProjectSnapshot, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.ProjectSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotListRequest) ([]*services.ProjectSnapshot, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotListRequest) []*services.ProjectSnapshot); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.ProjectSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectSnapshotListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectSnapshotListRequest
+func (_e *MockProjectSnapshotListService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotListService_Exec_Call {
+	return &MockProjectSnapshotListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotListService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectSnapshotListRequest)) *MockProjectSnapshotListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectSnapshotListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectSnapshotListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotListService_Exec_Call) Return(projectSnapshots []*services.ProjectSnapshot, err error) *MockProjectSnapshotListService_Exec_Call {
+	_c.Call.Return(projectSnapshots, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectSnapshotListRequest) ([]*services.ProjectSnapshot, error)) *MockProjectSnapshotListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotRestoreService creates a new instance of MockProjectSnapshotRestoreService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotRestoreService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotRestoreService {
+	mock := &MockProjectSnapshotRestoreService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotRestoreService is an autogenerated mock type for the ProjectSnapshotRestoreService type
+type MockProjectSnapshotRestoreService struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotRestoreService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotRestoreService) EXPECT() *MockProjectSnapshotRestoreService_Expecter {
+	return &MockProjectSnapshotRestoreService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotRestoreService
+func (_mock *MockProjectSnapshotRestoreService) Exec(ctx context.Context, request *services.ProjectSnapshotRestoreRequest) ([]*services.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotRestoreRequest) ([]*services.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotRestoreRequest) []*services.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectSnapshotRestoreRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotRestoreService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotRestoreService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectSnapshotRestoreRequest
+func (_e *MockProjectSnapshotRestoreService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotRestoreService_Exec_Call {
+	return &MockProjectSnapshotRestoreService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotRestoreService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectSnapshotRestoreRequest)) *MockProjectSnapshotRestoreService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectSnapshotRestoreRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectSnapshotRestoreRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreService_Exec_Call) Return(schemas []*services.Schema, err error) *MockProjectSnapshotRestoreService_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectSnapshotRestoreRequest) ([]*services.Schema, error)) *MockProjectSnapshotRestoreService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotSelectService creates a new instance of MockProjectSnapshotSelectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotSelectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotSelectService {
+	mock := &MockProjectSnapshotSelectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotSelectService is an autogenerated mock type for the ProjectSnapshotSelectService type
+type MockProjectSnapshotSelectService struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotSelectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotSelectService) EXPECT() *MockProjectSnapshotSelectService_Expecter {
+	return &MockProjectSnapshotSelectService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotSelectService
+func (_mock *MockProjectSnapshotSelectService) Exec(ctx context.Context, request *services.ProjectSnapshotSelectRequest) (*services.ProjectSnapshotContent, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.ProjectSnapshotContent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotSelectRequest) (*services.ProjectSnapshotContent, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectSnapshotSelectRequest) *services.ProjectSnapshotContent); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ProjectSnapshotContent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectSnapshotSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotSelectService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotSelectService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectSnapshotSelectRequest
+func (_e *MockProjectSnapshotSelectService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotSelectService_Exec_Call {
+	return &MockProjectSnapshotSelectService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotSelectService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectSnapshotSelectRequest)) *MockProjectSnapshotSelectService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectSnapshotSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectSnapshotSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectService_Exec_Call) Return(projectSnapshotContent *services.ProjectSnapshotContent, err error) *MockProjectSnapshotSelectService_Exec_Call {
+	_c.Call.Return(projectSnapshotContent, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectSnapshotSelectRequest) (*services.ProjectSnapshotContent, error)) *MockProjectSnapshotSelectService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectStateService creates a new instance of MockProjectStateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectStateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectStateService {
+	mock := &MockProjectStateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectStateService is an autogenerated mock type for the ProjectStateService type
+type MockProjectStateService struct {
+	mock.Mock
+}
+
+type MockProjectStateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectStateService) EXPECT() *MockProjectStateService_Expecter {
+	return &MockProjectStateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectStateService
+func (_mock *MockProjectStateService) Exec(ctx context.Context, request *services.ProjectStateRequest) ([]*services.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectStateRequest) ([]*services.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectStateRequest) []*services.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectStateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectStateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectStateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectStateRequest
+func (_e *MockProjectStateService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectStateService_Exec_Call {
+	return &MockProjectStateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectStateService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectStateRequest)) *MockProjectStateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectStateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectStateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectStateService_Exec_Call) Return(schemas []*services.Schema, err error) *MockProjectStateService_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectStateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectStateRequest) ([]*services.Schema, error)) *MockProjectStateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateService creates a new instance of MockProjectTranslateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateService {
+	mock := &MockProjectTranslateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateService is an autogenerated mock type for the ProjectTranslateService type
+type MockProjectTranslateService struct {
+	mock.Mock
+}
+
+type MockProjectTranslateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateService) EXPECT() *MockProjectTranslateService_Expecter {
+	return &MockProjectTranslateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateService
+func (_mock *MockProjectTranslateService) Exec(ctx context.Context, request *services.ProjectTranslateRequest) (*services.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectTranslateRequest) (*services.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectTranslateRequest) *services.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectTranslateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectTranslateRequest
+func (_e *MockProjectTranslateService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateService_Exec_Call {
+	return &MockProjectTranslateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectTranslateRequest)) *MockProjectTranslateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectTranslateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectTranslateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateService_Exec_Call) Return(project *services.Project, err error) *MockProjectTranslateService_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectTranslateRequest) (*services.Project, error)) *MockProjectTranslateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateService creates a new instance of MockProjectUpdateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateService {
+	mock := &MockProjectUpdateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateService is an autogenerated mock type for the ProjectUpdateService type
+type MockProjectUpdateService struct {
+	mock.Mock
+}
+
+type MockProjectUpdateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateService) EXPECT() *MockProjectUpdateService_Expecter {
+	return &MockProjectUpdateService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateService
+func (_mock *MockProjectUpdateService) Exec(ctx context.Context, request *services.ProjectUpdateRequest) (*services.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectUpdateRequest) (*services.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectUpdateRequest) *services.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectUpdateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectUpdateRequest
+func (_e *MockProjectUpdateService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateService_Exec_Call {
+	return &MockProjectUpdateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectUpdateRequest)) *MockProjectUpdateService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectUpdateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectUpdateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateService_Exec_Call) Return(project *services.Project, err error) *MockProjectUpdateService_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectUpdateRequest) (*services.Project, error)) *MockProjectUpdateService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleService creates a new instance of MockProjectUpgradeModuleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleService {
+	mock := &MockProjectUpgradeModuleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleService is an autogenerated mock type for the ProjectUpgradeModuleService type
+type MockProjectUpgradeModuleService struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleService) EXPECT() *MockProjectUpgradeModuleService_Expecter {
+	return &MockProjectUpgradeModuleService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleService
+func (_mock *MockProjectUpgradeModuleService) Exec(ctx context.Context, request *services.ProjectUpgradeModuleRequest) (*services.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectUpgradeModuleRequest) (*services.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectUpgradeModuleRequest) *services.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectUpgradeModuleRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.ProjectUpgradeModuleRequest
+func (_e *MockProjectUpgradeModuleService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleService_Exec_Call {
+	return &MockProjectUpgradeModuleService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectUpgradeModuleRequest)) *MockProjectUpgradeModuleService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.ProjectUpgradeModuleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.ProjectUpgradeModuleRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleService_Exec_Call) Return(project *services.Project, err error) *MockProjectUpgradeModuleService_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectUpgradeModuleRequest) (*services.Project, error)) *MockProjectUpgradeModuleService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidateListService creates a new instance of MockSchemaCandidateListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidateListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidateListService {
+	mock := &MockSchemaCandidateListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidateListService is an autogenerated mock type for the SchemaCandidateListService type
+type MockSchemaCandidateListService struct {
+	mock.Mock
+}
+
+type MockSchemaCandidateListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidateListService) EXPECT() *MockSchemaCandidateListService_Expecter {
+	return &MockSchemaCandidateListService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidateListService
+func (_mock *MockSchemaCandidateListService) Exec(ctx context.Context, request *services.SchemaCandidateListRequest) ([]*services.SchemaCandidate, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*services.SchemaCandidate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCandidateListRequest) ([]*services.SchemaCandidate, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCandidateListRequest) []*services.SchemaCandidate); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*services.SchemaCandidate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaCandidateListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidateListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidateListService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.SchemaCandidateListRequest
+func (_e *MockSchemaCandidateListService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidateListService_Exec_Call {
+	return &MockSchemaCandidateListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidateListService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaCandidateListRequest)) *MockSchemaCandidateListService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.SchemaCandidateListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.SchemaCandidateListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidateListService_Exec_Call) Return(schemaCandidates []*services.SchemaCandidate, err error) *MockSchemaCandidateListService_Exec_Call {
+	_c.Call.Return(schemaCandidates, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidateListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaCandidateListRequest) ([]*services.SchemaCandidate, error)) *MockSchemaCandidateListService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidatePromoteService creates a new instance of MockSchemaCandidatePromoteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidatePromoteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidatePromoteService {
+	mock := &MockSchemaCandidatePromoteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidatePromoteService is an autogenerated mock type for the SchemaCandidatePromoteService type
+type MockSchemaCandidatePromoteService struct {
+	mock.Mock
+}
+
+type MockSchemaCandidatePromoteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidatePromoteService) EXPECT() *MockSchemaCandidatePromoteService_Expecter {
+	return &MockSchemaCandidatePromoteService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidatePromoteService
+func (_mock *MockSchemaCandidatePromoteService) Exec(ctx context.Context, request *services.SchemaCandidatePromoteRequest) (*services.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCandidatePromoteRequest) (*services.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCandidatePromoteRequest) *services.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaCandidatePromoteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidatePromoteService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidatePromoteService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.SchemaCandidatePromoteRequest
+func (_e *MockSchemaCandidatePromoteService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidatePromoteService_Exec_Call {
+	return &MockSchemaCandidatePromoteService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectDeleteService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectDeleteRequest)) *MockProjectDeleteService_Exec_Call {
+func (_c *MockSchemaCandidatePromoteService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaCandidatePromoteRequest)) *MockSchemaCandidatePromoteService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *services.ProjectDeleteRequest
+		var arg1 *services.SchemaCandidatePromoteRequest
 		if args[1] != nil {
-			arg1 = args[1].(*services.ProjectDeleteRequest)
+			arg1 = args[1].(*services.SchemaCandidatePromoteRequest)
 		}
 		run(
 			arg0,
@@ -398,23 +2773,23 @@ func (_c *MockProjectDeleteService_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockProjectDeleteService_Exec_Call) Return(project *services.Project, err error) *MockProjectDeleteService_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaCandidatePromoteService_Exec_Call) Return(schema *services.Schema, err error) *MockSchemaCandidatePromoteService_Exec_Call {
+	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockProjectDeleteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectDeleteRequest) (*services.Project, error)) *MockProjectDeleteService_Exec_Call {
+func (_c *MockSchemaCandidatePromoteService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaCandidatePromoteRequest) (*services.Schema, error)) *MockSchemaCandidatePromoteService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectInitService creates a new instance of MockProjectInitService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaCreateService creates a new instance of MockSchemaCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectInitService(t interface {
+func NewMockSchemaCreateService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectInitService {
-	mock := &MockProjectInitService{}
+}) *MockSchemaCreateService {
+	mock := &MockSchemaCreateService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -422,40 +2797,40 @@ func NewMockProjectInitService(t interface {
 	return mock
 }
 
-// MockProjectInitService is an autogenerated mock type for the ProjectInitService type
-type MockProjectInitService struct {
+// MockSchemaCreateService is an autogenerated mock type for the SchemaCreateService type
+type MockSchemaCreateService struct {
 	mock.Mock
 }
 
-type MockProjectInitService_Expecter struct {
+type MockSchemaCreateService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectInitService) EXPECT() *MockProjectInitService_Expecter {
-	return &MockProjectInitService_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaCreateService) EXPECT() *MockSchemaCreateService_Expecter {
+	return &MockSchemaCreateService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectInitService
-func (_mock *MockProjectInitService) Exec(ctx context.Context, request *services.ProjectInitRequest) (*services.Project, error) {
+// Exec provides a mock function for the type MockSchemaCreateService
+func (_mock *MockSchemaCreateService) Exec(ctx context.Context, request *services.SchemaCreateRequest) (*services.Schema, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *services.Project
+	var r0 *services.Schema
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectInitRequest) (*services.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCreateRequest) (*services.Schema, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectInitRequest) *services.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCreateRequest) *services.Schema); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*services.Project)
+			r0 = ret.Get(0).(*services.Schema)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectInitRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaCreateRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -463,27 +2838,27 @@ func (_mock *MockProjectInitService) Exec(ctx context.Context, request *services
 	return r0, r1
 }
 
-// MockProjectInitService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectInitService_Exec_Call struct {
+// MockSchemaCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCreateService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *services.ProjectInitRequest
-func (_e *MockProjectInitService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInitService_Exec_Call {
-	return &MockProjectInitService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.SchemaCreateRequest
+func (_e *MockSchemaCreateService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateService_Exec_Call {
+	return &MockSchemaCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectInitService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectInitRequest)) *MockProjectInitService_Exec_Call {
+func (_c *MockSchemaCreateService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaCreateRequest)) *MockSchemaCreateService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *services.ProjectInitRequest
+		var arg1 *services.SchemaCreateRequest
 		if args[1] != nil {
-			arg1 = args[1].(*services.ProjectInitRequest)
+			arg1 = args[1].(*services.SchemaCreateRequest)
 		}
 		run(
 			arg0,
@@ -493,23 +2868,23 @@ func (_c *MockProjectInitService_Exec_Call) Run(run func(ctx context.Context, re
 	return _c
 }
 
-func (_c *MockProjectInitService_Exec_Call) Return(project *services.Project, err error) *MockProjectInitService_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaCreateService_Exec_Call) Return(schema *services.Schema, err error) *MockSchemaCreateService_Exec_Call {
+	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockProjectInitService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectInitRequest) (*services.Project, error)) *MockProjectInitService_Exec_Call {
+func (_c *MockSchemaCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaCreateRequest) (*services.Schema, error)) *MockSchemaCreateService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectListService creates a new instance of MockProjectListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateService creates a new instance of MockSchemaGenerateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectListService(t interface {
+func NewMockSchemaGenerateService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectListService {
-	mock := &MockProjectListService{}
+}) *MockSchemaGenerateService {
+	mock := &MockSchemaGenerateService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -517,40 +2892,40 @@ func NewMockProjectListService(t interface {
 	return mock
 }
 
-// MockProjectListService is an autogenerated mock type for the ProjectListService type
-type MockProjectListService struct {
+// MockSchemaGenerateService is an autogenerated mock type for the SchemaGenerateService type
+type MockSchemaGenerateService struct {
 	mock.Mock
 }
 
-type MockProjectListService_Expecter struct {
+type MockSchemaGenerateService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectListService) EXPECT() *MockProjectListService_Expecter {
-	return &MockProjectListService_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateService) EXPECT() *MockSchemaGenerateService_Expecter {
+	return &MockSchemaGenerateService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectListService
-func (_mock *MockProjectListService) Exec(ctx context.Context, request *services.ProjectListRequest) ([]*services.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateService
+func (_mock *MockSchemaGenerateService) Exec(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*services.Project
+	var r0 *services.Schema
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectListRequest) ([]*services.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateRequest) (*services.Schema, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectListRequest) []*services.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateRequest) *services.Schema); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*services.Project)
+			r0 = ret.Get(0).(*services.Schema)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectListRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaGenerateRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -558,27 +2933,27 @@ func (_mock *MockProjectListService) Exec(ctx context.Context, request *services
 	return r0, r1
 }
 
-// MockProjectListService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectListService_Exec_Call struct {
+// MockSchemaGenerateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *services.ProjectListRequest
-func (_e *MockProjectListService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectListService_Exec_Call {
-	return &MockProjectListService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.SchemaGenerateRequest
+func (_e *MockSchemaGenerateService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateService_Exec_Call {
+	return &MockSchemaGenerateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectListService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectListRequest)) *MockProjectListService_Exec_Call {
+func (_c *MockSchemaGenerateService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaGenerateRequest)) *MockSchemaGenerateService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *services.ProjectListRequest
+		var arg1 *services.SchemaGenerateRequest
 		if args[1] != nil {
-			arg1 = args[1].(*services.ProjectListRequest)
+			arg1 = args[1].(*services.SchemaGenerateRequest)
 		}
 		run(
 			arg0,
@@ -588,23 +2963,23 @@ func (_c *MockProjectListService_Exec_Call) Run(run func(ctx context.Context, re
 	return _c
 }
 
-func (_c *MockProjectListService_Exec_Call) Return(projects []*services.Project, err error) *MockProjectListService_Exec_Call {
-	_c.Call.Return(projects, err)
+func (_c *MockSchemaGenerateService_Exec_Call) Return(schema *services.Schema, err error) *MockSchemaGenerateService_Exec_Call {
+	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockProjectListService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectListRequest) ([]*services.Project, error)) *MockProjectListService_Exec_Call {
+func (_c *MockSchemaGenerateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error)) *MockSchemaGenerateService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectUpdateService creates a new instance of MockProjectUpdateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateEnqueueService creates a new instance of MockSchemaGenerateEnqueueService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectUpdateService(t interface {
+func NewMockSchemaGenerateEnqueueService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectUpdateService {
-	mock := &MockProjectUpdateService{}
+}) *MockSchemaGenerateEnqueueService {
+	mock := &MockSchemaGenerateEnqueueService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -612,40 +2987,40 @@ func NewMockProjectUpdateService(t interface {
 	return mock
 }
 
-// MockProjectUpdateService is an autogenerated mock type for the ProjectUpdateService type
-type MockProjectUpdateService struct {
+// MockSchemaGenerateEnqueueService is an autogenerated mock type for the SchemaGenerateEnqueueService type
+type MockSchemaGenerateEnqueueService struct {
 	mock.Mock
 }
 
-type MockProjectUpdateService_Expecter struct {
+type MockSchemaGenerateEnqueueService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectUpdateService) EXPECT() *MockProjectUpdateService_Expecter {
-	return &MockProjectUpdateService_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateEnqueueService) EXPECT() *MockSchemaGenerateEnqueueService_Expecter {
+	return &MockSchemaGenerateEnqueueService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectUpdateService
-func (_mock *MockProjectUpdateService) Exec(ctx context.Context, request *services.ProjectUpdateRequest) (*services.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateEnqueueService
+func (_mock *MockSchemaGenerateEnqueueService) Exec(ctx context.Context, request *services.SchemaGenerateEnqueueRequest) (*services.GenerationJob, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *services.Project
+	var r0 *services.GenerationJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectUpdateRequest) (*services.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateEnqueueRequest) (*services.GenerationJob, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.ProjectUpdateRequest) *services.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateEnqueueRequest) *services.GenerationJob); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*services.Project)
+			r0 = ret.Get(0).(*services.GenerationJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.ProjectUpdateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaGenerateEnqueueRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -653,27 +3028,27 @@ func (_mock *MockProjectUpdateService) Exec(ctx context.Context, request *servic
 	return r0, r1
 }
 
-// MockProjectUpdateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectUpdateService_Exec_Call struct {
+// MockSchemaGenerateEnqueueService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateEnqueueService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *services.ProjectUpdateRequest
-func (_e *MockProjectUpdateService_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateService_Exec_Call {
-	return &MockProjectUpdateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.SchemaGenerateEnqueueRequest
+func (_e *MockSchemaGenerateEnqueueService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateEnqueueService_Exec_Call {
+	return &MockSchemaGenerateEnqueueService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectUpdateService_Exec_Call) Run(run func(ctx context.Context, request *services.ProjectUpdateRequest)) *MockProjectUpdateService_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaGenerateEnqueueRequest)) *MockSchemaGenerateEnqueueService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *services.ProjectUpdateRequest
+		var arg1 *services.SchemaGenerateEnqueueRequest
 		if args[1] != nil {
-			arg1 = args[1].(*services.ProjectUpdateRequest)
+			arg1 = args[1].(*services.SchemaGenerateEnqueueRequest)
 		}
 		run(
 			arg0,
@@ -683,23 +3058,23 @@ func (_c *MockProjectUpdateService_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockProjectUpdateService_Exec_Call) Return(project *services.Project, err error) *MockProjectUpdateService_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaGenerateEnqueueService_Exec_Call) Return(generationJob *services.GenerationJob, err error) *MockSchemaGenerateEnqueueService_Exec_Call {
+	_c.Call.Return(generationJob, err)
 	return _c
 }
 
-func (_c *MockProjectUpdateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.ProjectUpdateRequest) (*services.Project, error)) *MockProjectUpdateService_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaGenerateEnqueueRequest) (*services.GenerationJob, error)) *MockSchemaGenerateEnqueueService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaCreateService creates a new instance of MockSchemaCreateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateCandidatesService creates a new instance of MockSchemaGenerateCandidatesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaCreateService(t interface {
+func NewMockSchemaGenerateCandidatesService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaCreateService {
-	mock := &MockSchemaCreateService{}
+}) *MockSchemaGenerateCandidatesService {
+	mock := &MockSchemaGenerateCandidatesService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -707,40 +3082,40 @@ func NewMockSchemaCreateService(t interface {
 	return mock
 }
 
-// MockSchemaCreateService is an autogenerated mock type for the SchemaCreateService type
-type MockSchemaCreateService struct {
+// MockSchemaGenerateCandidatesService is an autogenerated mock type for the SchemaGenerateCandidatesService type
+type MockSchemaGenerateCandidatesService struct {
 	mock.Mock
 }
 
-type MockSchemaCreateService_Expecter struct {
+type MockSchemaGenerateCandidatesService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaCreateService) EXPECT() *MockSchemaCreateService_Expecter {
-	return &MockSchemaCreateService_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateCandidatesService) EXPECT() *MockSchemaGenerateCandidatesService_Expecter {
+	return &MockSchemaGenerateCandidatesService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaCreateService
-func (_mock *MockSchemaCreateService) Exec(ctx context.Context, request *services.SchemaCreateRequest) (*services.Schema, error) {
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesService
+func (_mock *MockSchemaGenerateCandidatesService) Exec(ctx context.Context, request *services.SchemaGenerateCandidatesRequest) ([]*services.SchemaCandidate, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *services.Schema
+	var r0 []*services.SchemaCandidate
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCreateRequest) (*services.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateCandidatesRequest) ([]*services.SchemaCandidate, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaCreateRequest) *services.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateCandidatesRequest) []*services.SchemaCandidate); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*services.Schema)
+			r0 = ret.Get(0).([]*services.SchemaCandidate)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaCreateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaGenerateCandidatesRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -748,27 +3123,27 @@ func (_mock *MockSchemaCreateService) Exec(ctx context.Context, request *service
 	return r0, r1
 }
 
-// MockSchemaCreateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaCreateService_Exec_Call struct {
+// MockSchemaGenerateCandidatesService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *services.SchemaCreateRequest
-func (_e *MockSchemaCreateService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateService_Exec_Call {
-	return &MockSchemaCreateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.SchemaGenerateCandidatesRequest
+func (_e *MockSchemaGenerateCandidatesService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesService_Exec_Call {
+	return &MockSchemaGenerateCandidatesService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaCreateService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaCreateRequest)) *MockSchemaCreateService_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaGenerateCandidatesRequest)) *MockSchemaGenerateCandidatesService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *services.SchemaCreateRequest
+		var arg1 *services.SchemaGenerateCandidatesRequest
 		if args[1] != nil {
-			arg1 = args[1].(*services.SchemaCreateRequest)
+			arg1 = args[1].(*services.SchemaGenerateCandidatesRequest)
 		}
 		run(
 			arg0,
@@ -778,23 +3153,23 @@ func (_c *MockSchemaCreateService_Exec_Call) Run(run func(ctx context.Context, r
 	return _c
 }
 
-func (_c *MockSchemaCreateService_Exec_Call) Return(schema *services.Schema, err error) *MockSchemaCreateService_Exec_Call {
-	_c.Call.Return(schema, err)
+func (_c *MockSchemaGenerateCandidatesService_Exec_Call) Return(schemaCandidates []*services.SchemaCandidate, err error) *MockSchemaGenerateCandidatesService_Exec_Call {
+	_c.Call.Return(schemaCandidates, err)
 	return _c
 }
 
-func (_c *MockSchemaCreateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaCreateRequest) (*services.Schema, error)) *MockSchemaCreateService_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaGenerateCandidatesRequest) ([]*services.SchemaCandidate, error)) *MockSchemaGenerateCandidatesService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaGenerateService creates a new instance of MockSchemaGenerateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateStreamService creates a new instance of MockSchemaGenerateStreamService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaGenerateService(t interface {
+func NewMockSchemaGenerateStreamService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaGenerateService {
-	mock := &MockSchemaGenerateService{}
+}) *MockSchemaGenerateStreamService {
+	mock := &MockSchemaGenerateStreamService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -802,21 +3177,21 @@ func NewMockSchemaGenerateService(t interface {
 	return mock
 }
 
-// MockSchemaGenerateService is an autogenerated mock type for the SchemaGenerateService type
-type MockSchemaGenerateService struct {
+// MockSchemaGenerateStreamService is an autogenerated mock type for the SchemaGenerateStreamService type
+type MockSchemaGenerateStreamService struct {
 	mock.Mock
 }
 
-type MockSchemaGenerateService_Expecter struct {
+type MockSchemaGenerateStreamService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaGenerateService) EXPECT() *MockSchemaGenerateService_Expecter {
-	return &MockSchemaGenerateService_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateStreamService) EXPECT() *MockSchemaGenerateStreamService_Expecter {
+	return &MockSchemaGenerateStreamService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaGenerateService
-func (_mock *MockSchemaGenerateService) Exec(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error) {
+// Exec provides a mock function for the type MockSchemaGenerateStreamService
+func (_mock *MockSchemaGenerateStreamService) Exec(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -843,19 +3218,19 @@ func (_mock *MockSchemaGenerateService) Exec(ctx context.Context, request *servi
 	return r0, r1
 }
 
-// MockSchemaGenerateService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaGenerateService_Exec_Call struct {
+// MockSchemaGenerateStreamService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateStreamService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
 //   - request *services.SchemaGenerateRequest
-func (_e *MockSchemaGenerateService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateService_Exec_Call {
-	return &MockSchemaGenerateService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+func (_e *MockSchemaGenerateStreamService_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateStreamService_Exec_Call {
+	return &MockSchemaGenerateStreamService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaGenerateService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaGenerateRequest)) *MockSchemaGenerateService_Exec_Call {
+func (_c *MockSchemaGenerateStreamService_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaGenerateRequest)) *MockSchemaGenerateStreamService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -873,12 +3248,12 @@ func (_c *MockSchemaGenerateService_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockSchemaGenerateService_Exec_Call) Return(schema *services.Schema, err error) *MockSchemaGenerateService_Exec_Call {
+func (_c *MockSchemaGenerateStreamService_Exec_Call) Return(schema *services.Schema, err error) *MockSchemaGenerateStreamService_Exec_Call {
 	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockSchemaGenerateService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error)) *MockSchemaGenerateService_Exec_Call {
+func (_c *MockSchemaGenerateStreamService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error)) *MockSchemaGenerateStreamService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1167,3 +3542,98 @@ func (_c *MockSchemaSelectService_Exec_Call) RunAndReturn(run func(ctx context.C
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockUsageSelectService creates a new instance of MockUsageSelectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUsageSelectService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUsageSelectService {
+	mock := &MockUsageSelectService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUsageSelectService is an autogenerated mock type for the UsageSelectService type
+type MockUsageSelectService struct {
+	mock.Mock
+}
+
+type MockUsageSelectService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUsageSelectService) EXPECT() *MockUsageSelectService_Expecter {
+	return &MockUsageSelectService_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockUsageSelectService
+func (_mock *MockUsageSelectService) Exec(ctx context.Context, request *services.UsageSelectRequest) (*services.Usage, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *services.Usage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.UsageSelectRequest) (*services.Usage, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.UsageSelectRequest) *services.Usage); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Usage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.UsageSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUsageSelectService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockUsageSelectService_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *services.UsageSelectRequest
+func (_e *MockUsageSelectService_Expecter) Exec(ctx interface{}, request interface{}) *MockUsageSelectService_Exec_Call {
+	return &MockUsageSelectService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockUsageSelectService_Exec_Call) Run(run func(ctx context.Context, request *services.UsageSelectRequest)) *MockUsageSelectService_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *services.UsageSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*services.UsageSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsageSelectService_Exec_Call) Return(usage *services.Usage, err error) *MockUsageSelectService_Exec_Call {
+	_c.Call.Return(usage, err)
+	return _c
+}
+
+func (_c *MockUsageSelectService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.UsageSelectRequest) (*services.Usage, error)) *MockUsageSelectService_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,33 +5,99 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/openai/openai-go/v3"
+	"github.com/google/jsonschema-go/jsonschema"
 
 	"github.com/a-novel/service-narrative-engine/internal/config"
 	"github.com/a-novel/service-narrative-engine/internal/config/env"
 	"github.com/a-novel/service-narrative-engine/internal/config/prompts"
 )
 
-var ErrUnknownChatCompletionLang = errors.New("unknown chat completion language")
+var (
+	ErrUnknownChatCompletionLang = errors.New("unknown chat completion language")
+	ErrEmptyChatCompletion       = errors.New("empty chat completion")
+	ErrUnknownCompletionProvider = errors.New("unknown completion provider")
+)
+
+// Supported completion providers.
+const (
+	// CompletionProviderOpenAI generates completions using the OpenAI api.
+	CompletionProviderOpenAI = "openai"
+	// CompletionProviderFake generates deterministic completions locally, from the expected output schema. It is
+	// meant for tests and local development, and does not require network access.
+	CompletionProviderFake = "fake"
+)
+
+type CompletionRole string
+
+const (
+	CompletionRoleSystem    CompletionRole = "system"
+	CompletionRoleUser      CompletionRole = "user"
+	CompletionRoleAssistant CompletionRole = "assistant"
+)
+
+type CompletionMessage struct {
+	Role    CompletionRole
+	Content string
+}
+
+type CompletionRequest struct {
+	// Model overrides the default model of the provider.
+	Model    string
+	Messages []CompletionMessage
+
+	// SchemaName and SchemaDescription describe the expected output to the model.
+	SchemaName        string
+	SchemaDescription string
+	// Schema is the JSON Schema the output must conform to. It must be limited to the subset supported by
+	// structured outputs (see JSONSchemaLLM).
+	Schema *jsonschema.Schema
 
-func NewCompletion(
-	ctx context.Context,
-	lang string,
-	params openai.ChatCompletionNewParams,
-) (*openai.ChatCompletion, error) {
-	if params.Model == "" {
-		params.Model = env.OpenAiModel
+	Temperature *float64
+	MaxTokens   *int64
+}
+
+type CompletionUsage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+type Completion struct {
+	// Content is the raw JSON output of the model.
+	Content string
+	// Model that generated the completion.
+	Model string
+	Usage CompletionUsage
+}
+
+// CompletionProvider generates structured outputs using a language model.
+type CompletionProvider interface {
+	Complete(ctx context.Context, request *CompletionRequest) (*Completion, error)
+	// CompleteStream works like Complete, but streams the completion from the model. Each chunk of content is
+	// passed to onDelta as soon as it is received, and the full completion is returned once the stream ends.
+	//
+	// The stream is interrupted as soon as the context is canceled. When the stream fails after the model started
+	// generating, a completion holding the usage spent so far is returned along with the error, so it can be billed.
+	CompleteStream(ctx context.Context, request *CompletionRequest, onDelta func(delta string)) (*Completion, error)
+}
+
+// NewCompletionProvider returns the completion provider with the given name.
+func NewCompletionProvider(name string) (CompletionProvider, error) {
+	switch name {
+	case CompletionProviderOpenAI:
+		return NewOpenAIProvider(config.OpenAiClient, env.OpenAiModel), nil
+	case CompletionProviderFake:
+		return NewFakeProvider(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCompletionProvider, name)
 	}
+}
 
+// CompletionSystemMessage returns the system message that sets the language of completions.
+func CompletionSystemMessage(lang string) (CompletionMessage, error) {
 	systemPrompt, ok := prompts.System[lang]
 	if !ok {
-		return nil, fmt.Errorf("%w: %s", ErrUnknownChatCompletionLang, lang)
+		return CompletionMessage{}, fmt.Errorf("%w: %s", ErrUnknownChatCompletionLang, lang)
 	}
 
-	params.Messages = append(
-		[]openai.ChatCompletionMessageParamUnion{openai.SystemMessage(systemPrompt)},
-		params.Messages...,
-	)
-
-	return config.OpenAiClient.Chat.Completions.New(ctx, params)
+	return CompletionMessage{Role: CompletionRoleSystem, Content: systemPrompt}, nil
 }
@@ -0,0 +1,87 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+)
+
+// fakeProviderChunkSize is the number of bytes sent per delta, when streaming fake completions.
+const fakeProviderChunkSize = 16
+
+// fakeProviderBytesPerToken is used to estimate token usage.
+const fakeProviderBytesPerToken = 4
+
+// FakeProvider generates completions locally, without calling any model. The output is built from the schema of
+// the request using JSONSchemaExample, so the same request always produces the same completion.
+type FakeProvider struct{}
+
+func NewFakeProvider() *FakeProvider {
+	return new(FakeProvider)
+}
+
+func (provider *FakeProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(JSONSchemaExample(request.Schema))
+	if err != nil {
+		return nil, fmt.Errorf("marshal example: %w", err)
+	}
+
+	var promptSize int
+	for _, message := range request.Messages {
+		promptSize += len(message.Content)
+	}
+
+	return &Completion{
+		Content: string(content),
+		Model:   CompletionProviderFake,
+		// Rough estimate, so usage accounting can be tested.
+		Usage: CompletionUsage{
+			PromptTokens:     int64(promptSize / fakeProviderBytesPerToken),
+			CompletionTokens: int64(len(content) / fakeProviderBytesPerToken),
+		},
+	}, nil
+}
+
+func (provider *FakeProvider) CompleteStream(
+	ctx context.Context, request *CompletionRequest, onDelta func(delta string),
+) (*Completion, error) {
+	completion, err := provider.Complete(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	content := completion.Content
+
+	for len(content) > 0 {
+		err = ctx.Err()
+		if err != nil {
+			// Only bill the part of the completion that was streamed.
+			streamed := len(completion.Content) - len(content)
+
+			return &Completion{
+				Model: completion.Model,
+				Usage: CompletionUsage{
+					PromptTokens:     completion.Usage.PromptTokens,
+					CompletionTokens: int64(streamed / fakeProviderBytesPerToken),
+				},
+			}, err
+		}
+
+		// Never split a character across chunks.
+		end := min(fakeProviderChunkSize, len(content))
+		for end < len(content) && !utf8.RuneStart(content[end]) {
+			end++
+		}
+
+		onDelta(content[:end])
+		content = content[end:]
+	}
+
+	return completion, nil
+}
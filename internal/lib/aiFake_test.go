@@ -0,0 +1,91 @@
+package lib_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestFakeProvider(t *testing.T) {
+	t.Parallel()
+
+	request := &lib.CompletionRequest{
+		Messages: []lib.CompletionMessage{
+			{Role: lib.CompletionRoleUser, Content: "Write a story about a lighthouse keeper."},
+		},
+		SchemaName: "story",
+		Schema: &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"title":   {Type: "string"},
+				"summary": {Type: "string", MinLength: lo.ToPtr(64)},
+				"chapters": {
+					Type:    "integer",
+					Minimum: lo.ToPtr(1.0),
+					Maximum: lo.ToPtr(12.0),
+				},
+			},
+			Required: []string{"title", "summary", "chapters"},
+		},
+	}
+
+	provider := lib.NewFakeProvider()
+
+	t.Run("Complete", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := provider.Complete(t.Context(), request)
+		require.NoError(t, err)
+
+		require.JSONEq(
+			t,
+			`{"title":"Example title","summary":"Example summary`+strings.Repeat(".", 49)+`","chapters":1}`,
+			res.Content,
+		)
+		require.Equal(t, lib.CompletionProviderFake, res.Model)
+		require.Positive(t, res.Usage.PromptTokens)
+		require.Positive(t, res.Usage.CompletionTokens)
+
+		// The output is deterministic.
+		again, err := provider.Complete(t.Context(), request)
+		require.NoError(t, err)
+		require.Equal(t, res, again)
+	})
+
+	t.Run("CompleteStream", func(t *testing.T) {
+		t.Parallel()
+
+		var deltas []string
+
+		res, err := provider.CompleteStream(t.Context(), request, func(delta string) {
+			deltas = append(deltas, delta)
+		})
+		require.NoError(t, err)
+
+		require.Greater(t, len(deltas), 1)
+		require.Equal(t, res.Content, strings.Join(deltas, ""))
+	})
+
+	t.Run("CompleteStream/Canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(t.Context())
+
+		res, err := provider.CompleteStream(ctx, request, func(_ string) {
+			cancel()
+		})
+		require.ErrorIs(t, err, context.Canceled)
+
+		// The usage spent before the cancellation is returned along with the error.
+		require.NotNil(t, res)
+		require.Empty(t, res.Content)
+		require.Positive(t, res.Usage.PromptTokens)
+		require.Positive(t, res.Usage.CompletionTokens)
+	})
+}
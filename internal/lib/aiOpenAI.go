@@ -0,0 +1,152 @@
+package lib
+
+import (
+	"context"
+
+	"github.com/openai/openai-go/v3"
+)
+
+// OpenAIProvider generates completions using the OpenAI api, or any api compatible with it.
+type OpenAIProvider struct {
+	client openai.Client
+	model  string
+}
+
+// NewOpenAIProvider creates a provider that uses the given model by default.
+func NewOpenAIProvider(client openai.Client, model string) *OpenAIProvider {
+	return &OpenAIProvider{client: client, model: model}
+}
+
+func (provider *OpenAIProvider) Complete(ctx context.Context, request *CompletionRequest) (*Completion, error) {
+	res, err := provider.client.Chat.Completions.New(ctx, provider.params(request))
+	if err != nil {
+		return nil, err
+	}
+
+	return loadOpenAICompletion(res)
+}
+
+func (provider *OpenAIProvider) CompleteStream(
+	ctx context.Context, request *CompletionRequest, onDelta func(delta string),
+) (*Completion, error) {
+	params := provider.params(request)
+	params.StreamOptions = openai.ChatCompletionStreamOptionsParam{IncludeUsage: openai.Bool(true)}
+
+	stream := provider.client.Chat.Completions.NewStreaming(ctx, params)
+	defer stream.Close()
+
+	accumulator := new(openai.ChatCompletionAccumulator)
+
+	var deltas int64
+
+	for stream.Next() {
+		chunk := stream.Current()
+		accumulator.AddChunk(chunk)
+
+		if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+			deltas++
+
+			onDelta(chunk.Choices[0].Delta.Content)
+		}
+	}
+
+	// Report cancellation first, as the stream may end with a transport error, or no error at all, in this case.
+	err := ctx.Err()
+	if err == nil {
+		err = stream.Err()
+	}
+
+	if err != nil {
+		return loadOpenAIPartialCompletion(&accumulator.ChatCompletion, request, deltas), err
+	}
+
+	return loadOpenAICompletion(&accumulator.ChatCompletion)
+}
+
+func (provider *OpenAIProvider) params(request *CompletionRequest) openai.ChatCompletionNewParams {
+	messages := make([]openai.ChatCompletionMessageParamUnion, len(request.Messages))
+
+	for i, message := range request.Messages {
+		switch message.Role {
+		case CompletionRoleSystem:
+			messages[i] = openai.SystemMessage(message.Content)
+		case CompletionRoleAssistant:
+			messages[i] = openai.AssistantMessage(message.Content)
+		default:
+			messages[i] = openai.UserMessage(message.Content)
+		}
+	}
+
+	params := openai.ChatCompletionNewParams{
+		Model:    request.Model,
+		Messages: messages,
+		ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+				JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:        request.SchemaName,
+					Schema:      request.Schema,
+					Description: openai.String(request.SchemaDescription),
+					Strict:      openai.Bool(true),
+				},
+			},
+		},
+	}
+
+	if params.Model == "" {
+		params.Model = provider.model
+	}
+
+	if request.Temperature != nil {
+		params.Temperature = openai.Float(*request.Temperature)
+	}
+
+	if request.MaxTokens != nil {
+		params.MaxCompletionTokens = openai.Int(*request.MaxTokens)
+	}
+
+	return params
+}
+
+// openAIBytesPerToken is a rough average, used to estimate the prompt tokens of interrupted streams.
+const openAIBytesPerToken = 4
+
+// loadOpenAIPartialCompletion returns the usage of an interrupted stream. The usage is only reported in the last
+// chunk of the stream: when it was not received, it is estimated from the prompt size and the number of content
+// chunks, as each of them holds about one token.
+func loadOpenAIPartialCompletion(res *openai.ChatCompletion, request *CompletionRequest, deltas int64) *Completion {
+	if deltas == 0 && res.Usage.TotalTokens == 0 {
+		return nil
+	}
+
+	usage := CompletionUsage{
+		PromptTokens:     res.Usage.PromptTokens,
+		CompletionTokens: res.Usage.CompletionTokens,
+	}
+
+	if res.Usage.TotalTokens == 0 {
+		var promptSize int
+		for _, message := range request.Messages {
+			promptSize += len(message.Content)
+		}
+
+		usage.PromptTokens = int64(promptSize / openAIBytesPerToken)
+		usage.CompletionTokens = deltas
+	}
+
+	return &Completion{Model: res.Model, Usage: usage}
+}
+
+func loadOpenAICompletion(res *openai.ChatCompletion) (*Completion, error) {
+	if len(res.Choices) == 0 {
+		return nil, ErrEmptyChatCompletion
+	}
+
+	return &Completion{
+		Content: res.Choices[0].Message.Content,
+		Model:   res.Model,
+		Usage: CompletionUsage{
+			PromptTokens:     res.Usage.PromptTokens,
+			CompletionTokens: res.Usage.CompletionTokens,
+		},
+	}, nil
+}
@@ -1,6 +1,11 @@
 package lib
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/samber/lo"
 )
@@ -30,32 +35,111 @@ var supportedOpenAIFormats = []string{
 	"uuid",
 }
 
+// JSONSchemaLLMChangeKind tells how JSONSchemaLLM altered a part of a schema.
+type JSONSchemaLLMChangeKind string
+
+const (
+	// JSONSchemaLLMChangeDropped parts of the schema are not sent to the model, which never generates values
+	// for them.
+	JSONSchemaLLMChangeDropped JSONSchemaLLMChangeKind = "dropped"
+	// JSONSchemaLLMChangeRewritten parts of the schema are sent to the model in a different form, or without some
+	// of their keywords.
+	JSONSchemaLLMChangeRewritten JSONSchemaLLMChangeKind = "rewritten"
+)
+
+// JSONSchemaLLMChange describes a single alteration made by JSONSchemaLLM.
+type JSONSchemaLLMChange struct {
+	// Path is the JSON Pointer (RFC 6901) to the values affected by the change. Array items are denoted by
+	// the "*" token.
+	Path    string                  `json:"path"`
+	Kind    JSONSchemaLLMChangeKind `json:"kind"`
+	Message string                  `json:"message"`
+}
+
+// JSONSchemaLLMReport lists the alterations made by JSONSchemaLLM to fit a schema to the subset supported by the
+// model.
+type JSONSchemaLLMReport []*JSONSchemaLLMChange
+
+// Dropped returns the changes of the report that removed parts of the schema.
+func (report JSONSchemaLLMReport) Dropped() JSONSchemaLLMReport {
+	return lo.Filter(report, func(item *JSONSchemaLLMChange, _ int) bool {
+		return item.Kind == JSONSchemaLLMChangeDropped
+	})
+}
+
 // JSONSchemaLLM strips a JSON Schema definition to fit OpenAI supported subset:
 // https://platform.openai.com/docs/guides/structured-outputs
-func JSONSchemaLLM(src *jsonschema.Schema) bool {
+//
+// Every alteration made to the schema is listed in the returned report. The boolean is false when the schema
+// becomes unusable as a whole, in which case the content of src is undefined.
+func JSONSchemaLLM(src *jsonschema.Schema) (JSONSchemaLLMReport, bool) {
+	converter := new(jsonSchemaLLMConverter)
+	ok := converter.convert(src, "")
+
+	return converter.report, ok
+}
+
+type jsonSchemaLLMConverter struct {
+	report JSONSchemaLLMReport
+}
+
+func (converter *jsonSchemaLLMConverter) add(kind JSONSchemaLLMChangeKind, path, message string, args ...any) {
+	converter.report = append(converter.report, &JSONSchemaLLMChange{
+		Path:    path,
+		Kind:    kind,
+		Message: fmt.Sprintf(message, args...),
+	})
+}
+
+func (converter *jsonSchemaLLMConverter) convert(src *jsonschema.Schema, path string) bool {
 	if src == nil {
+		converter.add(JSONSchemaLLMChangeDropped, path, "schema is empty")
+
 		return false
 	}
 
 	// AnyOf is a special case.
 	if src.AnyOf != nil {
-		*src = jsonschema.Schema{AnyOf: lo.Filter(src.AnyOf, func(item *jsonschema.Schema, _ int) bool {
-			return JSONSchemaLLM(item)
-		})}
+		var anyOf []*jsonschema.Schema
+
+		for i, item := range src.AnyOf {
+			if !converter.convert(item, path) {
+				converter.add(JSONSchemaLLMChangeDropped, path, "anyOf branch %d is not supported", i)
+
+				continue
+			}
+
+			anyOf = append(anyOf, item)
+		}
+
+		*src = jsonschema.Schema{AnyOf: anyOf}
 
 		return len(src.AnyOf) > 0
 	}
 
+	// Check if the current schema contains unsupported properties that cannot be ignored.
+	unsupportedKeywords := jsonSchemaLLMUnsupportedKeywords(src)
+	if len(unsupportedKeywords) > 0 {
+		converter.add(JSONSchemaLLMChangeDropped, path, "unsupported %s", strings.Join(unsupportedKeywords, ", "))
+
+		return false
+	}
+
 	// Only keep types supported by OpenAI.
-	types := append([]string{}, src.Types...)
-	types = append(types, src.Type)
-	types = lo.Filter(types, func(item string, _ int) bool {
+	allTypes := lo.Compact(append(slices.Clone(src.Types), src.Type))
+	types := lo.Filter(allTypes, func(item string, _ int) bool {
 		return lo.Contains(supportedOpenAITypes, item)
 	})
 
 	switch len(types) {
 	case 0:
 		// Ignore values whose type is not supported by OpenAI.
+		if len(allTypes) == 0 {
+			converter.add(JSONSchemaLLMChangeDropped, path, "schema has no type")
+		} else {
+			converter.add(JSONSchemaLLMChangeDropped, path, "type %s is not supported", strings.Join(allTypes, ", "))
+		}
+
 		return false
 	case 1:
 		src.Type = types[0]
@@ -65,20 +149,8 @@ func JSONSchemaLLM(src *jsonschema.Schema) bool {
 		src.Types = types
 	}
 
-	// Check if the current schema contains unsupported properties that cannot be ignored.
-	isSupported := (src.Format == "" || lo.Contains(supportedOpenAIFormats, src.Format)) &&
-		src.Const == nil &&
-		src.Contains == nil &&
-		!src.UniqueItems &&
-		!src.Deprecated &&
-		!src.ReadOnly &&
-		src.PatternProperties == nil &&
-		src.AdditionalItems == nil &&
-		src.AllOf == nil &&
-		src.OneOf == nil &&
-		src.PrefixItems == nil
-	if !isSupported {
-		return false
+	if unsupported, _ := lo.Difference(allTypes, types); len(unsupported) > 0 {
+		converter.add(JSONSchemaLLMChangeRewritten, path, "type %s is not supported", strings.Join(unsupported, ", "))
 	}
 
 	isObject := lo.Contains(types, "object")
@@ -86,49 +158,28 @@ func JSONSchemaLLM(src *jsonschema.Schema) bool {
 
 	// Filter supported object properties.
 	if isObject {
-		src.Properties = lo.MapValues(src.Properties, func(value *jsonschema.Schema, key string) *jsonschema.Schema {
-			if !JSONSchemaLLM(value) {
-				return nil
-			}
-
-			return value
-		})
-
-		// Map all properties and make them required. Properties that were not initially required should be converted
-		// to have a union type with null.
-		for key, prop := range src.Properties {
-			if prop == nil {
-				continue
-			}
-
-			if !lo.Contains(src.Required, key) {
-				if prop.Type != "" {
-					prop.Types = []string{prop.Type, "null"}
-					prop.Type = ""
-
-					continue
-				}
-
-				if !lo.Contains(prop.Types, "null") {
-					prop.Types = append(prop.Types, "null")
-				}
-			}
-		}
-
-		src.Required = lo.Keys(src.Properties)
+		converter.convertProperties(src, path)
 	}
 
 	// Filter supported array items.
 	if isArray {
 		// Only one of Items or ItemsArray should be set.
 		if src.Items != nil {
-			if !JSONSchemaLLM(src.Items) {
+			if !converter.convert(src.Items, path+"/*") {
+				converter.add(JSONSchemaLLMChangeDropped, path, "array items are not supported")
+
 				return false
 			}
 		} else if len(src.ItemsArray) > 0 {
-			src.ItemsArray = lo.Filter(src.ItemsArray, func(item *jsonschema.Schema, _ int) bool {
-				return JSONSchemaLLM(item)
-			})
+			var itemsArray []*jsonschema.Schema
+
+			for i, item := range src.ItemsArray {
+				if converter.convert(item, path+"/"+strconv.Itoa(i)) {
+					itemsArray = append(itemsArray, item)
+				}
+			}
+
+			src.ItemsArray = itemsArray
 		}
 	}
 
@@ -137,17 +188,27 @@ func JSONSchemaLLM(src *jsonschema.Schema) bool {
 	// - Arrays must have items defined
 	// Simple types (string, number, integer, boolean, null) are valid without additional content.
 	if isObject && len(src.Properties) == 0 {
+		converter.add(JSONSchemaLLMChangeDropped, path, "object has no supported properties")
+
 		return false
 	}
 
 	if isArray && len(src.ItemsArray) == 0 && src.Items == nil {
+		converter.add(JSONSchemaLLMChangeDropped, path, "array has no supported items")
+
 		return false
 	}
 
+	if ignoredKeywords := jsonSchemaLLMIgnoredKeywords(src, isObject); len(ignoredKeywords) > 0 {
+		converter.add(JSONSchemaLLMChangeRewritten, path, "ignored %s", strings.Join(ignoredKeywords, ", "))
+	}
+
 	*src = jsonschema.Schema{
 		Type:  src.Type,
 		Types: src.Types,
 
+		Enum: src.Enum,
+
 		MinLength: src.MinLength,
 		MaxLength: src.MaxLength,
 		Pattern:   src.Pattern,
@@ -176,3 +237,172 @@ func JSONSchemaLLM(src *jsonschema.Schema) bool {
 
 	return true
 }
+
+func (converter *jsonSchemaLLMConverter) convertProperties(src *jsonschema.Schema, path string) {
+	// Sort keys, so the report is deterministic.
+	keys := lo.Keys(src.Properties)
+	slices.Sort(keys)
+
+	properties := make(map[string]*jsonschema.Schema, len(src.Properties))
+
+	for _, key := range keys {
+		propertyPath := path + "/" + escapeJSONPointer(key)
+
+		prop := src.Properties[key]
+		if !converter.convert(prop, propertyPath) {
+			continue
+		}
+
+		properties[key] = prop
+
+		// Map all properties and make them required. Properties that were not initially required should be
+		// converted to have a union type with null. This is not reported, as a null value is equivalent to a
+		// missing one.
+		if lo.Contains(src.Required, key) {
+			continue
+		}
+
+		if prop.AnyOf != nil {
+			if !lo.ContainsBy(prop.AnyOf, func(item *jsonschema.Schema) bool { return item.Type == "null" }) {
+				prop.AnyOf = append(prop.AnyOf, &jsonschema.Schema{Type: "null"})
+			}
+
+			continue
+		}
+
+		// A null value must also be allowed by the enum, if any.
+		if prop.Enum != nil && !lo.Contains(prop.Enum, nil) {
+			prop.Enum = append(prop.Enum, nil)
+		}
+
+		if prop.Type != "" {
+			prop.Types = []string{prop.Type, "null"}
+			prop.Type = ""
+
+			continue
+		}
+
+		if !lo.Contains(prop.Types, "null") {
+			prop.Types = append(prop.Types, "null")
+		}
+	}
+
+	src.Properties = properties
+
+	src.Required = lo.Keys(src.Properties)
+	slices.Sort(src.Required)
+}
+
+// StripJSONSchemaLLMNulls reverts, on the output of the model, a change made by JSONSchemaLLM: properties that are
+// optional in the source schema become required, and are generated as null when the model has no value for them.
+// Those nulls are removed, so the properties are missing instead, as expected by the source schema.
+//
+// Only nulls of properties declared, but not required, by the source schema are removed. Values under anyOf are left
+// as is, as the branch they were generated for is ambiguous. The source data is left untouched.
+func StripJSONSchemaLLMNulls(schema *jsonschema.Schema, data map[string]any) map[string]any {
+	output, _ := stripJSONSchemaLLMNulls(schema, data).(map[string]any)
+
+	return output
+}
+
+func stripJSONSchemaLLMNulls(schema *jsonschema.Schema, value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		output := make(map[string]any, len(typed))
+
+		for key, item := range typed {
+			var propertySchema *jsonschema.Schema
+			if schema != nil {
+				propertySchema = schema.Properties[key]
+			}
+
+			if item == nil && propertySchema != nil && !lo.Contains(schema.Required, key) {
+				continue
+			}
+
+			output[key] = stripJSONSchemaLLMNulls(propertySchema, item)
+		}
+
+		return output
+	case []any:
+		output := make([]any, len(typed))
+
+		for i, item := range typed {
+			var itemSchema *jsonschema.Schema
+
+			switch {
+			case schema == nil:
+			case i < len(schema.ItemsArray):
+				itemSchema = schema.ItemsArray[i]
+			case schema.Items != nil:
+				itemSchema = schema.Items
+			}
+
+			output[i] = stripJSONSchemaLLMNulls(itemSchema, item)
+		}
+
+		return output
+	}
+
+	return value
+}
+
+// jsonSchemaLLMUnsupportedKeywords lists the keywords of a schema that prevent it from being sent to the model.
+func jsonSchemaLLMUnsupportedKeywords(src *jsonschema.Schema) []string {
+	var keywords []string
+
+	if src.Format != "" && !lo.Contains(supportedOpenAIFormats, src.Format) {
+		keywords = append(keywords, fmt.Sprintf("format %q", src.Format))
+	}
+
+	for keyword, present := range map[string]bool{
+		"const":             src.Const != nil,
+		"contains":          src.Contains != nil,
+		"uniqueItems":       src.UniqueItems,
+		"deprecated":        src.Deprecated,
+		"readOnly":          src.ReadOnly,
+		"patternProperties": src.PatternProperties != nil,
+		"additionalItems":   src.AdditionalItems != nil,
+		"allOf":             src.AllOf != nil,
+		"oneOf":             src.OneOf != nil,
+		"prefixItems":       src.PrefixItems != nil,
+	} {
+		if present {
+			keywords = append(keywords, keyword)
+		}
+	}
+
+	slices.Sort(keywords)
+
+	return keywords
+}
+
+// jsonSchemaLLMIgnoredKeywords lists the keywords of a supported schema that are not sent to the model.
+func jsonSchemaLLMIgnoredKeywords(src *jsonschema.Schema, isObject bool) []string {
+	var keywords []string
+
+	for keyword, present := range map[string]bool{
+		"title":                 src.Title != "",
+		"description":           src.Description != "",
+		"default":               len(src.Default) > 0,
+		"not":                   src.Not != nil,
+		"if":                    src.If != nil,
+		"minProperties":         src.MinProperties != nil,
+		"maxProperties":         src.MaxProperties != nil,
+		"dependentRequired":     src.DependentRequired != nil,
+		"dependentSchemas":      src.DependentSchemas != nil,
+		"propertyNames":         src.PropertyNames != nil,
+		"unevaluatedItems":      src.UnevaluatedItems != nil,
+		"unevaluatedProperties": src.UnevaluatedProperties != nil,
+		// Objects never accept additional properties.
+		"additionalProperties": isObject && src.AdditionalProperties != nil && !isFalseSchema(src.AdditionalProperties),
+	} {
+		if present {
+			keywords = append(keywords, keyword)
+		}
+	}
+
+	slices.Sort(keywords)
+
+	return keywords
+}
@@ -0,0 +1,354 @@
+package lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+)
+
+// JSONSchemaChangeKind tells whether data that was valid under a schema remains valid after the schema changed.
+type JSONSchemaChangeKind string
+
+const (
+	// JSONSchemaChangeCompatible changes accept every value the previous schema accepted.
+	JSONSchemaChangeCompatible JSONSchemaChangeKind = "compatible"
+	// JSONSchemaChangeBreaking changes may reject values the previous schema accepted.
+	JSONSchemaChangeBreaking JSONSchemaChangeKind = "breaking"
+)
+
+// JSONSchemaChange describes a single difference between 2 versions of a JSON Schema.
+type JSONSchemaChange struct {
+	// Path is the JSON Pointer (RFC 6901) to the values affected by the change. Array items are denoted by
+	// the "*" token.
+	Path    string               `json:"path"`
+	Kind    JSONSchemaChangeKind `json:"kind"`
+	Message string               `json:"message"`
+}
+
+// JSONSchemaCompatibilityReport lists the differences between 2 versions of a JSON Schema.
+//
+// The report implements error, so it can be returned when breaking changes are not allowed. The error message only
+// lists the breaking changes.
+type JSONSchemaCompatibilityReport []*JSONSchemaChange
+
+// Breaking returns the breaking changes of the report.
+func (report JSONSchemaCompatibilityReport) Breaking() JSONSchemaCompatibilityReport {
+	return lo.Filter(report, func(item *JSONSchemaChange, _ int) bool {
+		return item.Kind == JSONSchemaChangeBreaking
+	})
+}
+
+// HasBreakingChanges returns true if at least one change of the report is breaking.
+func (report JSONSchemaCompatibilityReport) HasBreakingChanges() bool {
+	return len(report.Breaking()) > 0
+}
+
+func (report JSONSchemaCompatibilityReport) Error() string {
+	messages := lo.Map(report.Breaking(), func(item *JSONSchemaChange, _ int) string {
+		return fmt.Sprintf("%s: %s", lo.Ternary(item.Path == "", "/", item.Path), item.Message)
+	})
+
+	return "breaking schema changes: " + strings.Join(messages, "; ")
+}
+
+// CompareJSONSchemas lists the changes between 2 versions of a JSON Schema, and classifies them as compatible or
+// breaking for the data written under the previous version.
+//
+// A change is compatible when it widens the set of accepted values (new optional property, extra enum value, looser
+// bound...), and breaking when it may reject values that were accepted before (removed required property, narrowed
+// type, new constraint...). Changes the analyzer cannot reason about, such as edited combinators (allOf, anyOf,
+// oneOf, not), are reported as breaking.
+func CompareJSONSchemas(previous, next *jsonschema.Schema) JSONSchemaCompatibilityReport {
+	comparer := &jsonSchemaComparer{
+		previousRoot: previous,
+		nextRoot:     next,
+		visited:      map[[2]*jsonschema.Schema]bool{},
+	}
+	comparer.compare(previous, next, "")
+
+	return comparer.report
+}
+
+type jsonSchemaComparer struct {
+	previousRoot *jsonschema.Schema
+	nextRoot     *jsonschema.Schema
+	// visited prevents infinite loops on recursive references.
+	visited map[[2]*jsonschema.Schema]bool
+	report  JSONSchemaCompatibilityReport
+}
+
+func (comparer *jsonSchemaComparer) add(kind JSONSchemaChangeKind, path, message string, args ...any) {
+	comparer.report = append(comparer.report, &JSONSchemaChange{
+		Path:    path,
+		Kind:    kind,
+		Message: fmt.Sprintf(message, args...),
+	})
+}
+
+func (comparer *jsonSchemaComparer) compare(previous, next *jsonschema.Schema, path string) {
+	previous = resolveLocalRef(comparer.previousRoot, previous)
+	next = resolveLocalRef(comparer.nextRoot, next)
+
+	// A missing schema accepts any value.
+	if previous == nil {
+		previous = &jsonschema.Schema{}
+	}
+
+	if next == nil {
+		next = &jsonschema.Schema{}
+	}
+
+	if comparer.visited[[2]*jsonschema.Schema{previous, next}] {
+		return
+	}
+
+	comparer.visited[[2]*jsonschema.Schema{previous, next}] = true
+
+	if isFalseSchema(next) || isFalseSchema(previous) {
+		switch {
+		case isFalseSchema(next) && !isFalseSchema(previous):
+			comparer.add(JSONSchemaChangeBreaking, path, "value is no longer allowed")
+		case isFalseSchema(previous) && !isFalseSchema(next):
+			comparer.add(JSONSchemaChangeCompatible, path, "value is now allowed")
+		}
+
+		return
+	}
+
+	comparer.compareTypes(previous, next, path)
+	comparer.compareValues(previous, next, path)
+	comparer.compareConstraints(previous, next, path)
+	comparer.compareCombinators(previous, next, path)
+	comparer.compareObjects(previous, next, path)
+
+	if previous.Items != nil || next.Items != nil {
+		comparer.compare(previous.Items, next.Items, path+"/*")
+	}
+}
+
+func (comparer *jsonSchemaComparer) compareTypes(previous, next *jsonschema.Schema, path string) {
+	previousTypes, nextTypes := schemaTypes(previous), schemaTypes(next)
+
+	switch {
+	case len(previousTypes) == 0 && len(nextTypes) > 0:
+		comparer.add(JSONSchemaChangeBreaking, path, "type restricted to %s", strings.Join(nextTypes, ", "))
+
+		return
+	case len(previousTypes) > 0 && len(nextTypes) == 0:
+		comparer.add(JSONSchemaChangeCompatible, path, "type restriction removed")
+
+		return
+	}
+
+	for _, previousType := range previousTypes {
+		// Integers remain valid when the type is widened to any number.
+		if !slices.Contains(nextTypes, previousType) &&
+			(previousType != "integer" || !slices.Contains(nextTypes, "number")) {
+			comparer.add(JSONSchemaChangeBreaking, path, "type no longer accepts %s", previousType)
+		}
+	}
+
+	for _, nextType := range nextTypes {
+		if !slices.Contains(previousTypes, nextType) {
+			comparer.add(JSONSchemaChangeCompatible, path, "type now accepts %s", nextType)
+		}
+	}
+}
+
+func (comparer *jsonSchemaComparer) compareValues(previous, next *jsonschema.Schema, path string) {
+	switch {
+	case previous.Enum == nil && next.Enum != nil:
+		comparer.add(JSONSchemaChangeBreaking, path, "value restricted to %s", mustMarshalJSON(next.Enum))
+	case previous.Enum != nil && next.Enum == nil:
+		comparer.add(JSONSchemaChangeCompatible, path, "enum restriction removed")
+	default:
+		for _, value := range previous.Enum {
+			if !slices.ContainsFunc(next.Enum, func(item any) bool { return jsonEqual(item, value) }) {
+				comparer.add(JSONSchemaChangeBreaking, path, "enum no longer accepts %s", mustMarshalJSON(value))
+			}
+		}
+
+		for _, value := range next.Enum {
+			if !slices.ContainsFunc(previous.Enum, func(item any) bool { return jsonEqual(item, value) }) {
+				comparer.add(JSONSchemaChangeCompatible, path, "enum now accepts %s", mustMarshalJSON(value))
+			}
+		}
+	}
+
+	switch {
+	case previous.Const == nil && next.Const != nil:
+		comparer.add(JSONSchemaChangeBreaking, path, "value restricted to %s", mustMarshalJSON(*next.Const))
+	case previous.Const != nil && next.Const == nil:
+		comparer.add(JSONSchemaChangeCompatible, path, "const restriction removed")
+	case previous.Const != nil && !jsonEqual(*previous.Const, *next.Const):
+		comparer.add(JSONSchemaChangeBreaking, path, "const changed to %s", mustMarshalJSON(*next.Const))
+	}
+}
+
+func (comparer *jsonSchemaComparer) compareConstraints(previous, next *jsonschema.Schema, path string) {
+	compareBound(comparer, path, "minimum", previous.Minimum, next.Minimum, true)
+	compareBound(comparer, path, "exclusiveMinimum", previous.ExclusiveMinimum, next.ExclusiveMinimum, true)
+	compareBound(comparer, path, "maximum", previous.Maximum, next.Maximum, false)
+	compareBound(comparer, path, "exclusiveMaximum", previous.ExclusiveMaximum, next.ExclusiveMaximum, false)
+	compareBound(comparer, path, "minLength", previous.MinLength, next.MinLength, true)
+	compareBound(comparer, path, "maxLength", previous.MaxLength, next.MaxLength, false)
+	compareBound(comparer, path, "minItems", previous.MinItems, next.MinItems, true)
+	compareBound(comparer, path, "maxItems", previous.MaxItems, next.MaxItems, false)
+	compareBound(comparer, path, "minProperties", previous.MinProperties, next.MinProperties, true)
+	compareBound(comparer, path, "maxProperties", previous.MaxProperties, next.MaxProperties, false)
+
+	switch {
+	case previous.Pattern == next.Pattern:
+	case next.Pattern == "":
+		comparer.add(JSONSchemaChangeCompatible, path, "pattern restriction removed")
+	default:
+		comparer.add(JSONSchemaChangeBreaking, path, "value must match pattern %q", next.Pattern)
+	}
+
+	if !previous.UniqueItems && next.UniqueItems {
+		comparer.add(JSONSchemaChangeBreaking, path, "array items must be unique")
+	} else if previous.UniqueItems && !next.UniqueItems {
+		comparer.add(JSONSchemaChangeCompatible, path, "array items no longer need to be unique")
+	}
+}
+
+func (comparer *jsonSchemaComparer) compareCombinators(previous, next *jsonschema.Schema, path string) {
+	combinators := []struct {
+		name           string
+		previous, next any
+	}{
+		{name: "allOf", previous: previous.AllOf, next: next.AllOf},
+		{name: "anyOf", previous: previous.AnyOf, next: next.AnyOf},
+		{name: "oneOf", previous: previous.OneOf, next: next.OneOf},
+		{name: "not", previous: previous.Not, next: next.Not},
+	}
+
+	for _, combinator := range combinators {
+		// Missing and empty combinators are equivalent.
+		if isEmptyJSON(combinator.previous) && isEmptyJSON(combinator.next) {
+			continue
+		}
+
+		if !jsonEqual(combinator.previous, combinator.next) {
+			comparer.add(JSONSchemaChangeBreaking, path, "%s changed, compatibility cannot be verified", combinator.name)
+		}
+	}
+}
+
+func (comparer *jsonSchemaComparer) compareObjects(previous, next *jsonschema.Schema, path string) {
+	names := lo.Uniq(slices.Concat(
+		lo.Keys(previous.Properties), lo.Keys(next.Properties), previous.Required, next.Required,
+	))
+	slices.Sort(names)
+
+	nextAllowsAdditional := next.AdditionalProperties == nil || !isFalseSchema(next.AdditionalProperties)
+
+	for _, name := range names {
+		propertyPath := path + "/" + escapeJSONPointer(name)
+
+		previousProperty, inPrevious := previous.Properties[name]
+		nextProperty, inNext := next.Properties[name]
+		previousRequired := slices.Contains(previous.Required, name)
+		nextRequired := slices.Contains(next.Required, name)
+
+		switch {
+		case inPrevious && !inNext && previousRequired:
+			comparer.add(JSONSchemaChangeBreaking, propertyPath, "required property removed")
+		case inPrevious && !inNext && !nextAllowsAdditional:
+			comparer.add(JSONSchemaChangeBreaking, propertyPath, "property removed, and unknown properties are not allowed")
+		case inPrevious && !inNext:
+			comparer.add(JSONSchemaChangeCompatible, propertyPath, "optional property removed")
+		case !inPrevious && inNext && nextRequired:
+			comparer.add(JSONSchemaChangeBreaking, propertyPath, "required property added")
+		case !inPrevious && inNext:
+			comparer.add(JSONSchemaChangeCompatible, propertyPath, "optional property added")
+		default:
+			if !previousRequired && nextRequired {
+				comparer.add(JSONSchemaChangeBreaking, propertyPath, "property is now required")
+			} else if previousRequired && !nextRequired {
+				comparer.add(JSONSchemaChangeCompatible, propertyPath, "property is no longer required")
+			}
+
+			if inPrevious && inNext {
+				comparer.compare(previousProperty, nextProperty, propertyPath)
+			}
+		}
+	}
+
+	previousAllowsAdditional := previous.AdditionalProperties == nil || !isFalseSchema(previous.AdditionalProperties)
+
+	switch {
+	case previousAllowsAdditional && !nextAllowsAdditional:
+		comparer.add(JSONSchemaChangeBreaking, path, "unknown properties are no longer allowed")
+	case !previousAllowsAdditional && nextAllowsAdditional:
+		comparer.add(JSONSchemaChangeCompatible, path, "unknown properties are now allowed")
+	}
+}
+
+// compareBound reports changes to a numeric constraint. Raising a lower bound, or lowering an upper bound, rejects
+// values that were accepted before.
+func compareBound[T int | float64](comparer *jsonSchemaComparer, path, keyword string, previous, next *T, lower bool) {
+	switch {
+	case previous == nil && next == nil:
+	case previous == nil:
+		comparer.add(JSONSchemaChangeBreaking, path, "%s set to %v", keyword, *next)
+	case next == nil:
+		comparer.add(JSONSchemaChangeCompatible, path, "%s removed", keyword)
+	case *previous == *next:
+	case (*next > *previous) == lower:
+		comparer.add(JSONSchemaChangeBreaking, path, "%s changed from %v to %v", keyword, *previous, *next)
+	default:
+		comparer.add(JSONSchemaChangeCompatible, path, "%s changed from %v to %v", keyword, *previous, *next)
+	}
+}
+
+func isEmptyJSON(value any) bool {
+	encoded := mustMarshalJSON(value)
+
+	return encoded == "null" || encoded == "[]"
+}
+
+func schemaTypes(schema *jsonschema.Schema) []string {
+	if schema.Type != "" {
+		return []string{schema.Type}
+	}
+
+	return schema.Types
+}
+
+// resolveLocalRef follows the local references of a schema, using the same rules as the validator.
+func resolveLocalRef(root, schema *jsonschema.Schema) *jsonschema.Schema {
+	// Bound the number of hops, in case references point to each other.
+	for range 32 {
+		if schema == nil || schema.Ref == "" {
+			return schema
+		}
+
+		schema = resolveRef(root, schema.Ref)
+	}
+
+	return schema
+}
+
+// jsonEqual compares 2 values using their JSON representation. Object keys are sorted by the encoder, so
+// equivalent values always produce the same output.
+func jsonEqual(a, b any) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
+
+func mustMarshalJSON(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(encoded)
+}
@@ -0,0 +1,266 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestCompareJSONSchemas(t *testing.T) {
+	t.Parallel()
+
+	baseSchema := func() *jsonschema.Schema {
+		return &jsonschema.Schema{
+			Type:                 "object",
+			Required:             []string{"title", "genre"},
+			AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+			Properties: map[string]*jsonschema.Schema{
+				"title": {Type: "string", MaxLength: lo.ToPtr(64)},
+				"genre": {Type: "string", Enum: []any{"DRAMA", "COMEDY"}},
+				"notes": {Type: "string"},
+				"tags":  {Type: "array", Items: &jsonschema.Schema{Type: "string"}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		previous *jsonschema.Schema
+		next     *jsonschema.Schema
+
+		expect         lib.JSONSchemaCompatibilityReport
+		expectBreaking bool
+	}{
+		{
+			name: "Identical",
+
+			previous: baseSchema(),
+			next:     baseSchema(),
+		},
+		{
+			name: "Compatible/OptionalPropertyAdded",
+
+			previous: baseSchema(),
+			next: func() *jsonschema.Schema {
+				schema := baseSchema()
+				schema.Properties["summary"] = &jsonschema.Schema{Type: "string"}
+
+				return schema
+			}(),
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "/summary", Kind: lib.JSONSchemaChangeCompatible, Message: "optional property added"},
+			},
+		},
+		{
+			name: "Compatible/EnumWidened",
+
+			previous: baseSchema(),
+			next: func() *jsonschema.Schema {
+				schema := baseSchema()
+				schema.Properties["genre"].Enum = []any{"DRAMA", "COMEDY", "THRILLER"}
+
+				return schema
+			}(),
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "/genre", Kind: lib.JSONSchemaChangeCompatible, Message: `enum now accepts "THRILLER"`},
+			},
+		},
+		{
+			name: "Compatible/Loosened",
+
+			previous: baseSchema(),
+			next: func() *jsonschema.Schema {
+				schema := baseSchema()
+				schema.Required = []string{"title"}
+				schema.AdditionalProperties = nil
+				schema.Properties["title"].MaxLength = lo.ToPtr(128)
+				schema.Properties["tags"].Items = &jsonschema.Schema{Types: []string{"string", "null"}}
+
+				return schema
+			}(),
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "/genre", Kind: lib.JSONSchemaChangeCompatible, Message: "property is no longer required"},
+				{Path: "/tags/*", Kind: lib.JSONSchemaChangeCompatible, Message: "type now accepts null"},
+				{Path: "/title", Kind: lib.JSONSchemaChangeCompatible, Message: "maxLength changed from 64 to 128"},
+				{Path: "", Kind: lib.JSONSchemaChangeCompatible, Message: "unknown properties are now allowed"},
+			},
+		},
+		{
+			name: "Compatible/IntegerToNumber",
+
+			previous: &jsonschema.Schema{Type: "integer"},
+			next:     &jsonschema.Schema{Type: "number"},
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "", Kind: lib.JSONSchemaChangeCompatible, Message: "type now accepts number"},
+			},
+		},
+		{
+			name: "Breaking/RequiredPropertyRemoved",
+
+			previous: baseSchema(),
+			next: func() *jsonschema.Schema {
+				schema := baseSchema()
+				schema.Required = []string{"title"}
+				delete(schema.Properties, "genre")
+
+				return schema
+			}(),
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "/genre", Kind: lib.JSONSchemaChangeBreaking, Message: "required property removed"},
+			},
+			expectBreaking: true,
+		},
+		{
+			name: "Breaking/OptionalPropertyRemovedWithoutAdditionalProperties",
+
+			previous: baseSchema(),
+			next: func() *jsonschema.Schema {
+				schema := baseSchema()
+				delete(schema.Properties, "notes")
+
+				return schema
+			}(),
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{
+					Path:    "/notes",
+					Kind:    lib.JSONSchemaChangeBreaking,
+					Message: "property removed, and unknown properties are not allowed",
+				},
+			},
+			expectBreaking: true,
+		},
+		{
+			name: "Breaking/TypeNarrowed",
+
+			previous: &jsonschema.Schema{Types: []string{"string", "null"}},
+			next:     &jsonschema.Schema{Type: "string"},
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "", Kind: lib.JSONSchemaChangeBreaking, Message: "type no longer accepts null"},
+			},
+			expectBreaking: true,
+		},
+		{
+			name: "Breaking/TypeChanged",
+
+			previous: baseSchema(),
+			next: func() *jsonschema.Schema {
+				schema := baseSchema()
+				schema.Properties["tags"].Items = &jsonschema.Schema{Type: "integer"}
+
+				return schema
+			}(),
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "/tags/*", Kind: lib.JSONSchemaChangeBreaking, Message: "type no longer accepts string"},
+				{Path: "/tags/*", Kind: lib.JSONSchemaChangeCompatible, Message: "type now accepts integer"},
+			},
+			expectBreaking: true,
+		},
+		{
+			name: "Breaking/Tightened",
+
+			previous: baseSchema(),
+			next: func() *jsonschema.Schema {
+				schema := baseSchema()
+				schema.Required = []string{"title", "genre", "notes", "summary"}
+				schema.Properties["summary"] = &jsonschema.Schema{Type: "string"}
+				schema.Properties["genre"].Enum = []any{"DRAMA"}
+				schema.Properties["title"].MaxLength = lo.ToPtr(32)
+				schema.Properties["title"].Pattern = "^[A-Z]"
+				schema.Properties["tags"].MinItems = lo.ToPtr(1)
+
+				return schema
+			}(),
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "/genre", Kind: lib.JSONSchemaChangeBreaking, Message: `enum no longer accepts "COMEDY"`},
+				{Path: "/notes", Kind: lib.JSONSchemaChangeBreaking, Message: "property is now required"},
+				{Path: "/summary", Kind: lib.JSONSchemaChangeBreaking, Message: "required property added"},
+				{Path: "/tags", Kind: lib.JSONSchemaChangeBreaking, Message: "minItems set to 1"},
+				{Path: "/title", Kind: lib.JSONSchemaChangeBreaking, Message: "maxLength changed from 64 to 32"},
+				{Path: "/title", Kind: lib.JSONSchemaChangeBreaking, Message: `value must match pattern "^[A-Z]"`},
+			},
+			expectBreaking: true,
+		},
+		{
+			name: "Breaking/CombinatorChanged",
+
+			previous: &jsonschema.Schema{AnyOf: []*jsonschema.Schema{{Type: "string"}, {Type: "integer"}}},
+			next:     &jsonschema.Schema{AnyOf: []*jsonschema.Schema{{Type: "string"}}},
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "", Kind: lib.JSONSchemaChangeBreaking, Message: "anyOf changed, compatibility cannot be verified"},
+			},
+			expectBreaking: true,
+		},
+		{
+			name: "LocalRef",
+
+			previous: &jsonschema.Schema{
+				Type:       "object",
+				Defs:       map[string]*jsonschema.Schema{"name": {Type: "string"}},
+				Properties: map[string]*jsonschema.Schema{"name": {Ref: "#/$defs/name"}},
+			},
+			next: &jsonschema.Schema{
+				Type:       "object",
+				Properties: map[string]*jsonschema.Schema{"name": {Type: "string", MinLength: lo.ToPtr(1)}},
+			},
+
+			expect: lib.JSONSchemaCompatibilityReport{
+				{Path: "/name", Kind: lib.JSONSchemaChangeBreaking, Message: "minLength set to 1"},
+			},
+			expectBreaking: true,
+		},
+		{
+			name: "RecursiveRef",
+
+			previous: &jsonschema.Schema{
+				Type:       "object",
+				Properties: map[string]*jsonschema.Schema{"child": {Ref: "#"}},
+			},
+			next: &jsonschema.Schema{
+				Type:       "object",
+				Properties: map[string]*jsonschema.Schema{"child": {Ref: "#"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := lib.CompareJSONSchemas(testCase.previous, testCase.next)
+			require.Equal(t, testCase.expect, report)
+			require.Equal(t, testCase.expectBreaking, report.HasBreakingChanges())
+		})
+	}
+}
+
+func TestJSONSchemaCompatibilityReportError(t *testing.T) {
+	t.Parallel()
+
+	report := lib.JSONSchemaCompatibilityReport{
+		{Path: "", Kind: lib.JSONSchemaChangeBreaking, Message: "type no longer accepts null"},
+		{Path: "/notes", Kind: lib.JSONSchemaChangeCompatible, Message: "optional property added"},
+		{Path: "/title", Kind: lib.JSONSchemaChangeBreaking, Message: "required property removed"},
+	}
+
+	require.Len(t, report.Breaking(), 2)
+	require.Equal(
+		t,
+		"breaking schema changes: /: type no longer accepts null; /title: required property removed",
+		report.Error(),
+	)
+}
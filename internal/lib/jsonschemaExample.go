@@ -0,0 +1,204 @@
+package lib
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+)
+
+// Example values for the string formats supported by structured outputs.
+var jsonSchemaExampleFormats = map[string]string{
+	"date-time": "2009-11-10T23:00:00Z",
+	"time":      "23:00:00",
+	"date":      "2009-11-10",
+	"duration":  "P1D",
+	"email":     "writer@example.com",
+	"hostname":  "example.com",
+	"ipv4":      "192.0.2.1",
+	"ipv6":      "2001:db8::1",
+	"uuid":      "00000000-0000-0000-0000-000000000001",
+}
+
+// jsonSchemaExampleNumberStep is the default step between example numbers.
+const jsonSchemaExampleNumberStep = 0.5
+
+// JSONSchemaExample builds a value that conforms to a JSON Schema. The same schema always produces the same value.
+//
+// Values are taken from the enum, const and examples keywords when available. Otherwise, they are built to
+// satisfy the type, format, length, bounds and item count constraints of the schema. Patterns are not supported.
+func JSONSchemaExample(schema *jsonschema.Schema) any {
+	return jsonSchemaExample(schema, "value")
+}
+
+func jsonSchemaExample(schema *jsonschema.Schema, name string) any {
+	if schema == nil {
+		return nil
+	}
+
+	if schema.Const != nil {
+		return *schema.Const
+	}
+
+	if len(schema.Enum) > 0 {
+		// Prefer values over null, for nullable enums.
+		value, ok := lo.Find(schema.Enum, func(item any) bool { return item != nil })
+		if !ok {
+			value = schema.Enum[0]
+		}
+
+		return value
+	}
+
+	if len(schema.Examples) > 0 {
+		return schema.Examples[0]
+	}
+
+	if len(schema.AnyOf) > 0 {
+		// Prefer branches that hold a value.
+		branch, ok := lo.Find(schema.AnyOf, func(item *jsonschema.Schema) bool {
+			return item.Type != "null"
+		})
+		if !ok {
+			branch = schema.AnyOf[0]
+		}
+
+		return jsonSchemaExample(branch, name)
+	}
+
+	// Prefer types that hold a value.
+	types := lo.Compact(append(slices.Clone(schema.Types), schema.Type))
+	schemaType, ok := lo.Find(types, func(item string) bool { return item != "null" })
+
+	if !ok {
+		return nil
+	}
+
+	switch schemaType {
+	case "object":
+		return jsonSchemaExampleObject(schema)
+	case "array":
+		return jsonSchemaExampleArray(schema, name)
+	case "string":
+		return jsonSchemaExampleString(schema, name)
+	case "integer":
+		return int64(jsonSchemaExampleNumber(schema, true))
+	case "number":
+		return jsonSchemaExampleNumber(schema, false)
+	case "boolean":
+		return true
+	default:
+		return nil
+	}
+}
+
+func jsonSchemaExampleObject(schema *jsonschema.Schema) map[string]any {
+	output := make(map[string]any, len(schema.Properties))
+
+	for key, property := range schema.Properties {
+		output[key] = jsonSchemaExample(property, key)
+	}
+
+	return output
+}
+
+func jsonSchemaExampleArray(schema *jsonschema.Schema, name string) []any {
+	if len(schema.ItemsArray) > 0 {
+		return lo.Map(schema.ItemsArray, func(item *jsonschema.Schema, _ int) any {
+			return jsonSchemaExample(item, name)
+		})
+	}
+
+	if schema.Items == nil {
+		return []any{}
+	}
+
+	count := max(lo.FromPtr(schema.MinItems), 1)
+	if schema.MaxItems != nil {
+		count = min(count, *schema.MaxItems)
+	}
+
+	output := make([]any, count)
+	for i := range output {
+		output[i] = jsonSchemaExample(schema.Items, name)
+	}
+
+	return output
+}
+
+func jsonSchemaExampleString(schema *jsonschema.Schema, name string) string {
+	if value, ok := jsonSchemaExampleFormats[schema.Format]; ok {
+		return value
+	}
+
+	value := "Example " + strings.ReplaceAll(name, "_", " ")
+
+	if minLength := lo.FromPtr(schema.MinLength); utf8.RuneCountInString(value) < minLength {
+		value += strings.Repeat(".", minLength-utf8.RuneCountInString(value))
+	}
+
+	if schema.MaxLength != nil && utf8.RuneCountInString(value) > *schema.MaxLength {
+		value = string([]rune(value)[:*schema.MaxLength])
+	}
+
+	return value
+}
+
+func jsonSchemaExampleNumber(schema *jsonschema.Schema, integer bool) float64 {
+	step := lo.Ternary(integer, 1.0, jsonSchemaExampleNumberStep)
+	if schema.MultipleOf != nil && *schema.MultipleOf > 0 {
+		step = *schema.MultipleOf
+	}
+
+	if integer {
+		step = math.Max(1, math.Ceil(step))
+	}
+
+	lower, upper := math.Inf(-1), math.Inf(1)
+	lowerExclusive, upperExclusive := false, false
+
+	if schema.Minimum != nil {
+		lower = *schema.Minimum
+	}
+
+	if schema.ExclusiveMinimum != nil && *schema.ExclusiveMinimum >= lower {
+		lower, lowerExclusive = *schema.ExclusiveMinimum, true
+	}
+
+	if schema.Maximum != nil {
+		upper = *schema.Maximum
+	}
+
+	if schema.ExclusiveMaximum != nil && *schema.ExclusiveMaximum <= upper {
+		upper, upperExclusive = *schema.ExclusiveMaximum, true
+	}
+
+	inBounds := func(value float64) bool {
+		return (value > lower || (value == lower && !lowerExclusive)) &&
+			(value < upper || (value == upper && !upperExclusive))
+	}
+
+	// Start from 0 when allowed, so values stay readable. Otherwise, use the closest multiple of the step.
+	value := math.Min(math.Max(0, lower), upper)
+
+	for _, candidate := range []float64{
+		math.Ceil(value/step) * step,
+		math.Ceil(value/step)*step + step,
+		math.Floor(value/step) * step,
+		math.Floor(value/step)*step - step,
+	} {
+		if inBounds(candidate) {
+			return candidate
+		}
+	}
+
+	// No multiple of the step fits the bounds.
+	if !math.IsInf(lower, 0) && !math.IsInf(upper, 0) {
+		return (lower + upper) / 2 //nolint:mnd
+	}
+
+	return value
+}
@@ -0,0 +1,196 @@
+package lib_test
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models/modules"
+)
+
+func TestJSONSchemaExample(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		schema *jsonschema.Schema
+
+		expect any
+	}{
+		{
+			name:   "Nil",
+			schema: nil,
+			expect: nil,
+		},
+		{
+			name:   "Enum",
+			schema: &jsonschema.Schema{Type: "string", Enum: []any{"FILM", "NOVEL"}},
+			expect: "FILM",
+		},
+		{
+			name:   "Enum/Nullable",
+			schema: &jsonschema.Schema{Types: []string{"string", "null"}, Enum: []any{nil, "FILM"}},
+			expect: "FILM",
+		},
+		{
+			name:   "Const",
+			schema: &jsonschema.Schema{Const: lo.ToPtr[any]("fixed")},
+			expect: "fixed",
+		},
+		{
+			name:   "Examples",
+			schema: &jsonschema.Schema{Type: "string", Examples: []any{"The Deep", "Dune"}},
+			expect: "The Deep",
+		},
+		{
+			name:   "String",
+			schema: &jsonschema.Schema{Type: "string"},
+			expect: "Example value",
+		},
+		{
+			name:   "String/MinLength",
+			schema: &jsonschema.Schema{Type: "string", MinLength: lo.ToPtr(16)},
+			expect: "Example value...",
+		},
+		{
+			name:   "String/MaxLength",
+			schema: &jsonschema.Schema{Type: "string", MaxLength: lo.ToPtr(4)},
+			expect: "Exam",
+		},
+		{
+			name:   "String/Format",
+			schema: &jsonschema.Schema{Type: "string", Format: "date"},
+			expect: "2009-11-10",
+		},
+		{
+			name:   "Integer",
+			schema: &jsonschema.Schema{Type: "integer"},
+			expect: int64(0),
+		},
+		{
+			name:   "Integer/Minimum",
+			schema: &jsonschema.Schema{Type: "integer", Minimum: lo.ToPtr(3.0)},
+			expect: int64(3),
+		},
+		{
+			name:   "Integer/ExclusiveMinimum",
+			schema: &jsonschema.Schema{Type: "integer", ExclusiveMinimum: lo.ToPtr(3.0)},
+			expect: int64(4),
+		},
+		{
+			name:   "Integer/Maximum",
+			schema: &jsonschema.Schema{Type: "integer", Maximum: lo.ToPtr(-2.0)},
+			expect: int64(-2),
+		},
+		{
+			name:   "Integer/MultipleOf",
+			schema: &jsonschema.Schema{Type: "integer", Minimum: lo.ToPtr(7.0), MultipleOf: lo.ToPtr(5.0)},
+			expect: int64(10),
+		},
+		{
+			name:   "Number/Bounds",
+			schema: &jsonschema.Schema{Type: "number", ExclusiveMinimum: lo.ToPtr(0.1), Maximum: lo.ToPtr(0.2)},
+			expect: 0.15000000000000002,
+		},
+		{
+			name:   "Boolean",
+			schema: &jsonschema.Schema{Type: "boolean"},
+			expect: true,
+		},
+		{
+			name:   "Null",
+			schema: &jsonschema.Schema{Type: "null"},
+			expect: nil,
+		},
+		{
+			name:   "Nullable",
+			schema: &jsonschema.Schema{Types: []string{"null", "boolean"}},
+			expect: true,
+		},
+		{
+			name: "AnyOf",
+			schema: &jsonschema.Schema{AnyOf: []*jsonschema.Schema{
+				{Type: "null"},
+				{Type: "integer", Minimum: lo.ToPtr(1.0)},
+			}},
+			expect: int64(1),
+		},
+		{
+			name: "Array",
+			schema: &jsonschema.Schema{
+				Type:     "array",
+				MinItems: lo.ToPtr(2),
+				Items:    &jsonschema.Schema{Type: "string"},
+			},
+			expect: []any{"Example value", "Example value"},
+		},
+		{
+			name: "Array/ItemsArray",
+			schema: &jsonschema.Schema{
+				Type:       "array",
+				ItemsArray: []*jsonschema.Schema{{Type: "string"}, {Type: "integer"}},
+			},
+			expect: []any{"Example value", int64(0)},
+		},
+		{
+			name: "Object",
+			schema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"story_title": {Type: "string"},
+					"tags": {
+						Type:  "array",
+						Items: &jsonschema.Schema{Type: "string", Enum: []any{"scifi"}},
+					},
+				},
+			},
+			expect: map[string]any{
+				"story_title": "Example story title",
+				"tags":        []any{"scifi"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, lib.JSONSchemaExample(testCase.schema))
+		})
+	}
+}
+
+// Examples of system modules must be valid, so the fake completion provider can be used to generate them.
+func TestJSONSchemaExample_SystemModules(t *testing.T) {
+	t.Parallel()
+
+	for namespace, embedFS := range modules.KnownModules {
+		files, err := fs.Glob(embedFS, namespace+"/*.yaml")
+		require.NoError(t, err)
+
+		for _, file := range files {
+			t.Run(file, func(t *testing.T) {
+				t.Parallel()
+
+				data, err := embedFS.ReadFile(file)
+				require.NoError(t, err)
+
+				var module modules.SystemModule
+				require.NoError(t, yaml.Unmarshal(data, &module))
+
+				schema := module.Schema.CloneSchemas()
+
+				_, ok := lib.JSONSchemaLLM(schema)
+				require.True(t, ok)
+
+				require.NoError(t, lib.ValidateJSONSchema(schema, lib.JSONSchemaExample(schema), false))
+			})
+		}
+	}
+}
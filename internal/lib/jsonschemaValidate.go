@@ -0,0 +1,339 @@
+package lib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+)
+
+// JSONSchemaValidationError reports a single value that does not satisfy a JSON Schema.
+type JSONSchemaValidationError struct {
+	// Path is the JSON Pointer (RFC 6901) to the invalid value. The root value has an empty path.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// JSONSchemaValidationErrors lists every violation found while validating a value against a JSON Schema.
+type JSONSchemaValidationErrors []*JSONSchemaValidationError
+
+func (errs JSONSchemaValidationErrors) Error() string {
+	messages := lo.Map(errs, func(item *JSONSchemaValidationError, _ int) string {
+		return fmt.Sprintf("%s: %s", lo.Ternary(item.Path == "", "/", item.Path), item.Message)
+	})
+
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+// ValidateJSONSchema checks data against the given JSON Schema, and returns a JSONSchemaValidationErrors
+// describing every violation found, or nil if the data is valid.
+//
+// Each value is checked with jsonschema.Resolved.Validate. Properties and array items are walked separately,
+// so validation does not stop at the first error, and every invalid path is reported at once.
+//
+// In draft mode, only the types and enumerated values are checked: missing required properties, unknown
+// properties and other constraints (length, bounds, patterns...) are ignored, and null values are treated as
+// if the property was not set. This allows partially filled documents to be saved.
+func ValidateJSONSchema(schema *jsonschema.Schema, data any, draft bool) error {
+	if schema == nil {
+		return nil
+	}
+
+	// Round-trip the data through JSON, so values built in Go (typed slices, ints, structs...) are compared
+	// using the same representation as decoded payloads.
+	normalized, err := normalizeJSON(data)
+	if err != nil {
+		return JSONSchemaValidationErrors{{Message: fmt.Sprintf("value cannot be encoded as JSON: %s", err)}}
+	}
+
+	if draft {
+		schema = relaxJSONSchema(schema)
+	}
+
+	validator := &jsonSchemaValidator{
+		root:     schema,
+		draft:    draft,
+		resolved: make(map[*jsonschema.Schema]*jsonschema.Resolved),
+	}
+	validator.validate(schema, normalized, "")
+
+	if len(validator.errs) == 0 {
+		return nil
+	}
+
+	return validator.errs
+}
+
+type jsonSchemaValidator struct {
+	root  *jsonschema.Schema
+	draft bool
+	errs  JSONSchemaValidationErrors
+
+	// resolved caches the local schema of each node, so it is only resolved once per validation.
+	resolved map[*jsonschema.Schema]*jsonschema.Resolved
+}
+
+func (validator *jsonSchemaValidator) fail(path, message string, args ...any) {
+	validator.errs = append(validator.errs, &JSONSchemaValidationError{
+		Path:    path,
+		Message: fmt.Sprintf(message, args...),
+	})
+}
+
+// validate checks the value against the keywords of the schema that apply to the value itself, then walks
+// its properties or items, so errors are reported on the path of the nested value rather than on its parent.
+func (validator *jsonSchemaValidator) validate(schema *jsonschema.Schema, value any, path string) {
+	if schema == nil {
+		return
+	}
+
+	// In draft mode, null values stand for values that were not filled yet.
+	if validator.draft && value == nil {
+		return
+	}
+
+	if schema.Ref != "" {
+		validator.validate(resolveRef(validator.root, schema.Ref), value, path)
+	}
+
+	err := validator.validateLocal(schema, value)
+	if err != nil {
+		validator.fail(path, "%s", err)
+	}
+
+	switch typed := value.(type) {
+	case []any:
+		validator.validateArray(schema, typed, path)
+	case map[string]any:
+		validator.validateObject(schema, typed, path)
+	}
+}
+
+func (validator *jsonSchemaValidator) validateLocal(schema *jsonschema.Schema, value any) error {
+	resolved, ok := validator.resolved[schema]
+	if !ok {
+		var err error
+
+		resolved, err = localJSONSchema(validator.root, schema).Resolve(nil)
+		if err != nil {
+			return fmt.Errorf("invalid schema: %w", err)
+		}
+
+		validator.resolved[schema] = resolved
+	}
+
+	err := resolved.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	// Resolved.Validate wraps the error once per schema it went through. Only the innermost error describes
+	// the actual violation.
+	for inner := errors.Unwrap(err); inner != nil; inner = errors.Unwrap(err) {
+		err = inner
+	}
+
+	return err
+}
+
+func (validator *jsonSchemaValidator) validateArray(schema *jsonschema.Schema, value []any, path string) {
+	prefixItems := schema.PrefixItems
+	if len(prefixItems) == 0 {
+		prefixItems = schema.ItemsArray
+	}
+
+	for i, item := range value {
+		itemPath := fmt.Sprintf("%s/%d", path, i)
+
+		switch {
+		case i < len(prefixItems):
+			validator.validate(prefixItems[i], item, itemPath)
+		case schema.Items != nil:
+			validator.validate(schema.Items, item, itemPath)
+		case schema.AdditionalItems != nil:
+			validator.validate(schema.AdditionalItems, item, itemPath)
+		}
+	}
+}
+
+func (validator *jsonSchemaValidator) validateObject(schema *jsonschema.Schema, value map[string]any, path string) {
+	// Iterate in a stable order, so errors are reported deterministically.
+	keys := lo.Keys(value)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		propertyPath := path + "/" + escapeJSONPointer(key)
+		propertyValue := value[key]
+
+		var evaluated bool
+
+		if propertySchema, ok := schema.Properties[key]; ok {
+			evaluated = true
+
+			validator.validate(propertySchema, propertyValue, propertyPath)
+		}
+
+		for pattern, patternSchema := range schema.PatternProperties {
+			re, err := regexp.Compile(pattern)
+			if err == nil && re.MatchString(key) {
+				evaluated = true
+
+				validator.validate(patternSchema, propertyValue, propertyPath)
+			}
+		}
+
+		if evaluated || schema.AdditionalProperties == nil {
+			continue
+		}
+
+		if isFalseSchema(schema.AdditionalProperties) {
+			validator.fail(propertyPath, "unexpected property")
+
+			continue
+		}
+
+		validator.validate(schema.AdditionalProperties, propertyValue, propertyPath)
+	}
+
+	for _, key := range schema.Required {
+		if _, ok := value[key]; !ok {
+			validator.fail(path+"/"+escapeJSONPointer(key), "missing required property")
+		}
+	}
+}
+
+// localJSONSchema returns a copy of the schema without the keywords that apply to nested values, which are
+// walked by the validator instead. Definitions of the root schema are kept, so references used by
+// combinators can still be resolved.
+func localJSONSchema(root, schema *jsonschema.Schema) *jsonschema.Schema {
+	local := schema.CloneSchemas()
+
+	local.Ref = ""
+	local.Required = nil
+	local.Properties = nil
+	local.PatternProperties = nil
+	local.AdditionalProperties = nil
+	local.UnevaluatedProperties = nil
+	local.Items = nil
+	local.ItemsArray = nil
+	local.PrefixItems = nil
+	local.AdditionalItems = nil
+	local.UnevaluatedItems = nil
+
+	local.Defs = root.CloneSchemas().Defs
+	local.Definitions = root.CloneSchemas().Definitions
+
+	return local
+}
+
+// relaxJSONSchema returns a copy of the schema that only checks types and enumerated values, for draft mode.
+func relaxJSONSchema(schema *jsonschema.Schema) *jsonschema.Schema {
+	relaxed := schema.CloneSchemas()
+	walkJSONSchema(relaxed, func(item *jsonschema.Schema) {
+		item.Required = nil
+		item.DependentRequired = nil
+		item.MinLength = nil
+		item.MaxLength = nil
+		item.Pattern = ""
+		item.Format = ""
+		item.Minimum = nil
+		item.Maximum = nil
+		item.ExclusiveMinimum = nil
+		item.ExclusiveMaximum = nil
+		item.MultipleOf = nil
+		item.MinItems = nil
+		item.MaxItems = nil
+		item.UniqueItems = false
+		item.Contains = nil
+		item.MinContains = nil
+		item.MaxContains = nil
+		item.MinProperties = nil
+		item.MaxProperties = nil
+
+		if item.AdditionalProperties != nil && isFalseSchema(item.AdditionalProperties) {
+			item.AdditionalProperties = nil
+		}
+	})
+
+	return relaxed
+}
+
+var (
+	jsonSchemaType      = reflect.TypeFor[*jsonschema.Schema]()
+	jsonSchemaSliceType = reflect.TypeFor[[]*jsonschema.Schema]()
+	jsonSchemaMapType   = reflect.TypeFor[map[string]*jsonschema.Schema]()
+)
+
+// walkJSONSchema calls fn on the schema, then on every sub-schema it contains.
+func walkJSONSchema(schema *jsonschema.Schema, fn func(item *jsonschema.Schema)) {
+	if schema == nil {
+		return
+	}
+
+	fn(schema)
+
+	value := reflect.ValueOf(schema).Elem()
+
+	for i := range value.NumField() {
+		field := value.Field(i)
+
+		switch field.Type() {
+		case jsonSchemaType:
+			walkJSONSchema(field.Interface().(*jsonschema.Schema), fn)
+		case jsonSchemaSliceType:
+			for _, item := range field.Interface().([]*jsonschema.Schema) {
+				walkJSONSchema(item, fn)
+			}
+		case jsonSchemaMapType:
+			for _, item := range field.Interface().(map[string]*jsonschema.Schema) {
+				walkJSONSchema(item, fn)
+			}
+		}
+	}
+}
+
+// resolveRef looks up local references, in the form "#", "#/$defs/name" or "#/definitions/name".
+// Other references are not supported, and are ignored.
+func resolveRef(root *jsonschema.Schema, ref string) *jsonschema.Schema {
+	switch {
+	case ref == "#":
+		return root
+	case strings.HasPrefix(ref, "#/$defs/"):
+		return root.Defs[strings.TrimPrefix(ref, "#/$defs/")]
+	case strings.HasPrefix(ref, "#/definitions/"):
+		return root.Definitions[strings.TrimPrefix(ref, "#/definitions/")]
+	}
+
+	return nil
+}
+
+// isFalseSchema returns true for the "false" boolean schema, which jsonschema-go represents as {"not": {}}.
+func isFalseSchema(schema *jsonschema.Schema) bool {
+	return schema.Not != nil && reflect.ValueOf(*schema.Not).IsZero()
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func normalizeJSON(data any) (any, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized any
+
+	err = json.Unmarshal(encoded, &normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,236 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestValidateJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &jsonschema.Schema{
+		Type:     "object",
+		Required: []string{"targets", "tags"},
+		Properties: map[string]*jsonschema.Schema{
+			"targets": {
+				Type:                 "object",
+				Required:             []string{"target_medium", "title"},
+				AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+				Properties: map[string]*jsonschema.Schema{
+					"target_medium": {Type: "string", Enum: []any{"FILM", "NOVEL"}},
+					"title":         {Type: "string", MinLength: lo.ToPtr(3), MaxLength: lo.ToPtr(10)},
+					"episodes":      {Type: "integer", Minimum: lo.ToPtr(1.0)},
+				},
+			},
+			"tags": {
+				Type:     "array",
+				MinItems: lo.ToPtr(1),
+				Items:    &jsonschema.Schema{Type: "string", Pattern: "^[a-z]+$"},
+			},
+			"notes": {Types: []string{"string", "null"}},
+			"score": {
+				AnyOf: []*jsonschema.Schema{
+					{Type: "integer"},
+					{Type: "string", Enum: []any{"N/A"}},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		schema *jsonschema.Schema
+		data   any
+		draft  bool
+
+		expect lib.JSONSchemaValidationErrors
+	}{
+		{
+			name: "Valid",
+
+			schema: schema,
+			data: map[string]any{
+				"targets": map[string]any{"target_medium": "FILM", "title": "Dune", "episodes": 3},
+				"tags":    []string{"scifi"},
+				"notes":   nil,
+				"score":   "N/A",
+			},
+		},
+		{
+			name: "NilSchema",
+
+			data: map[string]any{"foo": "bar"},
+		},
+		{
+			name: "InvalidEnum",
+
+			schema: schema,
+			data: map[string]any{
+				"targets": map[string]any{"target_medium": "RADIO", "title": "Dune"},
+				"tags":    []any{"scifi"},
+			},
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "/targets/target_medium", Message: "enum: RADIO does not equal any of: [FILM NOVEL]"},
+			},
+		},
+		{
+			name: "MultipleErrors",
+
+			schema: schema,
+			data: map[string]any{
+				"targets": map[string]any{"target_medium": 42, "episodes": 1.5, "extra/key": true},
+				"tags":    []any{"ok", "NOT OK", 3},
+				"score":   true,
+			},
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "/score", Message: "anyOf: did not validate against any of [<anonymous schema> <anonymous schema>]"},
+				{Path: "/tags/1", Message: `pattern: "NOT OK" does not match regular expression "^[a-z]+$"`},
+				{Path: "/tags/2", Message: `type: 3 has type "integer", want "string"`},
+				{Path: "/targets/episodes", Message: `type: 1.5 has type "number", want "integer"`},
+				{Path: "/targets/extra~1key", Message: "unexpected property"},
+				{Path: "/targets/target_medium", Message: `type: 42 has type "integer", want "string"`},
+				{Path: "/targets/title", Message: "missing required property"},
+			},
+		},
+		{
+			name: "Constraints",
+
+			schema: schema,
+			data: map[string]any{
+				"targets": map[string]any{"target_medium": "NOVEL", "title": "A very long title", "episodes": 0},
+				"tags":    []any{},
+			},
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "/tags", Message: "minItems: array length 0 is less than 1"},
+				{Path: "/targets/episodes", Message: "minimum: 0/1 is less than 1.000000"},
+				{Path: "/targets/title", Message: `maxLength: "A very long title" contains 17 Unicode code points, more than 10`},
+			},
+		},
+		{
+			name: "MissingRoot",
+
+			schema: schema,
+			data:   map[string]any{},
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "/targets", Message: "missing required property"},
+				{Path: "/tags", Message: "missing required property"},
+			},
+		},
+		{
+			name: "WrongRootType",
+
+			schema: schema,
+			data:   []any{"foo"},
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "", Message: `type: [foo] has type "array", want "object"`},
+			},
+		},
+		{
+			name: "Draft/PartialData",
+
+			schema: schema,
+			data: map[string]any{
+				"targets": map[string]any{"target_medium": nil, "title": "", "extra": true},
+				"tags":    []any{},
+			},
+			draft: true,
+		},
+		{
+			name: "Draft/InvalidTypesAndEnums",
+
+			schema: schema,
+			data: map[string]any{
+				"targets": map[string]any{"target_medium": "RADIO", "title": 42},
+				"tags":    []any{"NOT OK", false},
+			},
+			draft: true,
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "/tags/1", Message: `type: false has type "boolean", want "string"`},
+				{Path: "/targets/target_medium", Message: "enum: RADIO does not equal any of: [FILM NOVEL]"},
+				{Path: "/targets/title", Message: `type: 42 has type "integer", want "string"`},
+			},
+		},
+		{
+			name: "LocalRef",
+
+			schema: &jsonschema.Schema{
+				Type: "object",
+				Defs: map[string]*jsonschema.Schema{
+					"name": {Type: "string"},
+				},
+				Properties: map[string]*jsonschema.Schema{
+					"name": {Ref: "#/$defs/name"},
+				},
+			},
+			data: map[string]any{"name": 42},
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "/name", Message: `type: 42 has type "integer", want "string"`},
+			},
+		},
+		{
+			name: "LocalRef/Combinator",
+
+			schema: &jsonschema.Schema{
+				Type: "object",
+				Defs: map[string]*jsonschema.Schema{
+					"name": {Type: "string", MinLength: lo.ToPtr(3)},
+				},
+				Properties: map[string]*jsonschema.Schema{
+					"name": {AnyOf: []*jsonschema.Schema{{Ref: "#/$defs/name"}, {Type: "null"}}},
+				},
+			},
+			data: map[string]any{"name": "ab"},
+
+			expect: lib.JSONSchemaValidationErrors{
+				{Path: "/name", Message: "anyOf: did not validate against any of [<anonymous schema> <anonymous schema>]"},
+			},
+		},
+		{
+			name: "Draft/LocalRef/Combinator",
+
+			schema: &jsonschema.Schema{
+				Type: "object",
+				Defs: map[string]*jsonschema.Schema{
+					"name": {Type: "string", MinLength: lo.ToPtr(3)},
+				},
+				Properties: map[string]*jsonschema.Schema{
+					"name": {AnyOf: []*jsonschema.Schema{{Ref: "#/$defs/name"}, {Type: "null"}}},
+				},
+			},
+			data:  map[string]any{"name": "ab"},
+			draft: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := lib.ValidateJSONSchema(testCase.schema, testCase.data, testCase.draft)
+
+			if testCase.expect == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var validationErrors lib.JSONSchemaValidationErrors
+
+			require.ErrorAs(t, err, &validationErrors)
+			require.Equal(t, testCase.expect, validationErrors)
+		})
+	}
+}
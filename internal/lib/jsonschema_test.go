@@ -16,7 +16,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 	t.Run("NilInput", func(t *testing.T) {
 		t.Parallel()
 
-		result := lib.JSONSchemaLLM(nil)
+		_, result := lib.JSONSchemaLLM(nil)
 		require.False(t, result)
 	})
 
@@ -39,7 +39,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Len(t, schema.AnyOf, 2)
 		})
@@ -62,7 +62,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Len(t, schema.AnyOf, 1)
 		})
@@ -86,7 +86,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 	})
@@ -102,7 +102,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Equal(t, "object", schema.Type)
 			require.Nil(t, schema.Types)
@@ -118,7 +118,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Empty(t, schema.Type)
 			require.ElementsMatch(t, []string{"object", "null"}, schema.Types)
@@ -131,7 +131,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Type: "unsupported",
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -145,7 +145,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Empty(t, schema.Type)
 			require.ElementsMatch(t, []string{"object", "null"}, schema.Types)
@@ -162,7 +162,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Empty(t, schema.Type)
 			require.ElementsMatch(t, []string{"object", "null"}, schema.Types)
@@ -182,7 +182,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -195,7 +195,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -208,7 +208,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties:  map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -221,7 +221,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -234,7 +234,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -247,7 +247,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties:        map[string]*jsonschema.Schema{"bar": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -260,7 +260,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties:      map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -273,7 +273,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -286,7 +286,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -299,7 +299,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties:  map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 	})
@@ -322,7 +322,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 					Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 				}
 
-				result := lib.JSONSchemaLLM(schema)
+				_, result := lib.JSONSchemaLLM(schema)
 				require.True(t, result)
 			})
 		}
@@ -336,7 +336,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -349,7 +349,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Properties: map[string]*jsonschema.Schema{"foo": {Type: "string"}},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 		})
 	})
@@ -364,7 +364,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Type: "object",
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -379,7 +379,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Contains(t, schema.Properties, "valid")
 			require.Nil(t, schema.Properties["invalid"])
@@ -396,7 +396,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Required: []string{"foo"},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Equal(t, "string", schema.Properties["foo"].Type)
 			require.Nil(t, schema.Properties["foo"].Types)
@@ -412,7 +412,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Empty(t, schema.Properties["optional"].Type)
 			require.ElementsMatch(t, []string{"string", "null"}, schema.Properties["optional"].Types)
@@ -429,7 +429,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.ElementsMatch(t, []string{"string", "integer", "null"}, schema.Properties["optional"].Types)
 		})
@@ -444,11 +444,30 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.ElementsMatch(t, []string{"string", "null"}, schema.Properties["optional"].Types)
 		})
 
+		t.Run("NonRequiredEnumBecomesNullable", func(t *testing.T) {
+			t.Parallel()
+
+			schema := &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"required": {Type: "string", Enum: []any{"FILM", "NOVEL"}},
+					"optional": {Type: "string", Enum: []any{"FILM", "NOVEL"}},
+				},
+				Required: []string{"required"},
+			}
+
+			_, result := lib.JSONSchemaLLM(schema)
+			require.True(t, result)
+			require.Equal(t, []any{"FILM", "NOVEL"}, schema.Properties["required"].Enum)
+			require.Equal(t, []any{"FILM", "NOVEL", nil}, schema.Properties["optional"].Enum)
+			require.ElementsMatch(t, []string{"string", "null"}, schema.Properties["optional"].Types)
+		})
+
 		t.Run("AllPropertiesBecomesRequired", func(t *testing.T) {
 			t.Parallel()
 
@@ -461,7 +480,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Required: []string{"foo"},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.ElementsMatch(t, []string{"foo", "bar"}, schema.Required)
 		})
@@ -476,7 +495,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.NotNil(t, schema.AdditionalProperties)
 			require.NotNil(t, schema.AdditionalProperties.Not)
@@ -497,7 +516,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.NotNil(t, schema.Properties["nested"])
 			require.Contains(t, schema.Properties["nested"].Properties, "inner")
@@ -514,7 +533,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Type: "array",
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -526,7 +545,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Items: &jsonschema.Schema{Type: "string"},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.NotNil(t, schema.Items)
 		})
@@ -539,7 +558,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Items: &jsonschema.Schema{Type: "unsupported"},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.False(t, result)
 		})
 
@@ -554,7 +573,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Len(t, schema.ItemsArray, 2)
 		})
@@ -570,7 +589,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Len(t, schema.ItemsArray, 1)
 		})
@@ -588,7 +607,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.NotNil(t, schema.Items)
 			require.Contains(t, schema.Items.Properties, "foo")
@@ -602,7 +621,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Items: &jsonschema.Schema{Type: "string"},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Nil(t, schema.AdditionalProperties)
 		})
@@ -626,7 +645,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Equal(t, lo.ToPtr(5), schema.Properties["foo"].MinLength)
 			require.Equal(t, lo.ToPtr(10), schema.Properties["foo"].MaxLength)
@@ -650,7 +669,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Equal(t, lo.ToPtr(2.5), schema.Properties["foo"].MultipleOf)
 			require.Equal(t, lo.ToPtr(100.0), schema.Properties["foo"].Maximum)
@@ -669,7 +688,7 @@ func TestJSONSchemaLLM(t *testing.T) {
 				MaxItems: lo.ToPtr(10),
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Equal(t, lo.ToPtr(1), schema.MinItems)
 			require.Equal(t, lo.ToPtr(10), schema.MaxItems)
@@ -686,9 +705,198 @@ func TestJSONSchemaLLM(t *testing.T) {
 				Examples: []any{"example1", "example2"},
 			}
 
-			result := lib.JSONSchemaLLM(schema)
+			_, result := lib.JSONSchemaLLM(schema)
 			require.True(t, result)
 			require.Equal(t, []any{"example1", "example2"}, schema.Examples)
 		})
 	})
+
+	t.Run("Report", func(t *testing.T) {
+		t.Parallel()
+
+		constVal := any("fixed")
+		schema := &jsonschema.Schema{
+			Type:        "object",
+			Description: "A story.",
+			Properties: map[string]*jsonschema.Schema{
+				"title":    {Type: "string", Description: "The title."},
+				"subtitle": {Types: []string{"string", "unknown"}},
+				"kind":     {Type: "string", Const: &constVal},
+				"website":  {Type: "string", Format: "uri"},
+				"choice":   {OneOf: []*jsonschema.Schema{{Type: "string"}, {Type: "integer"}}},
+				"tags": {
+					Type:        "array",
+					UniqueItems: true,
+					Items:       &jsonschema.Schema{Type: "string"},
+				},
+				"genres": {
+					Type:  "array",
+					Items: &jsonschema.Schema{Type: "string", Enum: []any{"DRAMA", "COMEDY"}},
+				},
+				"empty": {
+					Type:       "object",
+					Properties: map[string]*jsonschema.Schema{"id": {Type: "string", Format: "uri"}},
+				},
+			},
+			Required: []string{"title", "genres", "empty"},
+		}
+
+		report, result := lib.JSONSchemaLLM(schema)
+		require.True(t, result)
+		require.Equal(t, lib.JSONSchemaLLMReport{
+			{Path: "/choice", Kind: lib.JSONSchemaLLMChangeDropped, Message: "unsupported oneOf"},
+			{Path: "/empty/id", Kind: lib.JSONSchemaLLMChangeDropped, Message: `unsupported format "uri"`},
+			{Path: "/empty", Kind: lib.JSONSchemaLLMChangeDropped, Message: "object has no supported properties"},
+			{Path: "/kind", Kind: lib.JSONSchemaLLMChangeDropped, Message: "unsupported const"},
+			{Path: "/subtitle", Kind: lib.JSONSchemaLLMChangeRewritten, Message: "type unknown is not supported"},
+			{Path: "/tags", Kind: lib.JSONSchemaLLMChangeDropped, Message: "unsupported uniqueItems"},
+			{Path: "/title", Kind: lib.JSONSchemaLLMChangeRewritten, Message: "ignored description"},
+			{Path: "/website", Kind: lib.JSONSchemaLLMChangeDropped, Message: `unsupported format "uri"`},
+			{Path: "", Kind: lib.JSONSchemaLLMChangeRewritten, Message: "ignored description"},
+		}, report)
+		require.Len(t, report.Dropped(), 6)
+
+		require.Equal(t, []string{"genres", "subtitle", "title"}, schema.Required)
+		require.Equal(t, []string{"string", "null"}, schema.Properties["subtitle"].Types)
+		require.Equal(t, []any{"DRAMA", "COMEDY"}, schema.Properties["genres"].Items.Enum)
+		require.NotContains(t, schema.Properties, "kind")
+	})
+
+	t.Run("OptionalAnyOfBecomesNullable", func(t *testing.T) {
+		t.Parallel()
+
+		schema := &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"value": {AnyOf: []*jsonschema.Schema{{Type: "string"}, {Type: "integer"}}},
+			},
+		}
+
+		_, result := lib.JSONSchemaLLM(schema)
+		require.True(t, result)
+		require.Len(t, schema.Properties["value"].AnyOf, 3)
+		require.Equal(t, "null", schema.Properties["value"].AnyOf[2].Type)
+		require.Empty(t, schema.Properties["value"].Types)
+	})
+}
+
+func TestStripJSONSchemaLLMNulls(t *testing.T) {
+	t.Parallel()
+
+	schema := &jsonschema.Schema{
+		Type:     "object",
+		Required: []string{"title"},
+		Properties: map[string]*jsonschema.Schema{
+			"title":    {Types: []string{"string", "null"}},
+			"subtitle": {Type: "string"},
+			"characters": {
+				Type: "array",
+				Items: &jsonschema.Schema{
+					Type:     "object",
+					Required: []string{"name"},
+					Properties: map[string]*jsonschema.Schema{
+						"name":     {Type: "string"},
+						"nickname": {Type: "string"},
+					},
+				},
+			},
+			"setting": {
+				AnyOf: []*jsonschema.Schema{
+					{
+						Type:       "object",
+						Properties: map[string]*jsonschema.Schema{"place": {Type: "string"}},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		data map[string]any
+
+		expect map[string]any
+	}{
+		{
+			name: "OptionalNulls",
+
+			data: map[string]any{
+				"title":      "The Lighthouse",
+				"subtitle":   nil,
+				"characters": nil,
+				"setting":    nil,
+			},
+
+			expect: map[string]any{
+				"title": "The Lighthouse",
+			},
+		},
+		{
+			name: "RequiredNulls",
+
+			data: map[string]any{
+				"title":    nil,
+				"subtitle": "A ghost story",
+			},
+
+			expect: map[string]any{
+				"title":    nil,
+				"subtitle": "A ghost story",
+			},
+		},
+		{
+			name: "ArrayItems",
+
+			data: map[string]any{
+				"title": "The Lighthouse",
+				"characters": []any{
+					map[string]any{"name": "Ada", "nickname": nil},
+					map[string]any{"name": "Bob", "nickname": "The Keeper"},
+				},
+			},
+
+			expect: map[string]any{
+				"title": "The Lighthouse",
+				"characters": []any{
+					map[string]any{"name": "Ada"},
+					map[string]any{"name": "Bob", "nickname": "The Keeper"},
+				},
+			},
+		},
+		{
+			name: "AnyOfLeftAsIs",
+
+			data: map[string]any{
+				"title":   "The Lighthouse",
+				"setting": map[string]any{"place": nil},
+			},
+
+			expect: map[string]any{
+				"title":   "The Lighthouse",
+				"setting": map[string]any{"place": nil},
+			},
+		},
+		{
+			name: "UnknownProperties",
+
+			data: map[string]any{
+				"title": "The Lighthouse",
+				"extra": nil,
+			},
+
+			expect: map[string]any{
+				"title": "The Lighthouse",
+				"extra": nil,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, lib.StripJSONSchemaLLMNulls(schema, testCase.data))
+		})
+	}
 }
@@ -0,0 +1,68 @@
+package lib
+
+import (
+	"unicode/utf8"
+)
+
+// tokenEstimateRatio is the average number of characters per token. It is a rough estimate for english text, that
+// tends to overestimate the number of tokens of JSON data, which is the safe side when fitting a budget.
+const tokenEstimateRatio = 4
+
+// moduleDataTruncateSuffix marks strings that were cut by TruncateModuleData.
+const moduleDataTruncateSuffix = "…"
+
+// EstimateTokens returns an estimate of the number of tokens of a text, without running a tokenizer. It is meant to
+// fit content in a budget, and is not accurate enough to compute costs.
+func EstimateTokens(text string) int64 {
+	length := int64(utf8.RuneCountInString(text))
+
+	return (length + tokenEstimateRatio - 1) / tokenEstimateRatio
+}
+
+// TruncateModuleData shortens the strings of a module data, so none of them exceeds maxLength characters. Truncated
+// strings end with an ellipsis. The boolean is true if any string was truncated. The source data is left untouched.
+func TruncateModuleData(data map[string]any, maxLength int) (map[string]any, bool) {
+	output, truncated := truncateModuleData(data, maxLength)
+	mapOutput, _ := output.(map[string]any)
+
+	return mapOutput, truncated
+}
+
+func truncateModuleData(value any, maxLength int) (any, bool) {
+	switch typed := value.(type) {
+	case string:
+		if utf8.RuneCountInString(typed) <= maxLength {
+			return typed, false
+		}
+
+		return string([]rune(typed)[:maxLength]) + moduleDataTruncateSuffix, true
+	case map[string]any:
+		output := make(map[string]any, len(typed))
+
+		var truncated bool
+
+		for key, item := range typed {
+			var itemTruncated bool
+
+			output[key], itemTruncated = truncateModuleData(item, maxLength)
+			truncated = truncated || itemTruncated
+		}
+
+		return output, truncated
+	case []any:
+		output := make([]any, len(typed))
+
+		var truncated bool
+
+		for i, item := range typed {
+			var itemTruncated bool
+
+			output[i], itemTruncated = truncateModuleData(item, maxLength)
+			truncated = truncated || itemTruncated
+		}
+
+		return output, truncated
+	}
+
+	return value, false
+}
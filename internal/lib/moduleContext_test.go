@@ -0,0 +1,112 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestEstimateTokens(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		text string
+
+		expect int64
+	}{
+		{
+			name:   "Empty",
+			text:   "",
+			expect: 0,
+		},
+		{
+			name:   "RoundUp",
+			text:   "Hello",
+			expect: 2,
+		},
+		{
+			name:   "Exact",
+			text:   "The lighthouse",
+			expect: 4,
+		},
+		{
+			name:   "Runes",
+			text:   "Été",
+			expect: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, lib.EstimateTokens(testCase.text))
+		})
+	}
+}
+
+func TestTruncateModuleData(t *testing.T) {
+	t.Parallel()
+
+	testData := func() map[string]any {
+		return map[string]any{
+			"title": "The Lighthouse",
+			"exploration": map[string]any{
+				"what_if": "What if the light never went out?",
+				"themes":  []any{"solitude", "mémoire collective"},
+			},
+			"count": 1.0,
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		maxLength int
+
+		expect          map[string]any
+		expectTruncated bool
+	}{
+		{
+			name: "NoTruncation",
+
+			maxLength: 64,
+
+			expect: testData(),
+		},
+		{
+			name: "Truncated",
+
+			maxLength: 10,
+
+			expect: map[string]any{
+				"title": "The Lighth…",
+				"exploration": map[string]any{
+					"what_if": "What if th…",
+					"themes":  []any{"solitude", "mémoire co…"},
+				},
+				"count": 1.0,
+			},
+			expectTruncated: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := testData()
+
+			res, truncated := lib.TruncateModuleData(source, testCase.maxLength)
+			require.Equal(t, testCase.expect, res)
+			require.Equal(t, testCase.expectTruncated, truncated)
+
+			// Source is left untouched.
+			require.Equal(t, testData(), source)
+		})
+	}
+}
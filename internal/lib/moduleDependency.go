@@ -0,0 +1,101 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/a-novel/service-narrative-engine/internal/models"
+)
+
+var ErrInvalidModuleDependency = errors.New("invalid module dependency")
+
+// VersionlessModuleStringRegexp matches module strings without a version, in the "namespace:module" format.
+var VersionlessModuleStringRegexp = regexp.MustCompile(fmt.Sprintf(
+	`^%[1]s%[2]s%[1]s$`, ModuleNameRegex, ModuleNamespaceSeparator,
+))
+
+// ValidateModuleDependencies checks the dependencies declared by a module are well-formed. The module parameter is
+// the version-less string of the module declaring the dependencies, which cannot depend on itself.
+func ValidateModuleDependencies(module string, dependencies []models.ModuleDependency) error {
+	seen := make(map[string]bool, len(dependencies))
+
+	for i, dependency := range dependencies {
+		err := validateModuleDependency(module, dependency, seen)
+		if err != nil {
+			return errors.Join(fmt.Errorf("dependency %d (%s): %w", i, dependency.Module, err),
+				ErrInvalidModuleDependency)
+		}
+
+		seen[dependency.Module] = true
+	}
+
+	return nil
+}
+
+func validateModuleDependency(module string, dependency models.ModuleDependency, seen map[string]bool) error {
+	if !VersionlessModuleStringRegexp.MatchString(dependency.Module) {
+		return errors.New("module must be in the 'namespace:module' format")
+	}
+
+	if dependency.Module == module {
+		return errors.New("a module cannot depend on itself")
+	}
+
+	if seen[dependency.Module] {
+		return errors.New("module is declared more than once")
+	}
+
+	for _, path := range dependency.Paths {
+		_, err := parseJSONPointer(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SliceModuleData narrows the data of a module down to the values targeted by a list of JSON Pointers. The
+// structure of the data is preserved, so every value keeps its original path. Paths that target missing values are
+// ignored. When no path is given, or a path targets the document root, the whole data is returned.
+//
+// As with migrations, only objects can be traversed.
+func SliceModuleData(data map[string]any, paths []string) (map[string]any, error) {
+	if len(paths) == 0 {
+		return data, nil
+	}
+
+	// Work on a copy, so the slices never share values with the input.
+	normalized, err := normalizeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("normalize data: %w", err)
+	}
+
+	source, ok := normalized.(map[string]any)
+	if !ok {
+		return map[string]any{}, nil
+	}
+
+	output := map[string]any{}
+
+	for _, pointer := range paths {
+		var path []string
+
+		path, err = parseJSONPointer(pointer)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(path) == 0 {
+			return source, nil
+		}
+
+		value, ok := getJSONPointer(source, path)
+		if ok {
+			setJSONPointer(output, path, value)
+		}
+	}
+
+	return output, nil
+}
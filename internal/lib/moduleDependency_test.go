@@ -0,0 +1,180 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+)
+
+func TestValidateModuleDependencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		module       string
+		dependencies []models.ModuleDependency
+
+		expectErr error
+	}{
+		{
+			name: "NoDependencies",
+
+			module: "agora:concept",
+		},
+		{
+			name: "Valid",
+
+			module: "agora:concept",
+			dependencies: []models.ModuleDependency{
+				{Module: "agora:idea", Paths: []string{"/intent", "/targets/target_medium"}},
+				{Module: "agora:characters"},
+			},
+		},
+		{
+			name: "Error/VersionedModule",
+
+			module: "agora:concept",
+			dependencies: []models.ModuleDependency{
+				{Module: "agora:idea@v1.0.0"},
+			},
+
+			expectErr: lib.ErrInvalidModuleDependency,
+		},
+		{
+			name: "Error/InvalidModule",
+
+			module: "agora:concept",
+			dependencies: []models.ModuleDependency{
+				{Module: "idea"},
+			},
+
+			expectErr: lib.ErrInvalidModuleDependency,
+		},
+		{
+			name: "Error/SelfDependency",
+
+			module: "agora:concept",
+			dependencies: []models.ModuleDependency{
+				{Module: "agora:concept"},
+			},
+
+			expectErr: lib.ErrInvalidModuleDependency,
+		},
+		{
+			name: "Error/Duplicate",
+
+			module: "agora:concept",
+			dependencies: []models.ModuleDependency{
+				{Module: "agora:idea", Paths: []string{"/intent"}},
+				{Module: "agora:idea", Paths: []string{"/targets"}},
+			},
+
+			expectErr: lib.ErrInvalidModuleDependency,
+		},
+		{
+			name: "Error/InvalidPath",
+
+			module: "agora:concept",
+			dependencies: []models.ModuleDependency{
+				{Module: "agora:idea", Paths: []string{"intent"}},
+			},
+
+			expectErr: lib.ErrInvalidModuleDependency,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := lib.ValidateModuleDependencies(testCase.module, testCase.dependencies)
+			require.ErrorIs(t, err, testCase.expectErr)
+		})
+	}
+}
+
+func TestSliceModuleData(t *testing.T) {
+	t.Parallel()
+
+	data := map[string]any{
+		"targets": map[string]any{"target_medium": "FILM", "age_rating": "PG"},
+		"intent":  map[string]any{"audience_promise": "Space opera", "emotional_target": "awe"},
+		"tags":    []any{"scifi"},
+	}
+
+	testCases := []struct {
+		name string
+
+		paths []string
+
+		expect    map[string]any
+		expectErr bool
+	}{
+		{
+			name: "NoPaths",
+
+			expect: data,
+		},
+		{
+			name: "RootPath",
+
+			paths: []string{"/tags", ""},
+
+			expect: data,
+		},
+		{
+			name: "Slices",
+
+			paths: []string{"/intent/audience_promise", "/targets/target_medium", "/tags"},
+
+			expect: map[string]any{
+				"targets": map[string]any{"target_medium": "FILM"},
+				"intent":  map[string]any{"audience_promise": "Space opera"},
+				"tags":    []any{"scifi"},
+			},
+		},
+		{
+			name: "OverlappingPaths",
+
+			paths: []string{"/intent", "/intent/audience_promise"},
+
+			expect: map[string]any{
+				"intent": map[string]any{"audience_promise": "Space opera", "emotional_target": "awe"},
+			},
+		},
+		{
+			name: "MissingPaths",
+
+			paths: []string{"/concept/logline", "/tags/0"},
+
+			expect: map[string]any{},
+		},
+		{
+			name: "Error/InvalidPath",
+
+			paths: []string{"intent"},
+
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := lib.SliceModuleData(data, testCase.paths)
+			if testCase.expectErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expect, output)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"text/template"
+)
+
+// modulePromptVersionLength is the number of hexadecimal characters kept from the hash of a prompt template.
+const modulePromptVersionLength = 12
+
+// ParseModulePrompt parses the prompt template of a module.
+func ParseModulePrompt(prompt string) (*template.Template, error) {
+	return template.New("").Option("missingkey=zero").Parse(prompt)
+}
+
+// ModulePromptVersion identifies a prompt template by its content. Two generations made from the same template
+// share the same version.
+func ModulePromptVersion(prompt string) string {
+	sum := sha256.Sum256([]byte(prompt))
+
+	return hex.EncodeToString(sum[:])[:modulePromptVersionLength]
+}
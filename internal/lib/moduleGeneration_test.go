@@ -0,0 +1,19 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestModulePromptVersion(t *testing.T) {
+	t.Parallel()
+
+	version := lib.ModulePromptVersion("Context: {{.context}}")
+
+	require.Len(t, version, 12)
+	require.Equal(t, version, lib.ModulePromptVersion("Context: {{.context}}"))
+	require.NotEqual(t, version, lib.ModulePromptVersion("Context: {{.context}} "))
+}
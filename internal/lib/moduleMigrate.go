@@ -0,0 +1,169 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/a-novel/service-narrative-engine/internal/models"
+)
+
+var ErrInvalidModuleMigration = errors.New("invalid module migration")
+
+// MigrateModuleData applies the migration rules of a module to data written for an earlier version of that module.
+//
+// The input is never modified: rules are applied to a deep copy of the data, which is returned. Rules that target
+// a value that does not exist are skipped, so the same set of rules can be applied to data coming from any earlier
+// version. Only objects can be traversed: a path that goes through a non-object value is treated as missing.
+func MigrateModuleData(data map[string]any, migrations []models.ModuleMigration) (map[string]any, error) {
+	normalized, err := normalizeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("normalize data: %w", err)
+	}
+
+	output, ok := normalized.(map[string]any)
+	if !ok {
+		output = map[string]any{}
+	}
+
+	for i, migration := range migrations {
+		err = applyModuleMigration(output, migration)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("migration %d (%s %s): %w", i, migration.Op, migration.Path, err),
+				ErrInvalidModuleMigration)
+		}
+	}
+
+	return output, nil
+}
+
+// ValidateModuleMigrations checks the migration rules are well-formed, without applying them to any data.
+func ValidateModuleMigrations(migrations []models.ModuleMigration) error {
+	_, err := MigrateModuleData(map[string]any{}, migrations)
+
+	return err
+}
+
+func applyModuleMigration(data map[string]any, migration models.ModuleMigration) error {
+	path, err := parseJSONPointer(migration.Path)
+	if err != nil {
+		return err
+	}
+
+	if len(path) == 0 {
+		return errors.New("path cannot target the document root")
+	}
+
+	switch migration.Op {
+	case models.ModuleMigrationOpRename:
+		if migration.To == "" || strings.Contains(migration.To, "/") {
+			return errors.New("rename requires a property name as destination")
+		}
+
+		value, ok := removeJSONPointer(data, path)
+		if ok {
+			setJSONPointer(data, append(path[:len(path)-1:len(path)-1], migration.To), value)
+		}
+	case models.ModuleMigrationOpMove:
+		to, err := parseJSONPointer(migration.To)
+		if err != nil {
+			return fmt.Errorf("destination: %w", err)
+		}
+
+		if len(to) == 0 {
+			return errors.New("move requires a JSON Pointer as destination")
+		}
+
+		value, ok := removeJSONPointer(data, path)
+		if ok {
+			setJSONPointer(data, to, value)
+		}
+	case models.ModuleMigrationOpDefault:
+		if current, ok := getJSONPointer(data, path); !ok || current == nil {
+			value, err := normalizeJSON(migration.Value)
+			if err != nil {
+				return fmt.Errorf("normalize value: %w", err)
+			}
+
+			setJSONPointer(data, path, value)
+		}
+	case models.ModuleMigrationOpDrop:
+		removeJSONPointer(data, path)
+	default:
+		return fmt.Errorf("unknown operation %q", migration.Op)
+	}
+
+	return nil
+}
+
+// parseJSONPointer splits a JSON Pointer (RFC 6901) into its unescaped reference tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON Pointer %q: must start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// parentJSONPointer returns the object that holds the last token of path. When create is true, missing
+// intermediate objects are created along the way.
+func parentJSONPointer(data map[string]any, path []string, create bool) (map[string]any, bool) {
+	current := data
+
+	for _, token := range path[:len(path)-1] {
+		next, ok := current[token].(map[string]any)
+		if !ok {
+			if !create || (current[token] != nil) {
+				return nil, false
+			}
+
+			next = map[string]any{}
+			current[token] = next
+		}
+
+		current = next
+	}
+
+	return current, true
+}
+
+func getJSONPointer(data map[string]any, path []string) (any, bool) {
+	parent, ok := parentJSONPointer(data, path, false)
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := parent[path[len(path)-1]]
+
+	return value, ok
+}
+
+func removeJSONPointer(data map[string]any, path []string) (any, bool) {
+	parent, ok := parentJSONPointer(data, path, false)
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := parent[path[len(path)-1]]
+	if ok {
+		delete(parent, path[len(path)-1])
+	}
+
+	return value, ok
+}
+
+func setJSONPointer(data map[string]any, path []string, value any) {
+	parent, ok := parentJSONPointer(data, path, true)
+	if ok {
+		parent[path[len(path)-1]] = value
+	}
+}
@@ -0,0 +1,181 @@
+package lib_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+)
+
+func TestMigrateModuleData(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		data       map[string]any
+		migrations []models.ModuleMigration
+
+		expect    map[string]any
+		expectErr error
+	}{
+		{
+			name: "NoMigrations",
+
+			data: map[string]any{"title": "Dune", "tags": []string{"scifi"}},
+
+			expect: map[string]any{"title": "Dune", "tags": []any{"scifi"}},
+		},
+		{
+			name: "NilData",
+
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpDefault, Path: "/title", Value: "Untitled"},
+			},
+
+			expect: map[string]any{"title": "Untitled"},
+		},
+		{
+			name: "Rename",
+
+			data: map[string]any{"targets": map[string]any{"name": "Dune", "medium": "FILM"}},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpRename, Path: "/targets/name", To: "title"},
+			},
+
+			expect: map[string]any{"targets": map[string]any{"title": "Dune", "medium": "FILM"}},
+		},
+		{
+			name: "Move",
+
+			data: map[string]any{"title": "Dune", "targets": map[string]any{"medium": "FILM"}},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpMove, Path: "/title", To: "/meta/info/title"},
+				{Op: models.ModuleMigrationOpMove, Path: "/targets/medium", To: "/medium"},
+			},
+
+			expect: map[string]any{
+				"meta":    map[string]any{"info": map[string]any{"title": "Dune"}},
+				"targets": map[string]any{},
+				"medium":  "FILM",
+			},
+		},
+		{
+			name: "Default",
+
+			data: map[string]any{"title": "Dune", "rating": nil},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpDefault, Path: "/title", Value: "Untitled"},
+				{Op: models.ModuleMigrationOpDefault, Path: "/rating", Value: "PG"},
+				{Op: models.ModuleMigrationOpDefault, Path: "/targets/episodes", Value: 1},
+			},
+
+			expect: map[string]any{
+				"title":   "Dune",
+				"rating":  "PG",
+				"targets": map[string]any{"episodes": float64(1)},
+			},
+		},
+		{
+			name: "Drop",
+
+			data: map[string]any{"title": "Dune", "legacy": map[string]any{"foo": "bar"}},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpDrop, Path: "/legacy"},
+			},
+
+			expect: map[string]any{"title": "Dune"},
+		},
+		{
+			name: "MissingSourceIsSkipped",
+
+			data: map[string]any{"title": "Dune", "tags": []any{"scifi"}},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpRename, Path: "/name", To: "label"},
+				{Op: models.ModuleMigrationOpMove, Path: "/tags/0", To: "/tag"},
+				{Op: models.ModuleMigrationOpDrop, Path: "/title/foo"},
+			},
+
+			expect: map[string]any{"title": "Dune", "tags": []any{"scifi"}},
+		},
+		{
+			name: "EscapedPointer",
+
+			data: map[string]any{"a/b": "foo", "c~d": "bar"},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpRename, Path: "/a~1b", To: "ab"},
+				{Op: models.ModuleMigrationOpMove, Path: "/c~0d", To: "/e~1f"},
+			},
+
+			expect: map[string]any{"ab": "foo", "e/f": "bar"},
+		},
+		{
+			name: "Error/UnknownOp",
+
+			data: map[string]any{"title": "Dune"},
+			migrations: []models.ModuleMigration{
+				{Op: "copy", Path: "/title", To: "/name"},
+			},
+
+			expectErr: lib.ErrInvalidModuleMigration,
+		},
+		{
+			name: "Error/InvalidPointer",
+
+			data: map[string]any{"title": "Dune"},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpDrop, Path: "title"},
+			},
+
+			expectErr: lib.ErrInvalidModuleMigration,
+		},
+		{
+			name: "Error/RootPath",
+
+			data: map[string]any{"title": "Dune"},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpDrop, Path: ""},
+			},
+
+			expectErr: lib.ErrInvalidModuleMigration,
+		},
+		{
+			name: "Error/RenameToPointer",
+
+			data: map[string]any{"title": "Dune"},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpRename, Path: "/title", To: "/meta/title"},
+			},
+
+			expectErr: lib.ErrInvalidModuleMigration,
+		},
+		{
+			name: "Error/MoveWithoutDestination",
+
+			data: map[string]any{"title": "Dune"},
+			migrations: []models.ModuleMigration{
+				{Op: models.ModuleMigrationOpMove, Path: "/title"},
+			},
+
+			expectErr: lib.ErrInvalidModuleMigration,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			original := maps.Clone(testCase.data)
+
+			res, err := lib.MigrateModuleData(testCase.data, testCase.migrations)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			// The input must never be modified.
+			require.Equal(t, original, testCase.data)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package lib
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ModuleRangeCaret matches versions that do not modify the left-most non-zero component of the range.
+	// "^1.2" matches ">=1.2.0 <2.0.0", "^0.2" matches ">=0.2.0 <0.3.0".
+	ModuleRangeCaret = "^"
+	// ModuleRangeTilde matches versions that only modify the patch component of the range, when a minor version is
+	// given. "~1.2.0" and "~1.2" match ">=1.2.0 <1.3.0", "~1" matches ">=1.0.0 <2.0.0".
+	ModuleRangeTilde = "~"
+
+	ModuleRangeRegex = `[\^~][0-9]+(\.[0-9]+(\.[0-9]+)?)?`
+)
+
+var ModuleRangeStringRegexp = regexp.MustCompile(fmt.Sprintf(
+	`^(?P<namespace>%[1]s)%[3]s(?P<module>%[1]s)%[4]s(?P<range>%[2]s)$`,
+	ModuleNameRegex, ModuleRangeRegex,
+	ModuleNamespaceSeparator, ModuleVersionSeparator,
+))
+
+// IsModuleRange returns true if the module string targets a version range rather than an exact version.
+func IsModuleRange(module string) bool {
+	return ModuleRangeStringRegexp.MatchString(module)
+}
+
+// MatchModuleRange returns true if the given version satisfies the range. Only stable versions can satisfy a range:
+// a non-empty preversion never matches.
+func MatchModuleRange(versionRange, version, preversion string) bool {
+	if preversion != "" || len(versionRange) < 2 {
+		return false
+	}
+
+	operator, bound := versionRange[:1], versionRange[1:]
+	parts := strings.Split(bound, ".")
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		numbers[i], _ = strconv.Atoi(part)
+	}
+
+	lower := fmt.Sprintf("%d.%d.%d", numbers[0], numbers[1], numbers[2])
+
+	var upper string
+
+	switch {
+	case operator == ModuleRangeTilde && len(parts) > 1:
+		upper = fmt.Sprintf("%d.%d.0", numbers[0], numbers[1]+1)
+	case operator == ModuleRangeTilde:
+		upper = fmt.Sprintf("%d.0.0", numbers[0]+1)
+	case numbers[0] > 0 || len(parts) == 1:
+		upper = fmt.Sprintf("%d.0.0", numbers[0]+1)
+	case numbers[1] > 0 || len(parts) == 2:
+		upper = fmt.Sprintf("0.%d.0", numbers[1]+1)
+	default:
+		upper = fmt.Sprintf("0.0.%d", numbers[2]+1)
+	}
+
+	return CompareModuleVersions(version, lower) >= 0 && CompareModuleVersions(version, upper) < 0
+}
@@ -0,0 +1,81 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestIsModuleRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		input  string
+		expect bool
+	}{
+		{name: "CaretMajor", input: "ab:cd@^1", expect: true},
+		{name: "CaretMinor", input: "ab:cd@^1.2", expect: true},
+		{name: "CaretPatch", input: "ab:cd@^1.2.3", expect: true},
+		{name: "Tilde", input: "ab:cd@~1.2.0", expect: true},
+		{name: "ExactVersion", input: "ab:cd@v1.2.3", expect: false},
+		{name: "RangeWithPrefix", input: "ab:cd@^v1.2", expect: false},
+		{name: "RangeWithPreversion", input: "ab:cd@^1.2.0-beta", expect: false},
+		{name: "TooManyComponents", input: "ab:cd@^1.2.3.4", expect: false},
+		{name: "UnsupportedOperator", input: "ab:cd@>=1.2.0", expect: false},
+		{name: "MissingNamespace", input: "cd@^1.2", expect: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, lib.IsModuleRange(testCase.input))
+		})
+	}
+}
+
+func TestMatchModuleRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		rangeValue string
+		version    string
+		preversion string
+		expect     bool
+	}{
+		{name: "Caret/LowerBound", rangeValue: "^1.2", version: "1.2.0", expect: true},
+		{name: "Caret/SameMajor", rangeValue: "^1.2", version: "1.9.4", expect: true},
+		{name: "Caret/BelowLowerBound", rangeValue: "^1.2", version: "1.1.9", expect: false},
+		{name: "Caret/NextMajor", rangeValue: "^1.2", version: "2.0.0", expect: false},
+		{name: "Caret/MajorOnly", rangeValue: "^1", version: "1.0.0", expect: true},
+		{name: "Caret/PatchBound", rangeValue: "^1.2.3", version: "1.2.2", expect: false},
+		{name: "Caret/ZeroMajor", rangeValue: "^0.2", version: "0.2.5", expect: true},
+		{name: "Caret/ZeroMajorNextMinor", rangeValue: "^0.2", version: "0.3.0", expect: false},
+		{name: "Caret/ZeroMinor", rangeValue: "^0.0.3", version: "0.0.3", expect: true},
+		{name: "Caret/ZeroMinorNextPatch", rangeValue: "^0.0.3", version: "0.0.4", expect: false},
+		{name: "Caret/ZeroMajorOnly", rangeValue: "^0", version: "0.9.0", expect: true},
+		{name: "Tilde/SameMinor", rangeValue: "~1.2.0", version: "1.2.7", expect: true},
+		{name: "Tilde/NextMinor", rangeValue: "~1.2.0", version: "1.3.0", expect: false},
+		{name: "Tilde/MinorOnly", rangeValue: "~1.2", version: "1.2.0", expect: true},
+		{name: "Tilde/PatchBound", rangeValue: "~1.2.3", version: "1.2.1", expect: false},
+		{name: "Tilde/MajorOnly", rangeValue: "~1", version: "1.5.0", expect: true},
+		{name: "Tilde/MajorOnlyNextMajor", rangeValue: "~1", version: "2.0.0", expect: false},
+		{name: "Preversion", rangeValue: "^1.2", version: "1.3.0", preversion: "-beta", expect: false},
+		{name: "NumericNotLexicographic", rangeValue: "^1.2", version: "1.10.0", expect: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(
+				t, testCase.expect,
+				lib.MatchModuleRange(testCase.rangeValue, testCase.version, testCase.preversion),
+			)
+		})
+	}
+}
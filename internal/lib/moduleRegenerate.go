@@ -0,0 +1,194 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+)
+
+var ErrInvalidModulePath = errors.New("invalid module path")
+
+// JSONPointerRegexp matches JSON Pointers (RFC 6901). The empty pointer targets the whole document.
+var JSONPointerRegexp = regexp.MustCompile(`^(/([^~/]|~[01])*)*$`)
+
+// jsonPointerTree merges a list of JSON Pointers into a tree of reference tokens. A nil subtree means the whole value
+// at this path is targeted.
+type jsonPointerTree map[string]jsonPointerTree
+
+func newJSONPointerTree(pointers []string) (jsonPointerTree, bool, error) {
+	tree := jsonPointerTree{}
+
+	for _, pointer := range pointers {
+		path, err := parseJSONPointer(pointer)
+		if err != nil {
+			return nil, false, errors.Join(err, ErrInvalidModulePath)
+		}
+
+		// The document root is targeted, which includes every other path.
+		if len(path) == 0 {
+			return nil, true, nil
+		}
+
+		current := tree
+
+		for i, token := range path {
+			if i == len(path)-1 {
+				current[token] = nil
+
+				break
+			}
+
+			next, ok := current[token]
+			// A parent value is already targeted as a whole.
+			if ok && next == nil {
+				break
+			}
+
+			if !ok {
+				next = jsonPointerTree{}
+				current[token] = next
+			}
+
+			current = next
+		}
+	}
+
+	return tree, false, nil
+}
+
+// SliceModuleSchema narrows a module schema down to the subtrees targeted by a list of JSON Pointers, so content
+// can be generated for those parts only. The structure of the schema is preserved: each targeted subtree is kept
+// whole, along with the objects that lead to it. Those objects only keep the properties on the way to a target,
+// and all of them become required.
+//
+// As with module data, only object properties can be targeted. Unlike SliceModuleData, paths must exist in the
+// schema. The source schema is left untouched.
+func SliceModuleSchema(schema *jsonschema.Schema, paths []string) (*jsonschema.Schema, error) {
+	tree, root, err := newJSONPointerTree(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	output := schema.CloneSchemas()
+
+	if root || len(paths) == 0 {
+		return output, nil
+	}
+
+	err = sliceModuleSchema(output, tree, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func sliceModuleSchema(schema *jsonschema.Schema, tree jsonPointerTree, path string) error {
+	// Sort keys, so errors are deterministic.
+	keys := lo.Keys(tree)
+	slices.Sort(keys)
+
+	properties := make(map[string]*jsonschema.Schema, len(keys))
+
+	for _, key := range keys {
+		propertyPath := path + "/" + escapeJSONPointer(key)
+
+		property, ok := schema.Properties[key]
+		if !ok || property == nil {
+			return fmt.Errorf("%w: %s does not target a property of the schema", ErrInvalidModulePath, propertyPath)
+		}
+
+		if tree[key] != nil {
+			err := sliceModuleSchema(property, tree[key], propertyPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		properties[key] = property
+	}
+
+	schema.Properties = properties
+	schema.Required = keys
+
+	return nil
+}
+
+// OmitModuleData removes the values targeted by a list of JSON Pointers from the data of a module. Other values are
+// left as is. The source data is left untouched.
+func OmitModuleData(data map[string]any, paths []string) (map[string]any, error) {
+	output, err := cloneModuleData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pointer := range paths {
+		var path []string
+
+		path, err = parseJSONPointer(pointer)
+		if err != nil {
+			return nil, errors.Join(err, ErrInvalidModulePath)
+		}
+
+		if len(path) == 0 {
+			return map[string]any{}, nil
+		}
+
+		removeJSONPointer(output, path)
+	}
+
+	return output, nil
+}
+
+// MergeModuleData replaces the values targeted by a list of JSON Pointers in the data of a module, with the values
+// found at the same paths in the generated data. Every other value of the data is preserved.
+//
+// Targets that are missing or null in the generated data are removed. The source data is left untouched.
+func MergeModuleData(data, generated map[string]any, paths []string) (map[string]any, error) {
+	output, err := cloneModuleData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pointer := range paths {
+		var path []string
+
+		path, err = parseJSONPointer(pointer)
+		if err != nil {
+			return nil, errors.Join(err, ErrInvalidModulePath)
+		}
+
+		if len(path) == 0 {
+			return cloneModuleData(generated)
+		}
+
+		value, ok := getJSONPointer(generated, path)
+		if !ok || value == nil {
+			removeJSONPointer(output, path)
+
+			continue
+		}
+
+		setJSONPointer(output, path, value)
+	}
+
+	return output, nil
+}
+
+func cloneModuleData(data map[string]any) (map[string]any, error) {
+	normalized, err := normalizeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("normalize data: %w", err)
+	}
+
+	output, ok := normalized.(map[string]any)
+	if !ok {
+		return map[string]any{}, nil
+	}
+
+	return output, nil
+}
@@ -0,0 +1,338 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestSliceModuleSchema(t *testing.T) {
+	t.Parallel()
+
+	testSchema := func() *jsonschema.Schema {
+		return &jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"title": {Type: "string"},
+				"exploration": {
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"what_if": {Type: "string"},
+						"themes": {
+							Type:  "array",
+							Items: &jsonschema.Schema{Type: "string"},
+						},
+					},
+					Required: []string{"themes"},
+				},
+				"a/b": {Type: "string"},
+			},
+			Required: []string{"title"},
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		paths []string
+
+		expect    *jsonschema.Schema
+		expectErr error
+	}{
+		{
+			name: "NoPaths",
+
+			expect: testSchema(),
+		},
+		{
+			name: "Root",
+
+			paths: []string{"/title", ""},
+
+			expect: testSchema(),
+		},
+		{
+			name: "TopLevel",
+
+			paths: []string{"/title"},
+
+			expect: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"title": {Type: "string"},
+				},
+				Required: []string{"title"},
+			},
+		},
+		{
+			name: "Nested",
+
+			paths: []string{"/exploration/what_if"},
+
+			expect: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"exploration": {
+						Type: "object",
+						Properties: map[string]*jsonschema.Schema{
+							"what_if": {Type: "string"},
+						},
+						Required: []string{"what_if"},
+					},
+				},
+				Required: []string{"exploration"},
+			},
+		},
+		{
+			name: "Overlapping",
+
+			paths: []string{"/exploration/what_if", "/exploration", "/a~1b"},
+
+			expect: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"exploration": testSchema().Properties["exploration"],
+					"a/b":         {Type: "string"},
+				},
+				Required: []string{"a/b", "exploration"},
+			},
+		},
+		{
+			name: "Error/UnknownProperty",
+
+			paths: []string{"/exploration/unknown"},
+
+			expectErr: lib.ErrInvalidModulePath,
+		},
+		{
+			name: "Error/ArrayItem",
+
+			paths: []string{"/exploration/themes/0"},
+
+			expectErr: lib.ErrInvalidModulePath,
+		},
+		{
+			name: "Error/InvalidPointer",
+
+			paths: []string{"title"},
+
+			expectErr: lib.ErrInvalidModulePath,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := testSchema()
+
+			res, err := lib.SliceModuleSchema(source, testCase.paths)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			// Source is left untouched.
+			require.Equal(t, testSchema(), source)
+		})
+	}
+}
+
+func TestOmitModuleData(t *testing.T) {
+	t.Parallel()
+
+	testData := func() map[string]any {
+		return map[string]any{
+			"title": "The Lighthouse",
+			"exploration": map[string]any{
+				"what_if": "What if the light never went out?",
+				"themes":  []any{"solitude"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		paths []string
+
+		expect    map[string]any
+		expectErr error
+	}{
+		{
+			name: "NoPaths",
+
+			expect: testData(),
+		},
+		{
+			name: "Nested",
+
+			paths: []string{"/exploration/what_if", "/missing/value"},
+
+			expect: map[string]any{
+				"title": "The Lighthouse",
+				"exploration": map[string]any{
+					"themes": []any{"solitude"},
+				},
+			},
+		},
+		{
+			name: "Root",
+
+			paths: []string{""},
+
+			expect: map[string]any{},
+		},
+		{
+			name: "Error/InvalidPointer",
+
+			paths: []string{"title"},
+
+			expectErr: lib.ErrInvalidModulePath,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := testData()
+
+			res, err := lib.OmitModuleData(source, testCase.paths)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			// Source is left untouched.
+			require.Equal(t, testData(), source)
+		})
+	}
+}
+
+func TestMergeModuleData(t *testing.T) {
+	t.Parallel()
+
+	testData := func() map[string]any {
+		return map[string]any{
+			"title": "The Lighthouse",
+			"exploration": map[string]any{
+				"what_if": "What if the light never went out?",
+				"themes":  []any{"solitude"},
+			},
+			"extra": 1.0,
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		generated map[string]any
+		paths     []string
+
+		expect    map[string]any
+		expectErr error
+	}{
+		{
+			name: "Nested",
+
+			generated: map[string]any{
+				"title": "Ignored",
+				"exploration": map[string]any{
+					"what_if": "What if the keeper was a ghost?",
+					"themes":  []any{"ignored"},
+				},
+			},
+			paths: []string{"/exploration/what_if"},
+
+			expect: map[string]any{
+				"title": "The Lighthouse",
+				"exploration": map[string]any{
+					"what_if": "What if the keeper was a ghost?",
+					"themes":  []any{"solitude"},
+				},
+				"extra": 1.0,
+			},
+		},
+		{
+			name: "NewValue",
+
+			generated: map[string]any{
+				"characters": map[string]any{"protagonist": "Ada"},
+			},
+			paths: []string{"/characters/protagonist"},
+
+			expect: map[string]any{
+				"title": "The Lighthouse",
+				"exploration": map[string]any{
+					"what_if": "What if the light never went out?",
+					"themes":  []any{"solitude"},
+				},
+				"characters": map[string]any{"protagonist": "Ada"},
+				"extra":      1.0,
+			},
+		},
+		{
+			name: "NullValue",
+
+			generated: map[string]any{
+				"title": nil,
+			},
+			paths: []string{"/title", "/extra"},
+
+			expect: map[string]any{
+				"exploration": map[string]any{
+					"what_if": "What if the light never went out?",
+					"themes":  []any{"solitude"},
+				},
+			},
+		},
+		{
+			name: "Root",
+
+			generated: map[string]any{"title": "The Deep"},
+			paths:     []string{""},
+
+			expect: map[string]any{"title": "The Deep"},
+		},
+		{
+			name: "Error/InvalidPointer",
+
+			generated: map[string]any{"title": "The Deep"},
+			paths:     []string{"title"},
+
+			expectErr: lib.ErrInvalidModulePath,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := testData()
+
+			res, err := lib.MergeModuleData(source, testCase.generated, testCase.paths)
+			require.ErrorIs(t, err, testCase.expectErr)
+			require.Equal(t, testCase.expect, res)
+
+			// Source is left untouched.
+			require.Equal(t, testData(), source)
+		})
+	}
+}
+
+func TestJSONPointerRegexp(t *testing.T) {
+	t.Parallel()
+
+	for pointer, expect := range map[string]bool{
+		"":                     true,
+		"/":                    true,
+		"/title":               true,
+		"/exploration/what_if": true,
+		"/a~1b/c~0d":           true,
+		"title":                false,
+		"/a~2b":                false,
+		"/a~":                  false,
+	} {
+		require.Equal(t, expect, lib.JSONPointerRegexp.MatchString(pointer), pointer)
+	}
+}
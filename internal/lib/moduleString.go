@@ -1,8 +1,10 @@
 package lib
 
 import (
+	"cmp"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -30,6 +32,9 @@ type DecodedModule struct {
 	Module     string `json:"module"`
 	Version    string `json:"version"`
 	Preversion string `json:"preversion"`
+	// Range is set, instead of Version and Preversion, when the module string targets a version range
+	// (e.g., "^1.2" or "~1.2.0").
+	Range string `json:"range,omitempty"`
 }
 
 func (m DecodedModule) String() string {
@@ -40,18 +45,28 @@ func (m DecodedModule) String() string {
 		m.Module,
 	)
 
-	if m.Version != "" {
+	switch {
+	case m.Version != "":
 		str += fmt.Sprintf("%sv%s%s", ModuleVersionSeparator, m.Version, m.Preversion)
+	case m.Range != "":
+		str += ModuleVersionSeparator + m.Range
 	}
 
 	return str
 }
 
+// DecodeModule parses a module string. Both exact versions (ModuleStringRegexp) and version ranges
+// (ModuleRangeStringRegexp) are supported. An empty value is returned if the string matches neither format.
 func DecodeModule(module string) DecodedModule {
-	matches := ModuleStringRegexp.FindStringSubmatch(module)
+	pattern := ModuleStringRegexp
+	if !pattern.MatchString(module) {
+		pattern = ModuleRangeStringRegexp
+	}
+
+	matches := pattern.FindStringSubmatch(module)
 	result := DecodedModule{}
 
-	for i, name := range ModuleStringRegexp.SubexpNames() {
+	for i, name := range pattern.SubexpNames() {
 		if i == 0 || len(matches) <= i {
 			continue
 		}
@@ -65,6 +80,8 @@ func DecodeModule(module string) DecodedModule {
 			result.Version = matches[i]
 		case "preversion":
 			result.Preversion = matches[i]
+		case "range":
+			result.Range = matches[i]
 		}
 	}
 
@@ -97,3 +114,56 @@ func CompareModules(expect, value string) bool {
 
 	return value == expect
 }
+
+// CompareModuleVersions compares 2 module versions, in the `X.Y.Z` format, by their numeric components. It returns
+// -1 if a is lower than b, 1 if a is greater than b, and 0 if both versions are equal. Pre-versions are not taken
+// into account.
+func CompareModuleVersions(a, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+
+	for i := range max(len(partsA), len(partsB)) {
+		var numA, numB int
+
+		if i < len(partsA) {
+			numA, _ = strconv.Atoi(partsA[i])
+		}
+
+		if i < len(partsB) {
+			numB, _ = strconv.Atoi(partsB[i])
+		}
+
+		if res := cmp.Compare(numA, numB); res != 0 {
+			return res
+		}
+	}
+
+	return 0
+}
+
+// IsMajorModuleUpgrade returns true if moving from the previous version to the next one allows breaking changes.
+// This is the case when the major component is incremented or, while the major component is 0, when the minor
+// component is incremented. The same rule decides which versions a caret range (ModuleRangeCaret) accepts.
+func IsMajorModuleUpgrade(previous, next string) bool {
+	partsPrevious := strings.Split(previous, ".")
+	partsNext := strings.Split(next, ".")
+
+	var majorPrevious, majorNext, minorPrevious, minorNext int
+
+	majorPrevious, _ = strconv.Atoi(partsPrevious[0])
+	majorNext, _ = strconv.Atoi(partsNext[0])
+
+	if len(partsPrevious) > 1 {
+		minorPrevious, _ = strconv.Atoi(partsPrevious[1])
+	}
+
+	if len(partsNext) > 1 {
+		minorNext, _ = strconv.Atoi(partsNext[1])
+	}
+
+	if majorNext != majorPrevious {
+		return majorNext > majorPrevious
+	}
+
+	return majorNext == 0 && minorNext > minorPrevious
+}
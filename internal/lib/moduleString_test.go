@@ -281,6 +281,29 @@ func TestDecodeModule(t *testing.T) {
 				Preversion: "-1-2-3",
 			},
 		},
+		{
+			name:  "CaretRange",
+			input: "ab:cd@^1.2",
+			expect: lib.DecodedModule{
+				Namespace: "ab",
+				Module:    "cd",
+				Range:     "^1.2",
+			},
+		},
+		{
+			name:  "TildeRange",
+			input: "my-namespace:my-module@~1.2.0",
+			expect: lib.DecodedModule{
+				Namespace: "my-namespace",
+				Module:    "my-module",
+				Range:     "~1.2.0",
+			},
+		},
+		{
+			name:   "InvalidRange",
+			input:  "ab:cd@>=1.2",
+			expect: lib.DecodedModule{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -391,6 +414,15 @@ func TestDecodedModule_String(t *testing.T) {
 			},
 			expect: "anovel-core:story-engine@v2.15.7-beta-1",
 		},
+		{
+			name: "ModuleWithRange",
+			input: lib.DecodedModule{
+				Namespace: "ab",
+				Module:    "cd",
+				Range:     "^1.2",
+			},
+			expect: "ab:cd@^1.2",
+		},
 		{
 			name: "LongNamespaceAndModule",
 			input: lib.DecodedModule{
@@ -442,3 +474,81 @@ func TestDecodedModule_String(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareModuleVersions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		a      string
+		b      string
+		expect int
+	}{
+		{
+			name:   "Equal",
+			a:      "1.2.3",
+			b:      "1.2.3",
+			expect: 0,
+		},
+		{
+			name:   "LowerPatch",
+			a:      "1.2.3",
+			b:      "1.2.4",
+			expect: -1,
+		},
+		{
+			name:   "GreaterMinor",
+			a:      "1.3.0",
+			b:      "1.2.9",
+			expect: 1,
+		},
+		{
+			name:   "NumericNotLexicographic",
+			a:      "1.10.0",
+			b:      "1.9.0",
+			expect: 1,
+		},
+		{
+			name:   "LowerMajor",
+			a:      "1.99.99",
+			b:      "2.0.0",
+			expect: -1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, lib.CompareModuleVersions(testCase.a, testCase.b))
+		})
+	}
+}
+
+func TestIsMajorModuleUpgrade(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		previous string
+		next     string
+		expect   bool
+	}{
+		{name: "Patch", previous: "1.2.3", next: "1.2.4", expect: false},
+		{name: "Minor", previous: "1.2.3", next: "1.3.0", expect: false},
+		{name: "Major", previous: "1.2.3", next: "2.0.0", expect: true},
+		{name: "NumericNotLexicographic", previous: "9.0.0", next: "10.0.0", expect: true},
+		{name: "Downgrade", previous: "2.0.0", next: "1.0.0", expect: false},
+		{name: "ZeroMajor/Minor", previous: "0.2.3", next: "0.3.0", expect: true},
+		{name: "ZeroMajor/Patch", previous: "0.2.3", next: "0.2.4", expect: false},
+		{name: "ZeroMajor/ToStable", previous: "0.9.0", next: "1.0.0", expect: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, lib.IsMajorModuleUpgrade(testCase.previous, testCase.next))
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package lib
+
+import (
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+)
+
+// MergeTranslatedModuleData returns the translation of a module data, made to fit the shape of the source data.
+//
+// Models are only trusted with text: the structure of the source data is kept as is, and translated values are only
+// used where they replace a source value of the same type. Values the model added are discarded, and values it left
+// out, or changed the type of, are taken from the source data. Arrays whose length changed are kept from the source
+// data as a whole.
+//
+// Values restricted by an enum or a const keyword in the source schema are never translated. Under anyOf, objects
+// and arrays follow the first branch of their type, and other values are kept when any branch restricts them. The
+// source and translated data are left untouched.
+func MergeTranslatedModuleData(schema *jsonschema.Schema, source, translated map[string]any) map[string]any {
+	output, _ := mergeTranslatedModuleData(schema, source, translated).(map[string]any)
+
+	return output
+}
+
+func mergeTranslatedModuleData(schema *jsonschema.Schema, source, translated any) any {
+	switch typed := source.(type) {
+	case map[string]any:
+		schema = translatedModuleDataBranch(schema, "object")
+		translatedMap, _ := translated.(map[string]any)
+
+		output := make(map[string]any, len(typed))
+
+		for key, item := range typed {
+			var propertySchema *jsonschema.Schema
+			if schema != nil {
+				propertySchema = schema.Properties[key]
+			}
+
+			translatedItem, ok := translatedMap[key]
+			if !ok {
+				output[key] = item
+
+				continue
+			}
+
+			output[key] = mergeTranslatedModuleData(propertySchema, item, translatedItem)
+		}
+
+		return output
+	case []any:
+		schema = translatedModuleDataBranch(schema, "array")
+		translatedSlice, _ := translated.([]any)
+
+		if len(translatedSlice) != len(typed) {
+			return typed
+		}
+
+		output := make([]any, len(typed))
+
+		for i, item := range typed {
+			var itemSchema *jsonschema.Schema
+
+			switch {
+			case schema == nil:
+			case i < len(schema.ItemsArray):
+				itemSchema = schema.ItemsArray[i]
+			case schema.Items != nil:
+				itemSchema = schema.Items
+			}
+
+			output[i] = mergeTranslatedModuleData(itemSchema, item, translatedSlice[i])
+		}
+
+		return output
+	case string:
+		translatedString, ok := translated.(string)
+		if !ok || isFixedModuleValue(schema) {
+			return typed
+		}
+
+		return translatedString
+	}
+
+	// Numbers, booleans and nulls have nothing to translate.
+	return source
+}
+
+// translatedModuleDataBranch returns the branch of an anyOf schema that describes values of the given type.
+func translatedModuleDataBranch(schema *jsonschema.Schema, valueType string) *jsonschema.Schema {
+	if schema == nil || len(schema.AnyOf) == 0 {
+		return schema
+	}
+
+	branch, _ := lo.Find(schema.AnyOf, func(item *jsonschema.Schema) bool {
+		return item != nil && (item.Type == valueType || lo.Contains(item.Types, valueType))
+	})
+
+	return branch
+}
+
+// isFixedModuleValue returns true if a schema restricts its values to a fixed list, which must not be translated.
+func isFixedModuleValue(schema *jsonschema.Schema) bool {
+	if schema == nil {
+		return false
+	}
+
+	if schema.Enum != nil || schema.Const != nil {
+		return true
+	}
+
+	return lo.SomeBy(schema.AnyOf, isFixedModuleValue)
+}
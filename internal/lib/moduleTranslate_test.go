@@ -0,0 +1,139 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+func TestMergeTranslatedModuleData(t *testing.T) {
+	t.Parallel()
+
+	testSchema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title":  {Type: "string"},
+			"medium": {Type: "string", Enum: []any{"FILM", "NOVEL"}},
+			"themes": {Type: "array", Items: &jsonschema.Schema{Type: "string"}},
+			"count":  {Type: "number"},
+			"setting": {
+				AnyOf: []*jsonschema.Schema{
+					{Type: "string", Enum: []any{"UNKNOWN"}},
+					{
+						Type: "object",
+						Properties: map[string]*jsonschema.Schema{
+							"place":  {Type: "string"},
+							"period": {Type: "string", Enum: []any{"PAST", "FUTURE"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		source     map[string]any
+		translated map[string]any
+
+		expect map[string]any
+	}{
+		{
+			name: "Translated",
+
+			source: map[string]any{
+				"title":  "The Lighthouse",
+				"themes": []any{"solitude", "memory"},
+			},
+			translated: map[string]any{
+				"title":  "Le Phare",
+				"themes": []any{"solitude", "mémoire"},
+			},
+
+			expect: map[string]any{
+				"title":  "Le Phare",
+				"themes": []any{"solitude", "mémoire"},
+			},
+		},
+		{
+			name: "Enum",
+
+			source:     map[string]any{"title": "The Lighthouse", "medium": "NOVEL"},
+			translated: map[string]any{"title": "Le Phare", "medium": "ROMAN"},
+
+			expect: map[string]any{"title": "Le Phare", "medium": "NOVEL"},
+		},
+		{
+			name: "Shape",
+
+			source: map[string]any{
+				"title":  "The Lighthouse",
+				"themes": []any{"solitude", "memory"},
+				"count":  2.0,
+			},
+			translated: map[string]any{
+				"themes": []any{"solitude"},
+				"count":  3.0,
+				"medium": "FILM",
+			},
+
+			expect: map[string]any{
+				"title":  "The Lighthouse",
+				"themes": []any{"solitude", "memory"},
+				"count":  2.0,
+			},
+		},
+		{
+			name: "Type",
+
+			source:     map[string]any{"title": "The Lighthouse"},
+			translated: map[string]any{"title": nil},
+
+			expect: map[string]any{"title": "The Lighthouse"},
+		},
+		{
+			name: "AnyOf/Object",
+
+			source: map[string]any{
+				"setting": map[string]any{"place": "A lighthouse", "period": "PAST"},
+			},
+			translated: map[string]any{
+				"setting": map[string]any{"place": "Un phare", "period": "PASSÉ"},
+			},
+
+			expect: map[string]any{
+				"setting": map[string]any{"place": "Un phare", "period": "PAST"},
+			},
+		},
+		{
+			name: "AnyOf/Enum",
+
+			source:     map[string]any{"setting": "UNKNOWN"},
+			translated: map[string]any{"setting": "INCONNU"},
+
+			expect: map[string]any{"setting": "UNKNOWN"},
+		},
+		{
+			name: "UnknownProperties",
+
+			source:     map[string]any{"notes": "Draft"},
+			translated: map[string]any{"notes": "Brouillon"},
+
+			expect: map[string]any{"notes": "Brouillon"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(
+				t, testCase.expect, lib.MergeTranslatedModuleData(testSchema, testCase.source, testCase.translated),
+			)
+		})
+	}
+}
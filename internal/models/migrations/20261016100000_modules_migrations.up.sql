@@ -0,0 +1,4 @@
+-- Declarative rules used to carry data written for earlier versions of a module forward, when a project upgrades
+-- to this version.
+ALTER TABLE modules
+ADD COLUMN migrations json;
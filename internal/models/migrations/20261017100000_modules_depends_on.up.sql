@@ -0,0 +1,4 @@
+-- Other modules of the project workflow whose data is relevant to a module, optionally narrowed to parts of their
+-- data. Only the data of those modules is sent as context when generating content.
+ALTER TABLE modules
+ADD COLUMN depends_on json;
@@ -0,0 +1,4 @@
+-- Module specific generation settings (prompt template, model, sampling parameters, extra instructions). Default
+-- settings are used when empty.
+ALTER TABLE modules
+ADD COLUMN generation json;
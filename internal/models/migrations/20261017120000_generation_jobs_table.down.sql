@@ -0,0 +1,8 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_generation_jobs_pending;
+
+-- Drop the generation jobs table
+DROP TABLE IF EXISTS generation_jobs;
+
+-- Drop the enum type
+DROP TYPE IF EXISTS generation_job_status;
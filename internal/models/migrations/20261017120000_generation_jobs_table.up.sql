@@ -0,0 +1,30 @@
+-- Create enum type for the status of generation jobs
+CREATE TYPE generation_job_status AS ENUM('QUEUED', 'RUNNING', 'SUCCEEDED', 'FAILED');
+
+CREATE TABLE generation_jobs (
+  id uuid PRIMARY KEY,
+  -- The project the schema is generated for.
+  project_id uuid NOT NULL,
+  -- User who requested the generation.
+  owner uuid NOT NULL,
+  -- The module to generate, as requested. It may be a version range, resolved when the job runs.
+  module text NOT NULL,
+  -- Lang of the generated content, ISO 639-1.
+  lang varchar(5) NOT NULL,
+  status generation_job_status NOT NULL,
+  -- Number of times the job was claimed by a worker.
+  attempts integer NOT NULL DEFAULT 0,
+  -- The schema created by a successful job.
+  schema_id uuid,
+  -- The reason a job failed.
+  error text,
+  created_at timestamp(0) with time zone NOT NULL,
+  updated_at timestamp(0) with time zone NOT NULL,
+  started_at timestamp(0) with time zone,
+  finished_at timestamp(0) with time zone
+);
+
+-- Index for workers looking for jobs to claim. Only unfinished jobs are indexed.
+CREATE INDEX idx_generation_jobs_pending ON generation_jobs (created_at)
+WHERE
+  status IN ('QUEUED', 'RUNNING');
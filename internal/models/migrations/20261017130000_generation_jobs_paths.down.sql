@@ -0,0 +1,2 @@
+ALTER TABLE generation_jobs
+DROP COLUMN IF EXISTS paths;
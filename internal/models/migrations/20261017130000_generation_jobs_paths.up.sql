@@ -0,0 +1,3 @@
+-- JSON Pointers to the values to regenerate. The whole module is generated when empty.
+ALTER TABLE generation_jobs
+ADD COLUMN paths text[];
@@ -0,0 +1,5 @@
+ALTER TABLE generation_jobs
+DROP COLUMN IF EXISTS instructions;
+
+ALTER TABLE schemas
+DROP COLUMN IF EXISTS instructions;
@@ -0,0 +1,6 @@
+-- Free-text instructions given by the user to steer the generation of a schema version.
+ALTER TABLE schemas
+ADD COLUMN instructions text;
+
+ALTER TABLE generation_jobs
+ADD COLUMN instructions text;
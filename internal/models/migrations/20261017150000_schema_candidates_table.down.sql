@@ -0,0 +1,5 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_schema_candidates_module;
+
+-- Drop the schema candidates table
+DROP TABLE IF EXISTS schema_candidates;
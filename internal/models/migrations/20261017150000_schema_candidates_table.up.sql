@@ -0,0 +1,25 @@
+CREATE TABLE schema_candidates (
+  id uuid PRIMARY KEY,
+  -- The project the candidate was generated for.
+  project_id uuid NOT NULL,
+  -- User who requested the generation.
+  owner uuid NOT NULL,
+  -- The module used to generate the candidate.
+  module_id text NOT NULL,
+  module_namespace text NOT NULL,
+  module_version text NOT NULL,
+  module_preversion text NOT NULL DEFAULT '',
+  -- Free-text instructions given by the user to steer the generation.
+  instructions text,
+  -- The generated content. It becomes a schema version once the candidate is promoted.
+  data jsonb NOT NULL,
+  created_at timestamp(0) with time zone NOT NULL
+);
+
+-- Candidates are always looked up by the module of a project they were generated for.
+CREATE INDEX idx_schema_candidates_module ON schema_candidates (
+  project_id,
+  module_id,
+  module_namespace,
+  created_at
+);
@@ -0,0 +1,5 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_schema_generations_project;
+
+-- Drop the schema generations table
+DROP TABLE IF EXISTS schema_generations;
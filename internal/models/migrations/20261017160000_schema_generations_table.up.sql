@@ -0,0 +1,25 @@
+CREATE TABLE schema_generations (
+  -- The schema version the output was saved as. Outputs saved as candidates use the id of the candidate, which
+  -- becomes the id of the schema version once promoted.
+  schema_id uuid PRIMARY KEY,
+  -- The project the output was generated for.
+  project_id uuid NOT NULL,
+  -- The model that generated the output.
+  model text NOT NULL,
+  -- The messages sent to the model.
+  messages jsonb NOT NULL,
+  -- Identifies the prompt template used to build the messages.
+  template_version text NOT NULL,
+  prompt_tokens bigint NOT NULL,
+  completion_tokens bigint NOT NULL,
+  -- Time spent waiting for the model, in milliseconds.
+  latency_ms bigint NOT NULL,
+  -- The schema versions given to the model as context.
+  context_schema_ids jsonb NOT NULL,
+  -- The schema version whose data was given to the model to complete, if any.
+  base_schema_id uuid,
+  created_at timestamp(0) with time zone NOT NULL
+);
+
+-- Index for project-wide operations.
+CREATE INDEX idx_schema_generations_project ON schema_generations (project_id);
@@ -0,0 +1,8 @@
+ALTER TABLE generation_jobs
+DROP COLUMN IF EXISTS roles;
+
+-- Drop index first
+DROP INDEX IF EXISTS idx_token_usages_user;
+
+-- Drop the token usages table
+DROP TABLE IF EXISTS token_usages;
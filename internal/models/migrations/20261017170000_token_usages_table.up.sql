@@ -0,0 +1,21 @@
+-- Tokens spent on AI generation. Entries are kept when their project is deleted, so deleting a project does not
+-- restore the quota of its owner.
+CREATE TABLE token_usages (
+  id uuid PRIMARY KEY,
+  -- The user the tokens are billed to.
+  user_id uuid NOT NULL,
+  -- The project the tokens were spent for.
+  project_id uuid NOT NULL,
+  -- The model that consumed the tokens.
+  model text NOT NULL,
+  prompt_tokens bigint NOT NULL,
+  completion_tokens bigint NOT NULL,
+  created_at timestamp(0) with time zone NOT NULL
+);
+
+-- Index for computing the usage of a user over a period.
+CREATE INDEX idx_token_usages_user ON token_usages (user_id, created_at);
+
+-- Roles of the user who requested the generation, used to enforce their quota when the job runs.
+ALTER TABLE generation_jobs
+ADD COLUMN roles text[];
@@ -0,0 +1,2 @@
+ALTER TABLE schema_generations
+DROP COLUMN IF EXISTS context_cuts;
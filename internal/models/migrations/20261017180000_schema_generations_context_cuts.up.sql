@@ -0,0 +1,3 @@
+-- The parts of the context that were truncated or dropped to fit the budget of the model.
+ALTER TABLE schema_generations
+ADD COLUMN context_cuts jsonb NOT NULL DEFAULT '[]';
@@ -0,0 +1,8 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_project_members_user;
+
+-- Drop the project members table
+DROP TABLE IF EXISTS project_members;
+
+-- Drop the enum type
+DROP TYPE IF EXISTS project_member_role;
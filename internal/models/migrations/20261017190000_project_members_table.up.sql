@@ -0,0 +1,15 @@
+-- Create enum type for the roles of project members
+CREATE TYPE project_member_role AS ENUM('VIEWER', 'EDITOR', 'GENERATOR', 'ADMIN');
+
+CREATE TABLE project_members (
+  project_id uuid NOT NULL,
+  -- The user the project is shared with. The owner of the project is never a member.
+  user_id uuid NOT NULL,
+  role project_member_role NOT NULL,
+  created_at timestamp(0) with time zone NOT NULL,
+  updated_at timestamp(0) with time zone NOT NULL,
+  PRIMARY KEY (project_id, user_id)
+);
+
+-- Index for listing the projects shared with a user.
+CREATE INDEX idx_project_members_user ON project_members (user_id);
@@ -0,0 +1,5 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_project_shares_project;
+
+-- Drop the project shares table
+DROP TABLE IF EXISTS project_shares;
@@ -0,0 +1,14 @@
+CREATE TABLE project_shares (
+  id uuid PRIMARY KEY,
+  project_id uuid NOT NULL,
+  -- SHA-256 hash of the share token. The token itself is only returned once, to the owner who creates the share.
+  token_hash text NOT NULL UNIQUE,
+  -- Modules the share gives access to, in the "namespace:module" format. The whole project is shared when null.
+  modules text[],
+  expires_at timestamp(0) with time zone,
+  revoked_at timestamp(0) with time zone,
+  created_at timestamp(0) with time zone NOT NULL
+);
+
+-- Index for listing the shares of a project.
+CREATE INDEX idx_project_shares_project ON project_shares (project_id);
@@ -0,0 +1,5 @@
+ALTER TABLE schemas
+DROP COLUMN IF EXISTS forked_from;
+
+ALTER TABLE projects
+DROP COLUMN IF EXISTS forked_from;
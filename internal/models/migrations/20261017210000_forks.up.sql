@@ -0,0 +1,7 @@
+-- The project a fork was copied from. Forks are independent, so the origin project may have been deleted since.
+ALTER TABLE projects
+ADD COLUMN forked_from uuid;
+
+-- The schema a forked schema was copied from, in the origin project.
+ALTER TABLE schemas
+ADD COLUMN forked_from uuid;
@@ -0,0 +1,2 @@
+-- Drop the project snapshots table
+DROP TABLE IF EXISTS project_snapshots;
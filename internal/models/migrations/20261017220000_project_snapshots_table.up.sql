@@ -0,0 +1,12 @@
+CREATE TABLE project_snapshots (
+  id uuid PRIMARY KEY,
+  project_id uuid NOT NULL,
+  -- The user who took the snapshot.
+  owner uuid NOT NULL,
+  name text NOT NULL,
+  -- IDs of the latest schema version of each module of the project, when the snapshot was taken.
+  schema_ids jsonb NOT NULL,
+  created_at timestamp(0) with time zone NOT NULL,
+  -- Snapshots are referred to by name, so names must be unique within a project.
+  CONSTRAINT project_snapshots_name_unique UNIQUE (project_id, name)
+);
@@ -10,3 +10,67 @@ type ModuleUi struct {
 	// Optional target field where the ui editable content will be written. Leave empty for passing through.
 	Target string `json:"target"`
 }
+
+type ModuleMigrationOp string
+
+const (
+	// ModuleMigrationOpRename renames the property at Path, keeping it under the same parent. To holds the new name.
+	ModuleMigrationOpRename ModuleMigrationOp = "rename"
+	// ModuleMigrationOpMove moves the value at Path to the JSON Pointer in To.
+	ModuleMigrationOpMove ModuleMigrationOp = "move"
+	// ModuleMigrationOpDefault sets Value at Path, if no value is present.
+	ModuleMigrationOpDefault ModuleMigrationOp = "default"
+	// ModuleMigrationOpDrop removes the value at Path.
+	ModuleMigrationOpDrop ModuleMigrationOp = "drop"
+)
+
+func (op ModuleMigrationOp) String() string {
+	return string(op)
+}
+
+var KnownModuleMigrationOps = []ModuleMigrationOp{
+	ModuleMigrationOpRename,
+	ModuleMigrationOpMove,
+	ModuleMigrationOpDefault,
+	ModuleMigrationOpDrop,
+}
+
+// ModuleMigration is a declarative rule, shipped with a module version, that carries data written for an earlier
+// version of the module forward. Rules are applied in order, and are no-ops when the data they target is absent.
+type ModuleMigration struct {
+	// The operation to perform.
+	Op ModuleMigrationOp `json:"op"`
+	// JSON Pointer to the value the operation applies to.
+	Path string `json:"path"`
+	// Destination of the operation: a property name for renames, a JSON Pointer for moves.
+	To string `json:"to,omitempty"`
+	// Value to set, for defaults.
+	Value any `json:"value,omitempty"`
+}
+
+// ModuleDependency declares another module of the project workflow whose data is relevant to a module. When
+// generating content for the module, only the data of its dependencies is sent as context.
+type ModuleDependency struct {
+	// Version-less reference to the module, in the "namespace:module" format. The version used is the one of the
+	// project workflow.
+	Module string `json:"module"`
+	// JSON Pointers narrowing the data of the module to the relevant parts. The whole data is used when empty.
+	Paths []string `json:"paths,omitempty"`
+}
+
+// ModuleGeneration overrides the default settings used to generate content for a module. Every setting is optional,
+// and falls back to the service defaults when left empty.
+type ModuleGeneration struct {
+	// Go template of the user prompt, replacing the default one. It receives the JSON encoded context as .context,
+	// and the JSON encoded prefilled data (if any) as .prefilled. Instructions given by the user for the generation
+	// are sent in a separate message.
+	Prompt string `json:"prompt,omitempty" validate:"max=8192,modulePrompt"`
+	// Extra system instructions, sent after the default system prompt.
+	Instructions string `json:"instructions,omitempty" validate:"max=8192"`
+	// The model used for generation.
+	Model string `json:"model,omitempty" validate:"max=128"`
+	// Sampling temperature, between 0 and 2.
+	Temperature *float64 `json:"temperature,omitempty" validate:"omitnil,min=0,max=2"`
+	// Maximum number of tokens the model can generate.
+	MaxTokens *int64 `json:"maxTokens,omitempty" validate:"omitnil,min=1,max=32768"`
+}
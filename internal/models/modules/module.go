@@ -16,6 +16,12 @@ type SystemModule struct {
 	Description string            `yaml:"description"`
 	Schema      jsonschema.Schema `yaml:"schema"`
 	UI          models.ModuleUi   `yaml:"ui"`
+	// Migrations carry the data of projects upgrading from an earlier version of the module forward.
+	Migrations []models.ModuleMigration `yaml:"migrations"`
+	// DependsOn lists the modules whose data is sent as context when generating content for the module.
+	DependsOn []models.ModuleDependency `yaml:"depends_on"`
+	// Generation overrides the default settings used to generate content for the module.
+	Generation *models.ModuleGeneration `yaml:"generation"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -39,11 +45,14 @@ func (module *SystemModule) UnmarshalYAML(data []byte) error {
 	}
 
 	var final struct {
-		ID          string            `json:"id"`
-		Namespace   string            `json:"namespace"`
-		Description string            `json:"description"`
-		Schema      jsonschema.Schema `json:"schema"`
-		UI          models.ModuleUi   `json:"ui"`
+		ID          string                    `json:"id"`
+		Namespace   string                    `json:"namespace"`
+		Description string                    `json:"description"`
+		Schema      jsonschema.Schema         `json:"schema"`
+		UI          models.ModuleUi           `json:"ui"`
+		Migrations  []models.ModuleMigration  `json:"migrations"`
+		DependsOn   []models.ModuleDependency `json:"depends_on"`
+		Generation  *models.ModuleGeneration  `json:"generation"`
 	}
 
 	err = json.Unmarshal(initMarshalled, &final)
@@ -56,6 +65,9 @@ func (module *SystemModule) UnmarshalYAML(data []byte) error {
 	module.Description = final.Description
 	module.Schema = final.Schema
 	module.UI = final.UI
+	module.Migrations = final.Migrations
+	module.DependsOn = final.DependsOn
+	module.Generation = final.Generation
 
 	return nil
 }
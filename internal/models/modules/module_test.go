@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/goccy/go-yaml"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/a-novel/service-narrative-engine/internal/models"
@@ -199,6 +200,64 @@ ui:
 				UI:          models.ModuleUi{},
 			},
 		},
+		{
+			name: "WithDependencies",
+			input: `
+id: concept
+namespace: test
+description: Test dependencies
+schema:
+  type: object
+  properties:
+    logline:
+      type: string
+depends_on:
+  - module: test:idea
+    paths:
+      - /intent
+      - /targets/target_medium
+  - module: test:characters
+`,
+			expect: modules.SystemModule{
+				ID:          "concept",
+				Namespace:   "test",
+				Description: "Test dependencies",
+				DependsOn: []models.ModuleDependency{
+					{Module: "test:idea", Paths: []string{"/intent", "/targets/target_medium"}},
+					{Module: "test:characters"},
+				},
+			},
+		},
+		{
+			name: "WithGeneration",
+			input: `
+id: beats
+namespace: test
+description: Test generation
+schema:
+  type: object
+generation:
+  prompt: |
+    Split the story into beats.
+    {{.context}}
+  instructions: Beats must be short.
+  model: gpt-4o-mini
+  temperature: 0.3
+  maxTokens: 4096
+`,
+			expect: modules.SystemModule{
+				ID:          "beats",
+				Namespace:   "test",
+				Description: "Test generation",
+				Generation: &models.ModuleGeneration{
+					Prompt:       "Split the story into beats.\n{{.context}}\n",
+					Instructions: "Beats must be short.",
+					Model:        "gpt-4o-mini",
+					Temperature:  lo.ToPtr(0.3),
+					MaxTokens:    lo.ToPtr[int64](4096),
+				},
+			},
+		},
 		{
 			name: "MultilineDescription",
 			input: `
@@ -235,6 +294,8 @@ schema:
 			require.Equal(t, tc.expect.Namespace, module.Namespace)
 			require.Equal(t, tc.expect.Description, module.Description)
 			require.Equal(t, tc.expect.UI, module.UI)
+			require.Equal(t, tc.expect.DependsOn, module.DependsOn)
+			require.Equal(t, tc.expect.Generation, module.Generation)
 		})
 	}
 }
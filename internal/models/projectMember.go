@@ -0,0 +1,21 @@
+package models
+
+type ProjectMemberRole string
+
+const (
+	ProjectMemberRoleViewer    ProjectMemberRole = "VIEWER"
+	ProjectMemberRoleEditor    ProjectMemberRole = "EDITOR"
+	ProjectMemberRoleGenerator ProjectMemberRole = "GENERATOR"
+	ProjectMemberRoleAdmin     ProjectMemberRole = "ADMIN"
+)
+
+func (role ProjectMemberRole) String() string {
+	return string(role)
+}
+
+var KnownProjectMemberRoles = []ProjectMemberRole{
+	ProjectMemberRoleViewer,
+	ProjectMemberRoleEditor,
+	ProjectMemberRoleGenerator,
+	ProjectMemberRoleAdmin,
+}
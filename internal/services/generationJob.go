@@ -0,0 +1,53 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+var ErrUserDoesNotOwnGenerationJob = errors.New("user is not the owner of this generation job")
+
+type GenerationJob struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	Owner     uuid.UUID
+	Module    string
+	Lang      string
+	Paths     []string
+	// Instructions are free-text instructions from the user, to steer the generation.
+	Instructions string
+	Status       string
+	Attempts     int
+	// SchemaID is the schema created by a successful job.
+	SchemaID *uuid.UUID
+	// Error is the reason a job failed. It is meant for diagnosis, and should not be exposed to users.
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+func loadGenerationJob(job *dao.GenerationJob) *GenerationJob {
+	return &GenerationJob{
+		ID:           job.ID,
+		ProjectID:    job.ProjectID,
+		Owner:        job.Owner,
+		Module:       job.Module,
+		Lang:         job.Lang,
+		Paths:        job.Paths,
+		Instructions: job.Instructions,
+		Status:       job.Status.String(),
+		Attempts:     job.Attempts,
+		SchemaID:     job.SchemaID,
+		Error:        job.Error,
+		CreatedAt:    job.CreatedAt,
+		UpdatedAt:    job.UpdatedAt,
+		StartedAt:    job.StartedAt,
+		FinishedAt:   job.FinishedAt,
+	}
+}
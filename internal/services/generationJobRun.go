@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+var errGenerationJobTooManyAttempts = errors.New("job was abandoned too many times")
+
+type GenerationJobRunRepositoryClaim interface {
+	Exec(ctx context.Context, request *dao.GenerationJobClaimRequest) (*dao.GenerationJob, error)
+}
+
+type GenerationJobRunRepositoryFinish interface {
+	Exec(ctx context.Context, request *dao.GenerationJobFinishRequest) (*dao.GenerationJob, error)
+}
+
+type GenerationJobRunServiceSchemaGenerate interface {
+	Exec(ctx context.Context, request *SchemaGenerateRequest) (*Schema, error)
+}
+
+type GenerationJobRunRequest struct {
+	// Timeout is the maximum duration of a job. Jobs that run longer are considered abandoned, and can be claimed
+	// again.
+	Timeout time.Duration `validate:"required,min=1s"`
+	// MaxAttempts is the maximum number of times a job can be claimed.
+	MaxAttempts int `validate:"required,min=1"`
+}
+
+type GenerationJobRun struct {
+	claimRepository       GenerationJobRunRepositoryClaim
+	finishRepository      GenerationJobRunRepositoryFinish
+	schemaGenerateService GenerationJobRunServiceSchemaGenerate
+}
+
+func NewGenerationJobRun(
+	claimRepository GenerationJobRunRepositoryClaim,
+	finishRepository GenerationJobRunRepositoryFinish,
+	schemaGenerateService GenerationJobRunServiceSchemaGenerate,
+) *GenerationJobRun {
+	return &GenerationJobRun{
+		claimRepository:       claimRepository,
+		finishRepository:      finishRepository,
+		schemaGenerateService: schemaGenerateService,
+	}
+}
+
+// Exec claims the oldest pending generation job, and processes it. It returns nil when there is no job to process.
+//
+// A failed generation does not return an error: the job is marked as failed, and returned.
+func (service *GenerationJobRun) Exec(ctx context.Context, request *GenerationJobRunRequest) (*GenerationJob, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.GenerationJobRun")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	now := time.Now()
+
+	job, err := service.claimRepository.Exec(ctx, &dao.GenerationJobClaimRequest{
+		StaleBefore: now.Add(-request.Timeout),
+		Now:         now,
+	})
+	if errors.Is(err, dao.ErrGenerationJobClaimEmpty) {
+		otel.ReportSuccessNoContent(span)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	span.SetAttributes(
+		attribute.String("job.id", job.ID.String()),
+		attribute.Int("job.attempts", job.Attempts),
+	)
+
+	// =================================================================================================================
+	// Generate.
+	// =================================================================================================================
+
+	var schema *Schema
+
+	// Jobs claimed again were abandoned by their worker, possibly because they caused it to crash.
+	if job.Attempts > request.MaxAttempts {
+		err = errGenerationJobTooManyAttempts
+	} else {
+		generateCtx, cancel := context.WithTimeout(ctx, request.Timeout)
+
+		schema, err = service.schemaGenerateService.Exec(generateCtx, &SchemaGenerateRequest{
+			ProjectID:    job.ProjectID,
+			UserID:       job.Owner,
+			Roles:        job.Roles,
+			Module:       job.Module,
+			Lang:         job.Lang,
+			Paths:        job.Paths,
+			Instructions: job.Instructions,
+		})
+
+		cancel()
+	}
+
+	// The worker is shutting down: leave the job running, so it is claimed again once it becomes stale.
+	if ctx.Err() != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ctx.Err()))
+	}
+
+	// =================================================================================================================
+	// Save result.
+	// =================================================================================================================
+
+	finishRequest := &dao.GenerationJobFinishRequest{
+		ID:       job.ID,
+		Attempts: job.Attempts,
+		Status:   dao.GenerationJobStatusSucceeded,
+		Now:      time.Now(),
+	}
+
+	if err != nil {
+		finishRequest.Status = dao.GenerationJobStatusFailed
+		finishRequest.Error = err.Error()
+	} else {
+		finishRequest.SchemaID = &schema.ID
+	}
+
+	job, err = service.finishRepository.Exec(ctx, finishRequest)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("finish job: %w", err))
+	}
+
+	span.SetAttributes(attribute.String("job.status", job.Status.String()))
+
+	return otel.ReportSuccess(span, loadGenerationJob(job)), nil
+}
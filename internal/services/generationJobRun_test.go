@@ -0,0 +1,363 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestGenerationJobRun(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	jobID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	startedAt := baseTime.Add(time.Second)
+	finishedAt := baseTime.Add(time.Minute)
+
+	claimedJob := func(attempts int) *dao.GenerationJob {
+		return &dao.GenerationJob{
+			ID:           jobID,
+			ProjectID:    projectID,
+			Owner:        ownerID,
+			Module:       "test-namespace:test-module@v1.0.0",
+			Lang:         config.LangEN,
+			Paths:        []string{"/title"},
+			Instructions: "Keep it short.",
+			Roles:        []string{"user"},
+			Status:       dao.GenerationJobStatusRunning,
+			Attempts:     attempts,
+			CreatedAt:    baseTime,
+			UpdatedAt:    startedAt,
+			StartedAt:    &startedAt,
+		}
+	}
+
+	type generationJobClaimMock struct {
+		resp *dao.GenerationJob
+		err  error
+	}
+
+	type schemaGenerateMock struct {
+		resp *services.Schema
+		err  error
+	}
+
+	type generationJobFinishMock struct {
+		attempts int
+		status   dao.GenerationJobStatus
+		schemaID *uuid.UUID
+		// errMessage is the error saved with the job. An empty string means no error.
+		errMessage string
+
+		resp *dao.GenerationJob
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.GenerationJobRunRequest
+
+		generationJobClaimMock  *generationJobClaimMock
+		schemaGenerateMock      *schemaGenerateMock
+		generationJobFinishMock *generationJobFinishMock
+
+		expect    *services.GenerationJob
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.GenerationJobRunRequest{
+				Timeout:     time.Minute,
+				MaxAttempts: 3,
+			},
+
+			generationJobClaimMock: &generationJobClaimMock{
+				resp: claimedJob(1),
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: &services.Schema{ID: schemaID},
+			},
+
+			generationJobFinishMock: &generationJobFinishMock{
+				attempts: 1,
+				status:   dao.GenerationJobStatusSucceeded,
+				schemaID: &schemaID,
+
+				resp: &dao.GenerationJob{
+					ID:         jobID,
+					ProjectID:  projectID,
+					Owner:      ownerID,
+					Module:     "test-namespace:test-module@v1.0.0",
+					Lang:       config.LangEN,
+					Status:     dao.GenerationJobStatusSucceeded,
+					Attempts:   1,
+					SchemaID:   &schemaID,
+					CreatedAt:  baseTime,
+					UpdatedAt:  finishedAt,
+					StartedAt:  &startedAt,
+					FinishedAt: &finishedAt,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:         jobID,
+				ProjectID:  projectID,
+				Owner:      ownerID,
+				Module:     "test-namespace:test-module@v1.0.0",
+				Lang:       config.LangEN,
+				Status:     dao.GenerationJobStatusSucceeded.String(),
+				Attempts:   1,
+				SchemaID:   &schemaID,
+				CreatedAt:  baseTime,
+				UpdatedAt:  finishedAt,
+				StartedAt:  &startedAt,
+				FinishedAt: &finishedAt,
+			},
+		},
+		{
+			name: "Success/EmptyQueue",
+
+			request: &services.GenerationJobRunRequest{
+				Timeout:     time.Minute,
+				MaxAttempts: 3,
+			},
+
+			generationJobClaimMock: &generationJobClaimMock{
+				err: dao.ErrGenerationJobClaimEmpty,
+			},
+		},
+		{
+			name: "Success/GenerationFailed",
+
+			request: &services.GenerationJobRunRequest{
+				Timeout:     time.Minute,
+				MaxAttempts: 3,
+			},
+
+			generationJobClaimMock: &generationJobClaimMock{
+				resp: claimedJob(1),
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				err: errFoo,
+			},
+
+			generationJobFinishMock: &generationJobFinishMock{
+				attempts:   1,
+				status:     dao.GenerationJobStatusFailed,
+				errMessage: errFoo.Error(),
+
+				resp: &dao.GenerationJob{
+					ID:         jobID,
+					ProjectID:  projectID,
+					Owner:      ownerID,
+					Module:     "test-namespace:test-module@v1.0.0",
+					Lang:       config.LangEN,
+					Status:     dao.GenerationJobStatusFailed,
+					Attempts:   1,
+					Error:      errFoo.Error(),
+					CreatedAt:  baseTime,
+					UpdatedAt:  finishedAt,
+					StartedAt:  &startedAt,
+					FinishedAt: &finishedAt,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:         jobID,
+				ProjectID:  projectID,
+				Owner:      ownerID,
+				Module:     "test-namespace:test-module@v1.0.0",
+				Lang:       config.LangEN,
+				Status:     dao.GenerationJobStatusFailed.String(),
+				Attempts:   1,
+				Error:      errFoo.Error(),
+				CreatedAt:  baseTime,
+				UpdatedAt:  finishedAt,
+				StartedAt:  &startedAt,
+				FinishedAt: &finishedAt,
+			},
+		},
+		{
+			name: "Success/TooManyAttempts",
+
+			request: &services.GenerationJobRunRequest{
+				Timeout:     time.Minute,
+				MaxAttempts: 3,
+			},
+
+			generationJobClaimMock: &generationJobClaimMock{
+				resp: claimedJob(4),
+			},
+
+			generationJobFinishMock: &generationJobFinishMock{
+				attempts:   4,
+				status:     dao.GenerationJobStatusFailed,
+				errMessage: "job was abandoned too many times",
+
+				resp: &dao.GenerationJob{
+					ID:         jobID,
+					ProjectID:  projectID,
+					Owner:      ownerID,
+					Module:     "test-namespace:test-module@v1.0.0",
+					Lang:       config.LangEN,
+					Status:     dao.GenerationJobStatusFailed,
+					Attempts:   4,
+					Error:      "job was abandoned too many times",
+					CreatedAt:  baseTime,
+					UpdatedAt:  finishedAt,
+					StartedAt:  &startedAt,
+					FinishedAt: &finishedAt,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:         jobID,
+				ProjectID:  projectID,
+				Owner:      ownerID,
+				Module:     "test-namespace:test-module@v1.0.0",
+				Lang:       config.LangEN,
+				Status:     dao.GenerationJobStatusFailed.String(),
+				Attempts:   4,
+				Error:      "job was abandoned too many times",
+				CreatedAt:  baseTime,
+				UpdatedAt:  finishedAt,
+				StartedAt:  &startedAt,
+				FinishedAt: &finishedAt,
+			},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.GenerationJobRunRequest{
+				Timeout: time.Minute,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/GenerationJobClaim",
+
+			request: &services.GenerationJobRunRequest{
+				Timeout:     time.Minute,
+				MaxAttempts: 3,
+			},
+
+			generationJobClaimMock: &generationJobClaimMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/GenerationJobFinish",
+
+			request: &services.GenerationJobRunRequest{
+				Timeout:     time.Minute,
+				MaxAttempts: 3,
+			},
+
+			generationJobClaimMock: &generationJobClaimMock{
+				resp: claimedJob(1),
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: &services.Schema{ID: schemaID},
+			},
+
+			generationJobFinishMock: &generationJobFinishMock{
+				attempts: 1,
+				status:   dao.GenerationJobStatusSucceeded,
+				schemaID: &schemaID,
+
+				err: dao.ErrGenerationJobFinishNotFound,
+			},
+
+			expectErr: dao.ErrGenerationJobFinishNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				claimRepository := servicesmocks.NewMockGenerationJobRunRepositoryClaim(t)
+				finishRepository := servicesmocks.NewMockGenerationJobRunRepositoryFinish(t)
+				schemaGenerateService := servicesmocks.NewMockGenerationJobRunServiceSchemaGenerate(t)
+
+				if testCase.generationJobClaimMock != nil {
+					claimRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.GenerationJobClaimRequest) bool {
+							return time.Since(req.Now) < time.Minute &&
+								req.Now.Sub(req.StaleBefore) == testCase.request.Timeout
+						})).
+						Return(testCase.generationJobClaimMock.resp, testCase.generationJobClaimMock.err)
+				}
+
+				if testCase.schemaGenerateMock != nil {
+					job := testCase.generationJobClaimMock.resp
+
+					schemaGenerateService.EXPECT().
+						Exec(mock.Anything, &services.SchemaGenerateRequest{
+							ProjectID:    job.ProjectID,
+							UserID:       job.Owner,
+							Roles:        job.Roles,
+							Module:       job.Module,
+							Lang:         job.Lang,
+							Paths:        job.Paths,
+							Instructions: job.Instructions,
+						}).
+						Return(testCase.schemaGenerateMock.resp, testCase.schemaGenerateMock.err)
+				}
+
+				if testCase.generationJobFinishMock != nil {
+					finishRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.GenerationJobFinishRequest) bool {
+							return req.ID == jobID &&
+								req.Attempts == testCase.generationJobFinishMock.attempts &&
+								req.Status == testCase.generationJobFinishMock.status &&
+								lo.FromPtr(req.SchemaID) == lo.FromPtr(testCase.generationJobFinishMock.schemaID) &&
+								req.Error == testCase.generationJobFinishMock.errMessage &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.generationJobFinishMock.resp, testCase.generationJobFinishMock.err)
+				}
+
+				service := services.NewGenerationJobRun(claimRepository, finishRepository, schemaGenerateService)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				claimRepository.AssertExpectations(t)
+				finishRepository.AssertExpectations(t)
+				schemaGenerateService.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type GenerationJobSelectRepository interface {
+	Exec(ctx context.Context, request *dao.GenerationJobSelectRequest) (*dao.GenerationJob, error)
+}
+
+type GenerationJobSelectRequest struct {
+	ID     uuid.UUID `validate:"required"`
+	UserID uuid.UUID `validate:"required"`
+}
+
+type GenerationJobSelect struct {
+	repository GenerationJobSelectRepository
+}
+
+func NewGenerationJobSelect(repository GenerationJobSelectRepository) *GenerationJobSelect {
+	return &GenerationJobSelect{repository: repository}
+}
+
+func (service *GenerationJobSelect) Exec(
+	ctx context.Context, request *GenerationJobSelectRequest,
+) (*GenerationJob, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.GenerationJobSelect")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	job, err := service.repository.Exec(ctx, &dao.GenerationJobSelectRequest{
+		ID: request.ID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	if job.Owner != request.UserID {
+		return nil, otel.ReportError(span, ErrUserDoesNotOwnGenerationJob)
+	}
+
+	return otel.ReportSuccess(span, loadGenerationJob(job)), nil
+}
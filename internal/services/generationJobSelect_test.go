@@ -0,0 +1,166 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestGenerationJobSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	jobID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	startedAt := baseTime.Add(time.Second)
+	finishedAt := baseTime.Add(time.Minute)
+
+	type generationJobSelectMock struct {
+		resp *dao.GenerationJob
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.GenerationJobSelectRequest
+
+		generationJobSelectMock *generationJobSelectMock
+
+		expect    *services.GenerationJob
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.GenerationJobSelectRequest{
+				ID:     jobID,
+				UserID: ownerID,
+			},
+
+			generationJobSelectMock: &generationJobSelectMock{
+				resp: &dao.GenerationJob{
+					ID:         jobID,
+					ProjectID:  projectID,
+					Owner:      ownerID,
+					Module:     "test-namespace:test-module@v1.0.0",
+					Lang:       config.LangEN,
+					Status:     dao.GenerationJobStatusSucceeded,
+					Attempts:   1,
+					SchemaID:   &schemaID,
+					CreatedAt:  baseTime,
+					UpdatedAt:  finishedAt,
+					StartedAt:  &startedAt,
+					FinishedAt: &finishedAt,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:         jobID,
+				ProjectID:  projectID,
+				Owner:      ownerID,
+				Module:     "test-namespace:test-module@v1.0.0",
+				Lang:       config.LangEN,
+				Status:     dao.GenerationJobStatusSucceeded.String(),
+				Attempts:   1,
+				SchemaID:   &schemaID,
+				CreatedAt:  baseTime,
+				UpdatedAt:  finishedAt,
+				StartedAt:  &startedAt,
+				FinishedAt: &finishedAt,
+			},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.GenerationJobSelectRequest{
+				ID: jobID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/UserDoesNotOwnGenerationJob",
+
+			request: &services.GenerationJobSelectRequest{
+				ID:     jobID,
+				UserID: otherUserID,
+			},
+
+			generationJobSelectMock: &generationJobSelectMock{
+				resp: &dao.GenerationJob{
+					ID:        jobID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Module:    "test-namespace:test-module@v1.0.0",
+					Lang:      config.LangEN,
+					Status:    dao.GenerationJobStatusQueued,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrUserDoesNotOwnGenerationJob,
+		},
+		{
+			name: "Error/GenerationJobSelect",
+
+			request: &services.GenerationJobSelectRequest{
+				ID:     jobID,
+				UserID: ownerID,
+			},
+
+			generationJobSelectMock: &generationJobSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				repository := servicesmocks.NewMockGenerationJobSelectRepository(t)
+
+				if testCase.generationJobSelectMock != nil {
+					repository.EXPECT().
+						Exec(mock.Anything, &dao.GenerationJobSelectRequest{
+							ID: testCase.request.ID,
+						}).
+						Return(testCase.generationJobSelectMock.resp, testCase.generationJobSelectMock.err)
+				}
+
+				service := services.NewGenerationJobSelect(repository)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				repository.AssertExpectations(t)
+			})
+		})
+	}
+}
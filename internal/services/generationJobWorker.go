@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+type GenerationJobWorkerService interface {
+	Exec(ctx context.Context, request *GenerationJobRunRequest) (*GenerationJob, error)
+}
+
+type GenerationJobWorkerConfig struct {
+	// PollInterval is the time to wait before looking for new jobs, when the queue is empty.
+	PollInterval time.Duration
+	Timeout      time.Duration
+	MaxAttempts  int
+}
+
+// RunGenerationJobWorker processes generation jobs until the context is canceled. Jobs are processed one at a
+// time: run multiple workers to process jobs concurrently. Workers share the queue, whether they run in the same
+// process or not.
+func RunGenerationJobWorker(ctx context.Context, service GenerationJobWorkerService, config GenerationJobWorkerConfig) {
+	request := &GenerationJobRunRequest{
+		Timeout:     config.Timeout,
+		MaxAttempts: config.MaxAttempts,
+	}
+
+	for ctx.Err() == nil {
+		job, err := service.Exec(ctx, request)
+		// Keep going as long as there are jobs in the queue.
+		if err == nil && job != nil {
+			continue
+		}
+
+		// Errors are reported by the service. Wait a bit before trying again, in case the failure is temporary.
+		select {
+		case <-ctx.Done():
+		case <-time.After(config.PollInterval):
+		}
+	}
+}
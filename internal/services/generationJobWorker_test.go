@@ -0,0 +1,110 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestRunGenerationJobWorker(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	config := services.GenerationJobWorkerConfig{
+		PollInterval: time.Hour,
+		Timeout:      time.Minute,
+		MaxAttempts:  3,
+	}
+
+	request := &services.GenerationJobRunRequest{
+		Timeout:     config.Timeout,
+		MaxAttempts: config.MaxAttempts,
+	}
+
+	t.Run("ProcessJobsUntilQueueIsEmpty", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		service := servicesmocks.NewMockGenerationJobWorkerService(t)
+
+		// Jobs are processed without waiting, as long as there are some.
+		service.EXPECT().
+			Exec(mock.Anything, request).
+			Return(&services.GenerationJob{ID: uuid.New()}, nil).
+			Twice()
+		// Stop the worker when it starts waiting on an empty queue.
+		service.EXPECT().
+			Exec(mock.Anything, request).
+			Run(func(_ context.Context, _ *services.GenerationJobRunRequest) { cancel() }).
+			Return(nil, nil).
+			Once()
+
+		done := make(chan struct{})
+
+		go func() {
+			services.RunGenerationJobWorker(ctx, service, config)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "worker did not stop")
+		}
+
+		service.AssertExpectations(t)
+	})
+
+	t.Run("WaitAfterError", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		service := servicesmocks.NewMockGenerationJobWorkerService(t)
+
+		calls := make(chan struct{}, 10)
+
+		service.EXPECT().
+			Exec(mock.Anything, request).
+			Run(func(_ context.Context, _ *services.GenerationJobRunRequest) { calls <- struct{}{} }).
+			Return(nil, errFoo)
+
+		done := make(chan struct{})
+
+		go func() {
+			services.RunGenerationJobWorker(ctx, service, config)
+			close(done)
+		}()
+
+		<-calls
+
+		// The worker waits for the poll interval before trying again.
+		select {
+		case <-calls:
+			require.FailNow(t, "worker did not wait after an error")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "worker did not stop")
+		}
+
+		service.AssertNumberOfCalls(t, "Exec", 1)
+	})
+}
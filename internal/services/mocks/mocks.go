@@ -8,16 +8,17 @@ import (
 	"context"
 
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockModuleCreateRepository creates a new instance of MockModuleCreateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGenerationJobRunRepositoryClaim creates a new instance of MockGenerationJobRunRepositoryClaim. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleCreateRepository(t interface {
+func NewMockGenerationJobRunRepositoryClaim(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleCreateRepository {
-	mock := &MockModuleCreateRepository{}
+}) *MockGenerationJobRunRepositoryClaim {
+	mock := &MockGenerationJobRunRepositoryClaim{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -25,40 +26,40 @@ func NewMockModuleCreateRepository(t interface {
 	return mock
 }
 
-// MockModuleCreateRepository is an autogenerated mock type for the ModuleCreateRepository type
-type MockModuleCreateRepository struct {
+// MockGenerationJobRunRepositoryClaim is an autogenerated mock type for the GenerationJobRunRepositoryClaim type
+type MockGenerationJobRunRepositoryClaim struct {
 	mock.Mock
 }
 
-type MockModuleCreateRepository_Expecter struct {
+type MockGenerationJobRunRepositoryClaim_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleCreateRepository) EXPECT() *MockModuleCreateRepository_Expecter {
-	return &MockModuleCreateRepository_Expecter{mock: &_m.Mock}
+func (_m *MockGenerationJobRunRepositoryClaim) EXPECT() *MockGenerationJobRunRepositoryClaim_Expecter {
+	return &MockGenerationJobRunRepositoryClaim_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleCreateRepository
-func (_mock *MockModuleCreateRepository) Exec(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockGenerationJobRunRepositoryClaim
+func (_mock *MockGenerationJobRunRepositoryClaim) Exec(ctx context.Context, request *dao.GenerationJobClaimRequest) (*dao.GenerationJob, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Module
+	var r0 *dao.GenerationJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobClaimRequest) (*dao.GenerationJob, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobClaimRequest) *dao.GenerationJob); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Module)
+			r0 = ret.Get(0).(*dao.GenerationJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.GenerationJobClaimRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -66,27 +67,27 @@ func (_mock *MockModuleCreateRepository) Exec(ctx context.Context, request *dao.
 	return r0, r1
 }
 
-// MockModuleCreateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleCreateRepository_Exec_Call struct {
+// MockGenerationJobRunRepositoryClaim_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGenerationJobRunRepositoryClaim_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleInsertRequest
-func (_e *MockModuleCreateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCreateRepository_Exec_Call {
-	return &MockModuleCreateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.GenerationJobClaimRequest
+func (_e *MockGenerationJobRunRepositoryClaim_Expecter) Exec(ctx interface{}, request interface{}) *MockGenerationJobRunRepositoryClaim_Exec_Call {
+	return &MockGenerationJobRunRepositoryClaim_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleCreateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleInsertRequest)) *MockModuleCreateRepository_Exec_Call {
+func (_c *MockGenerationJobRunRepositoryClaim_Exec_Call) Run(run func(ctx context.Context, request *dao.GenerationJobClaimRequest)) *MockGenerationJobRunRepositoryClaim_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleInsertRequest
+		var arg1 *dao.GenerationJobClaimRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleInsertRequest)
+			arg1 = args[1].(*dao.GenerationJobClaimRequest)
 		}
 		run(
 			arg0,
@@ -96,23 +97,23 @@ func (_c *MockModuleCreateRepository_Exec_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockModuleCreateRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleCreateRepository_Exec_Call {
-	_c.Call.Return(module, err)
+func (_c *MockGenerationJobRunRepositoryClaim_Exec_Call) Return(generationJob *dao.GenerationJob, err error) *MockGenerationJobRunRepositoryClaim_Exec_Call {
+	_c.Call.Return(generationJob, err)
 	return _c
 }
 
-func (_c *MockModuleCreateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error)) *MockModuleCreateRepository_Exec_Call {
+func (_c *MockGenerationJobRunRepositoryClaim_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.GenerationJobClaimRequest) (*dao.GenerationJob, error)) *MockGenerationJobRunRepositoryClaim_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleCreateRepositoryDelete creates a new instance of MockModuleCreateRepositoryDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGenerationJobRunRepositoryFinish creates a new instance of MockGenerationJobRunRepositoryFinish. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleCreateRepositoryDelete(t interface {
+func NewMockGenerationJobRunRepositoryFinish(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleCreateRepositoryDelete {
-	mock := &MockModuleCreateRepositoryDelete{}
+}) *MockGenerationJobRunRepositoryFinish {
+	mock := &MockGenerationJobRunRepositoryFinish{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -120,40 +121,40 @@ func NewMockModuleCreateRepositoryDelete(t interface {
 	return mock
 }
 
-// MockModuleCreateRepositoryDelete is an autogenerated mock type for the ModuleCreateRepositoryDelete type
-type MockModuleCreateRepositoryDelete struct {
+// MockGenerationJobRunRepositoryFinish is an autogenerated mock type for the GenerationJobRunRepositoryFinish type
+type MockGenerationJobRunRepositoryFinish struct {
 	mock.Mock
 }
 
-type MockModuleCreateRepositoryDelete_Expecter struct {
+type MockGenerationJobRunRepositoryFinish_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleCreateRepositoryDelete) EXPECT() *MockModuleCreateRepositoryDelete_Expecter {
-	return &MockModuleCreateRepositoryDelete_Expecter{mock: &_m.Mock}
+func (_m *MockGenerationJobRunRepositoryFinish) EXPECT() *MockGenerationJobRunRepositoryFinish_Expecter {
+	return &MockGenerationJobRunRepositoryFinish_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleCreateRepositoryDelete
-func (_mock *MockModuleCreateRepositoryDelete) Exec(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockGenerationJobRunRepositoryFinish
+func (_mock *MockGenerationJobRunRepositoryFinish) Exec(ctx context.Context, request *dao.GenerationJobFinishRequest) (*dao.GenerationJob, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Module
+	var r0 *dao.GenerationJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobFinishRequest) (*dao.GenerationJob, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobFinishRequest) *dao.GenerationJob); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Module)
+			r0 = ret.Get(0).(*dao.GenerationJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleDeleteRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.GenerationJobFinishRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -161,27 +162,27 @@ func (_mock *MockModuleCreateRepositoryDelete) Exec(ctx context.Context, request
 	return r0, r1
 }
 
-// MockModuleCreateRepositoryDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleCreateRepositoryDelete_Exec_Call struct {
+// MockGenerationJobRunRepositoryFinish_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGenerationJobRunRepositoryFinish_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleDeleteRequest
-func (_e *MockModuleCreateRepositoryDelete_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCreateRepositoryDelete_Exec_Call {
-	return &MockModuleCreateRepositoryDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.GenerationJobFinishRequest
+func (_e *MockGenerationJobRunRepositoryFinish_Expecter) Exec(ctx interface{}, request interface{}) *MockGenerationJobRunRepositoryFinish_Exec_Call {
+	return &MockGenerationJobRunRepositoryFinish_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleCreateRepositoryDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleDeleteRequest)) *MockModuleCreateRepositoryDelete_Exec_Call {
+func (_c *MockGenerationJobRunRepositoryFinish_Exec_Call) Run(run func(ctx context.Context, request *dao.GenerationJobFinishRequest)) *MockGenerationJobRunRepositoryFinish_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleDeleteRequest
+		var arg1 *dao.GenerationJobFinishRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleDeleteRequest)
+			arg1 = args[1].(*dao.GenerationJobFinishRequest)
 		}
 		run(
 			arg0,
@@ -191,23 +192,23 @@ func (_c *MockModuleCreateRepositoryDelete_Exec_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockModuleCreateRepositoryDelete_Exec_Call) Return(module *dao.Module, err error) *MockModuleCreateRepositoryDelete_Exec_Call {
-	_c.Call.Return(module, err)
+func (_c *MockGenerationJobRunRepositoryFinish_Exec_Call) Return(generationJob *dao.GenerationJob, err error) *MockGenerationJobRunRepositoryFinish_Exec_Call {
+	_c.Call.Return(generationJob, err)
 	return _c
 }
 
-func (_c *MockModuleCreateRepositoryDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error)) *MockModuleCreateRepositoryDelete_Exec_Call {
+func (_c *MockGenerationJobRunRepositoryFinish_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.GenerationJobFinishRequest) (*dao.GenerationJob, error)) *MockGenerationJobRunRepositoryFinish_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleListVersionsRepository creates a new instance of MockModuleListVersionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGenerationJobRunServiceSchemaGenerate creates a new instance of MockGenerationJobRunServiceSchemaGenerate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleListVersionsRepository(t interface {
+func NewMockGenerationJobRunServiceSchemaGenerate(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleListVersionsRepository {
-	mock := &MockModuleListVersionsRepository{}
+}) *MockGenerationJobRunServiceSchemaGenerate {
+	mock := &MockGenerationJobRunServiceSchemaGenerate{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -215,40 +216,40 @@ func NewMockModuleListVersionsRepository(t interface {
 	return mock
 }
 
-// MockModuleListVersionsRepository is an autogenerated mock type for the ModuleListVersionsRepository type
-type MockModuleListVersionsRepository struct {
+// MockGenerationJobRunServiceSchemaGenerate is an autogenerated mock type for the GenerationJobRunServiceSchemaGenerate type
+type MockGenerationJobRunServiceSchemaGenerate struct {
 	mock.Mock
 }
 
-type MockModuleListVersionsRepository_Expecter struct {
+type MockGenerationJobRunServiceSchemaGenerate_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleListVersionsRepository) EXPECT() *MockModuleListVersionsRepository_Expecter {
-	return &MockModuleListVersionsRepository_Expecter{mock: &_m.Mock}
+func (_m *MockGenerationJobRunServiceSchemaGenerate) EXPECT() *MockGenerationJobRunServiceSchemaGenerate_Expecter {
+	return &MockGenerationJobRunServiceSchemaGenerate_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleListVersionsRepository
-func (_mock *MockModuleListVersionsRepository) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+// Exec provides a mock function for the type MockGenerationJobRunServiceSchemaGenerate
+func (_mock *MockGenerationJobRunServiceSchemaGenerate) Exec(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*dao.ModuleVersion
+	var r0 *services.Schema
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateRequest) (*services.Schema, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.SchemaGenerateRequest) *services.Schema); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.ModuleVersion)
+			r0 = ret.Get(0).(*services.Schema)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.SchemaGenerateRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -256,27 +257,27 @@ func (_mock *MockModuleListVersionsRepository) Exec(ctx context.Context, request
 	return r0, r1
 }
 
-// MockModuleListVersionsRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleListVersionsRepository_Exec_Call struct {
+// MockGenerationJobRunServiceSchemaGenerate_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGenerationJobRunServiceSchemaGenerate_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleListVersionsRequest
-func (_e *MockModuleListVersionsRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleListVersionsRepository_Exec_Call {
-	return &MockModuleListVersionsRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.SchemaGenerateRequest
+func (_e *MockGenerationJobRunServiceSchemaGenerate_Expecter) Exec(ctx interface{}, request interface{}) *MockGenerationJobRunServiceSchemaGenerate_Exec_Call {
+	return &MockGenerationJobRunServiceSchemaGenerate_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleListVersionsRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockModuleListVersionsRepository_Exec_Call {
+func (_c *MockGenerationJobRunServiceSchemaGenerate_Exec_Call) Run(run func(ctx context.Context, request *services.SchemaGenerateRequest)) *MockGenerationJobRunServiceSchemaGenerate_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleListVersionsRequest
+		var arg1 *services.SchemaGenerateRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+			arg1 = args[1].(*services.SchemaGenerateRequest)
 		}
 		run(
 			arg0,
@@ -286,23 +287,23 @@ func (_c *MockModuleListVersionsRepository_Exec_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockModuleListVersionsRepository_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockModuleListVersionsRepository_Exec_Call {
-	_c.Call.Return(moduleVersions, err)
+func (_c *MockGenerationJobRunServiceSchemaGenerate_Exec_Call) Return(schema *services.Schema, err error) *MockGenerationJobRunServiceSchemaGenerate_Exec_Call {
+	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockModuleListVersionsRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockModuleListVersionsRepository_Exec_Call {
+func (_c *MockGenerationJobRunServiceSchemaGenerate_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.SchemaGenerateRequest) (*services.Schema, error)) *MockGenerationJobRunServiceSchemaGenerate_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleLoadSystemRepository creates a new instance of MockModuleLoadSystemRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGenerationJobSelectRepository creates a new instance of MockGenerationJobSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleLoadSystemRepository(t interface {
+func NewMockGenerationJobSelectRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleLoadSystemRepository {
-	mock := &MockModuleLoadSystemRepository{}
+}) *MockGenerationJobSelectRepository {
+	mock := &MockGenerationJobSelectRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -310,40 +311,40 @@ func NewMockModuleLoadSystemRepository(t interface {
 	return mock
 }
 
-// MockModuleLoadSystemRepository is an autogenerated mock type for the ModuleLoadSystemRepository type
-type MockModuleLoadSystemRepository struct {
+// MockGenerationJobSelectRepository is an autogenerated mock type for the GenerationJobSelectRepository type
+type MockGenerationJobSelectRepository struct {
 	mock.Mock
 }
 
-type MockModuleLoadSystemRepository_Expecter struct {
+type MockGenerationJobSelectRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleLoadSystemRepository) EXPECT() *MockModuleLoadSystemRepository_Expecter {
-	return &MockModuleLoadSystemRepository_Expecter{mock: &_m.Mock}
+func (_m *MockGenerationJobSelectRepository) EXPECT() *MockGenerationJobSelectRepository_Expecter {
+	return &MockGenerationJobSelectRepository_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleLoadSystemRepository
-func (_mock *MockModuleLoadSystemRepository) Exec(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockGenerationJobSelectRepository
+func (_mock *MockGenerationJobSelectRepository) Exec(ctx context.Context, request *dao.GenerationJobSelectRequest) (*dao.GenerationJob, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Module
+	var r0 *dao.GenerationJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobSelectRequest) (*dao.GenerationJob, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobSelectRequest) *dao.GenerationJob); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Module)
+			r0 = ret.Get(0).(*dao.GenerationJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.GenerationJobSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -351,27 +352,27 @@ func (_mock *MockModuleLoadSystemRepository) Exec(ctx context.Context, request *
 	return r0, r1
 }
 
-// MockModuleLoadSystemRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleLoadSystemRepository_Exec_Call struct {
+// MockGenerationJobSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGenerationJobSelectRepository_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleInsertRequest
-func (_e *MockModuleLoadSystemRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepository_Exec_Call {
-	return &MockModuleLoadSystemRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.GenerationJobSelectRequest
+func (_e *MockGenerationJobSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockGenerationJobSelectRepository_Exec_Call {
+	return &MockGenerationJobSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleLoadSystemRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleInsertRequest)) *MockModuleLoadSystemRepository_Exec_Call {
+func (_c *MockGenerationJobSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.GenerationJobSelectRequest)) *MockGenerationJobSelectRepository_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleInsertRequest
+		var arg1 *dao.GenerationJobSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleInsertRequest)
+			arg1 = args[1].(*dao.GenerationJobSelectRequest)
 		}
 		run(
 			arg0,
@@ -381,23 +382,23 @@ func (_c *MockModuleLoadSystemRepository_Exec_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleLoadSystemRepository_Exec_Call {
-	_c.Call.Return(module, err)
+func (_c *MockGenerationJobSelectRepository_Exec_Call) Return(generationJob *dao.GenerationJob, err error) *MockGenerationJobSelectRepository_Exec_Call {
+	_c.Call.Return(generationJob, err)
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error)) *MockModuleLoadSystemRepository_Exec_Call {
+func (_c *MockGenerationJobSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.GenerationJobSelectRequest) (*dao.GenerationJob, error)) *MockGenerationJobSelectRepository_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleLoadSystemRepositoryDelete creates a new instance of MockModuleLoadSystemRepositoryDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockGenerationJobWorkerService creates a new instance of MockGenerationJobWorkerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleLoadSystemRepositoryDelete(t interface {
+func NewMockGenerationJobWorkerService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleLoadSystemRepositoryDelete {
-	mock := &MockModuleLoadSystemRepositoryDelete{}
+}) *MockGenerationJobWorkerService {
+	mock := &MockGenerationJobWorkerService{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -405,40 +406,40 @@ func NewMockModuleLoadSystemRepositoryDelete(t interface {
 	return mock
 }
 
-// MockModuleLoadSystemRepositoryDelete is an autogenerated mock type for the ModuleLoadSystemRepositoryDelete type
-type MockModuleLoadSystemRepositoryDelete struct {
+// MockGenerationJobWorkerService is an autogenerated mock type for the GenerationJobWorkerService type
+type MockGenerationJobWorkerService struct {
 	mock.Mock
 }
 
-type MockModuleLoadSystemRepositoryDelete_Expecter struct {
+type MockGenerationJobWorkerService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleLoadSystemRepositoryDelete) EXPECT() *MockModuleLoadSystemRepositoryDelete_Expecter {
-	return &MockModuleLoadSystemRepositoryDelete_Expecter{mock: &_m.Mock}
+func (_m *MockGenerationJobWorkerService) EXPECT() *MockGenerationJobWorkerService_Expecter {
+	return &MockGenerationJobWorkerService_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleLoadSystemRepositoryDelete
-func (_mock *MockModuleLoadSystemRepositoryDelete) Exec(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockGenerationJobWorkerService
+func (_mock *MockGenerationJobWorkerService) Exec(ctx context.Context, request *services.GenerationJobRunRequest) (*services.GenerationJob, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Module
+	var r0 *services.GenerationJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.GenerationJobRunRequest) (*services.GenerationJob, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *services.GenerationJobRunRequest) *services.GenerationJob); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Module)
+			r0 = ret.Get(0).(*services.GenerationJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleDeleteRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *services.GenerationJobRunRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -446,27 +447,27 @@ func (_mock *MockModuleLoadSystemRepositoryDelete) Exec(ctx context.Context, req
 	return r0, r1
 }
 
-// MockModuleLoadSystemRepositoryDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleLoadSystemRepositoryDelete_Exec_Call struct {
+// MockGenerationJobWorkerService_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockGenerationJobWorkerService_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleDeleteRequest
-func (_e *MockModuleLoadSystemRepositoryDelete_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
-	return &MockModuleLoadSystemRepositoryDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *services.GenerationJobRunRequest
+func (_e *MockGenerationJobWorkerService_Expecter) Exec(ctx interface{}, request interface{}) *MockGenerationJobWorkerService_Exec_Call {
+	return &MockGenerationJobWorkerService_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleLoadSystemRepositoryDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleDeleteRequest)) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
+func (_c *MockGenerationJobWorkerService_Exec_Call) Run(run func(ctx context.Context, request *services.GenerationJobRunRequest)) *MockGenerationJobWorkerService_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleDeleteRequest
+		var arg1 *services.GenerationJobRunRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleDeleteRequest)
+			arg1 = args[1].(*services.GenerationJobRunRequest)
 		}
 		run(
 			arg0,
@@ -476,23 +477,23 @@ func (_c *MockModuleLoadSystemRepositoryDelete_Exec_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepositoryDelete_Exec_Call) Return(module *dao.Module, err error) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
-	_c.Call.Return(module, err)
+func (_c *MockGenerationJobWorkerService_Exec_Call) Return(generationJob *services.GenerationJob, err error) *MockGenerationJobWorkerService_Exec_Call {
+	_c.Call.Return(generationJob, err)
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepositoryDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error)) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
+func (_c *MockGenerationJobWorkerService_Exec_Call) RunAndReturn(run func(ctx context.Context, request *services.GenerationJobRunRequest) (*services.GenerationJob, error)) *MockGenerationJobWorkerService_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleLoadSystemRepositorySelect creates a new instance of MockModuleLoadSystemRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockModuleCheckCompatibilityRepositorySelect creates a new instance of MockModuleCheckCompatibilityRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleLoadSystemRepositorySelect(t interface {
+func NewMockModuleCheckCompatibilityRepositorySelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleLoadSystemRepositorySelect {
-	mock := &MockModuleLoadSystemRepositorySelect{}
+}) *MockModuleCheckCompatibilityRepositorySelect {
+	mock := &MockModuleCheckCompatibilityRepositorySelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -500,21 +501,21 @@ func NewMockModuleLoadSystemRepositorySelect(t interface {
 	return mock
 }
 
-// MockModuleLoadSystemRepositorySelect is an autogenerated mock type for the ModuleLoadSystemRepositorySelect type
-type MockModuleLoadSystemRepositorySelect struct {
+// MockModuleCheckCompatibilityRepositorySelect is an autogenerated mock type for the ModuleCheckCompatibilityRepositorySelect type
+type MockModuleCheckCompatibilityRepositorySelect struct {
 	mock.Mock
 }
 
-type MockModuleLoadSystemRepositorySelect_Expecter struct {
+type MockModuleCheckCompatibilityRepositorySelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleLoadSystemRepositorySelect) EXPECT() *MockModuleLoadSystemRepositorySelect_Expecter {
-	return &MockModuleLoadSystemRepositorySelect_Expecter{mock: &_m.Mock}
+func (_m *MockModuleCheckCompatibilityRepositorySelect) EXPECT() *MockModuleCheckCompatibilityRepositorySelect_Expecter {
+	return &MockModuleCheckCompatibilityRepositorySelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleLoadSystemRepositorySelect
-func (_mock *MockModuleLoadSystemRepositorySelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockModuleCheckCompatibilityRepositorySelect
+func (_mock *MockModuleCheckCompatibilityRepositorySelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -541,19 +542,19 @@ func (_mock *MockModuleLoadSystemRepositorySelect) Exec(ctx context.Context, req
 	return r0, r1
 }
 
-// MockModuleLoadSystemRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleLoadSystemRepositorySelect_Exec_Call struct {
+// MockModuleCheckCompatibilityRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleCheckCompatibilityRepositorySelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
 //   - request *dao.ModuleSelectRequest
-func (_e *MockModuleLoadSystemRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepositorySelect_Exec_Call {
-	return &MockModuleLoadSystemRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+func (_e *MockModuleCheckCompatibilityRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCheckCompatibilityRepositorySelect_Exec_Call {
+	return &MockModuleCheckCompatibilityRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleLoadSystemRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockModuleLoadSystemRepositorySelect_Exec_Call {
+func (_c *MockModuleCheckCompatibilityRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockModuleCheckCompatibilityRepositorySelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -571,23 +572,23 @@ func (_c *MockModuleLoadSystemRepositorySelect_Exec_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepositorySelect_Exec_Call) Return(module *dao.Module, err error) *MockModuleLoadSystemRepositorySelect_Exec_Call {
+func (_c *MockModuleCheckCompatibilityRepositorySelect_Exec_Call) Return(module *dao.Module, err error) *MockModuleCheckCompatibilityRepositorySelect_Exec_Call {
 	_c.Call.Return(module, err)
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockModuleLoadSystemRepositorySelect_Exec_Call {
+func (_c *MockModuleCheckCompatibilityRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockModuleCheckCompatibilityRepositorySelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleLoadSystemRepositoryListVersions creates a new instance of MockModuleLoadSystemRepositoryListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockModuleCheckCompatibilityRepositoryListVersions creates a new instance of MockModuleCheckCompatibilityRepositoryListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleLoadSystemRepositoryListVersions(t interface {
+func NewMockModuleCheckCompatibilityRepositoryListVersions(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleLoadSystemRepositoryListVersions {
-	mock := &MockModuleLoadSystemRepositoryListVersions{}
+}) *MockModuleCheckCompatibilityRepositoryListVersions {
+	mock := &MockModuleCheckCompatibilityRepositoryListVersions{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -595,21 +596,21 @@ func NewMockModuleLoadSystemRepositoryListVersions(t interface {
 	return mock
 }
 
-// MockModuleLoadSystemRepositoryListVersions is an autogenerated mock type for the ModuleLoadSystemRepositoryListVersions type
-type MockModuleLoadSystemRepositoryListVersions struct {
+// MockModuleCheckCompatibilityRepositoryListVersions is an autogenerated mock type for the ModuleCheckCompatibilityRepositoryListVersions type
+type MockModuleCheckCompatibilityRepositoryListVersions struct {
 	mock.Mock
 }
 
-type MockModuleLoadSystemRepositoryListVersions_Expecter struct {
+type MockModuleCheckCompatibilityRepositoryListVersions_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleLoadSystemRepositoryListVersions) EXPECT() *MockModuleLoadSystemRepositoryListVersions_Expecter {
-	return &MockModuleLoadSystemRepositoryListVersions_Expecter{mock: &_m.Mock}
+func (_m *MockModuleCheckCompatibilityRepositoryListVersions) EXPECT() *MockModuleCheckCompatibilityRepositoryListVersions_Expecter {
+	return &MockModuleCheckCompatibilityRepositoryListVersions_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleLoadSystemRepositoryListVersions
-func (_mock *MockModuleLoadSystemRepositoryListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+// Exec provides a mock function for the type MockModuleCheckCompatibilityRepositoryListVersions
+func (_mock *MockModuleCheckCompatibilityRepositoryListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -636,19 +637,19 @@ func (_mock *MockModuleLoadSystemRepositoryListVersions) Exec(ctx context.Contex
 	return r0, r1
 }
 
-// MockModuleLoadSystemRepositoryListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleLoadSystemRepositoryListVersions_Exec_Call struct {
+// MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
 //   - request *dao.ModuleListVersionsRequest
-func (_e *MockModuleLoadSystemRepositoryListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
-	return &MockModuleLoadSystemRepositoryListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+func (_e *MockModuleCheckCompatibilityRepositoryListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call {
+	return &MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleLoadSystemRepositoryListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
+func (_c *MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -666,23 +667,23 @@ func (_c *MockModuleLoadSystemRepositoryListVersions_Exec_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepositoryListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
+func (_c *MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call {
 	_c.Call.Return(moduleVersions, err)
 	return _c
 }
 
-func (_c *MockModuleLoadSystemRepositoryListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
+func (_c *MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockModuleCheckCompatibilityRepositoryListVersions_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModuleSelectRepository creates a new instance of MockModuleSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockModuleCreateRepository creates a new instance of MockModuleCreateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModuleSelectRepository(t interface {
+func NewMockModuleCreateRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModuleSelectRepository {
-	mock := &MockModuleSelectRepository{}
+}) *MockModuleCreateRepository {
+	mock := &MockModuleCreateRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -690,21 +691,21 @@ func NewMockModuleSelectRepository(t interface {
 	return mock
 }
 
-// MockModuleSelectRepository is an autogenerated mock type for the ModuleSelectRepository type
-type MockModuleSelectRepository struct {
+// MockModuleCreateRepository is an autogenerated mock type for the ModuleCreateRepository type
+type MockModuleCreateRepository struct {
 	mock.Mock
 }
 
-type MockModuleSelectRepository_Expecter struct {
+type MockModuleCreateRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModuleSelectRepository) EXPECT() *MockModuleSelectRepository_Expecter {
-	return &MockModuleSelectRepository_Expecter{mock: &_m.Mock}
+func (_m *MockModuleCreateRepository) EXPECT() *MockModuleCreateRepository_Expecter {
+	return &MockModuleCreateRepository_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockModuleSelectRepository
-func (_mock *MockModuleSelectRepository) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockModuleCreateRepository
+func (_mock *MockModuleCreateRepository) Exec(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -713,17 +714,17 @@ func (_mock *MockModuleSelectRepository) Exec(ctx context.Context, request *dao.
 
 	var r0 *dao.Module
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) (*dao.Module, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) *dao.Module); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Module)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -731,27 +732,27 @@ func (_mock *MockModuleSelectRepository) Exec(ctx context.Context, request *dao.
 	return r0, r1
 }
 
-// MockModuleSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockModuleSelectRepository_Exec_Call struct {
+// MockModuleCreateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleCreateRepository_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleSelectRequest
-func (_e *MockModuleSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleSelectRepository_Exec_Call {
-	return &MockModuleSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ModuleInsertRequest
+func (_e *MockModuleCreateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCreateRepository_Exec_Call {
+	return &MockModuleCreateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockModuleSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockModuleSelectRepository_Exec_Call {
+func (_c *MockModuleCreateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleInsertRequest)) *MockModuleCreateRepository_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleSelectRequest
+		var arg1 *dao.ModuleInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleSelectRequest)
+			arg1 = args[1].(*dao.ModuleInsertRequest)
 		}
 		run(
 			arg0,
@@ -761,23 +762,23 @@ func (_c *MockModuleSelectRepository_Exec_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockModuleSelectRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleSelectRepository_Exec_Call {
+func (_c *MockModuleCreateRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleCreateRepository_Exec_Call {
 	_c.Call.Return(module, err)
 	return _c
 }
 
-func (_c *MockModuleSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockModuleSelectRepository_Exec_Call {
+func (_c *MockModuleCreateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error)) *MockModuleCreateRepository_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectDeleteRepositorySelect creates a new instance of MockProjectDeleteRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockModuleCreateRepositoryDelete creates a new instance of MockModuleCreateRepositoryDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectDeleteRepositorySelect(t interface {
+func NewMockModuleCreateRepositoryDelete(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectDeleteRepositorySelect {
-	mock := &MockProjectDeleteRepositorySelect{}
+}) *MockModuleCreateRepositoryDelete {
+	mock := &MockModuleCreateRepositoryDelete{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -785,40 +786,40 @@ func NewMockProjectDeleteRepositorySelect(t interface {
 	return mock
 }
 
-// MockProjectDeleteRepositorySelect is an autogenerated mock type for the ProjectDeleteRepositorySelect type
-type MockProjectDeleteRepositorySelect struct {
+// MockModuleCreateRepositoryDelete is an autogenerated mock type for the ModuleCreateRepositoryDelete type
+type MockModuleCreateRepositoryDelete struct {
 	mock.Mock
 }
 
-type MockProjectDeleteRepositorySelect_Expecter struct {
+type MockModuleCreateRepositoryDelete_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectDeleteRepositorySelect) EXPECT() *MockProjectDeleteRepositorySelect_Expecter {
-	return &MockProjectDeleteRepositorySelect_Expecter{mock: &_m.Mock}
+func (_m *MockModuleCreateRepositoryDelete) EXPECT() *MockModuleCreateRepositoryDelete_Expecter {
+	return &MockModuleCreateRepositoryDelete_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectDeleteRepositorySelect
-func (_mock *MockProjectDeleteRepositorySelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockModuleCreateRepositoryDelete
+func (_mock *MockModuleCreateRepositoryDelete) Exec(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 *dao.Module
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) (*dao.Module, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) *dao.Module); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).(*dao.Module)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleDeleteRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -826,27 +827,11047 @@ func (_mock *MockProjectDeleteRepositorySelect) Exec(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockProjectDeleteRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectDeleteRepositorySelect_Exec_Call struct {
+// MockModuleCreateRepositoryDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleCreateRepositoryDelete_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleDeleteRequest
+func (_e *MockModuleCreateRepositoryDelete_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCreateRepositoryDelete_Exec_Call {
+	return &MockModuleCreateRepositoryDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleCreateRepositoryDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleDeleteRequest)) *MockModuleCreateRepositoryDelete_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleCreateRepositoryDelete_Exec_Call) Return(module *dao.Module, err error) *MockModuleCreateRepositoryDelete_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleCreateRepositoryDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error)) *MockModuleCreateRepositoryDelete_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleCreateRepositorySelect creates a new instance of MockModuleCreateRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleCreateRepositorySelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleCreateRepositorySelect {
+	mock := &MockModuleCreateRepositorySelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleCreateRepositorySelect is an autogenerated mock type for the ModuleCreateRepositorySelect type
+type MockModuleCreateRepositorySelect struct {
+	mock.Mock
+}
+
+type MockModuleCreateRepositorySelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleCreateRepositorySelect) EXPECT() *MockModuleCreateRepositorySelect_Expecter {
+	return &MockModuleCreateRepositorySelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleCreateRepositorySelect
+func (_mock *MockModuleCreateRepositorySelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleCreateRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleCreateRepositorySelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockModuleCreateRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCreateRepositorySelect_Exec_Call {
+	return &MockModuleCreateRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleCreateRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockModuleCreateRepositorySelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleCreateRepositorySelect_Exec_Call) Return(module *dao.Module, err error) *MockModuleCreateRepositorySelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleCreateRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockModuleCreateRepositorySelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleCreateRepositoryListVersions creates a new instance of MockModuleCreateRepositoryListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleCreateRepositoryListVersions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleCreateRepositoryListVersions {
+	mock := &MockModuleCreateRepositoryListVersions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleCreateRepositoryListVersions is an autogenerated mock type for the ModuleCreateRepositoryListVersions type
+type MockModuleCreateRepositoryListVersions struct {
+	mock.Mock
+}
+
+type MockModuleCreateRepositoryListVersions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleCreateRepositoryListVersions) EXPECT() *MockModuleCreateRepositoryListVersions_Expecter {
+	return &MockModuleCreateRepositoryListVersions_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleCreateRepositoryListVersions
+func (_mock *MockModuleCreateRepositoryListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleCreateRepositoryListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleCreateRepositoryListVersions_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockModuleCreateRepositoryListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleCreateRepositoryListVersions_Exec_Call {
+	return &MockModuleCreateRepositoryListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleCreateRepositoryListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockModuleCreateRepositoryListVersions_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleCreateRepositoryListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockModuleCreateRepositoryListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockModuleCreateRepositoryListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockModuleCreateRepositoryListVersions_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleDeleteRepository creates a new instance of MockModuleDeleteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleDeleteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleDeleteRepository {
+	mock := &MockModuleDeleteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleDeleteRepository is an autogenerated mock type for the ModuleDeleteRepository type
+type MockModuleDeleteRepository struct {
+	mock.Mock
+}
+
+type MockModuleDeleteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleDeleteRepository) EXPECT() *MockModuleDeleteRepository_Expecter {
+	return &MockModuleDeleteRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleDeleteRepository
+func (_mock *MockModuleDeleteRepository) Exec(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleDeleteRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleDeleteRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleDeleteRequest
+func (_e *MockModuleDeleteRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleDeleteRepository_Exec_Call {
+	return &MockModuleDeleteRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleDeleteRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleDeleteRequest)) *MockModuleDeleteRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleDeleteRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleDeleteRepository_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleDeleteRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error)) *MockModuleDeleteRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleListRepository creates a new instance of MockModuleListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleListRepository {
+	mock := &MockModuleListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleListRepository is an autogenerated mock type for the ModuleListRepository type
+type MockModuleListRepository struct {
+	mock.Mock
+}
+
+type MockModuleListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleListRepository) EXPECT() *MockModuleListRepository_Expecter {
+	return &MockModuleListRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleListRepository
+func (_mock *MockModuleListRepository) Exec(ctx context.Context, request *dao.ModuleListRequest) ([]*dao.ModuleSummary, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListRequest) ([]*dao.ModuleSummary, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListRequest) []*dao.ModuleSummary); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleListRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleListRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListRequest
+func (_e *MockModuleListRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleListRepository_Exec_Call {
+	return &MockModuleListRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleListRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListRequest)) *MockModuleListRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleListRepository_Exec_Call) Return(moduleSummarys []*dao.ModuleSummary, err error) *MockModuleListRepository_Exec_Call {
+	_c.Call.Return(moduleSummarys, err)
+	return _c
+}
+
+func (_c *MockModuleListRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListRequest) ([]*dao.ModuleSummary, error)) *MockModuleListRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleListVersionsRepository creates a new instance of MockModuleListVersionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleListVersionsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleListVersionsRepository {
+	mock := &MockModuleListVersionsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleListVersionsRepository is an autogenerated mock type for the ModuleListVersionsRepository type
+type MockModuleListVersionsRepository struct {
+	mock.Mock
+}
+
+type MockModuleListVersionsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleListVersionsRepository) EXPECT() *MockModuleListVersionsRepository_Expecter {
+	return &MockModuleListVersionsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleListVersionsRepository
+func (_mock *MockModuleListVersionsRepository) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleListVersionsRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleListVersionsRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockModuleListVersionsRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleListVersionsRepository_Exec_Call {
+	return &MockModuleListVersionsRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleListVersionsRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockModuleListVersionsRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleListVersionsRepository_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockModuleListVersionsRepository_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockModuleListVersionsRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockModuleListVersionsRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleLoadSystemRepository creates a new instance of MockModuleLoadSystemRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleLoadSystemRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleLoadSystemRepository {
+	mock := &MockModuleLoadSystemRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleLoadSystemRepository is an autogenerated mock type for the ModuleLoadSystemRepository type
+type MockModuleLoadSystemRepository struct {
+	mock.Mock
+}
+
+type MockModuleLoadSystemRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleLoadSystemRepository) EXPECT() *MockModuleLoadSystemRepository_Expecter {
+	return &MockModuleLoadSystemRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleLoadSystemRepository
+func (_mock *MockModuleLoadSystemRepository) Exec(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleInsertRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleLoadSystemRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleLoadSystemRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleInsertRequest
+func (_e *MockModuleLoadSystemRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepository_Exec_Call {
+	return &MockModuleLoadSystemRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleLoadSystemRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleInsertRequest)) *MockModuleLoadSystemRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleLoadSystemRepository_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleInsertRequest) (*dao.Module, error)) *MockModuleLoadSystemRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleLoadSystemRepositoryDelete creates a new instance of MockModuleLoadSystemRepositoryDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleLoadSystemRepositoryDelete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleLoadSystemRepositoryDelete {
+	mock := &MockModuleLoadSystemRepositoryDelete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleLoadSystemRepositoryDelete is an autogenerated mock type for the ModuleLoadSystemRepositoryDelete type
+type MockModuleLoadSystemRepositoryDelete struct {
+	mock.Mock
+}
+
+type MockModuleLoadSystemRepositoryDelete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleLoadSystemRepositoryDelete) EXPECT() *MockModuleLoadSystemRepositoryDelete_Expecter {
+	return &MockModuleLoadSystemRepositoryDelete_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleLoadSystemRepositoryDelete
+func (_mock *MockModuleLoadSystemRepositoryDelete) Exec(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleDeleteRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleLoadSystemRepositoryDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleLoadSystemRepositoryDelete_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleDeleteRequest
+func (_e *MockModuleLoadSystemRepositoryDelete_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
+	return &MockModuleLoadSystemRepositoryDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleLoadSystemRepositoryDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleDeleteRequest)) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepositoryDelete_Exec_Call) Return(module *dao.Module, err error) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepositoryDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error)) *MockModuleLoadSystemRepositoryDelete_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleLoadSystemRepositorySelect creates a new instance of MockModuleLoadSystemRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleLoadSystemRepositorySelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleLoadSystemRepositorySelect {
+	mock := &MockModuleLoadSystemRepositorySelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleLoadSystemRepositorySelect is an autogenerated mock type for the ModuleLoadSystemRepositorySelect type
+type MockModuleLoadSystemRepositorySelect struct {
+	mock.Mock
+}
+
+type MockModuleLoadSystemRepositorySelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleLoadSystemRepositorySelect) EXPECT() *MockModuleLoadSystemRepositorySelect_Expecter {
+	return &MockModuleLoadSystemRepositorySelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleLoadSystemRepositorySelect
+func (_mock *MockModuleLoadSystemRepositorySelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleLoadSystemRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleLoadSystemRepositorySelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockModuleLoadSystemRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepositorySelect_Exec_Call {
+	return &MockModuleLoadSystemRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleLoadSystemRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockModuleLoadSystemRepositorySelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepositorySelect_Exec_Call) Return(module *dao.Module, err error) *MockModuleLoadSystemRepositorySelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockModuleLoadSystemRepositorySelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleLoadSystemRepositoryListVersions creates a new instance of MockModuleLoadSystemRepositoryListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleLoadSystemRepositoryListVersions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleLoadSystemRepositoryListVersions {
+	mock := &MockModuleLoadSystemRepositoryListVersions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleLoadSystemRepositoryListVersions is an autogenerated mock type for the ModuleLoadSystemRepositoryListVersions type
+type MockModuleLoadSystemRepositoryListVersions struct {
+	mock.Mock
+}
+
+type MockModuleLoadSystemRepositoryListVersions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleLoadSystemRepositoryListVersions) EXPECT() *MockModuleLoadSystemRepositoryListVersions_Expecter {
+	return &MockModuleLoadSystemRepositoryListVersions_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleLoadSystemRepositoryListVersions
+func (_mock *MockModuleLoadSystemRepositoryListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleLoadSystemRepositoryListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleLoadSystemRepositoryListVersions_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockModuleLoadSystemRepositoryListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
+	return &MockModuleLoadSystemRepositoryListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleLoadSystemRepositoryListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepositoryListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockModuleLoadSystemRepositoryListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockModuleLoadSystemRepositoryListVersions_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleSelectRepository creates a new instance of MockModuleSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleSelectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleSelectRepository {
+	mock := &MockModuleSelectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleSelectRepository is an autogenerated mock type for the ModuleSelectRepository type
+type MockModuleSelectRepository struct {
+	mock.Mock
+}
+
+type MockModuleSelectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleSelectRepository) EXPECT() *MockModuleSelectRepository_Expecter {
+	return &MockModuleSelectRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleSelectRepository
+func (_mock *MockModuleSelectRepository) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleSelectRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockModuleSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleSelectRepository_Exec_Call {
+	return &MockModuleSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockModuleSelectRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleSelectRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleSelectRepository_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockModuleSelectRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModuleSelectLLMSchemaRepository creates a new instance of MockModuleSelectLLMSchemaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModuleSelectLLMSchemaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModuleSelectLLMSchemaRepository {
+	mock := &MockModuleSelectLLMSchemaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModuleSelectLLMSchemaRepository is an autogenerated mock type for the ModuleSelectLLMSchemaRepository type
+type MockModuleSelectLLMSchemaRepository struct {
+	mock.Mock
+}
+
+type MockModuleSelectLLMSchemaRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModuleSelectLLMSchemaRepository) EXPECT() *MockModuleSelectLLMSchemaRepository_Expecter {
+	return &MockModuleSelectLLMSchemaRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockModuleSelectLLMSchemaRepository
+func (_mock *MockModuleSelectLLMSchemaRepository) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModuleSelectLLMSchemaRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockModuleSelectLLMSchemaRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockModuleSelectLLMSchemaRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockModuleSelectLLMSchemaRepository_Exec_Call {
+	return &MockModuleSelectLLMSchemaRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockModuleSelectLLMSchemaRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockModuleSelectLLMSchemaRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModuleSelectLLMSchemaRepository_Exec_Call) Return(module *dao.Module, err error) *MockModuleSelectLLMSchemaRepository_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockModuleSelectLLMSchemaRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockModuleSelectLLMSchemaRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectAccessRepository creates a new instance of MockProjectAccessRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectAccessRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectAccessRepository {
+	mock := &MockProjectAccessRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectAccessRepository is an autogenerated mock type for the ProjectAccessRepository type
+type MockProjectAccessRepository struct {
+	mock.Mock
+}
+
+type MockProjectAccessRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectAccessRepository) EXPECT() *MockProjectAccessRepository_Expecter {
+	return &MockProjectAccessRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectAccessRepository
+func (_mock *MockProjectAccessRepository) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectAccessRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectAccessRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectAccessRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectAccessRepository_Exec_Call {
+	return &MockProjectAccessRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectAccessRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectAccessRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectAccessRepository_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectAccessRepository_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectAccessRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectAccessRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectDeleteRepositorySelect creates a new instance of MockProjectDeleteRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectDeleteRepositorySelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectDeleteRepositorySelect {
+	mock := &MockProjectDeleteRepositorySelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectDeleteRepositorySelect is an autogenerated mock type for the ProjectDeleteRepositorySelect type
+type MockProjectDeleteRepositorySelect struct {
+	mock.Mock
+}
+
+type MockProjectDeleteRepositorySelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectDeleteRepositorySelect) EXPECT() *MockProjectDeleteRepositorySelect_Expecter {
+	return &MockProjectDeleteRepositorySelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectDeleteRepositorySelect
+func (_mock *MockProjectDeleteRepositorySelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectDeleteRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectDeleteRepositorySelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectDeleteRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectDeleteRepositorySelect_Exec_Call {
+	return &MockProjectDeleteRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectDeleteRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectDeleteRepositorySelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectDeleteRepositorySelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectDeleteRepositorySelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectDeleteRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectDeleteRepositorySelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectDeleteRepository creates a new instance of MockProjectDeleteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectDeleteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectDeleteRepository {
+	mock := &MockProjectDeleteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectDeleteRepository is an autogenerated mock type for the ProjectDeleteRepository type
+type MockProjectDeleteRepository struct {
+	mock.Mock
+}
+
+type MockProjectDeleteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectDeleteRepository) EXPECT() *MockProjectDeleteRepository_Expecter {
+	return &MockProjectDeleteRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectDeleteRepository
+func (_mock *MockProjectDeleteRepository) Exec(ctx context.Context, request *dao.ProjectDeleteRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectDeleteRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectDeleteRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectDeleteRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectDeleteRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectDeleteRequest
+func (_e *MockProjectDeleteRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectDeleteRepository_Exec_Call {
+	return &MockProjectDeleteRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectDeleteRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectDeleteRequest)) *MockProjectDeleteRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectDeleteRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectDeleteRepository_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectDeleteRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectDeleteRequest) (*dao.Project, error)) *MockProjectDeleteRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectDeleteRepositoryProjectMemberSelect creates a new instance of MockProjectDeleteRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectDeleteRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectDeleteRepositoryProjectMemberSelect {
+	mock := &MockProjectDeleteRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectDeleteRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectDeleteRepositoryProjectMemberSelect type
+type MockProjectDeleteRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectDeleteRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectDeleteRepositoryProjectMemberSelect) EXPECT() *MockProjectDeleteRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectDeleteRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectDeleteRepositoryProjectMemberSelect
+func (_mock *MockProjectDeleteRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectDeleteRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectDeleteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectForkRepository creates a new instance of MockProjectForkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectForkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectForkRepository {
+	mock := &MockProjectForkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectForkRepository is an autogenerated mock type for the ProjectForkRepository type
+type MockProjectForkRepository struct {
+	mock.Mock
+}
+
+type MockProjectForkRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectForkRepository) EXPECT() *MockProjectForkRepository_Expecter {
+	return &MockProjectForkRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectForkRepository
+func (_mock *MockProjectForkRepository) Exec(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectForkRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectForkRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectInsertRequest
+func (_e *MockProjectForkRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectForkRepository_Exec_Call {
+	return &MockProjectForkRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectForkRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectInsertRequest)) *MockProjectForkRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectForkRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectForkRepository_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectForkRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error)) *MockProjectForkRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectForkRepositoryProjectSelect creates a new instance of MockProjectForkRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectForkRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectForkRepositoryProjectSelect {
+	mock := &MockProjectForkRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectForkRepositoryProjectSelect is an autogenerated mock type for the ProjectForkRepositoryProjectSelect type
+type MockProjectForkRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectForkRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectForkRepositoryProjectSelect) EXPECT() *MockProjectForkRepositoryProjectSelect_Expecter {
+	return &MockProjectForkRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectForkRepositoryProjectSelect
+func (_mock *MockProjectForkRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectForkRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectForkRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectForkRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectForkRepositoryProjectSelect_Exec_Call {
+	return &MockProjectForkRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectForkRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectForkRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectForkRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectForkRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectForkRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectForkRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectForkRepositorySchemaList creates a new instance of MockProjectForkRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectForkRepositorySchemaList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectForkRepositorySchemaList {
+	mock := &MockProjectForkRepositorySchemaList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectForkRepositorySchemaList is an autogenerated mock type for the ProjectForkRepositorySchemaList type
+type MockProjectForkRepositorySchemaList struct {
+	mock.Mock
+}
+
+type MockProjectForkRepositorySchemaList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectForkRepositorySchemaList) EXPECT() *MockProjectForkRepositorySchemaList_Expecter {
+	return &MockProjectForkRepositorySchemaList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectForkRepositorySchemaList
+func (_mock *MockProjectForkRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectForkRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectForkRepositorySchemaList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListRequest
+func (_e *MockProjectForkRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectForkRepositorySchemaList_Exec_Call {
+	return &MockProjectForkRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectForkRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListRequest)) *MockProjectForkRepositorySchemaList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectForkRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockProjectForkRepositorySchemaList_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectForkRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)) *MockProjectForkRepositorySchemaList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectForkRepositorySchemaInsert creates a new instance of MockProjectForkRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectForkRepositorySchemaInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectForkRepositorySchemaInsert {
+	mock := &MockProjectForkRepositorySchemaInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectForkRepositorySchemaInsert is an autogenerated mock type for the ProjectForkRepositorySchemaInsert type
+type MockProjectForkRepositorySchemaInsert struct {
+	mock.Mock
+}
+
+type MockProjectForkRepositorySchemaInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectForkRepositorySchemaInsert) EXPECT() *MockProjectForkRepositorySchemaInsert_Expecter {
+	return &MockProjectForkRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectForkRepositorySchemaInsert
+func (_mock *MockProjectForkRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectForkRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectForkRepositorySchemaInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockProjectForkRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectForkRepositorySchemaInsert_Exec_Call {
+	return &MockProjectForkRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectForkRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectForkRepositorySchemaInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectForkRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectForkRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectForkRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectForkRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectForkRepositoryProjectMemberSelect creates a new instance of MockProjectForkRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectForkRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectForkRepositoryProjectMemberSelect {
+	mock := &MockProjectForkRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectForkRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectForkRepositoryProjectMemberSelect type
+type MockProjectForkRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectForkRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectForkRepositoryProjectMemberSelect) EXPECT() *MockProjectForkRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectForkRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectForkRepositoryProjectMemberSelect
+func (_mock *MockProjectForkRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectForkRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectForkRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectForkRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectForkRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectForkRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectForkRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectForkRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectForkRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectForkRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectForkRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectForkRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectInsertRepository creates a new instance of MockProjectInsertRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectInsertRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectInsertRepository {
+	mock := &MockProjectInsertRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectInsertRepository is an autogenerated mock type for the ProjectInsertRepository type
+type MockProjectInsertRepository struct {
+	mock.Mock
+}
+
+type MockProjectInsertRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectInsertRepository) EXPECT() *MockProjectInsertRepository_Expecter {
+	return &MockProjectInsertRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectInsertRepository
+func (_mock *MockProjectInsertRepository) Exec(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectInsertRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectInsertRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectInsertRequest
+func (_e *MockProjectInsertRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInsertRepository_Exec_Call {
+	return &MockProjectInsertRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectInsertRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectInsertRequest)) *MockProjectInsertRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectInsertRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectInsertRepository_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectInsertRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error)) *MockProjectInsertRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectInsertRepositorySchemaInsert creates a new instance of MockProjectInsertRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectInsertRepositorySchemaInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectInsertRepositorySchemaInsert {
+	mock := &MockProjectInsertRepositorySchemaInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectInsertRepositorySchemaInsert is an autogenerated mock type for the ProjectInsertRepositorySchemaInsert type
+type MockProjectInsertRepositorySchemaInsert struct {
+	mock.Mock
+}
+
+type MockProjectInsertRepositorySchemaInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectInsertRepositorySchemaInsert) EXPECT() *MockProjectInsertRepositorySchemaInsert_Expecter {
+	return &MockProjectInsertRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectInsertRepositorySchemaInsert
+func (_mock *MockProjectInsertRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectInsertRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectInsertRepositorySchemaInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockProjectInsertRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
+	return &MockProjectInsertRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectInsertRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectInsertRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectInsertRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectInsertRepositoryModuleSelect creates a new instance of MockProjectInsertRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectInsertRepositoryModuleSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectInsertRepositoryModuleSelect {
+	mock := &MockProjectInsertRepositoryModuleSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectInsertRepositoryModuleSelect is an autogenerated mock type for the ProjectInsertRepositoryModuleSelect type
+type MockProjectInsertRepositoryModuleSelect struct {
+	mock.Mock
+}
+
+type MockProjectInsertRepositoryModuleSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectInsertRepositoryModuleSelect) EXPECT() *MockProjectInsertRepositoryModuleSelect_Expecter {
+	return &MockProjectInsertRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectInsertRepositoryModuleSelect
+func (_mock *MockProjectInsertRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectInsertRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectInsertRepositoryModuleSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockProjectInsertRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
+	return &MockProjectInsertRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectInsertRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectInsertRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockProjectInsertRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectInsertRepositoryModuleListVersions creates a new instance of MockProjectInsertRepositoryModuleListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectInsertRepositoryModuleListVersions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectInsertRepositoryModuleListVersions {
+	mock := &MockProjectInsertRepositoryModuleListVersions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectInsertRepositoryModuleListVersions is an autogenerated mock type for the ProjectInsertRepositoryModuleListVersions type
+type MockProjectInsertRepositoryModuleListVersions struct {
+	mock.Mock
+}
+
+type MockProjectInsertRepositoryModuleListVersions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectInsertRepositoryModuleListVersions) EXPECT() *MockProjectInsertRepositoryModuleListVersions_Expecter {
+	return &MockProjectInsertRepositoryModuleListVersions_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectInsertRepositoryModuleListVersions
+func (_mock *MockProjectInsertRepositoryModuleListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectInsertRepositoryModuleListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectInsertRepositoryModuleListVersions_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockProjectInsertRepositoryModuleListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInsertRepositoryModuleListVersions_Exec_Call {
+	return &MockProjectInsertRepositoryModuleListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectInsertRepositoryModuleListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockProjectInsertRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectInsertRepositoryModuleListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockProjectInsertRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockProjectInsertRepositoryModuleListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockProjectInsertRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectListRepository creates a new instance of MockProjectListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectListRepository {
+	mock := &MockProjectListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectListRepository is an autogenerated mock type for the ProjectListRepository type
+type MockProjectListRepository struct {
+	mock.Mock
+}
+
+type MockProjectListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectListRepository) EXPECT() *MockProjectListRepository_Expecter {
+	return &MockProjectListRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectListRepository
+func (_mock *MockProjectListRepository) Exec(ctx context.Context, request *dao.ProjectListRequest) ([]*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectListRequest) ([]*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectListRequest) []*dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectListRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectListRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectListRequest
+func (_e *MockProjectListRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectListRepository_Exec_Call {
+	return &MockProjectListRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectListRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectListRequest)) *MockProjectListRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectListRepository_Exec_Call) Return(projects []*dao.Project, err error) *MockProjectListRepository_Exec_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockProjectListRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectListRequest) ([]*dao.Project, error)) *MockProjectListRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberDeleteRepository creates a new instance of MockProjectMemberDeleteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberDeleteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberDeleteRepository {
+	mock := &MockProjectMemberDeleteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberDeleteRepository is an autogenerated mock type for the ProjectMemberDeleteRepository type
+type MockProjectMemberDeleteRepository struct {
+	mock.Mock
+}
+
+type MockProjectMemberDeleteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberDeleteRepository) EXPECT() *MockProjectMemberDeleteRepository_Expecter {
+	return &MockProjectMemberDeleteRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberDeleteRepository
+func (_mock *MockProjectMemberDeleteRepository) Exec(ctx context.Context, request *dao.ProjectMemberDeleteRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberDeleteRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberDeleteRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberDeleteRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberDeleteRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberDeleteRequest
+func (_e *MockProjectMemberDeleteRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberDeleteRepository_Exec_Call {
+	return &MockProjectMemberDeleteRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberDeleteRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberDeleteRequest)) *MockProjectMemberDeleteRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteRepository_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectMemberDeleteRepository_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberDeleteRequest) (*dao.ProjectMember, error)) *MockProjectMemberDeleteRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberDeleteRepositoryProjectSelect creates a new instance of MockProjectMemberDeleteRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberDeleteRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberDeleteRepositoryProjectSelect {
+	mock := &MockProjectMemberDeleteRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberDeleteRepositoryProjectSelect is an autogenerated mock type for the ProjectMemberDeleteRepositoryProjectSelect type
+type MockProjectMemberDeleteRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectMemberDeleteRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberDeleteRepositoryProjectSelect) EXPECT() *MockProjectMemberDeleteRepositoryProjectSelect_Expecter {
+	return &MockProjectMemberDeleteRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberDeleteRepositoryProjectSelect
+func (_mock *MockProjectMemberDeleteRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectMemberDeleteRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call {
+	return &MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectMemberDeleteRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberDeleteRepositoryProjectMemberSelect creates a new instance of MockProjectMemberDeleteRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberDeleteRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberDeleteRepositoryProjectMemberSelect {
+	mock := &MockProjectMemberDeleteRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberDeleteRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectMemberDeleteRepositoryProjectMemberSelect type
+type MockProjectMemberDeleteRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectMemberDeleteRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberDeleteRepositoryProjectMemberSelect) EXPECT() *MockProjectMemberDeleteRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectMemberDeleteRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberDeleteRepositoryProjectMemberSelect
+func (_mock *MockProjectMemberDeleteRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectMemberDeleteRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectMemberDeleteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberListRepository creates a new instance of MockProjectMemberListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberListRepository {
+	mock := &MockProjectMemberListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberListRepository is an autogenerated mock type for the ProjectMemberListRepository type
+type MockProjectMemberListRepository struct {
+	mock.Mock
+}
+
+type MockProjectMemberListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberListRepository) EXPECT() *MockProjectMemberListRepository_Expecter {
+	return &MockProjectMemberListRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberListRepository
+func (_mock *MockProjectMemberListRepository) Exec(ctx context.Context, request *dao.ProjectMemberListRequest) ([]*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberListRequest) ([]*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberListRequest) []*dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberListRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberListRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberListRequest
+func (_e *MockProjectMemberListRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberListRepository_Exec_Call {
+	return &MockProjectMemberListRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberListRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberListRequest)) *MockProjectMemberListRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberListRepository_Exec_Call) Return(projectMembers []*dao.ProjectMember, err error) *MockProjectMemberListRepository_Exec_Call {
+	_c.Call.Return(projectMembers, err)
+	return _c
+}
+
+func (_c *MockProjectMemberListRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberListRequest) ([]*dao.ProjectMember, error)) *MockProjectMemberListRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberListRepositoryProjectSelect creates a new instance of MockProjectMemberListRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberListRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberListRepositoryProjectSelect {
+	mock := &MockProjectMemberListRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberListRepositoryProjectSelect is an autogenerated mock type for the ProjectMemberListRepositoryProjectSelect type
+type MockProjectMemberListRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectMemberListRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberListRepositoryProjectSelect) EXPECT() *MockProjectMemberListRepositoryProjectSelect_Expecter {
+	return &MockProjectMemberListRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberListRepositoryProjectSelect
+func (_mock *MockProjectMemberListRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberListRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberListRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectMemberListRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberListRepositoryProjectSelect_Exec_Call {
+	return &MockProjectMemberListRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberListRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectMemberListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberListRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectMemberListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectMemberListRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectMemberListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberListRepositoryProjectMemberSelect creates a new instance of MockProjectMemberListRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberListRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberListRepositoryProjectMemberSelect {
+	mock := &MockProjectMemberListRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberListRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectMemberListRepositoryProjectMemberSelect type
+type MockProjectMemberListRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectMemberListRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberListRepositoryProjectMemberSelect) EXPECT() *MockProjectMemberListRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectMemberListRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberListRepositoryProjectMemberSelect
+func (_mock *MockProjectMemberListRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectMemberListRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectMemberListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberUpsertRepository creates a new instance of MockProjectMemberUpsertRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberUpsertRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberUpsertRepository {
+	mock := &MockProjectMemberUpsertRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberUpsertRepository is an autogenerated mock type for the ProjectMemberUpsertRepository type
+type MockProjectMemberUpsertRepository struct {
+	mock.Mock
+}
+
+type MockProjectMemberUpsertRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberUpsertRepository) EXPECT() *MockProjectMemberUpsertRepository_Expecter {
+	return &MockProjectMemberUpsertRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberUpsertRepository
+func (_mock *MockProjectMemberUpsertRepository) Exec(ctx context.Context, request *dao.ProjectMemberUpsertRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberUpsertRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberUpsertRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberUpsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberUpsertRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberUpsertRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberUpsertRequest
+func (_e *MockProjectMemberUpsertRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberUpsertRepository_Exec_Call {
+	return &MockProjectMemberUpsertRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberUpsertRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberUpsertRequest)) *MockProjectMemberUpsertRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberUpsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberUpsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertRepository_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectMemberUpsertRepository_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberUpsertRequest) (*dao.ProjectMember, error)) *MockProjectMemberUpsertRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberUpsertRepositoryProjectSelect creates a new instance of MockProjectMemberUpsertRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberUpsertRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberUpsertRepositoryProjectSelect {
+	mock := &MockProjectMemberUpsertRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberUpsertRepositoryProjectSelect is an autogenerated mock type for the ProjectMemberUpsertRepositoryProjectSelect type
+type MockProjectMemberUpsertRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectMemberUpsertRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberUpsertRepositoryProjectSelect) EXPECT() *MockProjectMemberUpsertRepositoryProjectSelect_Expecter {
+	return &MockProjectMemberUpsertRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberUpsertRepositoryProjectSelect
+func (_mock *MockProjectMemberUpsertRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectMemberUpsertRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call {
+	return &MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectMemberUpsertRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectMemberUpsertRepositoryProjectMemberSelect creates a new instance of MockProjectMemberUpsertRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectMemberUpsertRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectMemberUpsertRepositoryProjectMemberSelect {
+	mock := &MockProjectMemberUpsertRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectMemberUpsertRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectMemberUpsertRepositoryProjectMemberSelect type
+type MockProjectMemberUpsertRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectMemberUpsertRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectMemberUpsertRepositoryProjectMemberSelect) EXPECT() *MockProjectMemberUpsertRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectMemberUpsertRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectMemberUpsertRepositoryProjectMemberSelect
+func (_mock *MockProjectMemberUpsertRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectMemberUpsertRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectMemberUpsertRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSelectRepository creates a new instance of MockProjectSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSelectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSelectRepository {
+	mock := &MockProjectSelectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSelectRepository is an autogenerated mock type for the ProjectSelectRepository type
+type MockProjectSelectRepository struct {
+	mock.Mock
+}
+
+type MockProjectSelectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSelectRepository) EXPECT() *MockProjectSelectRepository_Expecter {
+	return &MockProjectSelectRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSelectRepository
+func (_mock *MockProjectSelectRepository) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSelectRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSelectRepository_Exec_Call {
+	return &MockProjectSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectSelectRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSelectRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectSelectRepository_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectSelectRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSelectRepositoryProjectMemberSelect creates a new instance of MockProjectSelectRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSelectRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSelectRepositoryProjectMemberSelect {
+	mock := &MockProjectSelectRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSelectRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectSelectRepositoryProjectMemberSelect type
+type MockProjectSelectRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectSelectRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSelectRepositoryProjectMemberSelect) EXPECT() *MockProjectSelectRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectSelectRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSelectRepositoryProjectMemberSelect
+func (_mock *MockProjectSelectRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSelectRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSelectRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectSelectRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSelectRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectSelectRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSelectRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectSelectRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSelectRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectSelectRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectSelectRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectSelectRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareCreateRepository creates a new instance of MockProjectShareCreateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareCreateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareCreateRepository {
+	mock := &MockProjectShareCreateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareCreateRepository is an autogenerated mock type for the ProjectShareCreateRepository type
+type MockProjectShareCreateRepository struct {
+	mock.Mock
+}
+
+type MockProjectShareCreateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareCreateRepository) EXPECT() *MockProjectShareCreateRepository_Expecter {
+	return &MockProjectShareCreateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareCreateRepository
+func (_mock *MockProjectShareCreateRepository) Exec(ctx context.Context, request *dao.ProjectShareInsertRequest) (*dao.ProjectShare, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectShare
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareInsertRequest) (*dao.ProjectShare, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareInsertRequest) *dao.ProjectShare); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectShare)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectShareInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareCreateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareCreateRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectShareInsertRequest
+func (_e *MockProjectShareCreateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareCreateRepository_Exec_Call {
+	return &MockProjectShareCreateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareCreateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectShareInsertRequest)) *MockProjectShareCreateRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectShareInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectShareInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareCreateRepository_Exec_Call) Return(projectShare *dao.ProjectShare, err error) *MockProjectShareCreateRepository_Exec_Call {
+	_c.Call.Return(projectShare, err)
+	return _c
+}
+
+func (_c *MockProjectShareCreateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectShareInsertRequest) (*dao.ProjectShare, error)) *MockProjectShareCreateRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareCreateRepositoryProjectSelect creates a new instance of MockProjectShareCreateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareCreateRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareCreateRepositoryProjectSelect {
+	mock := &MockProjectShareCreateRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareCreateRepositoryProjectSelect is an autogenerated mock type for the ProjectShareCreateRepositoryProjectSelect type
+type MockProjectShareCreateRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectShareCreateRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareCreateRepositoryProjectSelect) EXPECT() *MockProjectShareCreateRepositoryProjectSelect_Expecter {
+	return &MockProjectShareCreateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareCreateRepositoryProjectSelect
+func (_mock *MockProjectShareCreateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareCreateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareCreateRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectShareCreateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareCreateRepositoryProjectSelect_Exec_Call {
+	return &MockProjectShareCreateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareCreateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectShareCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareCreateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectShareCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectShareCreateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectShareCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareCreateRepositoryProjectMemberSelect creates a new instance of MockProjectShareCreateRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareCreateRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareCreateRepositoryProjectMemberSelect {
+	mock := &MockProjectShareCreateRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareCreateRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectShareCreateRepositoryProjectMemberSelect type
+type MockProjectShareCreateRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectShareCreateRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareCreateRepositoryProjectMemberSelect) EXPECT() *MockProjectShareCreateRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectShareCreateRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareCreateRepositoryProjectMemberSelect
+func (_mock *MockProjectShareCreateRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectShareCreateRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectShareCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareListRepository creates a new instance of MockProjectShareListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareListRepository {
+	mock := &MockProjectShareListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareListRepository is an autogenerated mock type for the ProjectShareListRepository type
+type MockProjectShareListRepository struct {
+	mock.Mock
+}
+
+type MockProjectShareListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareListRepository) EXPECT() *MockProjectShareListRepository_Expecter {
+	return &MockProjectShareListRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareListRepository
+func (_mock *MockProjectShareListRepository) Exec(ctx context.Context, request *dao.ProjectShareListRequest) ([]*dao.ProjectShare, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ProjectShare
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareListRequest) ([]*dao.ProjectShare, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareListRequest) []*dao.ProjectShare); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ProjectShare)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectShareListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareListRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareListRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectShareListRequest
+func (_e *MockProjectShareListRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareListRepository_Exec_Call {
+	return &MockProjectShareListRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareListRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectShareListRequest)) *MockProjectShareListRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectShareListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectShareListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareListRepository_Exec_Call) Return(projectShares []*dao.ProjectShare, err error) *MockProjectShareListRepository_Exec_Call {
+	_c.Call.Return(projectShares, err)
+	return _c
+}
+
+func (_c *MockProjectShareListRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectShareListRequest) ([]*dao.ProjectShare, error)) *MockProjectShareListRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareListRepositoryProjectSelect creates a new instance of MockProjectShareListRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareListRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareListRepositoryProjectSelect {
+	mock := &MockProjectShareListRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareListRepositoryProjectSelect is an autogenerated mock type for the ProjectShareListRepositoryProjectSelect type
+type MockProjectShareListRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectShareListRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareListRepositoryProjectSelect) EXPECT() *MockProjectShareListRepositoryProjectSelect_Expecter {
+	return &MockProjectShareListRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareListRepositoryProjectSelect
+func (_mock *MockProjectShareListRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareListRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareListRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectShareListRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareListRepositoryProjectSelect_Exec_Call {
+	return &MockProjectShareListRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareListRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectShareListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareListRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectShareListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectShareListRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectShareListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareListRepositoryProjectMemberSelect creates a new instance of MockProjectShareListRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareListRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareListRepositoryProjectMemberSelect {
+	mock := &MockProjectShareListRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareListRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectShareListRepositoryProjectMemberSelect type
+type MockProjectShareListRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectShareListRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareListRepositoryProjectMemberSelect) EXPECT() *MockProjectShareListRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectShareListRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareListRepositoryProjectMemberSelect
+func (_mock *MockProjectShareListRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareListRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareListRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectShareListRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareListRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectShareListRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareListRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectShareListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareListRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectShareListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectShareListRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectShareListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareRevokeRepository creates a new instance of MockProjectShareRevokeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareRevokeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareRevokeRepository {
+	mock := &MockProjectShareRevokeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareRevokeRepository is an autogenerated mock type for the ProjectShareRevokeRepository type
+type MockProjectShareRevokeRepository struct {
+	mock.Mock
+}
+
+type MockProjectShareRevokeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareRevokeRepository) EXPECT() *MockProjectShareRevokeRepository_Expecter {
+	return &MockProjectShareRevokeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareRevokeRepository
+func (_mock *MockProjectShareRevokeRepository) Exec(ctx context.Context, request *dao.ProjectShareRevokeRequest) (*dao.ProjectShare, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectShare
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareRevokeRequest) (*dao.ProjectShare, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareRevokeRequest) *dao.ProjectShare); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectShare)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectShareRevokeRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareRevokeRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareRevokeRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectShareRevokeRequest
+func (_e *MockProjectShareRevokeRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareRevokeRepository_Exec_Call {
+	return &MockProjectShareRevokeRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareRevokeRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectShareRevokeRequest)) *MockProjectShareRevokeRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectShareRevokeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectShareRevokeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareRevokeRepository_Exec_Call) Return(projectShare *dao.ProjectShare, err error) *MockProjectShareRevokeRepository_Exec_Call {
+	_c.Call.Return(projectShare, err)
+	return _c
+}
+
+func (_c *MockProjectShareRevokeRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectShareRevokeRequest) (*dao.ProjectShare, error)) *MockProjectShareRevokeRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareRevokeRepositoryProjectSelect creates a new instance of MockProjectShareRevokeRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareRevokeRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareRevokeRepositoryProjectSelect {
+	mock := &MockProjectShareRevokeRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareRevokeRepositoryProjectSelect is an autogenerated mock type for the ProjectShareRevokeRepositoryProjectSelect type
+type MockProjectShareRevokeRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectShareRevokeRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareRevokeRepositoryProjectSelect) EXPECT() *MockProjectShareRevokeRepositoryProjectSelect_Expecter {
+	return &MockProjectShareRevokeRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareRevokeRepositoryProjectSelect
+func (_mock *MockProjectShareRevokeRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareRevokeRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareRevokeRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectShareRevokeRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareRevokeRepositoryProjectSelect_Exec_Call {
+	return &MockProjectShareRevokeRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareRevokeRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectShareRevokeRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareRevokeRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectShareRevokeRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectShareRevokeRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectShareRevokeRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareRevokeRepositoryProjectMemberSelect creates a new instance of MockProjectShareRevokeRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareRevokeRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareRevokeRepositoryProjectMemberSelect {
+	mock := &MockProjectShareRevokeRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareRevokeRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectShareRevokeRepositoryProjectMemberSelect type
+type MockProjectShareRevokeRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectShareRevokeRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareRevokeRepositoryProjectMemberSelect) EXPECT() *MockProjectShareRevokeRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectShareRevokeRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareRevokeRepositoryProjectMemberSelect
+func (_mock *MockProjectShareRevokeRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectShareRevokeRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectShareRevokeRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareSelectRepository creates a new instance of MockProjectShareSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareSelectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareSelectRepository {
+	mock := &MockProjectShareSelectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareSelectRepository is an autogenerated mock type for the ProjectShareSelectRepository type
+type MockProjectShareSelectRepository struct {
+	mock.Mock
+}
+
+type MockProjectShareSelectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareSelectRepository) EXPECT() *MockProjectShareSelectRepository_Expecter {
+	return &MockProjectShareSelectRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareSelectRepository
+func (_mock *MockProjectShareSelectRepository) Exec(ctx context.Context, request *dao.ProjectShareSelectRequest) (*dao.ProjectShare, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectShare
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareSelectRequest) (*dao.ProjectShare, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectShareSelectRequest) *dao.ProjectShare); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectShare)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectShareSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareSelectRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectShareSelectRequest
+func (_e *MockProjectShareSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareSelectRepository_Exec_Call {
+	return &MockProjectShareSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectShareSelectRequest)) *MockProjectShareSelectRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectShareSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectShareSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareSelectRepository_Exec_Call) Return(projectShare *dao.ProjectShare, err error) *MockProjectShareSelectRepository_Exec_Call {
+	_c.Call.Return(projectShare, err)
+	return _c
+}
+
+func (_c *MockProjectShareSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectShareSelectRequest) (*dao.ProjectShare, error)) *MockProjectShareSelectRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareSelectRepositoryProjectSelect creates a new instance of MockProjectShareSelectRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareSelectRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareSelectRepositoryProjectSelect {
+	mock := &MockProjectShareSelectRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareSelectRepositoryProjectSelect is an autogenerated mock type for the ProjectShareSelectRepositoryProjectSelect type
+type MockProjectShareSelectRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectShareSelectRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareSelectRepositoryProjectSelect) EXPECT() *MockProjectShareSelectRepositoryProjectSelect_Expecter {
+	return &MockProjectShareSelectRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareSelectRepositoryProjectSelect
+func (_mock *MockProjectShareSelectRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareSelectRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareSelectRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectShareSelectRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareSelectRepositoryProjectSelect_Exec_Call {
+	return &MockProjectShareSelectRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareSelectRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectShareSelectRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareSelectRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectShareSelectRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectShareSelectRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectShareSelectRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectShareSelectRepositorySchemaList creates a new instance of MockProjectShareSelectRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectShareSelectRepositorySchemaList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectShareSelectRepositorySchemaList {
+	mock := &MockProjectShareSelectRepositorySchemaList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectShareSelectRepositorySchemaList is an autogenerated mock type for the ProjectShareSelectRepositorySchemaList type
+type MockProjectShareSelectRepositorySchemaList struct {
+	mock.Mock
+}
+
+type MockProjectShareSelectRepositorySchemaList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectShareSelectRepositorySchemaList) EXPECT() *MockProjectShareSelectRepositorySchemaList_Expecter {
+	return &MockProjectShareSelectRepositorySchemaList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectShareSelectRepositorySchemaList
+func (_mock *MockProjectShareSelectRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectShareSelectRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectShareSelectRepositorySchemaList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListRequest
+func (_e *MockProjectShareSelectRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectShareSelectRepositorySchemaList_Exec_Call {
+	return &MockProjectShareSelectRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectShareSelectRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListRequest)) *MockProjectShareSelectRepositorySchemaList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectShareSelectRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockProjectShareSelectRepositorySchemaList_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectShareSelectRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)) *MockProjectShareSelectRepositorySchemaList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotCreateRepository creates a new instance of MockProjectSnapshotCreateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotCreateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotCreateRepository {
+	mock := &MockProjectSnapshotCreateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotCreateRepository is an autogenerated mock type for the ProjectSnapshotCreateRepository type
+type MockProjectSnapshotCreateRepository struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotCreateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotCreateRepository) EXPECT() *MockProjectSnapshotCreateRepository_Expecter {
+	return &MockProjectSnapshotCreateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotCreateRepository
+func (_mock *MockProjectSnapshotCreateRepository) Exec(ctx context.Context, request *dao.ProjectSnapshotInsertRequest) (*dao.ProjectSnapshot, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotInsertRequest) (*dao.ProjectSnapshot, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotInsertRequest) *dao.ProjectSnapshot); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSnapshotInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotCreateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotCreateRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSnapshotInsertRequest
+func (_e *MockProjectSnapshotCreateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotCreateRepository_Exec_Call {
+	return &MockProjectSnapshotCreateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotCreateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSnapshotInsertRequest)) *MockProjectSnapshotCreateRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSnapshotInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSnapshotInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateRepository_Exec_Call) Return(projectSnapshot *dao.ProjectSnapshot, err error) *MockProjectSnapshotCreateRepository_Exec_Call {
+	_c.Call.Return(projectSnapshot, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSnapshotInsertRequest) (*dao.ProjectSnapshot, error)) *MockProjectSnapshotCreateRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotCreateRepositoryProjectSelect creates a new instance of MockProjectSnapshotCreateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotCreateRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotCreateRepositoryProjectSelect {
+	mock := &MockProjectSnapshotCreateRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotCreateRepositoryProjectSelect is an autogenerated mock type for the ProjectSnapshotCreateRepositoryProjectSelect type
+type MockProjectSnapshotCreateRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotCreateRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotCreateRepositoryProjectSelect) EXPECT() *MockProjectSnapshotCreateRepositoryProjectSelect_Expecter {
+	return &MockProjectSnapshotCreateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotCreateRepositoryProjectSelect
+func (_mock *MockProjectSnapshotCreateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectSnapshotCreateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call {
+	return &MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectSnapshotCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotCreateRepositoryProjectMemberSelect creates a new instance of MockProjectSnapshotCreateRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotCreateRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotCreateRepositoryProjectMemberSelect {
+	mock := &MockProjectSnapshotCreateRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotCreateRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectSnapshotCreateRepositoryProjectMemberSelect type
+type MockProjectSnapshotCreateRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotCreateRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotCreateRepositoryProjectMemberSelect) EXPECT() *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectSnapshotCreateRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotCreateRepositoryProjectMemberSelect
+func (_mock *MockProjectSnapshotCreateRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectSnapshotCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotListRepository creates a new instance of MockProjectSnapshotListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotListRepository {
+	mock := &MockProjectSnapshotListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotListRepository is an autogenerated mock type for the ProjectSnapshotListRepository type
+type MockProjectSnapshotListRepository struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotListRepository) EXPECT() *MockProjectSnapshotListRepository_Expecter {
+	return &MockProjectSnapshotListRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotListRepository
+func (_mock *MockProjectSnapshotListRepository) Exec(ctx context.Context, request *dao.ProjectSnapshotListRequest) ([]*dao.ProjectSnapshot, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ProjectSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotListRequest) ([]*dao.ProjectSnapshot, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotListRequest) []*dao.ProjectSnapshot); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ProjectSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSnapshotListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotListRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotListRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSnapshotListRequest
+func (_e *MockProjectSnapshotListRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotListRepository_Exec_Call {
+	return &MockProjectSnapshotListRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotListRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSnapshotListRequest)) *MockProjectSnapshotListRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSnapshotListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSnapshotListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotListRepository_Exec_Call) Return(projectSnapshots []*dao.ProjectSnapshot, err error) *MockProjectSnapshotListRepository_Exec_Call {
+	_c.Call.Return(projectSnapshots, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotListRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSnapshotListRequest) ([]*dao.ProjectSnapshot, error)) *MockProjectSnapshotListRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotListRepositoryProjectSelect creates a new instance of MockProjectSnapshotListRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotListRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotListRepositoryProjectSelect {
+	mock := &MockProjectSnapshotListRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotListRepositoryProjectSelect is an autogenerated mock type for the ProjectSnapshotListRepositoryProjectSelect type
+type MockProjectSnapshotListRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotListRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotListRepositoryProjectSelect) EXPECT() *MockProjectSnapshotListRepositoryProjectSelect_Expecter {
+	return &MockProjectSnapshotListRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotListRepositoryProjectSelect
+func (_mock *MockProjectSnapshotListRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotListRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotListRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectSnapshotListRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotListRepositoryProjectSelect_Exec_Call {
+	return &MockProjectSnapshotListRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotListRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectSnapshotListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotListRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectSnapshotListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotListRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectSnapshotListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotListRepositoryProjectMemberSelect creates a new instance of MockProjectSnapshotListRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotListRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotListRepositoryProjectMemberSelect {
+	mock := &MockProjectSnapshotListRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotListRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectSnapshotListRepositoryProjectMemberSelect type
+type MockProjectSnapshotListRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotListRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotListRepositoryProjectMemberSelect) EXPECT() *MockProjectSnapshotListRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectSnapshotListRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotListRepositoryProjectMemberSelect
+func (_mock *MockProjectSnapshotListRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectSnapshotListRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectSnapshotListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotRestoreRepository creates a new instance of MockProjectSnapshotRestoreRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotRestoreRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotRestoreRepository {
+	mock := &MockProjectSnapshotRestoreRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotRestoreRepository is an autogenerated mock type for the ProjectSnapshotRestoreRepository type
+type MockProjectSnapshotRestoreRepository struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotRestoreRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotRestoreRepository) EXPECT() *MockProjectSnapshotRestoreRepository_Expecter {
+	return &MockProjectSnapshotRestoreRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotRestoreRepository
+func (_mock *MockProjectSnapshotRestoreRepository) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotRestoreRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotRestoreRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockProjectSnapshotRestoreRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotRestoreRepository_Exec_Call {
+	return &MockProjectSnapshotRestoreRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotRestoreRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectSnapshotRestoreRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepository_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectSnapshotRestoreRepository_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectSnapshotRestoreRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotRestoreRepositoryProjectSnapshotSelect creates a new instance of MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotRestoreRepositoryProjectSnapshotSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect {
+	mock := &MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect is an autogenerated mock type for the ProjectSnapshotRestoreRepositoryProjectSnapshotSelect type
+type MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect) EXPECT() *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Expecter {
+	return &MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect
+func (_mock *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect) Exec(ctx context.Context, request *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotSelectRequest) *dao.ProjectSnapshot); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSnapshotSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSnapshotSelectRequest
+func (_e *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call {
+	return &MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSnapshotSelectRequest)) *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSnapshotSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSnapshotSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call) Return(projectSnapshot *dao.ProjectSnapshot, err error) *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call {
+	_c.Call.Return(projectSnapshot, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error)) *MockProjectSnapshotRestoreRepositoryProjectSnapshotSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotRestoreRepositoryProjectSelect creates a new instance of MockProjectSnapshotRestoreRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotRestoreRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotRestoreRepositoryProjectSelect {
+	mock := &MockProjectSnapshotRestoreRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotRestoreRepositoryProjectSelect is an autogenerated mock type for the ProjectSnapshotRestoreRepositoryProjectSelect type
+type MockProjectSnapshotRestoreRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotRestoreRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotRestoreRepositoryProjectSelect) EXPECT() *MockProjectSnapshotRestoreRepositoryProjectSelect_Expecter {
+	return &MockProjectSnapshotRestoreRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotRestoreRepositoryProjectSelect
+func (_mock *MockProjectSnapshotRestoreRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectSnapshotRestoreRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call {
+	return &MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectSnapshotRestoreRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotRestoreRepositorySchemaList creates a new instance of MockProjectSnapshotRestoreRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotRestoreRepositorySchemaList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotRestoreRepositorySchemaList {
+	mock := &MockProjectSnapshotRestoreRepositorySchemaList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotRestoreRepositorySchemaList is an autogenerated mock type for the ProjectSnapshotRestoreRepositorySchemaList type
+type MockProjectSnapshotRestoreRepositorySchemaList struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotRestoreRepositorySchemaList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotRestoreRepositorySchemaList) EXPECT() *MockProjectSnapshotRestoreRepositorySchemaList_Expecter {
+	return &MockProjectSnapshotRestoreRepositorySchemaList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotRestoreRepositorySchemaList
+func (_mock *MockProjectSnapshotRestoreRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListByIDsRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListByIDsRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListByIDsRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListByIDsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListByIDsRequest
+func (_e *MockProjectSnapshotRestoreRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call {
+	return &MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListByIDsRequest)) *MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaListByIDsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaListByIDsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListByIDsRequest) ([]*dao.Schema, error)) *MockProjectSnapshotRestoreRepositorySchemaList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotRestoreRepositoryProjectMemberSelect creates a new instance of MockProjectSnapshotRestoreRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotRestoreRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotRestoreRepositoryProjectMemberSelect {
+	mock := &MockProjectSnapshotRestoreRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotRestoreRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectSnapshotRestoreRepositoryProjectMemberSelect type
+type MockProjectSnapshotRestoreRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotRestoreRepositoryProjectMemberSelect) EXPECT() *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotRestoreRepositoryProjectMemberSelect
+func (_mock *MockProjectSnapshotRestoreRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectSnapshotRestoreRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotSelectRepository creates a new instance of MockProjectSnapshotSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotSelectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotSelectRepository {
+	mock := &MockProjectSnapshotSelectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotSelectRepository is an autogenerated mock type for the ProjectSnapshotSelectRepository type
+type MockProjectSnapshotSelectRepository struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotSelectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotSelectRepository) EXPECT() *MockProjectSnapshotSelectRepository_Expecter {
+	return &MockProjectSnapshotSelectRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotSelectRepository
+func (_mock *MockProjectSnapshotSelectRepository) Exec(ctx context.Context, request *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSnapshotSelectRequest) *dao.ProjectSnapshot); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSnapshotSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotSelectRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSnapshotSelectRequest
+func (_e *MockProjectSnapshotSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotSelectRepository_Exec_Call {
+	return &MockProjectSnapshotSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSnapshotSelectRequest)) *MockProjectSnapshotSelectRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSnapshotSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSnapshotSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepository_Exec_Call) Return(projectSnapshot *dao.ProjectSnapshot, err error) *MockProjectSnapshotSelectRepository_Exec_Call {
+	_c.Call.Return(projectSnapshot, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error)) *MockProjectSnapshotSelectRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotSelectRepositoryProjectSelect creates a new instance of MockProjectSnapshotSelectRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotSelectRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotSelectRepositoryProjectSelect {
+	mock := &MockProjectSnapshotSelectRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotSelectRepositoryProjectSelect is an autogenerated mock type for the ProjectSnapshotSelectRepositoryProjectSelect type
+type MockProjectSnapshotSelectRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotSelectRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotSelectRepositoryProjectSelect) EXPECT() *MockProjectSnapshotSelectRepositoryProjectSelect_Expecter {
+	return &MockProjectSnapshotSelectRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotSelectRepositoryProjectSelect
+func (_mock *MockProjectSnapshotSelectRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectSnapshotSelectRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call {
+	return &MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectSnapshotSelectRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotSelectRepositorySchemaList creates a new instance of MockProjectSnapshotSelectRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotSelectRepositorySchemaList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotSelectRepositorySchemaList {
+	mock := &MockProjectSnapshotSelectRepositorySchemaList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotSelectRepositorySchemaList is an autogenerated mock type for the ProjectSnapshotSelectRepositorySchemaList type
+type MockProjectSnapshotSelectRepositorySchemaList struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotSelectRepositorySchemaList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotSelectRepositorySchemaList) EXPECT() *MockProjectSnapshotSelectRepositorySchemaList_Expecter {
+	return &MockProjectSnapshotSelectRepositorySchemaList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotSelectRepositorySchemaList
+func (_mock *MockProjectSnapshotSelectRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListByIDsRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListByIDsRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListByIDsRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListByIDsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotSelectRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotSelectRepositorySchemaList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListByIDsRequest
+func (_e *MockProjectSnapshotSelectRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotSelectRepositorySchemaList_Exec_Call {
+	return &MockProjectSnapshotSelectRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotSelectRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListByIDsRequest)) *MockProjectSnapshotSelectRepositorySchemaList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaListByIDsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaListByIDsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockProjectSnapshotSelectRepositorySchemaList_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListByIDsRequest) ([]*dao.Schema, error)) *MockProjectSnapshotSelectRepositorySchemaList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSnapshotSelectRepositoryProjectMemberSelect creates a new instance of MockProjectSnapshotSelectRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSnapshotSelectRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSnapshotSelectRepositoryProjectMemberSelect {
+	mock := &MockProjectSnapshotSelectRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectSnapshotSelectRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectSnapshotSelectRepositoryProjectMemberSelect type
+type MockProjectSnapshotSelectRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectSnapshotSelectRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSnapshotSelectRepositoryProjectMemberSelect) EXPECT() *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectSnapshotSelectRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectSnapshotSelectRepositoryProjectMemberSelect
+func (_mock *MockProjectSnapshotSelectRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectSnapshotSelectRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectStateRepository creates a new instance of MockProjectStateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectStateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectStateRepository {
+	mock := &MockProjectStateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectStateRepository is an autogenerated mock type for the ProjectStateRepository type
+type MockProjectStateRepository struct {
+	mock.Mock
+}
+
+type MockProjectStateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectStateRepository) EXPECT() *MockProjectStateRepository_Expecter {
+	return &MockProjectStateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectStateRepository
+func (_mock *MockProjectStateRepository) Exec(ctx context.Context, request *dao.SchemaListAtRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListAtRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListAtRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListAtRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectStateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectStateRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListAtRequest
+func (_e *MockProjectStateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectStateRepository_Exec_Call {
+	return &MockProjectStateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectStateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListAtRequest)) *MockProjectStateRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaListAtRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaListAtRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectStateRepository_Exec_Call) Return(schemas []*dao.Schema, err error) *MockProjectStateRepository_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectStateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListAtRequest) ([]*dao.Schema, error)) *MockProjectStateRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectStateRepositoryProjectSelect creates a new instance of MockProjectStateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectStateRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectStateRepositoryProjectSelect {
+	mock := &MockProjectStateRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectStateRepositoryProjectSelect is an autogenerated mock type for the ProjectStateRepositoryProjectSelect type
+type MockProjectStateRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectStateRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectStateRepositoryProjectSelect) EXPECT() *MockProjectStateRepositoryProjectSelect_Expecter {
+	return &MockProjectStateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectStateRepositoryProjectSelect
+func (_mock *MockProjectStateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectStateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectStateRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectStateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectStateRepositoryProjectSelect_Exec_Call {
+	return &MockProjectStateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectStateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectStateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectStateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectStateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectStateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectStateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectStateRepositoryProjectMemberSelect creates a new instance of MockProjectStateRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectStateRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectStateRepositoryProjectMemberSelect {
+	mock := &MockProjectStateRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectStateRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectStateRepositoryProjectMemberSelect type
+type MockProjectStateRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectStateRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectStateRepositoryProjectMemberSelect) EXPECT() *MockProjectStateRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectStateRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectStateRepositoryProjectMemberSelect
+func (_mock *MockProjectStateRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectStateRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectStateRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectStateRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectStateRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectStateRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectStateRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectStateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectStateRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectStateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectStateRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectStateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepository creates a new instance of MockProjectTranslateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepository {
+	mock := &MockProjectTranslateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepository is an autogenerated mock type for the ProjectTranslateRepository type
+type MockProjectTranslateRepository struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepository) EXPECT() *MockProjectTranslateRepository_Expecter {
+	return &MockProjectTranslateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepository
+func (_mock *MockProjectTranslateRepository) Exec(ctx context.Context, request *dao.ModuleTranslateRequest) (*dao.ModuleGeneration, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ModuleGeneration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleTranslateRequest) (*dao.ModuleGeneration, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleTranslateRequest) *dao.ModuleGeneration); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ModuleGeneration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleTranslateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleTranslateRequest
+func (_e *MockProjectTranslateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepository_Exec_Call {
+	return &MockProjectTranslateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleTranslateRequest)) *MockProjectTranslateRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleTranslateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleTranslateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepository_Exec_Call) Return(moduleGeneration *dao.ModuleGeneration, err error) *MockProjectTranslateRepository_Exec_Call {
+	_c.Call.Return(moduleGeneration, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleTranslateRequest) (*dao.ModuleGeneration, error)) *MockProjectTranslateRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositoryProjectSelect creates a new instance of MockProjectTranslateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositoryProjectSelect {
+	mock := &MockProjectTranslateRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositoryProjectSelect is an autogenerated mock type for the ProjectTranslateRepositoryProjectSelect type
+type MockProjectTranslateRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositoryProjectSelect) EXPECT() *MockProjectTranslateRepositoryProjectSelect_Expecter {
+	return &MockProjectTranslateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositoryProjectSelect
+func (_mock *MockProjectTranslateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectTranslateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositoryProjectSelect_Exec_Call {
+	return &MockProjectTranslateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectTranslateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectTranslateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectTranslateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositoryProjectInsert creates a new instance of MockProjectTranslateRepositoryProjectInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositoryProjectInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositoryProjectInsert {
+	mock := &MockProjectTranslateRepositoryProjectInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositoryProjectInsert is an autogenerated mock type for the ProjectTranslateRepositoryProjectInsert type
+type MockProjectTranslateRepositoryProjectInsert struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositoryProjectInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositoryProjectInsert) EXPECT() *MockProjectTranslateRepositoryProjectInsert_Expecter {
+	return &MockProjectTranslateRepositoryProjectInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositoryProjectInsert
+func (_mock *MockProjectTranslateRepositoryProjectInsert) Exec(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositoryProjectInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositoryProjectInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectInsertRequest
+func (_e *MockProjectTranslateRepositoryProjectInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositoryProjectInsert_Exec_Call {
+	return &MockProjectTranslateRepositoryProjectInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositoryProjectInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectInsertRequest)) *MockProjectTranslateRepositoryProjectInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectInsert_Exec_Call) Return(project *dao.Project, err error) *MockProjectTranslateRepositoryProjectInsert_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error)) *MockProjectTranslateRepositoryProjectInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositoryProjectUpdateLang creates a new instance of MockProjectTranslateRepositoryProjectUpdateLang. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositoryProjectUpdateLang(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositoryProjectUpdateLang {
+	mock := &MockProjectTranslateRepositoryProjectUpdateLang{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositoryProjectUpdateLang is an autogenerated mock type for the ProjectTranslateRepositoryProjectUpdateLang type
+type MockProjectTranslateRepositoryProjectUpdateLang struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositoryProjectUpdateLang_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositoryProjectUpdateLang) EXPECT() *MockProjectTranslateRepositoryProjectUpdateLang_Expecter {
+	return &MockProjectTranslateRepositoryProjectUpdateLang_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositoryProjectUpdateLang
+func (_mock *MockProjectTranslateRepositoryProjectUpdateLang) Exec(ctx context.Context, request *dao.ProjectUpdateLangRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateLangRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateLangRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectUpdateLangRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectUpdateLangRequest
+func (_e *MockProjectTranslateRepositoryProjectUpdateLang_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call {
+	return &MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectUpdateLangRequest)) *MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectUpdateLangRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectUpdateLangRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call) Return(project *dao.Project, err error) *MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectUpdateLangRequest) (*dao.Project, error)) *MockProjectTranslateRepositoryProjectUpdateLang_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositorySchemaList creates a new instance of MockProjectTranslateRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositorySchemaList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositorySchemaList {
+	mock := &MockProjectTranslateRepositorySchemaList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositorySchemaList is an autogenerated mock type for the ProjectTranslateRepositorySchemaList type
+type MockProjectTranslateRepositorySchemaList struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositorySchemaList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositorySchemaList) EXPECT() *MockProjectTranslateRepositorySchemaList_Expecter {
+	return &MockProjectTranslateRepositorySchemaList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositorySchemaList
+func (_mock *MockProjectTranslateRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositorySchemaList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListRequest
+func (_e *MockProjectTranslateRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositorySchemaList_Exec_Call {
+	return &MockProjectTranslateRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListRequest)) *MockProjectTranslateRepositorySchemaList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockProjectTranslateRepositorySchemaList_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)) *MockProjectTranslateRepositorySchemaList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositorySchemaInsert creates a new instance of MockProjectTranslateRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositorySchemaInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositorySchemaInsert {
+	mock := &MockProjectTranslateRepositorySchemaInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositorySchemaInsert is an autogenerated mock type for the ProjectTranslateRepositorySchemaInsert type
+type MockProjectTranslateRepositorySchemaInsert struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositorySchemaInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositorySchemaInsert) EXPECT() *MockProjectTranslateRepositorySchemaInsert_Expecter {
+	return &MockProjectTranslateRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositorySchemaInsert
+func (_mock *MockProjectTranslateRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositorySchemaInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockProjectTranslateRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositorySchemaInsert_Exec_Call {
+	return &MockProjectTranslateRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectTranslateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectTranslateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectTranslateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositorySchemaGenerationInsert creates a new instance of MockProjectTranslateRepositorySchemaGenerationInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositorySchemaGenerationInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositorySchemaGenerationInsert {
+	mock := &MockProjectTranslateRepositorySchemaGenerationInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositorySchemaGenerationInsert is an autogenerated mock type for the ProjectTranslateRepositorySchemaGenerationInsert type
+type MockProjectTranslateRepositorySchemaGenerationInsert struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositorySchemaGenerationInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositorySchemaGenerationInsert) EXPECT() *MockProjectTranslateRepositorySchemaGenerationInsert_Expecter {
+	return &MockProjectTranslateRepositorySchemaGenerationInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositorySchemaGenerationInsert
+func (_mock *MockProjectTranslateRepositorySchemaGenerationInsert) Exec(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.SchemaGeneration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationInsertRequest) *dao.SchemaGeneration); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.SchemaGeneration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaGenerationInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaGenerationInsertRequest
+func (_e *MockProjectTranslateRepositorySchemaGenerationInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call {
+	return &MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaGenerationInsertRequest)) *MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaGenerationInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaGenerationInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call) Return(schemaGeneration *dao.SchemaGeneration, err error) *MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call {
+	_c.Call.Return(schemaGeneration, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)) *MockProjectTranslateRepositorySchemaGenerationInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositoryModuleSelect creates a new instance of MockProjectTranslateRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositoryModuleSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositoryModuleSelect {
+	mock := &MockProjectTranslateRepositoryModuleSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositoryModuleSelect is an autogenerated mock type for the ProjectTranslateRepositoryModuleSelect type
+type MockProjectTranslateRepositoryModuleSelect struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositoryModuleSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositoryModuleSelect) EXPECT() *MockProjectTranslateRepositoryModuleSelect_Expecter {
+	return &MockProjectTranslateRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositoryModuleSelect
+func (_mock *MockProjectTranslateRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositoryModuleSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockProjectTranslateRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositoryModuleSelect_Exec_Call {
+	return &MockProjectTranslateRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockProjectTranslateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockProjectTranslateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockProjectTranslateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositoryTokenUsageList creates a new instance of MockProjectTranslateRepositoryTokenUsageList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositoryTokenUsageList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositoryTokenUsageList {
+	mock := &MockProjectTranslateRepositoryTokenUsageList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositoryTokenUsageList is an autogenerated mock type for the ProjectTranslateRepositoryTokenUsageList type
+type MockProjectTranslateRepositoryTokenUsageList struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositoryTokenUsageList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositoryTokenUsageList) EXPECT() *MockProjectTranslateRepositoryTokenUsageList_Expecter {
+	return &MockProjectTranslateRepositoryTokenUsageList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositoryTokenUsageList
+func (_mock *MockProjectTranslateRepositoryTokenUsageList) Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.TokenUsageProject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) []*dao.TokenUsageProject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.TokenUsageProject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositoryTokenUsageList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositoryTokenUsageList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.TokenUsageListRequest
+func (_e *MockProjectTranslateRepositoryTokenUsageList_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositoryTokenUsageList_Exec_Call {
+	return &MockProjectTranslateRepositoryTokenUsageList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositoryTokenUsageList_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageListRequest)) *MockProjectTranslateRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.TokenUsageListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.TokenUsageListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryTokenUsageList_Exec_Call) Return(tokenUsageProjects []*dao.TokenUsageProject, err error) *MockProjectTranslateRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Return(tokenUsageProjects, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryTokenUsageList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)) *MockProjectTranslateRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositoryTokenUsageInsert creates a new instance of MockProjectTranslateRepositoryTokenUsageInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositoryTokenUsageInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositoryTokenUsageInsert {
+	mock := &MockProjectTranslateRepositoryTokenUsageInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositoryTokenUsageInsert is an autogenerated mock type for the ProjectTranslateRepositoryTokenUsageInsert type
+type MockProjectTranslateRepositoryTokenUsageInsert struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositoryTokenUsageInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositoryTokenUsageInsert) EXPECT() *MockProjectTranslateRepositoryTokenUsageInsert_Expecter {
+	return &MockProjectTranslateRepositoryTokenUsageInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositoryTokenUsageInsert
+func (_mock *MockProjectTranslateRepositoryTokenUsageInsert) Exec(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.TokenUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageInsertRequest) *dao.TokenUsage); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.TokenUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.TokenUsageInsertRequest
+func (_e *MockProjectTranslateRepositoryTokenUsageInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call {
+	return &MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageInsertRequest)) *MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.TokenUsageInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.TokenUsageInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call) Return(tokenUsage *dao.TokenUsage, err error) *MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Return(tokenUsage, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)) *MockProjectTranslateRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectTranslateRepositoryProjectMemberSelect creates a new instance of MockProjectTranslateRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectTranslateRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectTranslateRepositoryProjectMemberSelect {
+	mock := &MockProjectTranslateRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectTranslateRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectTranslateRepositoryProjectMemberSelect type
+type MockProjectTranslateRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectTranslateRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectTranslateRepositoryProjectMemberSelect) EXPECT() *MockProjectTranslateRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectTranslateRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectTranslateRepositoryProjectMemberSelect
+func (_mock *MockProjectTranslateRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectTranslateRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectTranslateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateRepositorySelect creates a new instance of MockProjectUpdateRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateRepositorySelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateRepositorySelect {
+	mock := &MockProjectUpdateRepositorySelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateRepositorySelect is an autogenerated mock type for the ProjectUpdateRepositorySelect type
+type MockProjectUpdateRepositorySelect struct {
+	mock.Mock
+}
+
+type MockProjectUpdateRepositorySelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateRepositorySelect) EXPECT() *MockProjectUpdateRepositorySelect_Expecter {
+	return &MockProjectUpdateRepositorySelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateRepositorySelect
+func (_mock *MockProjectUpdateRepositorySelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateRepositorySelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectUpdateRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositorySelect_Exec_Call {
+	return &MockProjectUpdateRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectUpdateRepositorySelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositorySelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectUpdateRepositorySelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectUpdateRepositorySelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateRepository creates a new instance of MockProjectUpdateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateRepository {
+	mock := &MockProjectUpdateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateRepository is an autogenerated mock type for the ProjectUpdateRepository type
+type MockProjectUpdateRepository struct {
+	mock.Mock
+}
+
+type MockProjectUpdateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateRepository) EXPECT() *MockProjectUpdateRepository_Expecter {
+	return &MockProjectUpdateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateRepository
+func (_mock *MockProjectUpdateRepository) Exec(ctx context.Context, request *dao.ProjectUpdateRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectUpdateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectUpdateRequest
+func (_e *MockProjectUpdateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepository_Exec_Call {
+	return &MockProjectUpdateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectUpdateRequest)) *MockProjectUpdateRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectUpdateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectUpdateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectUpdateRepository_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectUpdateRequest) (*dao.Project, error)) *MockProjectUpdateRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateRepositorySchemaInsert creates a new instance of MockProjectUpdateRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateRepositorySchemaInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateRepositorySchemaInsert {
+	mock := &MockProjectUpdateRepositorySchemaInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateRepositorySchemaInsert is an autogenerated mock type for the ProjectUpdateRepositorySchemaInsert type
+type MockProjectUpdateRepositorySchemaInsert struct {
+	mock.Mock
+}
+
+type MockProjectUpdateRepositorySchemaInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateRepositorySchemaInsert) EXPECT() *MockProjectUpdateRepositorySchemaInsert_Expecter {
+	return &MockProjectUpdateRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateRepositorySchemaInsert
+func (_mock *MockProjectUpdateRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateRepositorySchemaInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockProjectUpdateRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
+	return &MockProjectUpdateRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateRepositorySchemaSelect creates a new instance of MockProjectUpdateRepositorySchemaSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateRepositorySchemaSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateRepositorySchemaSelect {
+	mock := &MockProjectUpdateRepositorySchemaSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateRepositorySchemaSelect is an autogenerated mock type for the ProjectUpdateRepositorySchemaSelect type
+type MockProjectUpdateRepositorySchemaSelect struct {
+	mock.Mock
+}
+
+type MockProjectUpdateRepositorySchemaSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateRepositorySchemaSelect) EXPECT() *MockProjectUpdateRepositorySchemaSelect_Expecter {
+	return &MockProjectUpdateRepositorySchemaSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateRepositorySchemaSelect
+func (_mock *MockProjectUpdateRepositorySchemaSelect) Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateRepositorySchemaSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateRepositorySchemaSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaSelectRequest
+func (_e *MockProjectUpdateRepositorySchemaSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositorySchemaSelect_Exec_Call {
+	return &MockProjectUpdateRepositorySchemaSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateRepositorySchemaSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaSelectRequest)) *MockProjectUpdateRepositorySchemaSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositorySchemaSelect_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectUpdateRepositorySchemaSelect_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositorySchemaSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)) *MockProjectUpdateRepositorySchemaSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateRepositoryModuleSelect creates a new instance of MockProjectUpdateRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateRepositoryModuleSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateRepositoryModuleSelect {
+	mock := &MockProjectUpdateRepositoryModuleSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateRepositoryModuleSelect is an autogenerated mock type for the ProjectUpdateRepositoryModuleSelect type
+type MockProjectUpdateRepositoryModuleSelect struct {
+	mock.Mock
+}
+
+type MockProjectUpdateRepositoryModuleSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateRepositoryModuleSelect) EXPECT() *MockProjectUpdateRepositoryModuleSelect_Expecter {
+	return &MockProjectUpdateRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateRepositoryModuleSelect
+func (_mock *MockProjectUpdateRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateRepositoryModuleSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockProjectUpdateRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
+	return &MockProjectUpdateRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateRepositoryModuleListVersions creates a new instance of MockProjectUpdateRepositoryModuleListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateRepositoryModuleListVersions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateRepositoryModuleListVersions {
+	mock := &MockProjectUpdateRepositoryModuleListVersions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateRepositoryModuleListVersions is an autogenerated mock type for the ProjectUpdateRepositoryModuleListVersions type
+type MockProjectUpdateRepositoryModuleListVersions struct {
+	mock.Mock
+}
+
+type MockProjectUpdateRepositoryModuleListVersions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateRepositoryModuleListVersions) EXPECT() *MockProjectUpdateRepositoryModuleListVersions_Expecter {
+	return &MockProjectUpdateRepositoryModuleListVersions_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateRepositoryModuleListVersions
+func (_mock *MockProjectUpdateRepositoryModuleListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateRepositoryModuleListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateRepositoryModuleListVersions_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockProjectUpdateRepositoryModuleListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositoryModuleListVersions_Exec_Call {
+	return &MockProjectUpdateRepositoryModuleListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateRepositoryModuleListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockProjectUpdateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositoryModuleListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockProjectUpdateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositoryModuleListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockProjectUpdateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpdateRepositoryProjectMemberSelect creates a new instance of MockProjectUpdateRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpdateRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpdateRepositoryProjectMemberSelect {
+	mock := &MockProjectUpdateRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpdateRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectUpdateRepositoryProjectMemberSelect type
+type MockProjectUpdateRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectUpdateRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpdateRepositoryProjectMemberSelect) EXPECT() *MockProjectUpdateRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectUpdateRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpdateRepositoryProjectMemberSelect
+func (_mock *MockProjectUpdateRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectUpdateRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectUpdateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleRepository creates a new instance of MockProjectUpgradeModuleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleRepository {
+	mock := &MockProjectUpgradeModuleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleRepository is an autogenerated mock type for the ProjectUpgradeModuleRepository type
+type MockProjectUpgradeModuleRepository struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleRepository) EXPECT() *MockProjectUpgradeModuleRepository_Expecter {
+	return &MockProjectUpgradeModuleRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleRepository
+func (_mock *MockProjectUpgradeModuleRepository) Exec(ctx context.Context, request *dao.ProjectUpdateRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectUpdateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectUpdateRequest
+func (_e *MockProjectUpgradeModuleRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleRepository_Exec_Call {
+	return &MockProjectUpgradeModuleRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectUpdateRequest)) *MockProjectUpgradeModuleRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectUpdateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectUpdateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectUpgradeModuleRepository_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectUpdateRequest) (*dao.Project, error)) *MockProjectUpgradeModuleRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleRepositorySelect creates a new instance of MockProjectUpgradeModuleRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleRepositorySelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleRepositorySelect {
+	mock := &MockProjectUpgradeModuleRepositorySelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleRepositorySelect is an autogenerated mock type for the ProjectUpgradeModuleRepositorySelect type
+type MockProjectUpgradeModuleRepositorySelect struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleRepositorySelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleRepositorySelect) EXPECT() *MockProjectUpgradeModuleRepositorySelect_Expecter {
+	return &MockProjectUpgradeModuleRepositorySelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleRepositorySelect
+func (_mock *MockProjectUpgradeModuleRepositorySelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleRepositorySelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockProjectUpgradeModuleRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleRepositorySelect_Exec_Call {
+	return &MockProjectUpgradeModuleRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectUpgradeModuleRepositorySelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectUpgradeModuleRepositorySelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectUpgradeModuleRepositorySelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleRepositoryModuleSelect creates a new instance of MockProjectUpgradeModuleRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleRepositoryModuleSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleRepositoryModuleSelect {
+	mock := &MockProjectUpgradeModuleRepositoryModuleSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleRepositoryModuleSelect is an autogenerated mock type for the ProjectUpgradeModuleRepositoryModuleSelect type
+type MockProjectUpgradeModuleRepositoryModuleSelect struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleRepositoryModuleSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleRepositoryModuleSelect) EXPECT() *MockProjectUpgradeModuleRepositoryModuleSelect_Expecter {
+	return &MockProjectUpgradeModuleRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleRepositoryModuleSelect
+func (_mock *MockProjectUpgradeModuleRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockProjectUpgradeModuleRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call {
+	return &MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockProjectUpgradeModuleRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleRepositorySchemaSelect creates a new instance of MockProjectUpgradeModuleRepositorySchemaSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleRepositorySchemaSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleRepositorySchemaSelect {
+	mock := &MockProjectUpgradeModuleRepositorySchemaSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleRepositorySchemaSelect is an autogenerated mock type for the ProjectUpgradeModuleRepositorySchemaSelect type
+type MockProjectUpgradeModuleRepositorySchemaSelect struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleRepositorySchemaSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleRepositorySchemaSelect) EXPECT() *MockProjectUpgradeModuleRepositorySchemaSelect_Expecter {
+	return &MockProjectUpgradeModuleRepositorySchemaSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleRepositorySchemaSelect
+func (_mock *MockProjectUpgradeModuleRepositorySchemaSelect) Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaSelectRequest
+func (_e *MockProjectUpgradeModuleRepositorySchemaSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call {
+	return &MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaSelectRequest)) *MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)) *MockProjectUpgradeModuleRepositorySchemaSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleRepositorySchemaInsert creates a new instance of MockProjectUpgradeModuleRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleRepositorySchemaInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleRepositorySchemaInsert {
+	mock := &MockProjectUpgradeModuleRepositorySchemaInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleRepositorySchemaInsert is an autogenerated mock type for the ProjectUpgradeModuleRepositorySchemaInsert type
+type MockProjectUpgradeModuleRepositorySchemaInsert struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleRepositorySchemaInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleRepositorySchemaInsert) EXPECT() *MockProjectUpgradeModuleRepositorySchemaInsert_Expecter {
+	return &MockProjectUpgradeModuleRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleRepositorySchemaInsert
+func (_mock *MockProjectUpgradeModuleRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockProjectUpgradeModuleRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call {
+	return &MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectUpgradeModuleRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleRepositoryModuleListVersions creates a new instance of MockProjectUpgradeModuleRepositoryModuleListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleRepositoryModuleListVersions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleRepositoryModuleListVersions {
+	mock := &MockProjectUpgradeModuleRepositoryModuleListVersions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleRepositoryModuleListVersions is an autogenerated mock type for the ProjectUpgradeModuleRepositoryModuleListVersions type
+type MockProjectUpgradeModuleRepositoryModuleListVersions struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleRepositoryModuleListVersions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleRepositoryModuleListVersions) EXPECT() *MockProjectUpgradeModuleRepositoryModuleListVersions_Expecter {
+	return &MockProjectUpgradeModuleRepositoryModuleListVersions_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleRepositoryModuleListVersions
+func (_mock *MockProjectUpgradeModuleRepositoryModuleListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockProjectUpgradeModuleRepositoryModuleListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call {
+	return &MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockProjectUpgradeModuleRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUpgradeModuleRepositoryProjectMemberSelect creates a new instance of MockProjectUpgradeModuleRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUpgradeModuleRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUpgradeModuleRepositoryProjectMemberSelect {
+	mock := &MockProjectUpgradeModuleRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectUpgradeModuleRepositoryProjectMemberSelect is an autogenerated mock type for the ProjectUpgradeModuleRepositoryProjectMemberSelect type
+type MockProjectUpgradeModuleRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockProjectUpgradeModuleRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUpgradeModuleRepositoryProjectMemberSelect) EXPECT() *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Expecter {
+	return &MockProjectUpgradeModuleRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockProjectUpgradeModuleRepositoryProjectMemberSelect
+func (_mock *MockProjectUpgradeModuleRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call {
+	return &MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockProjectUpgradeModuleRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidateListRepository creates a new instance of MockSchemaCandidateListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidateListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidateListRepository {
+	mock := &MockSchemaCandidateListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidateListRepository is an autogenerated mock type for the SchemaCandidateListRepository type
+type MockSchemaCandidateListRepository struct {
+	mock.Mock
+}
+
+type MockSchemaCandidateListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidateListRepository) EXPECT() *MockSchemaCandidateListRepository_Expecter {
+	return &MockSchemaCandidateListRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidateListRepository
+func (_mock *MockSchemaCandidateListRepository) Exec(ctx context.Context, request *dao.SchemaCandidateListRequest) ([]*dao.SchemaCandidate, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.SchemaCandidate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateListRequest) ([]*dao.SchemaCandidate, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateListRequest) []*dao.SchemaCandidate); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.SchemaCandidate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaCandidateListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidateListRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidateListRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaCandidateListRequest
+func (_e *MockSchemaCandidateListRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidateListRepository_Exec_Call {
+	return &MockSchemaCandidateListRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidateListRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaCandidateListRequest)) *MockSchemaCandidateListRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaCandidateListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaCandidateListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidateListRepository_Exec_Call) Return(schemaCandidates []*dao.SchemaCandidate, err error) *MockSchemaCandidateListRepository_Exec_Call {
+	_c.Call.Return(schemaCandidates, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidateListRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaCandidateListRequest) ([]*dao.SchemaCandidate, error)) *MockSchemaCandidateListRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidateListRepositoryProjectSelect creates a new instance of MockSchemaCandidateListRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidateListRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidateListRepositoryProjectSelect {
+	mock := &MockSchemaCandidateListRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidateListRepositoryProjectSelect is an autogenerated mock type for the SchemaCandidateListRepositoryProjectSelect type
+type MockSchemaCandidateListRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockSchemaCandidateListRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidateListRepositoryProjectSelect) EXPECT() *MockSchemaCandidateListRepositoryProjectSelect_Expecter {
+	return &MockSchemaCandidateListRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidateListRepositoryProjectSelect
+func (_mock *MockSchemaCandidateListRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidateListRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidateListRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockSchemaCandidateListRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidateListRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaCandidateListRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidateListRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaCandidateListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidateListRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaCandidateListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidateListRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaCandidateListRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidateListRepositoryProjectMemberSelect creates a new instance of MockSchemaCandidateListRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidateListRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidateListRepositoryProjectMemberSelect {
+	mock := &MockSchemaCandidateListRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidateListRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaCandidateListRepositoryProjectMemberSelect type
+type MockSchemaCandidateListRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockSchemaCandidateListRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidateListRepositoryProjectMemberSelect) EXPECT() *MockSchemaCandidateListRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaCandidateListRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidateListRepositoryProjectMemberSelect
+func (_mock *MockSchemaCandidateListRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaCandidateListRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaCandidateListRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidatePromoteRepository creates a new instance of MockSchemaCandidatePromoteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidatePromoteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidatePromoteRepository {
+	mock := &MockSchemaCandidatePromoteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidatePromoteRepository is an autogenerated mock type for the SchemaCandidatePromoteRepository type
+type MockSchemaCandidatePromoteRepository struct {
+	mock.Mock
+}
+
+type MockSchemaCandidatePromoteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidatePromoteRepository) EXPECT() *MockSchemaCandidatePromoteRepository_Expecter {
+	return &MockSchemaCandidatePromoteRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidatePromoteRepository
+func (_mock *MockSchemaCandidatePromoteRepository) Exec(ctx context.Context, request *dao.SchemaCandidateSelectRequest) (*dao.SchemaCandidate, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.SchemaCandidate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateSelectRequest) (*dao.SchemaCandidate, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateSelectRequest) *dao.SchemaCandidate); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.SchemaCandidate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaCandidateSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidatePromoteRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidatePromoteRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaCandidateSelectRequest
+func (_e *MockSchemaCandidatePromoteRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidatePromoteRepository_Exec_Call {
+	return &MockSchemaCandidatePromoteRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidatePromoteRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaCandidateSelectRequest)) *MockSchemaCandidatePromoteRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaCandidateSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaCandidateSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepository_Exec_Call) Return(schemaCandidate *dao.SchemaCandidate, err error) *MockSchemaCandidatePromoteRepository_Exec_Call {
+	_c.Call.Return(schemaCandidate, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaCandidateSelectRequest) (*dao.SchemaCandidate, error)) *MockSchemaCandidatePromoteRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidatePromoteRepositorySchemaCandidateDelete creates a new instance of MockSchemaCandidatePromoteRepositorySchemaCandidateDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidatePromoteRepositorySchemaCandidateDelete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete {
+	mock := &MockSchemaCandidatePromoteRepositorySchemaCandidateDelete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidatePromoteRepositorySchemaCandidateDelete is an autogenerated mock type for the SchemaCandidatePromoteRepositorySchemaCandidateDelete type
+type MockSchemaCandidatePromoteRepositorySchemaCandidateDelete struct {
+	mock.Mock
+}
+
+type MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete) EXPECT() *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Expecter {
+	return &MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidatePromoteRepositorySchemaCandidateDelete
+func (_mock *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete) Exec(ctx context.Context, request *dao.SchemaCandidateDeleteRequest) ([]*dao.SchemaCandidate, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.SchemaCandidate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateDeleteRequest) ([]*dao.SchemaCandidate, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateDeleteRequest) []*dao.SchemaCandidate); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.SchemaCandidate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaCandidateDeleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaCandidateDeleteRequest
+func (_e *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call {
+	return &MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaCandidateDeleteRequest)) *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaCandidateDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaCandidateDeleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call) Return(schemaCandidates []*dao.SchemaCandidate, err error) *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call {
+	_c.Call.Return(schemaCandidates, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaCandidateDeleteRequest) ([]*dao.SchemaCandidate, error)) *MockSchemaCandidatePromoteRepositorySchemaCandidateDelete_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidatePromoteRepositorySchemaInsert creates a new instance of MockSchemaCandidatePromoteRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidatePromoteRepositorySchemaInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidatePromoteRepositorySchemaInsert {
+	mock := &MockSchemaCandidatePromoteRepositorySchemaInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidatePromoteRepositorySchemaInsert is an autogenerated mock type for the SchemaCandidatePromoteRepositorySchemaInsert type
+type MockSchemaCandidatePromoteRepositorySchemaInsert struct {
+	mock.Mock
+}
+
+type MockSchemaCandidatePromoteRepositorySchemaInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidatePromoteRepositorySchemaInsert) EXPECT() *MockSchemaCandidatePromoteRepositorySchemaInsert_Expecter {
+	return &MockSchemaCandidatePromoteRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidatePromoteRepositorySchemaInsert
+func (_mock *MockSchemaCandidatePromoteRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockSchemaCandidatePromoteRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call {
+	return &MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockSchemaCandidatePromoteRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidatePromoteRepositoryProjectSelect creates a new instance of MockSchemaCandidatePromoteRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidatePromoteRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidatePromoteRepositoryProjectSelect {
+	mock := &MockSchemaCandidatePromoteRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidatePromoteRepositoryProjectSelect is an autogenerated mock type for the SchemaCandidatePromoteRepositoryProjectSelect type
+type MockSchemaCandidatePromoteRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockSchemaCandidatePromoteRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidatePromoteRepositoryProjectSelect) EXPECT() *MockSchemaCandidatePromoteRepositoryProjectSelect_Expecter {
+	return &MockSchemaCandidatePromoteRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidatePromoteRepositoryProjectSelect
+func (_mock *MockSchemaCandidatePromoteRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockSchemaCandidatePromoteRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaCandidatePromoteRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCandidatePromoteRepositoryProjectMemberSelect creates a new instance of MockSchemaCandidatePromoteRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCandidatePromoteRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCandidatePromoteRepositoryProjectMemberSelect {
+	mock := &MockSchemaCandidatePromoteRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCandidatePromoteRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaCandidatePromoteRepositoryProjectMemberSelect type
+type MockSchemaCandidatePromoteRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCandidatePromoteRepositoryProjectMemberSelect) EXPECT() *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCandidatePromoteRepositoryProjectMemberSelect
+func (_mock *MockSchemaCandidatePromoteRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaCandidatePromoteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCreateRepository creates a new instance of MockSchemaCreateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCreateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCreateRepository {
+	mock := &MockSchemaCreateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCreateRepository is an autogenerated mock type for the SchemaCreateRepository type
+type MockSchemaCreateRepository struct {
+	mock.Mock
+}
+
+type MockSchemaCreateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCreateRepository) EXPECT() *MockSchemaCreateRepository_Expecter {
+	return &MockSchemaCreateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCreateRepository
+func (_mock *MockSchemaCreateRepository) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCreateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCreateRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockSchemaCreateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepository_Exec_Call {
+	return &MockSchemaCreateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCreateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockSchemaCreateRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCreateRepository_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaCreateRepository_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockSchemaCreateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockSchemaCreateRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCreateRepositoryProjectSelect creates a new instance of MockSchemaCreateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCreateRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCreateRepositoryProjectSelect {
+	mock := &MockSchemaCreateRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCreateRepositoryProjectSelect is an autogenerated mock type for the SchemaCreateRepositoryProjectSelect type
+type MockSchemaCreateRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockSchemaCreateRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCreateRepositoryProjectSelect) EXPECT() *MockSchemaCreateRepositoryProjectSelect_Expecter {
+	return &MockSchemaCreateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCreateRepositoryProjectSelect
+func (_mock *MockSchemaCreateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCreateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCreateRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockSchemaCreateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaCreateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCreateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCreateRepositoryModuleSelect creates a new instance of MockSchemaCreateRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCreateRepositoryModuleSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCreateRepositoryModuleSelect {
+	mock := &MockSchemaCreateRepositoryModuleSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCreateRepositoryModuleSelect is an autogenerated mock type for the SchemaCreateRepositoryModuleSelect type
+type MockSchemaCreateRepositoryModuleSelect struct {
+	mock.Mock
+}
+
+type MockSchemaCreateRepositoryModuleSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCreateRepositoryModuleSelect) EXPECT() *MockSchemaCreateRepositoryModuleSelect_Expecter {
+	return &MockSchemaCreateRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCreateRepositoryModuleSelect
+func (_mock *MockSchemaCreateRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCreateRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCreateRepositoryModuleSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockSchemaCreateRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
+	return &MockSchemaCreateRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCreateRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCreateRepositoryModuleListVersions creates a new instance of MockSchemaCreateRepositoryModuleListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCreateRepositoryModuleListVersions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCreateRepositoryModuleListVersions {
+	mock := &MockSchemaCreateRepositoryModuleListVersions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCreateRepositoryModuleListVersions is an autogenerated mock type for the SchemaCreateRepositoryModuleListVersions type
+type MockSchemaCreateRepositoryModuleListVersions struct {
+	mock.Mock
+}
+
+type MockSchemaCreateRepositoryModuleListVersions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCreateRepositoryModuleListVersions) EXPECT() *MockSchemaCreateRepositoryModuleListVersions_Expecter {
+	return &MockSchemaCreateRepositoryModuleListVersions_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCreateRepositoryModuleListVersions
+func (_mock *MockSchemaCreateRepositoryModuleListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCreateRepositoryModuleListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCreateRepositoryModuleListVersions_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockSchemaCreateRepositoryModuleListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepositoryModuleListVersions_Exec_Call {
+	return &MockSchemaCreateRepositoryModuleListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCreateRepositoryModuleListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockSchemaCreateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryModuleListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockSchemaCreateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryModuleListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockSchemaCreateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaCreateRepositoryProjectMemberSelect creates a new instance of MockSchemaCreateRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaCreateRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaCreateRepositoryProjectMemberSelect {
+	mock := &MockSchemaCreateRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaCreateRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaCreateRepositoryProjectMemberSelect type
+type MockSchemaCreateRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockSchemaCreateRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaCreateRepositoryProjectMemberSelect) EXPECT() *MockSchemaCreateRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaCreateRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaCreateRepositoryProjectMemberSelect
+func (_mock *MockSchemaCreateRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaCreateRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaCreateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepository creates a new instance of MockSchemaGenerateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepository {
+	mock := &MockSchemaGenerateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepository is an autogenerated mock type for the SchemaGenerateRepository type
+type MockSchemaGenerateRepository struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepository) EXPECT() *MockSchemaGenerateRepository_Expecter {
+	return &MockSchemaGenerateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepository
+func (_mock *MockSchemaGenerateRepository) Exec(ctx context.Context, request *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ModuleGeneration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleGenerateRequest) *dao.ModuleGeneration); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ModuleGeneration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleGenerateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleGenerateRequest
+func (_e *MockSchemaGenerateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepository_Exec_Call {
+	return &MockSchemaGenerateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleGenerateRequest)) *MockSchemaGenerateRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleGenerateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleGenerateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepository_Exec_Call) Return(moduleGeneration *dao.ModuleGeneration, err error) *MockSchemaGenerateRepository_Exec_Call {
+	_c.Call.Return(moduleGeneration, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error)) *MockSchemaGenerateRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositorySchemaList creates a new instance of MockSchemaGenerateRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositorySchemaList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositorySchemaList {
+	mock := &MockSchemaGenerateRepositorySchemaList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositorySchemaList is an autogenerated mock type for the SchemaGenerateRepositorySchemaList type
+type MockSchemaGenerateRepositorySchemaList struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositorySchemaList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositorySchemaList) EXPECT() *MockSchemaGenerateRepositorySchemaList_Expecter {
+	return &MockSchemaGenerateRepositorySchemaList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositorySchemaList
+func (_mock *MockSchemaGenerateRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositorySchemaList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListRequest
+func (_e *MockSchemaGenerateRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
+	return &MockSchemaGenerateRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListRequest)) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
+	_c.Call.Return(schemas, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositorySchemaInsert creates a new instance of MockSchemaGenerateRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositorySchemaInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositorySchemaInsert {
+	mock := &MockSchemaGenerateRepositorySchemaInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositorySchemaInsert is an autogenerated mock type for the SchemaGenerateRepositorySchemaInsert type
+type MockSchemaGenerateRepositorySchemaInsert struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositorySchemaInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositorySchemaInsert) EXPECT() *MockSchemaGenerateRepositorySchemaInsert_Expecter {
+	return &MockSchemaGenerateRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositorySchemaInsert
+func (_mock *MockSchemaGenerateRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositorySchemaInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaInsertRequest
+func (_e *MockSchemaGenerateRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
+	return &MockSchemaGenerateRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(schema, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositorySchemaGenerationInsert creates a new instance of MockSchemaGenerateRepositorySchemaGenerationInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositorySchemaGenerationInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositorySchemaGenerationInsert {
+	mock := &MockSchemaGenerateRepositorySchemaGenerationInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositorySchemaGenerationInsert is an autogenerated mock type for the SchemaGenerateRepositorySchemaGenerationInsert type
+type MockSchemaGenerateRepositorySchemaGenerationInsert struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositorySchemaGenerationInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositorySchemaGenerationInsert) EXPECT() *MockSchemaGenerateRepositorySchemaGenerationInsert_Expecter {
+	return &MockSchemaGenerateRepositorySchemaGenerationInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositorySchemaGenerationInsert
+func (_mock *MockSchemaGenerateRepositorySchemaGenerationInsert) Exec(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.SchemaGeneration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationInsertRequest) *dao.SchemaGeneration); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.SchemaGeneration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaGenerationInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaGenerationInsertRequest
+func (_e *MockSchemaGenerateRepositorySchemaGenerationInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call {
+	return &MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaGenerationInsertRequest)) *MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.SchemaGenerationInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.SchemaGenerationInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call) Return(schemaGeneration *dao.SchemaGeneration, err error) *MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call {
+	_c.Call.Return(schemaGeneration, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)) *MockSchemaGenerateRepositorySchemaGenerationInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositoryTokenUsageList creates a new instance of MockSchemaGenerateRepositoryTokenUsageList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositoryTokenUsageList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositoryTokenUsageList {
+	mock := &MockSchemaGenerateRepositoryTokenUsageList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositoryTokenUsageList is an autogenerated mock type for the SchemaGenerateRepositoryTokenUsageList type
+type MockSchemaGenerateRepositoryTokenUsageList struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositoryTokenUsageList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositoryTokenUsageList) EXPECT() *MockSchemaGenerateRepositoryTokenUsageList_Expecter {
+	return &MockSchemaGenerateRepositoryTokenUsageList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositoryTokenUsageList
+func (_mock *MockSchemaGenerateRepositoryTokenUsageList) Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.TokenUsageProject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) []*dao.TokenUsageProject); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.TokenUsageProject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositoryTokenUsageList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositoryTokenUsageList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.TokenUsageListRequest
+func (_e *MockSchemaGenerateRepositoryTokenUsageList_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryTokenUsageList_Exec_Call {
+	return &MockSchemaGenerateRepositoryTokenUsageList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositoryTokenUsageList_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageListRequest)) *MockSchemaGenerateRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.TokenUsageListRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.TokenUsageListRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryTokenUsageList_Exec_Call) Return(tokenUsageProjects []*dao.TokenUsageProject, err error) *MockSchemaGenerateRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Return(tokenUsageProjects, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryTokenUsageList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)) *MockSchemaGenerateRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositoryTokenUsageInsert creates a new instance of MockSchemaGenerateRepositoryTokenUsageInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositoryTokenUsageInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositoryTokenUsageInsert {
+	mock := &MockSchemaGenerateRepositoryTokenUsageInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositoryTokenUsageInsert is an autogenerated mock type for the SchemaGenerateRepositoryTokenUsageInsert type
+type MockSchemaGenerateRepositoryTokenUsageInsert struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositoryTokenUsageInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositoryTokenUsageInsert) EXPECT() *MockSchemaGenerateRepositoryTokenUsageInsert_Expecter {
+	return &MockSchemaGenerateRepositoryTokenUsageInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositoryTokenUsageInsert
+func (_mock *MockSchemaGenerateRepositoryTokenUsageInsert) Exec(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.TokenUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageInsertRequest) *dao.TokenUsage); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.TokenUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.TokenUsageInsertRequest
+func (_e *MockSchemaGenerateRepositoryTokenUsageInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call {
+	return &MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageInsertRequest)) *MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.TokenUsageInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.TokenUsageInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call) Return(tokenUsage *dao.TokenUsage, err error) *MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Return(tokenUsage, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)) *MockSchemaGenerateRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositoryProjectSelect creates a new instance of MockSchemaGenerateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositoryProjectSelect {
+	mock := &MockSchemaGenerateRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositoryProjectSelect is an autogenerated mock type for the SchemaGenerateRepositoryProjectSelect type
+type MockSchemaGenerateRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositoryProjectSelect) EXPECT() *MockSchemaGenerateRepositoryProjectSelect_Expecter {
+	return &MockSchemaGenerateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositoryProjectSelect
+func (_mock *MockSchemaGenerateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositoryProjectSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
 //   - request *dao.ProjectSelectRequest
-func (_e *MockProjectDeleteRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectDeleteRepositorySelect_Exec_Call {
-	return &MockProjectDeleteRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+func (_e *MockSchemaGenerateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaGenerateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositoryModuleSelect creates a new instance of MockSchemaGenerateRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositoryModuleSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositoryModuleSelect {
+	mock := &MockSchemaGenerateRepositoryModuleSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositoryModuleSelect is an autogenerated mock type for the SchemaGenerateRepositoryModuleSelect type
+type MockSchemaGenerateRepositoryModuleSelect struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositoryModuleSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositoryModuleSelect) EXPECT() *MockSchemaGenerateRepositoryModuleSelect_Expecter {
+	return &MockSchemaGenerateRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositoryModuleSelect
+func (_mock *MockSchemaGenerateRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Module
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Module)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositoryModuleSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleSelectRequest
+func (_e *MockSchemaGenerateRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
+	return &MockSchemaGenerateRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(module, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositoryModuleListVersions creates a new instance of MockSchemaGenerateRepositoryModuleListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositoryModuleListVersions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositoryModuleListVersions {
+	mock := &MockSchemaGenerateRepositoryModuleListVersions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositoryModuleListVersions is an autogenerated mock type for the SchemaGenerateRepositoryModuleListVersions type
+type MockSchemaGenerateRepositoryModuleListVersions struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositoryModuleListVersions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositoryModuleListVersions) EXPECT() *MockSchemaGenerateRepositoryModuleListVersions_Expecter {
+	return &MockSchemaGenerateRepositoryModuleListVersions_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositoryModuleListVersions
+func (_mock *MockSchemaGenerateRepositoryModuleListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.ModuleVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositoryModuleListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositoryModuleListVersions_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockSchemaGenerateRepositoryModuleListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryModuleListVersions_Exec_Call {
+	return &MockSchemaGenerateRepositoryModuleListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositoryModuleListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockSchemaGenerateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleListVersionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryModuleListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockSchemaGenerateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryModuleListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockSchemaGenerateRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateRepositoryProjectMemberSelect creates a new instance of MockSchemaGenerateRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateRepositoryProjectMemberSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateRepositoryProjectMemberSelect {
+	mock := &MockSchemaGenerateRepositoryProjectMemberSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaGenerateRepositoryProjectMemberSelect type
+type MockSchemaGenerateRepositoryProjectMemberSelect struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateRepositoryProjectMemberSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateRepositoryProjectMemberSelect) EXPECT() *MockSchemaGenerateRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaGenerateRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateRepositoryProjectMemberSelect
+func (_mock *MockSchemaGenerateRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ProjectMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ProjectMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaGenerateRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectMemberSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaGenerateRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateCandidatesRepository creates a new instance of MockSchemaGenerateCandidatesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateCandidatesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateCandidatesRepository {
+	mock := &MockSchemaGenerateCandidatesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateCandidatesRepository is an autogenerated mock type for the SchemaGenerateCandidatesRepository type
+type MockSchemaGenerateCandidatesRepository struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateCandidatesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateCandidatesRepository) EXPECT() *MockSchemaGenerateCandidatesRepository_Expecter {
+	return &MockSchemaGenerateCandidatesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepository
+func (_mock *MockSchemaGenerateCandidatesRepository) Exec(ctx context.Context, request *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.ModuleGeneration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleGenerateRequest) *dao.ModuleGeneration); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.ModuleGeneration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleGenerateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateCandidatesRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ModuleGenerateRequest
+func (_e *MockSchemaGenerateCandidatesRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepository_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateCandidatesRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleGenerateRequest)) *MockSchemaGenerateCandidatesRepository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ModuleGenerateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ModuleGenerateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateCandidatesRepository_Exec_Call) Return(moduleGeneration *dao.ModuleGeneration, err error) *MockSchemaGenerateCandidatesRepository_Exec_Call {
+	_c.Call.Return(moduleGeneration, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateCandidatesRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error)) *MockSchemaGenerateCandidatesRepository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateCandidatesRepositorySchemaList creates a new instance of MockSchemaGenerateCandidatesRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateCandidatesRepositorySchemaList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateCandidatesRepositorySchemaList {
+	mock := &MockSchemaGenerateCandidatesRepositorySchemaList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateCandidatesRepositorySchemaList is an autogenerated mock type for the SchemaGenerateCandidatesRepositorySchemaList type
+type MockSchemaGenerateCandidatesRepositorySchemaList struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateCandidatesRepositorySchemaList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateCandidatesRepositorySchemaList) EXPECT() *MockSchemaGenerateCandidatesRepositorySchemaList_Expecter {
+	return &MockSchemaGenerateCandidatesRepositorySchemaList_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositorySchemaList
+func (_mock *MockSchemaGenerateCandidatesRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 []*dao.Schema
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) ([]*dao.Schema, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) []*dao.Schema); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dao.Schema)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-func (_c *MockProjectDeleteRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectDeleteRepositorySelect_Exec_Call {
+// MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.SchemaListRequest
+func (_e *MockSchemaGenerateCandidatesRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListRequest)) *MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectSelectRequest
+		var arg1 *dao.SchemaListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectSelectRequest)
+			arg1 = args[1].(*dao.SchemaListRequest)
 		}
 		run(
 			arg0,
@@ -856,23 +11877,23 @@ func (_c *MockProjectDeleteRepositorySelect_Exec_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockProjectDeleteRepositorySelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectDeleteRepositorySelect_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call {
+	_c.Call.Return(schemas, err)
 	return _c
 }
 
-func (_c *MockProjectDeleteRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectDeleteRepositorySelect_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)) *MockSchemaGenerateCandidatesRepositorySchemaList_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectDeleteRepository creates a new instance of MockProjectDeleteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateCandidatesRepositorySchemaCandidateInsert creates a new instance of MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectDeleteRepository(t interface {
+func NewMockSchemaGenerateCandidatesRepositorySchemaCandidateInsert(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectDeleteRepository {
-	mock := &MockProjectDeleteRepository{}
+}) *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert {
+	mock := &MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -880,40 +11901,40 @@ func NewMockProjectDeleteRepository(t interface {
 	return mock
 }
 
-// MockProjectDeleteRepository is an autogenerated mock type for the ProjectDeleteRepository type
-type MockProjectDeleteRepository struct {
+// MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert is an autogenerated mock type for the SchemaGenerateCandidatesRepositorySchemaCandidateInsert type
+type MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert struct {
 	mock.Mock
 }
 
-type MockProjectDeleteRepository_Expecter struct {
+type MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectDeleteRepository) EXPECT() *MockProjectDeleteRepository_Expecter {
-	return &MockProjectDeleteRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert) EXPECT() *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Expecter {
+	return &MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectDeleteRepository
-func (_mock *MockProjectDeleteRepository) Exec(ctx context.Context, request *dao.ProjectDeleteRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert
+func (_mock *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert) Exec(ctx context.Context, request *dao.SchemaCandidateInsertRequest) (*dao.SchemaCandidate, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 *dao.SchemaCandidate
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectDeleteRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateInsertRequest) (*dao.SchemaCandidate, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectDeleteRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaCandidateInsertRequest) *dao.SchemaCandidate); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).(*dao.SchemaCandidate)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectDeleteRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaCandidateInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -921,27 +11942,27 @@ func (_mock *MockProjectDeleteRepository) Exec(ctx context.Context, request *dao
 	return r0, r1
 }
 
-// MockProjectDeleteRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectDeleteRepository_Exec_Call struct {
+// MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectDeleteRequest
-func (_e *MockProjectDeleteRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectDeleteRepository_Exec_Call {
-	return &MockProjectDeleteRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaCandidateInsertRequest
+func (_e *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectDeleteRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectDeleteRequest)) *MockProjectDeleteRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaCandidateInsertRequest)) *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectDeleteRequest
+		var arg1 *dao.SchemaCandidateInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectDeleteRequest)
+			arg1 = args[1].(*dao.SchemaCandidateInsertRequest)
 		}
 		run(
 			arg0,
@@ -951,23 +11972,23 @@ func (_c *MockProjectDeleteRepository_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockProjectDeleteRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectDeleteRepository_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call) Return(schemaCandidate *dao.SchemaCandidate, err error) *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call {
+	_c.Call.Return(schemaCandidate, err)
 	return _c
 }
 
-func (_c *MockProjectDeleteRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectDeleteRequest) (*dao.Project, error)) *MockProjectDeleteRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaCandidateInsertRequest) (*dao.SchemaCandidate, error)) *MockSchemaGenerateCandidatesRepositorySchemaCandidateInsert_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectInsertRepository creates a new instance of MockProjectInsertRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateCandidatesRepositorySchemaGenerationInsert creates a new instance of MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectInsertRepository(t interface {
+func NewMockSchemaGenerateCandidatesRepositorySchemaGenerationInsert(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectInsertRepository {
-	mock := &MockProjectInsertRepository{}
+}) *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert {
+	mock := &MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -975,40 +11996,40 @@ func NewMockProjectInsertRepository(t interface {
 	return mock
 }
 
-// MockProjectInsertRepository is an autogenerated mock type for the ProjectInsertRepository type
-type MockProjectInsertRepository struct {
+// MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert is an autogenerated mock type for the SchemaGenerateCandidatesRepositorySchemaGenerationInsert type
+type MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert struct {
 	mock.Mock
 }
 
-type MockProjectInsertRepository_Expecter struct {
+type MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectInsertRepository) EXPECT() *MockProjectInsertRepository_Expecter {
-	return &MockProjectInsertRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert) EXPECT() *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Expecter {
+	return &MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectInsertRepository
-func (_mock *MockProjectInsertRepository) Exec(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert
+func (_mock *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert) Exec(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 *dao.SchemaGeneration
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectInsertRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationInsertRequest) *dao.SchemaGeneration); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).(*dao.SchemaGeneration)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaGenerationInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1016,27 +12037,27 @@ func (_mock *MockProjectInsertRepository) Exec(ctx context.Context, request *dao
 	return r0, r1
 }
 
-// MockProjectInsertRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectInsertRepository_Exec_Call struct {
+// MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectInsertRequest
-func (_e *MockProjectInsertRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInsertRepository_Exec_Call {
-	return &MockProjectInsertRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaGenerationInsertRequest
+func (_e *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectInsertRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectInsertRequest)) *MockProjectInsertRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaGenerationInsertRequest)) *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectInsertRequest
+		var arg1 *dao.SchemaGenerationInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectInsertRequest)
+			arg1 = args[1].(*dao.SchemaGenerationInsertRequest)
 		}
 		run(
 			arg0,
@@ -1046,23 +12067,23 @@ func (_c *MockProjectInsertRepository_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockProjectInsertRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectInsertRepository_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call) Return(schemaGeneration *dao.SchemaGeneration, err error) *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call {
+	_c.Call.Return(schemaGeneration, err)
 	return _c
 }
 
-func (_c *MockProjectInsertRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error)) *MockProjectInsertRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)) *MockSchemaGenerateCandidatesRepositorySchemaGenerationInsert_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectInsertRepositorySchemaInsert creates a new instance of MockProjectInsertRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateCandidatesRepositoryTokenUsageList creates a new instance of MockSchemaGenerateCandidatesRepositoryTokenUsageList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectInsertRepositorySchemaInsert(t interface {
+func NewMockSchemaGenerateCandidatesRepositoryTokenUsageList(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectInsertRepositorySchemaInsert {
-	mock := &MockProjectInsertRepositorySchemaInsert{}
+}) *MockSchemaGenerateCandidatesRepositoryTokenUsageList {
+	mock := &MockSchemaGenerateCandidatesRepositoryTokenUsageList{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1070,40 +12091,40 @@ func NewMockProjectInsertRepositorySchemaInsert(t interface {
 	return mock
 }
 
-// MockProjectInsertRepositorySchemaInsert is an autogenerated mock type for the ProjectInsertRepositorySchemaInsert type
-type MockProjectInsertRepositorySchemaInsert struct {
+// MockSchemaGenerateCandidatesRepositoryTokenUsageList is an autogenerated mock type for the SchemaGenerateCandidatesRepositoryTokenUsageList type
+type MockSchemaGenerateCandidatesRepositoryTokenUsageList struct {
 	mock.Mock
 }
 
-type MockProjectInsertRepositorySchemaInsert_Expecter struct {
+type MockSchemaGenerateCandidatesRepositoryTokenUsageList_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectInsertRepositorySchemaInsert) EXPECT() *MockProjectInsertRepositorySchemaInsert_Expecter {
-	return &MockProjectInsertRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateCandidatesRepositoryTokenUsageList) EXPECT() *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Expecter {
+	return &MockSchemaGenerateCandidatesRepositoryTokenUsageList_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectInsertRepositorySchemaInsert
-func (_mock *MockProjectInsertRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositoryTokenUsageList
+func (_mock *MockSchemaGenerateCandidatesRepositoryTokenUsageList) Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Schema
+	var r0 []*dao.TokenUsageProject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) []*dao.TokenUsageProject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Schema)
+			r0 = ret.Get(0).([]*dao.TokenUsageProject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1111,27 +12132,27 @@ func (_mock *MockProjectInsertRepositorySchemaInsert) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockProjectInsertRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectInsertRepositorySchemaInsert_Exec_Call struct {
+// MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaInsertRequest
-func (_e *MockProjectInsertRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
-	return &MockProjectInsertRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.TokenUsageListRequest
+func (_e *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectInsertRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageListRequest)) *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaInsertRequest
+		var arg1 *dao.TokenUsageListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaInsertRequest)
+			arg1 = args[1].(*dao.TokenUsageListRequest)
 		}
 		run(
 			arg0,
@@ -1141,23 +12162,213 @@ func (_c *MockProjectInsertRepositorySchemaInsert_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockProjectInsertRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
-	_c.Call.Return(schema, err)
+func (_c *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call) Return(tokenUsageProjects []*dao.TokenUsageProject, err error) *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Return(tokenUsageProjects, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)) *MockSchemaGenerateCandidatesRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateCandidatesRepositoryTokenUsageInsert creates a new instance of MockSchemaGenerateCandidatesRepositoryTokenUsageInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateCandidatesRepositoryTokenUsageInsert(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert {
+	mock := &MockSchemaGenerateCandidatesRepositoryTokenUsageInsert{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateCandidatesRepositoryTokenUsageInsert is an autogenerated mock type for the SchemaGenerateCandidatesRepositoryTokenUsageInsert type
+type MockSchemaGenerateCandidatesRepositoryTokenUsageInsert struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert) EXPECT() *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Expecter {
+	return &MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositoryTokenUsageInsert
+func (_mock *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert) Exec(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.TokenUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageInsertRequest) *dao.TokenUsage); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.TokenUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageInsertRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.TokenUsageInsertRequest
+func (_e *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageInsertRequest)) *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.TokenUsageInsertRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.TokenUsageInsertRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call) Return(tokenUsage *dao.TokenUsage, err error) *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Return(tokenUsage, err)
+	return _c
+}
+
+func (_c *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)) *MockSchemaGenerateCandidatesRepositoryTokenUsageInsert_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchemaGenerateCandidatesRepositoryProjectSelect creates a new instance of MockSchemaGenerateCandidatesRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchemaGenerateCandidatesRepositoryProjectSelect(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchemaGenerateCandidatesRepositoryProjectSelect {
+	mock := &MockSchemaGenerateCandidatesRepositoryProjectSelect{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchemaGenerateCandidatesRepositoryProjectSelect is an autogenerated mock type for the SchemaGenerateCandidatesRepositoryProjectSelect type
+type MockSchemaGenerateCandidatesRepositoryProjectSelect struct {
+	mock.Mock
+}
+
+type MockSchemaGenerateCandidatesRepositoryProjectSelect_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchemaGenerateCandidatesRepositoryProjectSelect) EXPECT() *MockSchemaGenerateCandidatesRepositoryProjectSelect_Expecter {
+	return &MockSchemaGenerateCandidatesRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+}
+
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositoryProjectSelect
+func (_mock *MockSchemaGenerateCandidatesRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 *dao.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dao.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *dao.ProjectSelectRequest
+func (_e *MockSchemaGenerateCandidatesRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+}
+
+func (_c *MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *dao.ProjectSelectRequest
+		if args[1] != nil {
+			arg1 = args[1].(*dao.ProjectSelectRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
 	return _c
 }
 
-func (_c *MockProjectInsertRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectInsertRepositorySchemaInsert_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaGenerateCandidatesRepositoryProjectSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectInsertRepositoryModuleSelect creates a new instance of MockProjectInsertRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateCandidatesRepositoryModuleSelect creates a new instance of MockSchemaGenerateCandidatesRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectInsertRepositoryModuleSelect(t interface {
+func NewMockSchemaGenerateCandidatesRepositoryModuleSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectInsertRepositoryModuleSelect {
-	mock := &MockProjectInsertRepositoryModuleSelect{}
+}) *MockSchemaGenerateCandidatesRepositoryModuleSelect {
+	mock := &MockSchemaGenerateCandidatesRepositoryModuleSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1165,21 +12376,21 @@ func NewMockProjectInsertRepositoryModuleSelect(t interface {
 	return mock
 }
 
-// MockProjectInsertRepositoryModuleSelect is an autogenerated mock type for the ProjectInsertRepositoryModuleSelect type
-type MockProjectInsertRepositoryModuleSelect struct {
+// MockSchemaGenerateCandidatesRepositoryModuleSelect is an autogenerated mock type for the SchemaGenerateCandidatesRepositoryModuleSelect type
+type MockSchemaGenerateCandidatesRepositoryModuleSelect struct {
 	mock.Mock
 }
 
-type MockProjectInsertRepositoryModuleSelect_Expecter struct {
+type MockSchemaGenerateCandidatesRepositoryModuleSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectInsertRepositoryModuleSelect) EXPECT() *MockProjectInsertRepositoryModuleSelect_Expecter {
-	return &MockProjectInsertRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateCandidatesRepositoryModuleSelect) EXPECT() *MockSchemaGenerateCandidatesRepositoryModuleSelect_Expecter {
+	return &MockSchemaGenerateCandidatesRepositoryModuleSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectInsertRepositoryModuleSelect
-func (_mock *MockProjectInsertRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositoryModuleSelect
+func (_mock *MockSchemaGenerateCandidatesRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -1206,19 +12417,19 @@ func (_mock *MockProjectInsertRepositoryModuleSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockProjectInsertRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectInsertRepositoryModuleSelect_Exec_Call struct {
+// MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
 //   - request *dao.ModuleSelectRequest
-func (_e *MockProjectInsertRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
-	return &MockProjectInsertRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+func (_e *MockSchemaGenerateCandidatesRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectInsertRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1236,23 +12447,23 @@ func (_c *MockProjectInsertRepositoryModuleSelect_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockProjectInsertRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call {
 	_c.Call.Return(module, err)
 	return _c
 }
 
-func (_c *MockProjectInsertRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockProjectInsertRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockSchemaGenerateCandidatesRepositoryModuleSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectListRepository creates a new instance of MockProjectListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateCandidatesRepositoryModuleListVersions creates a new instance of MockSchemaGenerateCandidatesRepositoryModuleListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectListRepository(t interface {
+func NewMockSchemaGenerateCandidatesRepositoryModuleListVersions(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectListRepository {
-	mock := &MockProjectListRepository{}
+}) *MockSchemaGenerateCandidatesRepositoryModuleListVersions {
+	mock := &MockSchemaGenerateCandidatesRepositoryModuleListVersions{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1260,40 +12471,40 @@ func NewMockProjectListRepository(t interface {
 	return mock
 }
 
-// MockProjectListRepository is an autogenerated mock type for the ProjectListRepository type
-type MockProjectListRepository struct {
+// MockSchemaGenerateCandidatesRepositoryModuleListVersions is an autogenerated mock type for the SchemaGenerateCandidatesRepositoryModuleListVersions type
+type MockSchemaGenerateCandidatesRepositoryModuleListVersions struct {
 	mock.Mock
 }
 
-type MockProjectListRepository_Expecter struct {
+type MockSchemaGenerateCandidatesRepositoryModuleListVersions_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectListRepository) EXPECT() *MockProjectListRepository_Expecter {
-	return &MockProjectListRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateCandidatesRepositoryModuleListVersions) EXPECT() *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Expecter {
+	return &MockSchemaGenerateCandidatesRepositoryModuleListVersions_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectListRepository
-func (_mock *MockProjectListRepository) Exec(ctx context.Context, request *dao.ProjectListRequest) ([]*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositoryModuleListVersions
+func (_mock *MockSchemaGenerateCandidatesRepositoryModuleListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*dao.Project
+	var r0 []*dao.ModuleVersion
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectListRequest) ([]*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectListRequest) []*dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Project)
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectListRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1301,27 +12512,27 @@ func (_mock *MockProjectListRepository) Exec(ctx context.Context, request *dao.P
 	return r0, r1
 }
 
-// MockProjectListRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectListRepository_Exec_Call struct {
+// MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectListRequest
-func (_e *MockProjectListRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectListRepository_Exec_Call {
-	return &MockProjectListRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectListRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectListRequest)) *MockProjectListRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectListRequest
+		var arg1 *dao.ModuleListVersionsRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectListRequest)
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
 		}
 		run(
 			arg0,
@@ -1331,23 +12542,23 @@ func (_c *MockProjectListRepository_Exec_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockProjectListRepository_Exec_Call) Return(projects []*dao.Project, err error) *MockProjectListRepository_Exec_Call {
-	_c.Call.Return(projects, err)
+func (_c *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
 	return _c
 }
 
-func (_c *MockProjectListRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectListRequest) ([]*dao.Project, error)) *MockProjectListRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockSchemaGenerateCandidatesRepositoryModuleListVersions_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectSelectRepository creates a new instance of MockProjectSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateCandidatesRepositoryProjectMemberSelect creates a new instance of MockSchemaGenerateCandidatesRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectSelectRepository(t interface {
+func NewMockSchemaGenerateCandidatesRepositoryProjectMemberSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectSelectRepository {
-	mock := &MockProjectSelectRepository{}
+}) *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect {
+	mock := &MockSchemaGenerateCandidatesRepositoryProjectMemberSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1355,40 +12566,40 @@ func NewMockProjectSelectRepository(t interface {
 	return mock
 }
 
-// MockProjectSelectRepository is an autogenerated mock type for the ProjectSelectRepository type
-type MockProjectSelectRepository struct {
+// MockSchemaGenerateCandidatesRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaGenerateCandidatesRepositoryProjectMemberSelect type
+type MockSchemaGenerateCandidatesRepositoryProjectMemberSelect struct {
 	mock.Mock
 }
 
-type MockProjectSelectRepository_Expecter struct {
+type MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectSelectRepository) EXPECT() *MockProjectSelectRepository_Expecter {
-	return &MockProjectSelectRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect) EXPECT() *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectSelectRepository
-func (_mock *MockProjectSelectRepository) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateCandidatesRepositoryProjectMemberSelect
+func (_mock *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 *dao.ProjectMember
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).(*dao.ProjectMember)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1396,27 +12607,27 @@ func (_mock *MockProjectSelectRepository) Exec(ctx context.Context, request *dao
 	return r0, r1
 }
 
-// MockProjectSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectSelectRepository_Exec_Call struct {
+// MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectSelectRequest
-func (_e *MockProjectSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectSelectRepository_Exec_Call {
-	return &MockProjectSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectSelectRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectSelectRequest
+		var arg1 *dao.ProjectMemberSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectSelectRequest)
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
 		}
 		run(
 			arg0,
@@ -1426,23 +12637,23 @@ func (_c *MockProjectSelectRepository_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockProjectSelectRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectSelectRepository_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
 	return _c
 }
 
-func (_c *MockProjectSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectSelectRepository_Exec_Call {
+func (_c *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaGenerateCandidatesRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectUpdateRepositorySelect creates a new instance of MockProjectUpdateRepositorySelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateEnqueueRepository creates a new instance of MockSchemaGenerateEnqueueRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectUpdateRepositorySelect(t interface {
+func NewMockSchemaGenerateEnqueueRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectUpdateRepositorySelect {
-	mock := &MockProjectUpdateRepositorySelect{}
+}) *MockSchemaGenerateEnqueueRepository {
+	mock := &MockSchemaGenerateEnqueueRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1450,40 +12661,40 @@ func NewMockProjectUpdateRepositorySelect(t interface {
 	return mock
 }
 
-// MockProjectUpdateRepositorySelect is an autogenerated mock type for the ProjectUpdateRepositorySelect type
-type MockProjectUpdateRepositorySelect struct {
+// MockSchemaGenerateEnqueueRepository is an autogenerated mock type for the SchemaGenerateEnqueueRepository type
+type MockSchemaGenerateEnqueueRepository struct {
 	mock.Mock
 }
 
-type MockProjectUpdateRepositorySelect_Expecter struct {
+type MockSchemaGenerateEnqueueRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectUpdateRepositorySelect) EXPECT() *MockProjectUpdateRepositorySelect_Expecter {
-	return &MockProjectUpdateRepositorySelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateEnqueueRepository) EXPECT() *MockSchemaGenerateEnqueueRepository_Expecter {
+	return &MockSchemaGenerateEnqueueRepository_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectUpdateRepositorySelect
-func (_mock *MockProjectUpdateRepositorySelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateEnqueueRepository
+func (_mock *MockSchemaGenerateEnqueueRepository) Exec(ctx context.Context, request *dao.GenerationJobInsertRequest) (*dao.GenerationJob, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 *dao.GenerationJob
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobInsertRequest) (*dao.GenerationJob, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.GenerationJobInsertRequest) *dao.GenerationJob); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).(*dao.GenerationJob)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.GenerationJobInsertRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1491,27 +12702,27 @@ func (_mock *MockProjectUpdateRepositorySelect) Exec(ctx context.Context, reques
 	return r0, r1
 }
 
-// MockProjectUpdateRepositorySelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectUpdateRepositorySelect_Exec_Call struct {
+// MockSchemaGenerateEnqueueRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateEnqueueRepository_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectSelectRequest
-func (_e *MockProjectUpdateRepositorySelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositorySelect_Exec_Call {
-	return &MockProjectUpdateRepositorySelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.GenerationJobInsertRequest
+func (_e *MockSchemaGenerateEnqueueRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateEnqueueRepository_Exec_Call {
+	return &MockSchemaGenerateEnqueueRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectUpdateRepositorySelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockProjectUpdateRepositorySelect_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.GenerationJobInsertRequest)) *MockSchemaGenerateEnqueueRepository_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectSelectRequest
+		var arg1 *dao.GenerationJobInsertRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectSelectRequest)
+			arg1 = args[1].(*dao.GenerationJobInsertRequest)
 		}
 		run(
 			arg0,
@@ -1521,23 +12732,23 @@ func (_c *MockProjectUpdateRepositorySelect_Exec_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockProjectUpdateRepositorySelect_Exec_Call) Return(project *dao.Project, err error) *MockProjectUpdateRepositorySelect_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaGenerateEnqueueRepository_Exec_Call) Return(generationJob *dao.GenerationJob, err error) *MockSchemaGenerateEnqueueRepository_Exec_Call {
+	_c.Call.Return(generationJob, err)
 	return _c
 }
 
-func (_c *MockProjectUpdateRepositorySelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockProjectUpdateRepositorySelect_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.GenerationJobInsertRequest) (*dao.GenerationJob, error)) *MockSchemaGenerateEnqueueRepository_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectUpdateRepository creates a new instance of MockProjectUpdateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateEnqueueRepositoryProjectSelect creates a new instance of MockSchemaGenerateEnqueueRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectUpdateRepository(t interface {
+func NewMockSchemaGenerateEnqueueRepositoryProjectSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectUpdateRepository {
-	mock := &MockProjectUpdateRepository{}
+}) *MockSchemaGenerateEnqueueRepositoryProjectSelect {
+	mock := &MockSchemaGenerateEnqueueRepositoryProjectSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1545,21 +12756,21 @@ func NewMockProjectUpdateRepository(t interface {
 	return mock
 }
 
-// MockProjectUpdateRepository is an autogenerated mock type for the ProjectUpdateRepository type
-type MockProjectUpdateRepository struct {
+// MockSchemaGenerateEnqueueRepositoryProjectSelect is an autogenerated mock type for the SchemaGenerateEnqueueRepositoryProjectSelect type
+type MockSchemaGenerateEnqueueRepositoryProjectSelect struct {
 	mock.Mock
 }
 
-type MockProjectUpdateRepository_Expecter struct {
+type MockSchemaGenerateEnqueueRepositoryProjectSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectUpdateRepository) EXPECT() *MockProjectUpdateRepository_Expecter {
-	return &MockProjectUpdateRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateEnqueueRepositoryProjectSelect) EXPECT() *MockSchemaGenerateEnqueueRepositoryProjectSelect_Expecter {
+	return &MockSchemaGenerateEnqueueRepositoryProjectSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectUpdateRepository
-func (_mock *MockProjectUpdateRepository) Exec(ctx context.Context, request *dao.ProjectUpdateRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaGenerateEnqueueRepositoryProjectSelect
+func (_mock *MockSchemaGenerateEnqueueRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -1568,17 +12779,17 @@ func (_mock *MockProjectUpdateRepository) Exec(ctx context.Context, request *dao
 
 	var r0 *dao.Project
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectUpdateRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Project)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectUpdateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1586,27 +12797,27 @@ func (_mock *MockProjectUpdateRepository) Exec(ctx context.Context, request *dao
 	return r0, r1
 }
 
-// MockProjectUpdateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectUpdateRepository_Exec_Call struct {
+// MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectUpdateRequest
-func (_e *MockProjectUpdateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepository_Exec_Call {
-	return &MockProjectUpdateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectSelectRequest
+func (_e *MockSchemaGenerateEnqueueRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectUpdateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectUpdateRequest)) *MockProjectUpdateRepository_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectUpdateRequest
+		var arg1 *dao.ProjectSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectUpdateRequest)
+			arg1 = args[1].(*dao.ProjectSelectRequest)
 		}
 		run(
 			arg0,
@@ -1616,23 +12827,23 @@ func (_c *MockProjectUpdateRepository_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockProjectUpdateRepository_Exec_Call) Return(project *dao.Project, err error) *MockProjectUpdateRepository_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call {
 	_c.Call.Return(project, err)
 	return _c
 }
 
-func (_c *MockProjectUpdateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectUpdateRequest) (*dao.Project, error)) *MockProjectUpdateRepository_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaGenerateEnqueueRepositoryProjectSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectUpdateRepositorySchemaInsert creates a new instance of MockProjectUpdateRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateEnqueueRepositoryModuleListVersions creates a new instance of MockSchemaGenerateEnqueueRepositoryModuleListVersions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectUpdateRepositorySchemaInsert(t interface {
+func NewMockSchemaGenerateEnqueueRepositoryModuleListVersions(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectUpdateRepositorySchemaInsert {
-	mock := &MockProjectUpdateRepositorySchemaInsert{}
+}) *MockSchemaGenerateEnqueueRepositoryModuleListVersions {
+	mock := &MockSchemaGenerateEnqueueRepositoryModuleListVersions{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1640,40 +12851,40 @@ func NewMockProjectUpdateRepositorySchemaInsert(t interface {
 	return mock
 }
 
-// MockProjectUpdateRepositorySchemaInsert is an autogenerated mock type for the ProjectUpdateRepositorySchemaInsert type
-type MockProjectUpdateRepositorySchemaInsert struct {
+// MockSchemaGenerateEnqueueRepositoryModuleListVersions is an autogenerated mock type for the SchemaGenerateEnqueueRepositoryModuleListVersions type
+type MockSchemaGenerateEnqueueRepositoryModuleListVersions struct {
 	mock.Mock
 }
 
-type MockProjectUpdateRepositorySchemaInsert_Expecter struct {
+type MockSchemaGenerateEnqueueRepositoryModuleListVersions_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectUpdateRepositorySchemaInsert) EXPECT() *MockProjectUpdateRepositorySchemaInsert_Expecter {
-	return &MockProjectUpdateRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateEnqueueRepositoryModuleListVersions) EXPECT() *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Expecter {
+	return &MockSchemaGenerateEnqueueRepositoryModuleListVersions_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectUpdateRepositorySchemaInsert
-func (_mock *MockProjectUpdateRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaGenerateEnqueueRepositoryModuleListVersions
+func (_mock *MockSchemaGenerateEnqueueRepositoryModuleListVersions) Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Schema
+	var r0 []*dao.ModuleVersion
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleListVersionsRequest) []*dao.ModuleVersion); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Schema)
+			r0 = ret.Get(0).([]*dao.ModuleVersion)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleListVersionsRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1681,27 +12892,27 @@ func (_mock *MockProjectUpdateRepositorySchemaInsert) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockProjectUpdateRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectUpdateRepositorySchemaInsert_Exec_Call struct {
+// MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaInsertRequest
-func (_e *MockProjectUpdateRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
-	return &MockProjectUpdateRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ModuleListVersionsRequest
+func (_e *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call {
+	return &MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectUpdateRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleListVersionsRequest)) *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaInsertRequest
+		var arg1 *dao.ModuleListVersionsRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaInsertRequest)
+			arg1 = args[1].(*dao.ModuleListVersionsRequest)
 		}
 		run(
 			arg0,
@@ -1711,23 +12922,23 @@ func (_c *MockProjectUpdateRepositorySchemaInsert_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockProjectUpdateRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
-	_c.Call.Return(schema, err)
+func (_c *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call) Return(moduleVersions []*dao.ModuleVersion, err error) *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call {
+	_c.Call.Return(moduleVersions, err)
 	return _c
 }
 
-func (_c *MockProjectUpdateRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockProjectUpdateRepositorySchemaInsert_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)) *MockSchemaGenerateEnqueueRepositoryModuleListVersions_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProjectUpdateRepositoryModuleSelect creates a new instance of MockProjectUpdateRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateEnqueueRepositoryTokenUsageList creates a new instance of MockSchemaGenerateEnqueueRepositoryTokenUsageList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProjectUpdateRepositoryModuleSelect(t interface {
+func NewMockSchemaGenerateEnqueueRepositoryTokenUsageList(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProjectUpdateRepositoryModuleSelect {
-	mock := &MockProjectUpdateRepositoryModuleSelect{}
+}) *MockSchemaGenerateEnqueueRepositoryTokenUsageList {
+	mock := &MockSchemaGenerateEnqueueRepositoryTokenUsageList{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1735,40 +12946,40 @@ func NewMockProjectUpdateRepositoryModuleSelect(t interface {
 	return mock
 }
 
-// MockProjectUpdateRepositoryModuleSelect is an autogenerated mock type for the ProjectUpdateRepositoryModuleSelect type
-type MockProjectUpdateRepositoryModuleSelect struct {
+// MockSchemaGenerateEnqueueRepositoryTokenUsageList is an autogenerated mock type for the SchemaGenerateEnqueueRepositoryTokenUsageList type
+type MockSchemaGenerateEnqueueRepositoryTokenUsageList struct {
 	mock.Mock
 }
 
-type MockProjectUpdateRepositoryModuleSelect_Expecter struct {
+type MockSchemaGenerateEnqueueRepositoryTokenUsageList_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProjectUpdateRepositoryModuleSelect) EXPECT() *MockProjectUpdateRepositoryModuleSelect_Expecter {
-	return &MockProjectUpdateRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateEnqueueRepositoryTokenUsageList) EXPECT() *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Expecter {
+	return &MockSchemaGenerateEnqueueRepositoryTokenUsageList_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockProjectUpdateRepositoryModuleSelect
-func (_mock *MockProjectUpdateRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockSchemaGenerateEnqueueRepositoryTokenUsageList
+func (_mock *MockSchemaGenerateEnqueueRepositoryTokenUsageList) Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Module
+	var r0 []*dao.TokenUsageProject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) []*dao.TokenUsageProject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Module)
+			r0 = ret.Get(0).([]*dao.TokenUsageProject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1776,27 +12987,27 @@ func (_mock *MockProjectUpdateRepositoryModuleSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockProjectUpdateRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockProjectUpdateRepositoryModuleSelect_Exec_Call struct {
+// MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleSelectRequest
-func (_e *MockProjectUpdateRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
-	return &MockProjectUpdateRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.TokenUsageListRequest
+func (_e *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call {
+	return &MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockProjectUpdateRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageListRequest)) *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleSelectRequest
+		var arg1 *dao.TokenUsageListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleSelectRequest)
+			arg1 = args[1].(*dao.TokenUsageListRequest)
 		}
 		run(
 			arg0,
@@ -1806,23 +13017,23 @@ func (_c *MockProjectUpdateRepositoryModuleSelect_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockProjectUpdateRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
-	_c.Call.Return(module, err)
+func (_c *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call) Return(tokenUsageProjects []*dao.TokenUsageProject, err error) *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call {
+	_c.Call.Return(tokenUsageProjects, err)
 	return _c
 }
 
-func (_c *MockProjectUpdateRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockProjectUpdateRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)) *MockSchemaGenerateEnqueueRepositoryTokenUsageList_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaCreateRepository creates a new instance of MockSchemaCreateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaGenerateEnqueueRepositoryProjectMemberSelect creates a new instance of MockSchemaGenerateEnqueueRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaCreateRepository(t interface {
+func NewMockSchemaGenerateEnqueueRepositoryProjectMemberSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaCreateRepository {
-	mock := &MockSchemaCreateRepository{}
+}) *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect {
+	mock := &MockSchemaGenerateEnqueueRepositoryProjectMemberSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1830,40 +13041,40 @@ func NewMockSchemaCreateRepository(t interface {
 	return mock
 }
 
-// MockSchemaCreateRepository is an autogenerated mock type for the SchemaCreateRepository type
-type MockSchemaCreateRepository struct {
+// MockSchemaGenerateEnqueueRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaGenerateEnqueueRepositoryProjectMemberSelect type
+type MockSchemaGenerateEnqueueRepositoryProjectMemberSelect struct {
 	mock.Mock
 }
 
-type MockSchemaCreateRepository_Expecter struct {
+type MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaCreateRepository) EXPECT() *MockSchemaCreateRepository_Expecter {
-	return &MockSchemaCreateRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect) EXPECT() *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaCreateRepository
-func (_mock *MockSchemaCreateRepository) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaGenerateEnqueueRepositoryProjectMemberSelect
+func (_mock *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Schema
+	var r0 *dao.ProjectMember
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Schema)
+			r0 = ret.Get(0).(*dao.ProjectMember)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1871,27 +13082,27 @@ func (_mock *MockSchemaCreateRepository) Exec(ctx context.Context, request *dao.
 	return r0, r1
 }
 
-// MockSchemaCreateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaCreateRepository_Exec_Call struct {
+// MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaInsertRequest
-func (_e *MockSchemaCreateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepository_Exec_Call {
-	return &MockSchemaCreateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaCreateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockSchemaCreateRepository_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaInsertRequest
+		var arg1 *dao.ProjectMemberSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaInsertRequest)
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
 		}
 		run(
 			arg0,
@@ -1901,23 +13112,23 @@ func (_c *MockSchemaCreateRepository_Exec_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockSchemaCreateRepository_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaCreateRepository_Exec_Call {
-	_c.Call.Return(schema, err)
+func (_c *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
 	return _c
 }
 
-func (_c *MockSchemaCreateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockSchemaCreateRepository_Exec_Call {
+func (_c *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaGenerateEnqueueRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaCreateRepositoryProjectSelect creates a new instance of MockSchemaCreateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaListVersionsRepository creates a new instance of MockSchemaListVersionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaCreateRepositoryProjectSelect(t interface {
+func NewMockSchemaListVersionsRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaCreateRepositoryProjectSelect {
-	mock := &MockSchemaCreateRepositoryProjectSelect{}
+}) *MockSchemaListVersionsRepository {
+	mock := &MockSchemaListVersionsRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1925,40 +13136,40 @@ func NewMockSchemaCreateRepositoryProjectSelect(t interface {
 	return mock
 }
 
-// MockSchemaCreateRepositoryProjectSelect is an autogenerated mock type for the SchemaCreateRepositoryProjectSelect type
-type MockSchemaCreateRepositoryProjectSelect struct {
+// MockSchemaListVersionsRepository is an autogenerated mock type for the SchemaListVersionsRepository type
+type MockSchemaListVersionsRepository struct {
 	mock.Mock
 }
 
-type MockSchemaCreateRepositoryProjectSelect_Expecter struct {
+type MockSchemaListVersionsRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaCreateRepositoryProjectSelect) EXPECT() *MockSchemaCreateRepositoryProjectSelect_Expecter {
-	return &MockSchemaCreateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaListVersionsRepository) EXPECT() *MockSchemaListVersionsRepository_Expecter {
+	return &MockSchemaListVersionsRepository_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaCreateRepositoryProjectSelect
-func (_mock *MockSchemaCreateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaListVersionsRepository
+func (_mock *MockSchemaListVersionsRepository) Exec(ctx context.Context, request *dao.SchemaListVersionsRequest) ([]*dao.SchemaVersion, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 []*dao.SchemaVersion
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListVersionsRequest) ([]*dao.SchemaVersion, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListVersionsRequest) []*dao.SchemaVersion); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).([]*dao.SchemaVersion)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListVersionsRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -1966,27 +13177,27 @@ func (_mock *MockSchemaCreateRepositoryProjectSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockSchemaCreateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaCreateRepositoryProjectSelect_Exec_Call struct {
+// MockSchemaListVersionsRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaListVersionsRepository_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectSelectRequest
-func (_e *MockSchemaCreateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
-	return &MockSchemaCreateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaListVersionsRequest
+func (_e *MockSchemaListVersionsRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaListVersionsRepository_Exec_Call {
+	return &MockSchemaListVersionsRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaCreateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaListVersionsRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListVersionsRequest)) *MockSchemaListVersionsRepository_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectSelectRequest
+		var arg1 *dao.SchemaListVersionsRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectSelectRequest)
+			arg1 = args[1].(*dao.SchemaListVersionsRequest)
 		}
 		run(
 			arg0,
@@ -1996,23 +13207,23 @@ func (_c *MockSchemaCreateRepositoryProjectSelect_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockSchemaCreateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaListVersionsRepository_Exec_Call) Return(schemaVersions []*dao.SchemaVersion, err error) *MockSchemaListVersionsRepository_Exec_Call {
+	_c.Call.Return(schemaVersions, err)
 	return _c
 }
 
-func (_c *MockSchemaCreateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaCreateRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaListVersionsRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListVersionsRequest) ([]*dao.SchemaVersion, error)) *MockSchemaListVersionsRepository_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaCreateRepositoryModuleSelect creates a new instance of MockSchemaCreateRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaListVersionsRepositorySchemaGenerationList creates a new instance of MockSchemaListVersionsRepositorySchemaGenerationList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaCreateRepositoryModuleSelect(t interface {
+func NewMockSchemaListVersionsRepositorySchemaGenerationList(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaCreateRepositoryModuleSelect {
-	mock := &MockSchemaCreateRepositoryModuleSelect{}
+}) *MockSchemaListVersionsRepositorySchemaGenerationList {
+	mock := &MockSchemaListVersionsRepositorySchemaGenerationList{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2020,40 +13231,40 @@ func NewMockSchemaCreateRepositoryModuleSelect(t interface {
 	return mock
 }
 
-// MockSchemaCreateRepositoryModuleSelect is an autogenerated mock type for the SchemaCreateRepositoryModuleSelect type
-type MockSchemaCreateRepositoryModuleSelect struct {
+// MockSchemaListVersionsRepositorySchemaGenerationList is an autogenerated mock type for the SchemaListVersionsRepositorySchemaGenerationList type
+type MockSchemaListVersionsRepositorySchemaGenerationList struct {
 	mock.Mock
 }
 
-type MockSchemaCreateRepositoryModuleSelect_Expecter struct {
+type MockSchemaListVersionsRepositorySchemaGenerationList_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaCreateRepositoryModuleSelect) EXPECT() *MockSchemaCreateRepositoryModuleSelect_Expecter {
-	return &MockSchemaCreateRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaListVersionsRepositorySchemaGenerationList) EXPECT() *MockSchemaListVersionsRepositorySchemaGenerationList_Expecter {
+	return &MockSchemaListVersionsRepositorySchemaGenerationList_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaCreateRepositoryModuleSelect
-func (_mock *MockSchemaCreateRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockSchemaListVersionsRepositorySchemaGenerationList
+func (_mock *MockSchemaListVersionsRepositorySchemaGenerationList) Exec(ctx context.Context, request *dao.SchemaGenerationListRequest) ([]*dao.SchemaGeneration, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Module
+	var r0 []*dao.SchemaGeneration
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationListRequest) ([]*dao.SchemaGeneration, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationListRequest) []*dao.SchemaGeneration); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Module)
+			r0 = ret.Get(0).([]*dao.SchemaGeneration)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaGenerationListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2061,27 +13272,27 @@ func (_mock *MockSchemaCreateRepositoryModuleSelect) Exec(ctx context.Context, r
 	return r0, r1
 }
 
-// MockSchemaCreateRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaCreateRepositoryModuleSelect_Exec_Call struct {
+// MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleSelectRequest
-func (_e *MockSchemaCreateRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
-	return &MockSchemaCreateRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaGenerationListRequest
+func (_e *MockSchemaListVersionsRepositorySchemaGenerationList_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call {
+	return &MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaCreateRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaGenerationListRequest)) *MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleSelectRequest
+		var arg1 *dao.SchemaGenerationListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleSelectRequest)
+			arg1 = args[1].(*dao.SchemaGenerationListRequest)
 		}
 		run(
 			arg0,
@@ -2091,23 +13302,23 @@ func (_c *MockSchemaCreateRepositoryModuleSelect_Exec_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockSchemaCreateRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
-	_c.Call.Return(module, err)
+func (_c *MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call) Return(schemaGenerations []*dao.SchemaGeneration, err error) *MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call {
+	_c.Call.Return(schemaGenerations, err)
 	return _c
 }
 
-func (_c *MockSchemaCreateRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockSchemaCreateRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaGenerationListRequest) ([]*dao.SchemaGeneration, error)) *MockSchemaListVersionsRepositorySchemaGenerationList_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaGenerateRepository creates a new instance of MockSchemaGenerateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaListVersionsRepositoryProjectSelect creates a new instance of MockSchemaListVersionsRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaGenerateRepository(t interface {
+func NewMockSchemaListVersionsRepositoryProjectSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaGenerateRepository {
-	mock := &MockSchemaGenerateRepository{}
+}) *MockSchemaListVersionsRepositoryProjectSelect {
+	mock := &MockSchemaListVersionsRepositoryProjectSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2115,40 +13326,40 @@ func NewMockSchemaGenerateRepository(t interface {
 	return mock
 }
 
-// MockSchemaGenerateRepository is an autogenerated mock type for the SchemaGenerateRepository type
-type MockSchemaGenerateRepository struct {
+// MockSchemaListVersionsRepositoryProjectSelect is an autogenerated mock type for the SchemaListVersionsRepositoryProjectSelect type
+type MockSchemaListVersionsRepositoryProjectSelect struct {
 	mock.Mock
 }
 
-type MockSchemaGenerateRepository_Expecter struct {
+type MockSchemaListVersionsRepositoryProjectSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaGenerateRepository) EXPECT() *MockSchemaGenerateRepository_Expecter {
-	return &MockSchemaGenerateRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaListVersionsRepositoryProjectSelect) EXPECT() *MockSchemaListVersionsRepositoryProjectSelect_Expecter {
+	return &MockSchemaListVersionsRepositoryProjectSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaGenerateRepository
-func (_mock *MockSchemaGenerateRepository) Exec(ctx context.Context, request *dao.ModuleGenerateRequest) (map[string]any, error) {
+// Exec provides a mock function for the type MockSchemaListVersionsRepositoryProjectSelect
+func (_mock *MockSchemaListVersionsRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 map[string]any
+	var r0 *dao.Project
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleGenerateRequest) (map[string]any, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleGenerateRequest) map[string]any); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]any)
+			r0 = ret.Get(0).(*dao.Project)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleGenerateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2156,27 +13367,27 @@ func (_mock *MockSchemaGenerateRepository) Exec(ctx context.Context, request *da
 	return r0, r1
 }
 
-// MockSchemaGenerateRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaGenerateRepository_Exec_Call struct {
+// MockSchemaListVersionsRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaListVersionsRepositoryProjectSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleGenerateRequest
-func (_e *MockSchemaGenerateRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepository_Exec_Call {
-	return &MockSchemaGenerateRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectSelectRequest
+func (_e *MockSchemaListVersionsRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaListVersionsRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaGenerateRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleGenerateRequest)) *MockSchemaGenerateRepository_Exec_Call {
+func (_c *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleGenerateRequest
+		var arg1 *dao.ProjectSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleGenerateRequest)
+			arg1 = args[1].(*dao.ProjectSelectRequest)
 		}
 		run(
 			arg0,
@@ -2186,23 +13397,23 @@ func (_c *MockSchemaGenerateRepository_Exec_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepository_Exec_Call) Return(stringToV map[string]any, err error) *MockSchemaGenerateRepository_Exec_Call {
-	_c.Call.Return(stringToV, err)
+func (_c *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleGenerateRequest) (map[string]any, error)) *MockSchemaGenerateRepository_Exec_Call {
+func (_c *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaGenerateRepositorySchemaList creates a new instance of MockSchemaGenerateRepositorySchemaList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaListVersionsRepositoryProjectMemberSelect creates a new instance of MockSchemaListVersionsRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaGenerateRepositorySchemaList(t interface {
+func NewMockSchemaListVersionsRepositoryProjectMemberSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaGenerateRepositorySchemaList {
-	mock := &MockSchemaGenerateRepositorySchemaList{}
+}) *MockSchemaListVersionsRepositoryProjectMemberSelect {
+	mock := &MockSchemaListVersionsRepositoryProjectMemberSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2210,40 +13421,40 @@ func NewMockSchemaGenerateRepositorySchemaList(t interface {
 	return mock
 }
 
-// MockSchemaGenerateRepositorySchemaList is an autogenerated mock type for the SchemaGenerateRepositorySchemaList type
-type MockSchemaGenerateRepositorySchemaList struct {
+// MockSchemaListVersionsRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaListVersionsRepositoryProjectMemberSelect type
+type MockSchemaListVersionsRepositoryProjectMemberSelect struct {
 	mock.Mock
 }
 
-type MockSchemaGenerateRepositorySchemaList_Expecter struct {
+type MockSchemaListVersionsRepositoryProjectMemberSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaGenerateRepositorySchemaList) EXPECT() *MockSchemaGenerateRepositorySchemaList_Expecter {
-	return &MockSchemaGenerateRepositorySchemaList_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaListVersionsRepositoryProjectMemberSelect) EXPECT() *MockSchemaListVersionsRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaListVersionsRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaGenerateRepositorySchemaList
-func (_mock *MockSchemaGenerateRepositorySchemaList) Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaListVersionsRepositoryProjectMemberSelect
+func (_mock *MockSchemaListVersionsRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*dao.Schema
+	var r0 *dao.ProjectMember
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) ([]*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListRequest) []*dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.Schema)
+			r0 = ret.Get(0).(*dao.ProjectMember)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2251,27 +13462,27 @@ func (_mock *MockSchemaGenerateRepositorySchemaList) Exec(ctx context.Context, r
 	return r0, r1
 }
 
-// MockSchemaGenerateRepositorySchemaList_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaGenerateRepositorySchemaList_Exec_Call struct {
+// MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaListRequest
-func (_e *MockSchemaGenerateRepositorySchemaList_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
-	return &MockSchemaGenerateRepositorySchemaList_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaListVersionsRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaGenerateRepositorySchemaList_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListRequest)) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
+func (_c *MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaListRequest
+		var arg1 *dao.ProjectMemberSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaListRequest)
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
 		}
 		run(
 			arg0,
@@ -2281,23 +13492,23 @@ func (_c *MockSchemaGenerateRepositorySchemaList_Exec_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositorySchemaList_Exec_Call) Return(schemas []*dao.Schema, err error) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
-	_c.Call.Return(schemas, err)
+func (_c *MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositorySchemaList_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)) *MockSchemaGenerateRepositorySchemaList_Exec_Call {
+func (_c *MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaListVersionsRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaGenerateRepositorySchemaInsert creates a new instance of MockSchemaGenerateRepositorySchemaInsert. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaRewriteRepository creates a new instance of MockSchemaRewriteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaGenerateRepositorySchemaInsert(t interface {
+func NewMockSchemaRewriteRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaGenerateRepositorySchemaInsert {
-	mock := &MockSchemaGenerateRepositorySchemaInsert{}
+}) *MockSchemaRewriteRepository {
+	mock := &MockSchemaRewriteRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2305,21 +13516,21 @@ func NewMockSchemaGenerateRepositorySchemaInsert(t interface {
 	return mock
 }
 
-// MockSchemaGenerateRepositorySchemaInsert is an autogenerated mock type for the SchemaGenerateRepositorySchemaInsert type
-type MockSchemaGenerateRepositorySchemaInsert struct {
+// MockSchemaRewriteRepository is an autogenerated mock type for the SchemaRewriteRepository type
+type MockSchemaRewriteRepository struct {
 	mock.Mock
 }
 
-type MockSchemaGenerateRepositorySchemaInsert_Expecter struct {
+type MockSchemaRewriteRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaGenerateRepositorySchemaInsert) EXPECT() *MockSchemaGenerateRepositorySchemaInsert_Expecter {
-	return &MockSchemaGenerateRepositorySchemaInsert_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaRewriteRepository) EXPECT() *MockSchemaRewriteRepository_Expecter {
+	return &MockSchemaRewriteRepository_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaGenerateRepositorySchemaInsert
-func (_mock *MockSchemaGenerateRepositorySchemaInsert) Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaRewriteRepository
+func (_mock *MockSchemaRewriteRepository) Exec(ctx context.Context, request *dao.SchemaUpdateRequest) (*dao.Schema, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -2328,17 +13539,17 @@ func (_mock *MockSchemaGenerateRepositorySchemaInsert) Exec(ctx context.Context,
 
 	var r0 *dao.Schema
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) (*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaUpdateRequest) (*dao.Schema, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaInsertRequest) *dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaUpdateRequest) *dao.Schema); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Schema)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaInsertRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaUpdateRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2346,27 +13557,27 @@ func (_mock *MockSchemaGenerateRepositorySchemaInsert) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockSchemaGenerateRepositorySchemaInsert_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaGenerateRepositorySchemaInsert_Exec_Call struct {
+// MockSchemaRewriteRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaRewriteRepository_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaInsertRequest
-func (_e *MockSchemaGenerateRepositorySchemaInsert_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
-	return &MockSchemaGenerateRepositorySchemaInsert_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaUpdateRequest
+func (_e *MockSchemaRewriteRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepository_Exec_Call {
+	return &MockSchemaRewriteRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaGenerateRepositorySchemaInsert_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaInsertRequest)) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
+func (_c *MockSchemaRewriteRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaUpdateRequest)) *MockSchemaRewriteRepository_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaInsertRequest
+		var arg1 *dao.SchemaUpdateRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaInsertRequest)
+			arg1 = args[1].(*dao.SchemaUpdateRequest)
 		}
 		run(
 			arg0,
@@ -2376,23 +13587,23 @@ func (_c *MockSchemaGenerateRepositorySchemaInsert_Exec_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositorySchemaInsert_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
+func (_c *MockSchemaRewriteRepository_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaRewriteRepository_Exec_Call {
 	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositorySchemaInsert_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)) *MockSchemaGenerateRepositorySchemaInsert_Exec_Call {
+func (_c *MockSchemaRewriteRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaUpdateRequest) (*dao.Schema, error)) *MockSchemaRewriteRepository_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaGenerateRepositoryProjectSelect creates a new instance of MockSchemaGenerateRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaRewriteRepositoryProjectSelect creates a new instance of MockSchemaRewriteRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaGenerateRepositoryProjectSelect(t interface {
+func NewMockSchemaRewriteRepositoryProjectSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaGenerateRepositoryProjectSelect {
-	mock := &MockSchemaGenerateRepositoryProjectSelect{}
+}) *MockSchemaRewriteRepositoryProjectSelect {
+	mock := &MockSchemaRewriteRepositoryProjectSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2400,21 +13611,21 @@ func NewMockSchemaGenerateRepositoryProjectSelect(t interface {
 	return mock
 }
 
-// MockSchemaGenerateRepositoryProjectSelect is an autogenerated mock type for the SchemaGenerateRepositoryProjectSelect type
-type MockSchemaGenerateRepositoryProjectSelect struct {
+// MockSchemaRewriteRepositoryProjectSelect is an autogenerated mock type for the SchemaRewriteRepositoryProjectSelect type
+type MockSchemaRewriteRepositoryProjectSelect struct {
 	mock.Mock
 }
 
-type MockSchemaGenerateRepositoryProjectSelect_Expecter struct {
+type MockSchemaRewriteRepositoryProjectSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaGenerateRepositoryProjectSelect) EXPECT() *MockSchemaGenerateRepositoryProjectSelect_Expecter {
-	return &MockSchemaGenerateRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaRewriteRepositoryProjectSelect) EXPECT() *MockSchemaRewriteRepositoryProjectSelect_Expecter {
+	return &MockSchemaRewriteRepositoryProjectSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaGenerateRepositoryProjectSelect
-func (_mock *MockSchemaGenerateRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaRewriteRepositoryProjectSelect
+func (_mock *MockSchemaRewriteRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -2441,19 +13652,19 @@ func (_mock *MockSchemaGenerateRepositoryProjectSelect) Exec(ctx context.Context
 	return r0, r1
 }
 
-// MockSchemaGenerateRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaGenerateRepositoryProjectSelect_Exec_Call struct {
+// MockSchemaRewriteRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaRewriteRepositoryProjectSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
 //   - request *dao.ProjectSelectRequest
-func (_e *MockSchemaGenerateRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
-	return &MockSchemaGenerateRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+func (_e *MockSchemaRewriteRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaRewriteRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaGenerateRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaRewriteRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2471,23 +13682,23 @@ func (_c *MockSchemaGenerateRepositoryProjectSelect_Exec_Call) Run(run func(ctx
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaRewriteRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
 	_c.Call.Return(project, err)
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaGenerateRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaRewriteRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaGenerateRepositoryModuleSelect creates a new instance of MockSchemaGenerateRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaRewriteRepositorySchemaSelect creates a new instance of MockSchemaRewriteRepositorySchemaSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaGenerateRepositoryModuleSelect(t interface {
+func NewMockSchemaRewriteRepositorySchemaSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaGenerateRepositoryModuleSelect {
-	mock := &MockSchemaGenerateRepositoryModuleSelect{}
+}) *MockSchemaRewriteRepositorySchemaSelect {
+	mock := &MockSchemaRewriteRepositorySchemaSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2495,40 +13706,40 @@ func NewMockSchemaGenerateRepositoryModuleSelect(t interface {
 	return mock
 }
 
-// MockSchemaGenerateRepositoryModuleSelect is an autogenerated mock type for the SchemaGenerateRepositoryModuleSelect type
-type MockSchemaGenerateRepositoryModuleSelect struct {
+// MockSchemaRewriteRepositorySchemaSelect is an autogenerated mock type for the SchemaRewriteRepositorySchemaSelect type
+type MockSchemaRewriteRepositorySchemaSelect struct {
 	mock.Mock
 }
 
-type MockSchemaGenerateRepositoryModuleSelect_Expecter struct {
+type MockSchemaRewriteRepositorySchemaSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaGenerateRepositoryModuleSelect) EXPECT() *MockSchemaGenerateRepositoryModuleSelect_Expecter {
-	return &MockSchemaGenerateRepositoryModuleSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaRewriteRepositorySchemaSelect) EXPECT() *MockSchemaRewriteRepositorySchemaSelect_Expecter {
+	return &MockSchemaRewriteRepositorySchemaSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaGenerateRepositoryModuleSelect
-func (_mock *MockSchemaGenerateRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
+// Exec provides a mock function for the type MockSchemaRewriteRepositorySchemaSelect
+func (_mock *MockSchemaRewriteRepositorySchemaSelect) Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Module
+	var r0 *dao.Schema
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) (*dao.Schema, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) *dao.Schema); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Module)
+			r0 = ret.Get(0).(*dao.Schema)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2536,27 +13747,27 @@ func (_mock *MockSchemaGenerateRepositoryModuleSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockSchemaGenerateRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaGenerateRepositoryModuleSelect_Exec_Call struct {
+// MockSchemaRewriteRepositorySchemaSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaRewriteRepositorySchemaSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ModuleSelectRequest
-func (_e *MockSchemaGenerateRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
-	return &MockSchemaGenerateRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaSelectRequest
+func (_e *MockSchemaRewriteRepositorySchemaSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
+	return &MockSchemaRewriteRepositorySchemaSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaGenerateRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaRewriteRepositorySchemaSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaSelectRequest)) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ModuleSelectRequest
+		var arg1 *dao.SchemaSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ModuleSelectRequest)
+			arg1 = args[1].(*dao.SchemaSelectRequest)
 		}
 		run(
 			arg0,
@@ -2566,23 +13777,23 @@ func (_c *MockSchemaGenerateRepositoryModuleSelect_Exec_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
-	_c.Call.Return(module, err)
+func (_c *MockSchemaRewriteRepositorySchemaSelect_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
+	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockSchemaGenerateRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockSchemaGenerateRepositoryModuleSelect_Exec_Call {
+func (_c *MockSchemaRewriteRepositorySchemaSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaListVersionsRepository creates a new instance of MockSchemaListVersionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaRewriteRepositoryModuleSelect creates a new instance of MockSchemaRewriteRepositoryModuleSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaListVersionsRepository(t interface {
+func NewMockSchemaRewriteRepositoryModuleSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaListVersionsRepository {
-	mock := &MockSchemaListVersionsRepository{}
+}) *MockSchemaRewriteRepositoryModuleSelect {
+	mock := &MockSchemaRewriteRepositoryModuleSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2590,40 +13801,40 @@ func NewMockSchemaListVersionsRepository(t interface {
 	return mock
 }
 
-// MockSchemaListVersionsRepository is an autogenerated mock type for the SchemaListVersionsRepository type
-type MockSchemaListVersionsRepository struct {
+// MockSchemaRewriteRepositoryModuleSelect is an autogenerated mock type for the SchemaRewriteRepositoryModuleSelect type
+type MockSchemaRewriteRepositoryModuleSelect struct {
 	mock.Mock
 }
 
-type MockSchemaListVersionsRepository_Expecter struct {
+type MockSchemaRewriteRepositoryModuleSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaListVersionsRepository) EXPECT() *MockSchemaListVersionsRepository_Expecter {
-	return &MockSchemaListVersionsRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaRewriteRepositoryModuleSelect) EXPECT() *MockSchemaRewriteRepositoryModuleSelect_Expecter {
+	return &MockSchemaRewriteRepositoryModuleSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaListVersionsRepository
-func (_mock *MockSchemaListVersionsRepository) Exec(ctx context.Context, request *dao.SchemaListVersionsRequest) ([]*dao.SchemaVersion, error) {
+// Exec provides a mock function for the type MockSchemaRewriteRepositoryModuleSelect
+func (_mock *MockSchemaRewriteRepositoryModuleSelect) Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 []*dao.SchemaVersion
+	var r0 *dao.Module
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListVersionsRequest) ([]*dao.SchemaVersion, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) (*dao.Module, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaListVersionsRequest) []*dao.SchemaVersion); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ModuleSelectRequest) *dao.Module); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dao.SchemaVersion)
+			r0 = ret.Get(0).(*dao.Module)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaListVersionsRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ModuleSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2631,27 +13842,27 @@ func (_mock *MockSchemaListVersionsRepository) Exec(ctx context.Context, request
 	return r0, r1
 }
 
-// MockSchemaListVersionsRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaListVersionsRepository_Exec_Call struct {
+// MockSchemaRewriteRepositoryModuleSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaRewriteRepositoryModuleSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaListVersionsRequest
-func (_e *MockSchemaListVersionsRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaListVersionsRepository_Exec_Call {
-	return &MockSchemaListVersionsRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ModuleSelectRequest
+func (_e *MockSchemaRewriteRepositoryModuleSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepositoryModuleSelect_Exec_Call {
+	return &MockSchemaRewriteRepositoryModuleSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaListVersionsRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaListVersionsRequest)) *MockSchemaListVersionsRepository_Exec_Call {
+func (_c *MockSchemaRewriteRepositoryModuleSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ModuleSelectRequest)) *MockSchemaRewriteRepositoryModuleSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaListVersionsRequest
+		var arg1 *dao.ModuleSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaListVersionsRequest)
+			arg1 = args[1].(*dao.ModuleSelectRequest)
 		}
 		run(
 			arg0,
@@ -2661,23 +13872,23 @@ func (_c *MockSchemaListVersionsRepository_Exec_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockSchemaListVersionsRepository_Exec_Call) Return(schemaVersions []*dao.SchemaVersion, err error) *MockSchemaListVersionsRepository_Exec_Call {
-	_c.Call.Return(schemaVersions, err)
+func (_c *MockSchemaRewriteRepositoryModuleSelect_Exec_Call) Return(module *dao.Module, err error) *MockSchemaRewriteRepositoryModuleSelect_Exec_Call {
+	_c.Call.Return(module, err)
 	return _c
 }
 
-func (_c *MockSchemaListVersionsRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaListVersionsRequest) ([]*dao.SchemaVersion, error)) *MockSchemaListVersionsRepository_Exec_Call {
+func (_c *MockSchemaRewriteRepositoryModuleSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)) *MockSchemaRewriteRepositoryModuleSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaListVersionsRepositoryProjectSelect creates a new instance of MockSchemaListVersionsRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaRewriteRepositoryProjectMemberSelect creates a new instance of MockSchemaRewriteRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaListVersionsRepositoryProjectSelect(t interface {
+func NewMockSchemaRewriteRepositoryProjectMemberSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaListVersionsRepositoryProjectSelect {
-	mock := &MockSchemaListVersionsRepositoryProjectSelect{}
+}) *MockSchemaRewriteRepositoryProjectMemberSelect {
+	mock := &MockSchemaRewriteRepositoryProjectMemberSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2685,40 +13896,40 @@ func NewMockSchemaListVersionsRepositoryProjectSelect(t interface {
 	return mock
 }
 
-// MockSchemaListVersionsRepositoryProjectSelect is an autogenerated mock type for the SchemaListVersionsRepositoryProjectSelect type
-type MockSchemaListVersionsRepositoryProjectSelect struct {
+// MockSchemaRewriteRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaRewriteRepositoryProjectMemberSelect type
+type MockSchemaRewriteRepositoryProjectMemberSelect struct {
 	mock.Mock
 }
 
-type MockSchemaListVersionsRepositoryProjectSelect_Expecter struct {
+type MockSchemaRewriteRepositoryProjectMemberSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaListVersionsRepositoryProjectSelect) EXPECT() *MockSchemaListVersionsRepositoryProjectSelect_Expecter {
-	return &MockSchemaListVersionsRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaRewriteRepositoryProjectMemberSelect) EXPECT() *MockSchemaRewriteRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaRewriteRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaListVersionsRepositoryProjectSelect
-func (_mock *MockSchemaListVersionsRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaRewriteRepositoryProjectMemberSelect
+func (_mock *MockSchemaRewriteRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 *dao.ProjectMember
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).(*dao.ProjectMember)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2726,27 +13937,27 @@ func (_mock *MockSchemaListVersionsRepositoryProjectSelect) Exec(ctx context.Con
 	return r0, r1
 }
 
-// MockSchemaListVersionsRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaListVersionsRepositoryProjectSelect_Exec_Call struct {
+// MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectSelectRequest
-func (_e *MockSchemaListVersionsRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
-	return &MockSchemaListVersionsRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaRewriteRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectSelectRequest
+		var arg1 *dao.ProjectMemberSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectSelectRequest)
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
 		}
 		run(
 			arg0,
@@ -2756,23 +13967,23 @@ func (_c *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call) Run(run func(
 	return _c
 }
 
-func (_c *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
 	return _c
 }
 
-func (_c *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaListVersionsRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaRewriteRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaRewriteRepository creates a new instance of MockSchemaRewriteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaSelectRepository creates a new instance of MockSchemaSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaRewriteRepository(t interface {
+func NewMockSchemaSelectRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaRewriteRepository {
-	mock := &MockSchemaRewriteRepository{}
+}) *MockSchemaSelectRepository {
+	mock := &MockSchemaSelectRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2780,21 +13991,21 @@ func NewMockSchemaRewriteRepository(t interface {
 	return mock
 }
 
-// MockSchemaRewriteRepository is an autogenerated mock type for the SchemaRewriteRepository type
-type MockSchemaRewriteRepository struct {
+// MockSchemaSelectRepository is an autogenerated mock type for the SchemaSelectRepository type
+type MockSchemaSelectRepository struct {
 	mock.Mock
 }
 
-type MockSchemaRewriteRepository_Expecter struct {
+type MockSchemaSelectRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaRewriteRepository) EXPECT() *MockSchemaRewriteRepository_Expecter {
-	return &MockSchemaRewriteRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaSelectRepository) EXPECT() *MockSchemaSelectRepository_Expecter {
+	return &MockSchemaSelectRepository_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaRewriteRepository
-func (_mock *MockSchemaRewriteRepository) Exec(ctx context.Context, request *dao.SchemaUpdateRequest) (*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaSelectRepository
+func (_mock *MockSchemaSelectRepository) Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
@@ -2803,17 +14014,17 @@ func (_mock *MockSchemaRewriteRepository) Exec(ctx context.Context, request *dao
 
 	var r0 *dao.Schema
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaUpdateRequest) (*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) (*dao.Schema, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaUpdateRequest) *dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) *dao.Schema); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dao.Schema)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaUpdateRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2821,27 +14032,27 @@ func (_mock *MockSchemaRewriteRepository) Exec(ctx context.Context, request *dao
 	return r0, r1
 }
 
-// MockSchemaRewriteRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaRewriteRepository_Exec_Call struct {
+// MockSchemaSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaSelectRepository_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaUpdateRequest
-func (_e *MockSchemaRewriteRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepository_Exec_Call {
-	return &MockSchemaRewriteRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaSelectRequest
+func (_e *MockSchemaSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaSelectRepository_Exec_Call {
+	return &MockSchemaSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaRewriteRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaUpdateRequest)) *MockSchemaRewriteRepository_Exec_Call {
+func (_c *MockSchemaSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaSelectRequest)) *MockSchemaSelectRepository_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaUpdateRequest
+		var arg1 *dao.SchemaSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaUpdateRequest)
+			arg1 = args[1].(*dao.SchemaSelectRequest)
 		}
 		run(
 			arg0,
@@ -2851,23 +14062,23 @@ func (_c *MockSchemaRewriteRepository_Exec_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockSchemaRewriteRepository_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaRewriteRepository_Exec_Call {
+func (_c *MockSchemaSelectRepository_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaSelectRepository_Exec_Call {
 	_c.Call.Return(schema, err)
 	return _c
 }
 
-func (_c *MockSchemaRewriteRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaUpdateRequest) (*dao.Schema, error)) *MockSchemaRewriteRepository_Exec_Call {
+func (_c *MockSchemaSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)) *MockSchemaSelectRepository_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaRewriteRepositoryProjectSelect creates a new instance of MockSchemaRewriteRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaSelectRepositorySchemaGenerationSelect creates a new instance of MockSchemaSelectRepositorySchemaGenerationSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaRewriteRepositoryProjectSelect(t interface {
+func NewMockSchemaSelectRepositorySchemaGenerationSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaRewriteRepositoryProjectSelect {
-	mock := &MockSchemaRewriteRepositoryProjectSelect{}
+}) *MockSchemaSelectRepositorySchemaGenerationSelect {
+	mock := &MockSchemaSelectRepositorySchemaGenerationSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2875,40 +14086,40 @@ func NewMockSchemaRewriteRepositoryProjectSelect(t interface {
 	return mock
 }
 
-// MockSchemaRewriteRepositoryProjectSelect is an autogenerated mock type for the SchemaRewriteRepositoryProjectSelect type
-type MockSchemaRewriteRepositoryProjectSelect struct {
+// MockSchemaSelectRepositorySchemaGenerationSelect is an autogenerated mock type for the SchemaSelectRepositorySchemaGenerationSelect type
+type MockSchemaSelectRepositorySchemaGenerationSelect struct {
 	mock.Mock
 }
 
-type MockSchemaRewriteRepositoryProjectSelect_Expecter struct {
+type MockSchemaSelectRepositorySchemaGenerationSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaRewriteRepositoryProjectSelect) EXPECT() *MockSchemaRewriteRepositoryProjectSelect_Expecter {
-	return &MockSchemaRewriteRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaSelectRepositorySchemaGenerationSelect) EXPECT() *MockSchemaSelectRepositorySchemaGenerationSelect_Expecter {
+	return &MockSchemaSelectRepositorySchemaGenerationSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaRewriteRepositoryProjectSelect
-func (_mock *MockSchemaRewriteRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockSchemaSelectRepositorySchemaGenerationSelect
+func (_mock *MockSchemaSelectRepositorySchemaGenerationSelect) Exec(ctx context.Context, request *dao.SchemaGenerationSelectRequest) (*dao.SchemaGeneration, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 *dao.SchemaGeneration
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationSelectRequest) (*dao.SchemaGeneration, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaGenerationSelectRequest) *dao.SchemaGeneration); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).(*dao.SchemaGeneration)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaGenerationSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -2916,27 +14127,27 @@ func (_mock *MockSchemaRewriteRepositoryProjectSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockSchemaRewriteRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaRewriteRepositoryProjectSelect_Exec_Call struct {
+// MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectSelectRequest
-func (_e *MockSchemaRewriteRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
-	return &MockSchemaRewriteRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.SchemaGenerationSelectRequest
+func (_e *MockSchemaSelectRepositorySchemaGenerationSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call {
+	return &MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaRewriteRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaGenerationSelectRequest)) *MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectSelectRequest
+		var arg1 *dao.SchemaGenerationSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectSelectRequest)
+			arg1 = args[1].(*dao.SchemaGenerationSelectRequest)
 		}
 		run(
 			arg0,
@@ -2946,23 +14157,23 @@ func (_c *MockSchemaRewriteRepositoryProjectSelect_Exec_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockSchemaRewriteRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call) Return(schemaGeneration *dao.SchemaGeneration, err error) *MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call {
+	_c.Call.Return(schemaGeneration, err)
 	return _c
 }
 
-func (_c *MockSchemaRewriteRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaRewriteRepositoryProjectSelect_Exec_Call {
+func (_c *MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaGenerationSelectRequest) (*dao.SchemaGeneration, error)) *MockSchemaSelectRepositorySchemaGenerationSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaRewriteRepositorySchemaSelect creates a new instance of MockSchemaRewriteRepositorySchemaSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaSelectRepositoryProjectSelect creates a new instance of MockSchemaSelectRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaRewriteRepositorySchemaSelect(t interface {
+func NewMockSchemaSelectRepositoryProjectSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaRewriteRepositorySchemaSelect {
-	mock := &MockSchemaRewriteRepositorySchemaSelect{}
+}) *MockSchemaSelectRepositoryProjectSelect {
+	mock := &MockSchemaSelectRepositoryProjectSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2970,40 +14181,40 @@ func NewMockSchemaRewriteRepositorySchemaSelect(t interface {
 	return mock
 }
 
-// MockSchemaRewriteRepositorySchemaSelect is an autogenerated mock type for the SchemaRewriteRepositorySchemaSelect type
-type MockSchemaRewriteRepositorySchemaSelect struct {
+// MockSchemaSelectRepositoryProjectSelect is an autogenerated mock type for the SchemaSelectRepositoryProjectSelect type
+type MockSchemaSelectRepositoryProjectSelect struct {
 	mock.Mock
 }
 
-type MockSchemaRewriteRepositorySchemaSelect_Expecter struct {
+type MockSchemaSelectRepositoryProjectSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaRewriteRepositorySchemaSelect) EXPECT() *MockSchemaRewriteRepositorySchemaSelect_Expecter {
-	return &MockSchemaRewriteRepositorySchemaSelect_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaSelectRepositoryProjectSelect) EXPECT() *MockSchemaSelectRepositoryProjectSelect_Expecter {
+	return &MockSchemaSelectRepositoryProjectSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaRewriteRepositorySchemaSelect
-func (_mock *MockSchemaRewriteRepositorySchemaSelect) Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaSelectRepositoryProjectSelect
+func (_mock *MockSchemaSelectRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Schema
+	var r0 *dao.Project
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) (*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) *dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Schema)
+			r0 = ret.Get(0).(*dao.Project)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -3011,27 +14222,27 @@ func (_mock *MockSchemaRewriteRepositorySchemaSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockSchemaRewriteRepositorySchemaSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaRewriteRepositorySchemaSelect_Exec_Call struct {
+// MockSchemaSelectRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaSelectRepositoryProjectSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaSelectRequest
-func (_e *MockSchemaRewriteRepositorySchemaSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
-	return &MockSchemaRewriteRepositorySchemaSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectSelectRequest
+func (_e *MockSchemaSelectRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
+	return &MockSchemaSelectRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaRewriteRepositorySchemaSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaSelectRequest)) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
+func (_c *MockSchemaSelectRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaSelectRequest
+		var arg1 *dao.ProjectSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaSelectRequest)
+			arg1 = args[1].(*dao.ProjectSelectRequest)
 		}
 		run(
 			arg0,
@@ -3041,23 +14252,23 @@ func (_c *MockSchemaRewriteRepositorySchemaSelect_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockSchemaRewriteRepositorySchemaSelect_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
-	_c.Call.Return(schema, err)
+func (_c *MockSchemaSelectRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
+	_c.Call.Return(project, err)
 	return _c
 }
 
-func (_c *MockSchemaRewriteRepositorySchemaSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)) *MockSchemaRewriteRepositorySchemaSelect_Exec_Call {
+func (_c *MockSchemaSelectRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaSelectRepository creates a new instance of MockSchemaSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSchemaSelectRepositoryProjectMemberSelect creates a new instance of MockSchemaSelectRepositoryProjectMemberSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaSelectRepository(t interface {
+func NewMockSchemaSelectRepositoryProjectMemberSelect(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaSelectRepository {
-	mock := &MockSchemaSelectRepository{}
+}) *MockSchemaSelectRepositoryProjectMemberSelect {
+	mock := &MockSchemaSelectRepositoryProjectMemberSelect{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -3065,40 +14276,40 @@ func NewMockSchemaSelectRepository(t interface {
 	return mock
 }
 
-// MockSchemaSelectRepository is an autogenerated mock type for the SchemaSelectRepository type
-type MockSchemaSelectRepository struct {
+// MockSchemaSelectRepositoryProjectMemberSelect is an autogenerated mock type for the SchemaSelectRepositoryProjectMemberSelect type
+type MockSchemaSelectRepositoryProjectMemberSelect struct {
 	mock.Mock
 }
 
-type MockSchemaSelectRepository_Expecter struct {
+type MockSchemaSelectRepositoryProjectMemberSelect_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaSelectRepository) EXPECT() *MockSchemaSelectRepository_Expecter {
-	return &MockSchemaSelectRepository_Expecter{mock: &_m.Mock}
+func (_m *MockSchemaSelectRepositoryProjectMemberSelect) EXPECT() *MockSchemaSelectRepositoryProjectMemberSelect_Expecter {
+	return &MockSchemaSelectRepositoryProjectMemberSelect_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaSelectRepository
-func (_mock *MockSchemaSelectRepository) Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error) {
+// Exec provides a mock function for the type MockSchemaSelectRepositoryProjectMemberSelect
+func (_mock *MockSchemaSelectRepositoryProjectMemberSelect) Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Schema
+	var r0 *dao.ProjectMember
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) (*dao.Schema, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.SchemaSelectRequest) *dao.Schema); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectMemberSelectRequest) *dao.ProjectMember); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Schema)
+			r0 = ret.Get(0).(*dao.ProjectMember)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.SchemaSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectMemberSelectRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -3106,27 +14317,27 @@ func (_mock *MockSchemaSelectRepository) Exec(ctx context.Context, request *dao.
 	return r0, r1
 }
 
-// MockSchemaSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaSelectRepository_Exec_Call struct {
+// MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.SchemaSelectRequest
-func (_e *MockSchemaSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaSelectRepository_Exec_Call {
-	return &MockSchemaSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.ProjectMemberSelectRequest
+func (_e *MockSchemaSelectRepositoryProjectMemberSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call {
+	return &MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.SchemaSelectRequest)) *MockSchemaSelectRepository_Exec_Call {
+func (_c *MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest)) *MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.SchemaSelectRequest
+		var arg1 *dao.ProjectMemberSelectRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.SchemaSelectRequest)
+			arg1 = args[1].(*dao.ProjectMemberSelectRequest)
 		}
 		run(
 			arg0,
@@ -3136,23 +14347,23 @@ func (_c *MockSchemaSelectRepository_Exec_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockSchemaSelectRepository_Exec_Call) Return(schema *dao.Schema, err error) *MockSchemaSelectRepository_Exec_Call {
-	_c.Call.Return(schema, err)
+func (_c *MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call) Return(projectMember *dao.ProjectMember, err error) *MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call {
+	_c.Call.Return(projectMember, err)
 	return _c
 }
 
-func (_c *MockSchemaSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)) *MockSchemaSelectRepository_Exec_Call {
+func (_c *MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)) *MockSchemaSelectRepositoryProjectMemberSelect_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSchemaSelectRepositoryProjectSelect creates a new instance of MockSchemaSelectRepositoryProjectSelect. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockUsageSelectRepository creates a new instance of MockUsageSelectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSchemaSelectRepositoryProjectSelect(t interface {
+func NewMockUsageSelectRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSchemaSelectRepositoryProjectSelect {
-	mock := &MockSchemaSelectRepositoryProjectSelect{}
+}) *MockUsageSelectRepository {
+	mock := &MockUsageSelectRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -3160,40 +14371,40 @@ func NewMockSchemaSelectRepositoryProjectSelect(t interface {
 	return mock
 }
 
-// MockSchemaSelectRepositoryProjectSelect is an autogenerated mock type for the SchemaSelectRepositoryProjectSelect type
-type MockSchemaSelectRepositoryProjectSelect struct {
+// MockUsageSelectRepository is an autogenerated mock type for the UsageSelectRepository type
+type MockUsageSelectRepository struct {
 	mock.Mock
 }
 
-type MockSchemaSelectRepositoryProjectSelect_Expecter struct {
+type MockUsageSelectRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSchemaSelectRepositoryProjectSelect) EXPECT() *MockSchemaSelectRepositoryProjectSelect_Expecter {
-	return &MockSchemaSelectRepositoryProjectSelect_Expecter{mock: &_m.Mock}
+func (_m *MockUsageSelectRepository) EXPECT() *MockUsageSelectRepository_Expecter {
+	return &MockUsageSelectRepository_Expecter{mock: &_m.Mock}
 }
 
-// Exec provides a mock function for the type MockSchemaSelectRepositoryProjectSelect
-func (_mock *MockSchemaSelectRepositoryProjectSelect) Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error) {
+// Exec provides a mock function for the type MockUsageSelectRepository
+func (_mock *MockUsageSelectRepository) Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Exec")
 	}
 
-	var r0 *dao.Project
+	var r0 []*dao.TokenUsageProject
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) (*dao.Project, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.ProjectSelectRequest) *dao.Project); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *dao.TokenUsageListRequest) []*dao.TokenUsageProject); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dao.Project)
+			r0 = ret.Get(0).([]*dao.TokenUsageProject)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.ProjectSelectRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *dao.TokenUsageListRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -3201,27 +14412,27 @@ func (_mock *MockSchemaSelectRepositoryProjectSelect) Exec(ctx context.Context,
 	return r0, r1
 }
 
-// MockSchemaSelectRepositoryProjectSelect_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
-type MockSchemaSelectRepositoryProjectSelect_Exec_Call struct {
+// MockUsageSelectRepository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type MockUsageSelectRepository_Exec_Call struct {
 	*mock.Call
 }
 
 // Exec is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request *dao.ProjectSelectRequest
-func (_e *MockSchemaSelectRepositoryProjectSelect_Expecter) Exec(ctx interface{}, request interface{}) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
-	return &MockSchemaSelectRepositoryProjectSelect_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
+//   - request *dao.TokenUsageListRequest
+func (_e *MockUsageSelectRepository_Expecter) Exec(ctx interface{}, request interface{}) *MockUsageSelectRepository_Exec_Call {
+	return &MockUsageSelectRepository_Exec_Call{Call: _e.mock.On("Exec", ctx, request)}
 }
 
-func (_c *MockSchemaSelectRepositoryProjectSelect_Exec_Call) Run(run func(ctx context.Context, request *dao.ProjectSelectRequest)) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
+func (_c *MockUsageSelectRepository_Exec_Call) Run(run func(ctx context.Context, request *dao.TokenUsageListRequest)) *MockUsageSelectRepository_Exec_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *dao.ProjectSelectRequest
+		var arg1 *dao.TokenUsageListRequest
 		if args[1] != nil {
-			arg1 = args[1].(*dao.ProjectSelectRequest)
+			arg1 = args[1].(*dao.TokenUsageListRequest)
 		}
 		run(
 			arg0,
@@ -3231,12 +14442,12 @@ func (_c *MockSchemaSelectRepositoryProjectSelect_Exec_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *MockSchemaSelectRepositoryProjectSelect_Exec_Call) Return(project *dao.Project, err error) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
-	_c.Call.Return(project, err)
+func (_c *MockUsageSelectRepository_Exec_Call) Return(tokenUsageProjects []*dao.TokenUsageProject, err error) *MockUsageSelectRepository_Exec_Call {
+	_c.Call.Return(tokenUsageProjects, err)
 	return _c
 }
 
-func (_c *MockSchemaSelectRepositoryProjectSelect_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)) *MockSchemaSelectRepositoryProjectSelect_Exec_Call {
+func (_c *MockUsageSelectRepository_Exec_Call) RunAndReturn(run func(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)) *MockUsageSelectRepository_Exec_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1,14 +1,23 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
 
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/models"
 )
 
+// ErrBreakingModuleChange is returned when a new module version breaks the schema of the previous stable version,
+// without being a major upgrade.
+var ErrBreakingModuleChange = errors.New("breaking schema changes require a major version upgrade")
+
 type Module struct {
 	ID          string
 	Namespace   string
@@ -17,7 +26,13 @@ type Module struct {
 	Description string
 	Schema      jsonschema.Schema
 	UI          models.ModuleUi
+	Migrations  []models.ModuleMigration
+	DependsOn   []models.ModuleDependency
+	Generation  *models.ModuleGeneration
 	CreatedAt   time.Time
+	// LLMReport lists the parts of the schema that are altered or dropped when generating content for the module.
+	// It is only set when publishing a module.
+	LLMReport lib.JSONSchemaLLMReport
 }
 
 func loadModule(module *dao.Module) *Module {
@@ -29,10 +44,22 @@ func loadModule(module *dao.Module) *Module {
 		Description: module.Description,
 		Schema:      module.Schema,
 		UI:          module.UI,
+		Migrations:  module.Migrations,
+		DependsOn:   module.DependsOn,
+		Generation:  module.Generation,
 		CreatedAt:   module.CreatedAt,
 	}
 }
 
+// loadPublishedModule loads a module that was just published, along with the report of its schema conversion for
+// generation.
+func loadPublishedModule(module *dao.Module) *Module {
+	output := loadModule(module)
+	output.LLMReport = LoadModuleLLMSchema(&module.Schema).Report
+
+	return output
+}
+
 type ModuleVersion struct {
 	Version    string
 	Preversion string
@@ -50,3 +77,123 @@ func loadModuleVersion(module *dao.ModuleVersion) *ModuleVersion {
 func loadModuleVersionsMap(d *dao.ModuleVersion, _ int) *ModuleVersion {
 	return loadModuleVersion(d)
 }
+
+type ModuleSummary struct {
+	ID          string
+	Namespace   string
+	Version     string
+	Description string
+	CreatedAt   time.Time
+}
+
+func loadModuleSummary(module *dao.ModuleSummary) *ModuleSummary {
+	return &ModuleSummary{
+		ID:          module.ID,
+		Namespace:   module.Namespace,
+		Version:     module.Version,
+		Description: module.Description,
+		CreatedAt:   module.CreatedAt,
+	}
+}
+
+func loadModuleSummariesMap(d *dao.ModuleSummary, _ int) *ModuleSummary {
+	return loadModuleSummary(d)
+}
+
+// ModuleCompatibility describes how the schema of a new module version relates to the previous stable version.
+type ModuleCompatibility struct {
+	// PreviousVersion is the stable version the schema was compared against. It is empty if the module has no
+	// stable version lower than the new one, in which case there is nothing to compare.
+	PreviousVersion string
+	// MajorUpgrade is true if the new version is allowed to introduce breaking changes.
+	MajorUpgrade bool
+	Changes      lib.JSONSchemaCompatibilityReport
+}
+
+// Allowed returns true if the new version can be published with its current schema.
+func (compatibility *ModuleCompatibility) Allowed() bool {
+	return compatibility.MajorUpgrade || !compatibility.Changes.HasBreakingChanges()
+}
+
+// Err returns the breaking changes of the report as an error, if they prevent the new version from being
+// published.
+func (compatibility *ModuleCompatibility) Err() error {
+	if compatibility.Allowed() {
+		return nil
+	}
+
+	return errors.Join(ErrBreakingModuleChange, compatibility.Changes.Breaking())
+}
+
+// CheckModuleCompatibility compares the schema of a new module version with the latest stable version that
+// precedes it.
+func CheckModuleCompatibility(
+	ctx context.Context,
+	listVersionsRepository ModuleListVersionsRepository,
+	selectRepository ModuleSelectRepository,
+	module lib.DecodedModule,
+	schema *jsonschema.Schema,
+) (*ModuleCompatibility, error) {
+	// Versions are sorted from the newest to the oldest, and only include stable releases.
+	versions, err := listVersionsRepository.Exec(ctx, &dao.ModuleListVersionsRequest{
+		ID:        module.Module,
+		Namespace: module.Namespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list module versions: %w", err)
+	}
+
+	compatibility := new(ModuleCompatibility)
+
+	for _, version := range versions {
+		if lib.CompareModuleVersions(version.Version, module.Version) < 0 {
+			compatibility.PreviousVersion = version.Version
+
+			break
+		}
+	}
+
+	if compatibility.PreviousVersion == "" {
+		return compatibility, nil
+	}
+
+	previous, err := selectRepository.Exec(ctx, &dao.ModuleSelectRequest{
+		ID:        module.Module,
+		Namespace: module.Namespace,
+		Version:   compatibility.PreviousVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("select previous module version: %w", err)
+	}
+
+	compatibility.MajorUpgrade = lib.IsMajorModuleUpgrade(compatibility.PreviousVersion, module.Version)
+	compatibility.Changes = lib.CompareJSONSchemas(&previous.Schema, schema)
+
+	return compatibility, nil
+}
+
+// ModuleLLMSchema is the schema of a module, as sent to the model when generating content.
+type ModuleLLMSchema struct {
+	// Schema is nil if the schema of the module cannot be used for generation.
+	Schema *jsonschema.Schema
+	// Report lists the parts of the module schema that were altered or dropped.
+	Report lib.JSONSchemaLLMReport
+}
+
+// Usable returns true if content can be generated for the module.
+func (schema *ModuleLLMSchema) Usable() bool {
+	return schema.Schema != nil
+}
+
+// LoadModuleLLMSchema converts the schema of a module to the subset supported by the model. The source schema is
+// left untouched.
+func LoadModuleLLMSchema(schema *jsonschema.Schema) *ModuleLLMSchema {
+	llmSchema := schema.CloneSchemas()
+
+	report, ok := lib.JSONSchemaLLM(llmSchema)
+
+	return &ModuleLLMSchema{
+		Schema: lo.Ternary(ok, llmSchema, nil),
+		Report: report,
+	}
+}
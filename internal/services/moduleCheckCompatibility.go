@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/jsonschema-go/jsonschema"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+type ModuleCheckCompatibilityRepositorySelect interface {
+	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
+}
+
+type ModuleCheckCompatibilityRepositoryListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
+type ModuleCheckCompatibilityRequest struct {
+	// Module is the module string of the version to publish, in the format "namespace:module@version".
+	Module string            `validate:"required,module,max=512"`
+	Schema jsonschema.Schema `validate:"required"`
+}
+
+type ModuleCheckCompatibility struct {
+	moduleSelectRepository       ModuleCheckCompatibilityRepositorySelect
+	moduleListVersionsRepository ModuleCheckCompatibilityRepositoryListVersions
+}
+
+func NewModuleCheckCompatibility(
+	moduleSelectRepository ModuleCheckCompatibilityRepositorySelect,
+	moduleListVersionsRepository ModuleCheckCompatibilityRepositoryListVersions,
+) *ModuleCheckCompatibility {
+	return &ModuleCheckCompatibility{
+		moduleSelectRepository:       moduleSelectRepository,
+		moduleListVersionsRepository: moduleListVersionsRepository,
+	}
+}
+
+// Exec reports how the schema of a module version relates to the previous stable version, without publishing
+// anything. Unlike the publishing services, breaking changes are not returned as an error: use
+// ModuleCompatibility.Err to know whether the version could be published.
+func (service *ModuleCheckCompatibility) Exec(
+	ctx context.Context, request *ModuleCheckCompatibilityRequest,
+) (*ModuleCompatibility, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ModuleCheckCompatibility")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	compatibility, err := CheckModuleCompatibility(
+		ctx,
+		service.moduleListVersionsRepository,
+		service.moduleSelectRepository,
+		lib.DecodeModule(request.Module),
+		&request.Schema,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, compatibility), nil
+}
@@ -0,0 +1,282 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestModuleCheckCompatibility(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testModuleSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"title"},
+	}
+
+	testModuleSchemaBreaking := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"name": {
+				Type: "string",
+			},
+		},
+		Required: []string{"name"},
+	}
+
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
+	type moduleSelectMock struct {
+		version string
+		resp    *dao.Module
+		err     error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ModuleCheckCompatibilityRequest
+
+		moduleListVersionsMock *moduleListVersionsMock
+		moduleSelectMock       *moduleSelectMock
+
+		expect        *services.ModuleCompatibility
+		expectAllowed bool
+		expectErr     error
+	}{
+		{
+			name: "Success/NoPreviousVersion",
+
+			request: &services.ModuleCheckCompatibilityRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+				Schema: testModuleSchemaBreaking,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			expect:        &services.ModuleCompatibility{},
+			expectAllowed: true,
+		},
+		{
+			name: "Success/Compatible",
+
+			request: &services.ModuleCheckCompatibilityRequest{
+				Module: "test-namespace:test-module@v1.0.1",
+				Schema: testModuleSchema,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				version: "1.0.0",
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ModuleCompatibility{
+				PreviousVersion: "1.0.0",
+			},
+			expectAllowed: true,
+		},
+		{
+			name: "Success/Breaking",
+
+			request: &services.ModuleCheckCompatibilityRequest{
+				Module: "test-namespace:test-module@v1.1.0",
+				Schema: testModuleSchemaBreaking,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				version: "1.0.0",
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ModuleCompatibility{
+				PreviousVersion: "1.0.0",
+				Changes: lib.JSONSchemaCompatibilityReport{
+					{Path: "/name", Kind: lib.JSONSchemaChangeBreaking, Message: "required property added"},
+					{Path: "/title", Kind: lib.JSONSchemaChangeBreaking, Message: "required property removed"},
+				},
+			},
+		},
+		{
+			name: "Success/BreakingMajorUpgrade",
+
+			request: &services.ModuleCheckCompatibilityRequest{
+				Module: "test-namespace:test-module@v0.3.0",
+				Schema: testModuleSchemaBreaking,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "0.2.4", CreatedAt: baseTime},
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				version: "0.2.4",
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "0.2.4",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ModuleCompatibility{
+				PreviousVersion: "0.2.4",
+				MajorUpgrade:    true,
+				Changes: lib.JSONSchemaCompatibilityReport{
+					{Path: "/name", Kind: lib.JSONSchemaChangeBreaking, Message: "required property added"},
+					{Path: "/title", Kind: lib.JSONSchemaChangeBreaking, Message: "required property removed"},
+				},
+			},
+			expectAllowed: true,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidModule",
+
+			request: &services.ModuleCheckCompatibilityRequest{
+				Module: "test-namespace:test-module@^1",
+				Schema: testModuleSchema,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ListVersions",
+
+			request: &services.ModuleCheckCompatibilityRequest{
+				Module: "test-namespace:test-module@v1.0.1",
+				Schema: testModuleSchema,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ModuleSelect",
+
+			request: &services.ModuleCheckCompatibilityRequest{
+				Module: "test-namespace:test-module@v1.0.1",
+				Schema: testModuleSchema,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				version: "1.0.0",
+				err:     errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				moduleSelectRepository := servicesmocks.NewMockModuleCheckCompatibilityRepositorySelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockModuleCheckCompatibilityRepositoryListVersions(t)
+
+				if testCase.moduleListVersionsMock != nil {
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        "test-module",
+							Namespace: "test-namespace",
+						}).
+						Return(testCase.moduleListVersionsMock.resp, testCase.moduleListVersionsMock.err)
+				}
+
+				if testCase.moduleSelectMock != nil {
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:        "test-module",
+							Namespace: "test-namespace",
+							Version:   testCase.moduleSelectMock.version,
+						}).
+						Return(testCase.moduleSelectMock.resp, testCase.moduleSelectMock.err)
+				}
+
+				service := services.NewModuleCheckCompatibility(moduleSelectRepository, moduleListVersionsRepository)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				if resp != nil {
+					require.Equal(t, testCase.expectAllowed, resp.Allowed())
+
+					if !testCase.expectAllowed {
+						require.ErrorIs(t, resp.Err(), services.ErrBreakingModuleChange)
+					}
+				}
+
+				moduleSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -24,29 +24,53 @@ type ModuleCreateRepositoryDelete interface {
 	Exec(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error)
 }
 
+type ModuleCreateRepositorySelect interface {
+	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
+}
+
+type ModuleCreateRepositoryListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
 type ModuleCreateRequest struct {
 	Module      string            `validate:"required,module,max=512"`
 	Description string            `validate:"required,min=32,max=512"`
 	Schema      jsonschema.Schema `validate:"required"`
 	UI          models.ModuleUi   `validate:"required"`
-	Overwrite   bool
+	// Migrations carry the data of projects upgrading from an earlier version of the module forward.
+	Migrations []models.ModuleMigration `validate:"max=128"`
+	// DependsOn lists the modules whose data is sent as context when generating content for the module.
+	DependsOn []models.ModuleDependency `validate:"max=32"`
+	// Generation overrides the default settings used to generate content for the module.
+	Generation *models.ModuleGeneration
+	Overwrite  bool
 }
 
 type ModuleCreate struct {
-	moduleInsertRepository ModuleCreateRepository
-	moduleDeleteRepository ModuleCreateRepositoryDelete
+	moduleInsertRepository       ModuleCreateRepository
+	moduleDeleteRepository       ModuleCreateRepositoryDelete
+	moduleSelectRepository       ModuleCreateRepositorySelect
+	moduleListVersionsRepository ModuleCreateRepositoryListVersions
 }
 
 func NewModuleCreate(
 	moduleInsertRepository ModuleCreateRepository,
 	moduleDeleteRepository ModuleCreateRepositoryDelete,
+	moduleSelectRepository ModuleCreateRepositorySelect,
+	moduleListVersionsRepository ModuleCreateRepositoryListVersions,
 ) *ModuleCreate {
 	return &ModuleCreate{
-		moduleInsertRepository: moduleInsertRepository,
-		moduleDeleteRepository: moduleDeleteRepository,
+		moduleInsertRepository:       moduleInsertRepository,
+		moduleDeleteRepository:       moduleDeleteRepository,
+		moduleSelectRepository:       moduleSelectRepository,
+		moduleListVersionsRepository: moduleListVersionsRepository,
 	}
 }
 
+// Exec publishes a new version of a module.
+//
+// The schema of the new version is compared with the previous stable version of the module. Breaking changes are
+// only accepted when the new version is a major upgrade.
 func (service *ModuleCreate) Exec(ctx context.Context, request *ModuleCreateRequest) (*Module, error) {
 	ctx, span := otel.Tracer().Start(ctx, "service.ModuleCreate")
 	defer span.End()
@@ -66,6 +90,28 @@ func (service *ModuleCreate) Exec(ctx context.Context, request *ModuleCreateRequ
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	err = lib.ValidateModuleMigrations(request.Migrations)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	err = lib.ValidateModuleDependencies(lib.VersionlessModule(request.Module), request.DependsOn)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	compatibility, err := CheckModuleCompatibility(
+		ctx, service.moduleListVersionsRepository, service.moduleSelectRepository, decodedModule, resolved.Schema(),
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = compatibility.Err()
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
 	var module *dao.Module
 
 	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, tx bun.IDB) error {
@@ -90,6 +136,9 @@ func (service *ModuleCreate) Exec(ctx context.Context, request *ModuleCreateRequ
 			Description: request.Description,
 			Schema:      *resolved.Schema(),
 			UI:          request.UI,
+			Migrations:  request.Migrations,
+			DependsOn:   request.DependsOn,
+			Generation:  request.Generation,
 			Now:         time.Now().UTC(),
 		})
 
@@ -99,5 +148,5 @@ func (service *ModuleCreate) Exec(ctx context.Context, request *ModuleCreateRequ
 		return nil, otel.ReportError(span, err)
 	}
 
-	return otel.ReportSuccess(span, loadModule(module)), nil
+	return otel.ReportSuccess(span, loadPublishedModule(module)), nil
 }
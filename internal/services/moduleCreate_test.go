@@ -3,10 +3,13 @@ package services_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -37,12 +40,49 @@ func TestModuleCreate(t *testing.T) {
 		Required: []string{"title"},
 	}
 
+	testModuleSchemaLLMReport := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {
+				Type: "string",
+			},
+			"website": {
+				Type:   "string",
+				Format: "uri",
+			},
+		},
+		Required: []string{"title"},
+	}
+
 	testModuleUI := models.ModuleUi{
 		Component: "test-component",
 		Params:    map[string]any{"key": "value"},
 		Target:    "title",
 	}
 
+	testModuleSchemaBreaking := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {
+				Type: "integer",
+			},
+		},
+		Required: []string{"title"},
+	}
+
+	testModuleSchemaCompatible := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {
+				Type: "string",
+			},
+			"summary": {
+				Type: "string",
+			},
+		},
+		Required: []string{"title"},
+	}
+
 	validDescription := "This is a valid description that is at least 32 characters long."
 
 	type moduleInsertMock struct {
@@ -55,13 +95,31 @@ func TestModuleCreate(t *testing.T) {
 		err  error
 	}
 
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
+	type moduleSelectMock struct {
+		resp *dao.Module
+		err  error
+	}
+
+	type previousModuleMock struct {
+		version string
+		resp    *dao.Module
+		err     error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.ModuleCreateRequest
 
-		moduleInsertMock *moduleInsertMock
-		moduleDeleteMock *moduleDeleteMock
+		previousVersionsMock *moduleListVersionsMock
+		previousModuleMock   *previousModuleMock
+		moduleInsertMock     *moduleInsertMock
+		moduleDeleteMock     *moduleDeleteMock
 
 		expect    *services.Module
 		expectErr error
@@ -76,6 +134,10 @@ func TestModuleCreate(t *testing.T) {
 				UI:          testModuleUI,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				resp: &dao.Module{
 					ID:          "test-module",
@@ -98,6 +160,186 @@ func TestModuleCreate(t *testing.T) {
 				CreatedAt:   baseTime,
 			},
 		},
+		{
+			name: "Success/WithMigrations",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v2.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Migrations: []models.ModuleMigration{
+					{Op: models.ModuleMigrationOpRename, Path: "/name", To: "title"},
+				},
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "2.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					Migrations: []models.ModuleMigration{
+						{Op: models.ModuleMigrationOpRename, Path: "/name", To: "title"},
+					},
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "2.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Migrations: []models.ModuleMigration{
+					{Op: models.ModuleMigrationOpRename, Path: "/name", To: "title"},
+				},
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/WithDependencies",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				DependsOn: []models.ModuleDependency{
+					{Module: "test-namespace:other-module", Paths: []string{"/intent"}},
+				},
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					DependsOn: []models.ModuleDependency{
+						{Module: "test-namespace:other-module", Paths: []string{"/intent"}},
+					},
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				DependsOn: []models.ModuleDependency{
+					{Module: "test-namespace:other-module", Paths: []string{"/intent"}},
+				},
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/WithGeneration",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Generation: &models.ModuleGeneration{
+					Prompt:      "Write beats from: {{.context}}",
+					Model:       "gpt-4o-mini",
+					Temperature: lo.ToPtr(0.4),
+				},
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					Generation: &models.ModuleGeneration{
+						Prompt:      "Write beats from: {{.context}}",
+						Model:       "gpt-4o-mini",
+						Temperature: lo.ToPtr(0.4),
+					},
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Generation: &models.ModuleGeneration{
+					Prompt:      "Write beats from: {{.context}}",
+					Model:       "gpt-4o-mini",
+					Temperature: lo.ToPtr(0.4),
+				},
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/WithLLMReport",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchemaLLMReport,
+				UI:          testModuleUI,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchemaLLMReport,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchemaLLMReport,
+				UI:          testModuleUI,
+				CreatedAt:   baseTime,
+				LLMReport: lib.JSONSchemaLLMReport{
+					{Path: "/website", Kind: lib.JSONSchemaLLMChangeDropped, Message: `unsupported format "uri"`},
+				},
+			},
+		},
 		{
 			name: "Success/WithPreversion",
 
@@ -108,6 +350,10 @@ func TestModuleCreate(t *testing.T) {
 				UI:          testModuleUI,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				resp: &dao.Module{
 					ID:          "test-module",
@@ -143,6 +389,10 @@ func TestModuleCreate(t *testing.T) {
 				Overwrite:   true,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleDeleteMock: &moduleDeleteMock{
 				resp: &dao.Module{
 					ID:          "test-module",
@@ -188,6 +438,10 @@ func TestModuleCreate(t *testing.T) {
 				Overwrite:   true,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleDeleteMock: &moduleDeleteMock{
 				err: dao.ErrModuleDeleteNotFound,
 			},
@@ -300,6 +554,271 @@ func TestModuleCreate(t *testing.T) {
 
 			expectErr: services.ErrInvalidRequest,
 		},
+		{
+			name: "Error/InvalidMigrations",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v2.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Migrations: []models.ModuleMigration{
+					{Op: models.ModuleMigrationOpMove, Path: "/name"},
+				},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Success/CompatibleUpgrade",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.1.0",
+				Description: validDescription,
+				Schema:      testModuleSchemaCompatible,
+				UI:          testModuleUI,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.2.0", CreatedAt: baseTime},
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.0.0",
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.1.0",
+					Description: validDescription,
+					Schema:      testModuleSchemaCompatible,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.1.0",
+				Description: validDescription,
+				Schema:      testModuleSchemaCompatible,
+				UI:          testModuleUI,
+				CreatedAt:   baseTime,
+			},
+		},
+		{
+			name: "Success/BreakingChangeMajorUpgrade",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v2.0.0-beta-1",
+				Description: validDescription,
+				Schema:      testModuleSchemaBreaking,
+				UI:          testModuleUI,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.0.0",
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "2.0.0",
+					Preversion:  "-beta-1",
+					Description: validDescription,
+					Schema:      testModuleSchemaBreaking,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "2.0.0",
+				Preversion:  "-beta-1",
+				Description: validDescription,
+				Schema:      testModuleSchemaBreaking,
+				UI:          testModuleUI,
+				CreatedAt:   baseTime,
+			},
+		},
+		{
+			name: "Error/BreakingChange",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.1",
+				Description: validDescription,
+				Schema:      testModuleSchemaBreaking,
+				UI:          testModuleUI,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.0.0",
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expectErr: services.ErrBreakingModuleChange,
+		},
+		{
+			name: "Error/PreviousVersions",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.1",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/PreviousModule",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.1",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.0.0",
+				err:     errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidDependencies",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				DependsOn: []models.ModuleDependency{
+					{Module: "test-namespace:test-module"},
+				},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidGeneration",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Generation: &models.ModuleGeneration{
+					Temperature: lo.ToPtr(3.0),
+				},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidGeneration/Prompt",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Generation: &models.ModuleGeneration{
+					Prompt: "Context: {{.context",
+				},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidGeneration/PromptTooLong",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Generation: &models.ModuleGeneration{
+					Prompt: strings.Repeat("a", 8193),
+				},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidGeneration/ZeroMaxTokens",
+
+			request: &services.ModuleCreateRequest{
+				Module:      "test-namespace:test-module@v1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Generation: &models.ModuleGeneration{
+					MaxTokens: lo.ToPtr[int64](0),
+				},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
 		{
 			name: "Error/ModuleInsert",
 
@@ -310,6 +829,10 @@ func TestModuleCreate(t *testing.T) {
 				UI:          testModuleUI,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				err: errFoo,
 			},
@@ -326,6 +849,10 @@ func TestModuleCreate(t *testing.T) {
 				UI:          testModuleUI,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				err: dao.ErrModuleInsertAlreadyExists,
 			},
@@ -343,6 +870,10 @@ func TestModuleCreate(t *testing.T) {
 				Overwrite:   true,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleDeleteMock: &moduleDeleteMock{
 				err: errFoo,
 			},
@@ -360,6 +891,29 @@ func TestModuleCreate(t *testing.T) {
 
 				moduleInsertRepository := servicesmocks.NewMockModuleCreateRepository(t)
 				moduleDeleteRepository := servicesmocks.NewMockModuleCreateRepositoryDelete(t)
+				moduleSelectRepository := servicesmocks.NewMockModuleCreateRepositorySelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockModuleCreateRepositoryListVersions(t)
+
+				if testCase.previousVersionsMock != nil {
+					decodedModule := lib.DecodeModule(testCase.request.Module)
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        decodedModule.Module,
+							Namespace: decodedModule.Namespace,
+						}).
+						Return(testCase.previousVersionsMock.resp, testCase.previousVersionsMock.err)
+				}
+
+				if testCase.previousModuleMock != nil {
+					decodedModule := lib.DecodeModule(testCase.request.Module)
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:        decodedModule.Module,
+							Namespace: decodedModule.Namespace,
+							Version:   testCase.previousModuleMock.version,
+						}).
+						Return(testCase.previousModuleMock.resp, testCase.previousModuleMock.err)
+				}
 
 				if testCase.moduleDeleteMock != nil {
 					decodedModule := lib.DecodeModule(testCase.request.Module)
@@ -382,6 +936,9 @@ func TestModuleCreate(t *testing.T) {
 								req.Version == decodedModule.Version &&
 								req.Preversion == decodedModule.Preversion &&
 								req.Description == testCase.request.Description &&
+								assert.Equal(t, testCase.request.Migrations, req.Migrations) &&
+								assert.Equal(t, testCase.request.DependsOn, req.DependsOn) &&
+								assert.Equal(t, testCase.request.Generation, req.Generation) &&
 								time.Since(req.Now) < time.Minute
 						})).
 						Return(testCase.moduleInsertMock.resp, testCase.moduleInsertMock.err)
@@ -390,6 +947,8 @@ func TestModuleCreate(t *testing.T) {
 				service := services.NewModuleCreate(
 					moduleInsertRepository,
 					moduleDeleteRepository,
+					moduleSelectRepository,
+					moduleListVersionsRepository,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -398,6 +957,8 @@ func TestModuleCreate(t *testing.T) {
 
 				moduleInsertRepository.AssertExpectations(t)
 				moduleDeleteRepository.AssertExpectations(t)
+				moduleSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
 			})
 		})
 	}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+type ModuleDeleteRepository interface {
+	Exec(ctx context.Context, request *dao.ModuleDeleteRequest) (*dao.Module, error)
+}
+
+type ModuleDeleteRequest struct {
+	// Module is the module string in the format "namespace:module@version".
+	Module string `validate:"required,module,max=512"`
+}
+
+type ModuleDelete struct {
+	moduleDeleteRepository ModuleDeleteRepository
+}
+
+func NewModuleDelete(
+	moduleDeleteRepository ModuleDeleteRepository,
+) *ModuleDelete {
+	return &ModuleDelete{
+		moduleDeleteRepository: moduleDeleteRepository,
+	}
+}
+
+func (service *ModuleDelete) Exec(ctx context.Context, request *ModuleDeleteRequest) (*Module, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ModuleDelete")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	decodedModule := lib.DecodeModule(request.Module)
+
+	module, err := service.moduleDeleteRepository.Exec(ctx, &dao.ModuleDeleteRequest{
+		ID:         decodedModule.Module,
+		Namespace:  decodedModule.Namespace,
+		Version:    decodedModule.Version,
+		Preversion: decodedModule.Preversion,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadModule(module)), nil
+}
@@ -0,0 +1,229 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestModuleDelete(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testModuleSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {
+				Type: "string",
+			},
+		},
+		Required: []string{"title"},
+	}
+
+	testModuleUI := models.ModuleUi{
+		Component: "test-component",
+		Params:    map[string]any{"key": "value"},
+		Target:    "title",
+	}
+
+	validDescription := "This is a valid description that is at least 32 characters long."
+
+	type moduleDeleteMock struct {
+		resp *dao.Module
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ModuleDeleteRequest
+
+		moduleDeleteMock *moduleDeleteMock
+
+		expect    *services.Module
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+			},
+
+			moduleDeleteMock: &moduleDeleteMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				CreatedAt:   baseTime,
+			},
+		},
+		{
+			name: "Success/WithPreversion",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "test-namespace:test-module@v1.0.0-beta-1",
+			},
+
+			moduleDeleteMock: &moduleDeleteMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Preversion:  "-beta-1",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.0.0",
+				Preversion:  "-beta-1",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				CreatedAt:   baseTime,
+			},
+		},
+		{
+			name: "Error/InvalidRequest/MissingModule",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidModuleFormat",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "invalid-module-format",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidModuleVersion",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "namespace:module@invalid",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/MissingVersion",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "test-namespace:test-module",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/ModuleTooLong",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "test-namespace:test-module@v1.0.0" + string(make([]byte, 500)),
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ModuleNotFound",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+			},
+
+			moduleDeleteMock: &moduleDeleteMock{
+				err: dao.ErrModuleDeleteNotFound,
+			},
+
+			expectErr: dao.ErrModuleDeleteNotFound,
+		},
+		{
+			name: "Error/RepositoryError",
+
+			request: &services.ModuleDeleteRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+			},
+
+			moduleDeleteMock: &moduleDeleteMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				moduleDeleteRepository := servicesmocks.NewMockModuleDeleteRepository(t)
+
+				if testCase.moduleDeleteMock != nil {
+					decodedModule := lib.DecodeModule(testCase.request.Module)
+					moduleDeleteRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleDeleteRequest{
+							ID:         decodedModule.Module,
+							Namespace:  decodedModule.Namespace,
+							Version:    decodedModule.Version,
+							Preversion: decodedModule.Preversion,
+						}).
+						Return(testCase.moduleDeleteMock.resp, testCase.moduleDeleteMock.err)
+				}
+
+				service := services.NewModuleDelete(
+					moduleDeleteRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				moduleDeleteRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
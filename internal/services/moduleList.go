@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ModuleListRepository interface {
+	Exec(ctx context.Context, request *dao.ModuleListRequest) ([]*dao.ModuleSummary, error)
+}
+
+type ModuleListRequest struct {
+	Namespace string `validate:"omitempty,max=128,moduleName"`
+	// Query performs a case-insensitive search over the module descriptions.
+	Query  string `validate:"max=256"`
+	Limit  int    `validate:"required,min=1,max=128"`
+	Offset int    `validate:"omitempty,min=0,max=8192"`
+}
+
+type ModuleList struct {
+	moduleListRepository ModuleListRepository
+}
+
+func NewModuleList(
+	moduleListRepository ModuleListRepository,
+) *ModuleList {
+	return &ModuleList{
+		moduleListRepository: moduleListRepository,
+	}
+}
+
+func (service *ModuleList) Exec(ctx context.Context, request *ModuleListRequest) ([]*ModuleSummary, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ModuleList")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	modules, err := service.moduleListRepository.Exec(ctx, &dao.ModuleListRequest{
+		Namespace: request.Namespace,
+		Query:     request.Query,
+		Limit:     request.Limit,
+		Offset:    request.Offset,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, lo.Map(modules, loadModuleSummariesMap)), nil
+}
@@ -0,0 +1,171 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestModuleList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type moduleListMock struct {
+		resp []*dao.ModuleSummary
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ModuleListRequest
+
+		moduleListMock *moduleListMock
+
+		expect    []*services.ModuleSummary
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ModuleListRequest{
+				Limit: 10,
+			},
+
+			moduleListMock: &moduleListMock{
+				resp: []*dao.ModuleSummary{
+					{
+						ID:          "idea",
+						Namespace:   "agora",
+						Version:     "1.0.0",
+						Description: "Generate an idea for a story",
+						CreatedAt:   baseTime,
+					},
+				},
+			},
+
+			expect: []*services.ModuleSummary{
+				{
+					ID:          "idea",
+					Namespace:   "agora",
+					Version:     "1.0.0",
+					Description: "Generate an idea for a story",
+					CreatedAt:   baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/WithFilters",
+
+			request: &services.ModuleListRequest{
+				Namespace: "agora",
+				Query:     "idea",
+				Limit:     10,
+				Offset:    10,
+			},
+
+			moduleListMock: &moduleListMock{
+				resp: []*dao.ModuleSummary{},
+			},
+
+			expect: []*services.ModuleSummary{},
+		},
+		{
+			name: "Error/InvalidRequest/MissingLimit",
+
+			request: &services.ModuleListRequest{},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/LimitTooHigh",
+
+			request: &services.ModuleListRequest{
+				Limit: 129,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidNamespace",
+
+			request: &services.ModuleListRequest{
+				Namespace: "Invalid Namespace",
+				Limit:     10,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/QueryTooLong",
+
+			request: &services.ModuleListRequest{
+				Query: strings.Repeat("a", 257),
+				Limit: 10,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/Repository",
+
+			request: &services.ModuleListRequest{
+				Limit: 10,
+			},
+
+			moduleListMock: &moduleListMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				moduleListRepository := servicesmocks.NewMockModuleListRepository(t)
+
+				if testCase.moduleListMock != nil {
+					moduleListRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListRequest{
+							Namespace: testCase.request.Namespace,
+							Query:     testCase.request.Query,
+							Limit:     testCase.request.Limit,
+							Offset:    testCase.request.Offset,
+						}).
+						Return(testCase.moduleListMock.resp, testCase.moduleListMock.err)
+				}
+
+				service := services.NewModuleList(
+					moduleListRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				moduleListRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
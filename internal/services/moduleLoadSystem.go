@@ -13,6 +13,7 @@ import (
 	"github.com/a-novel-kit/golib/otel"
 
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 	"github.com/a-novel/service-narrative-engine/internal/models/modules"
 )
 
@@ -67,6 +68,9 @@ func NewModuleLoadSystem(
 // For experimentation during local development, however, stable versions are never published. Instead, a new
 // pre-version is created over the current deployment version each time the service is started and new changes are
 // detected.
+//
+// Whatever the mode, the schema is compared with the previous stable version of the module, and breaking changes
+// are rejected unless the deployment version is a major upgrade.
 func (service *ModuleLoadSystem) Exec(
 	ctx context.Context, request *ModuleLoadSystemRequest,
 ) (*Module, error) {
@@ -78,6 +82,14 @@ func (service *ModuleLoadSystem) Exec(
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
+	err = lib.ValidateModuleDependencies(
+		lib.DecodedModule{Namespace: request.Module.Namespace, Module: request.Module.ID}.String(),
+		request.Module.DependsOn,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
 	if request.DevMode {
 		// Retrieve the latest module for the current version, to verify it there are any changes.
 		versionsList, err := service.moduleLoadSystemRepositoryListVersions.Exec(ctx, &dao.ModuleListVersionsRequest{
@@ -103,12 +115,31 @@ func (service *ModuleLoadSystem) Exec(
 			}
 
 			if reflect.DeepEqual(latest.Schema, request.Module.Schema) &&
-				reflect.DeepEqual(latest.UI, request.Module.UI) {
-				return otel.ReportSuccess(span, loadModule(latest)), nil
+				reflect.DeepEqual(latest.UI, request.Module.UI) &&
+				reflect.DeepEqual(latest.Migrations, request.Module.Migrations) &&
+				reflect.DeepEqual(latest.DependsOn, request.Module.DependsOn) &&
+				reflect.DeepEqual(latest.Generation, request.Module.Generation) {
+				return otel.ReportSuccess(span, loadPublishedModule(latest)), nil
 			}
 		}
 	}
 
+	compatibility, err := CheckModuleCompatibility(
+		ctx,
+		service.moduleLoadSystemRepositoryListVersions,
+		service.moduleLoadSystemRepositorySelect,
+		lib.DecodedModule{Namespace: request.Module.Namespace, Module: request.Module.ID, Version: request.Version},
+		&request.Module.Schema,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, fmt.Errorf("failed to check module compatibility: %w", err))
+	}
+
+	err = compatibility.Err()
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
 	// Default mode. Create a new version for the current deployment.
 	// In dev mode, prefix UUID with hyphen to match ModulePreversionRegex pattern (-[a-z0-9]+)*
 	result, err := service.moduleLoadSystemRepository.Exec(ctx, &dao.ModuleInsertRequest{
@@ -119,11 +150,14 @@ func (service *ModuleLoadSystem) Exec(
 		Description: request.Module.Description,
 		Schema:      request.Module.Schema,
 		UI:          request.Module.UI,
+		Migrations:  request.Module.Migrations,
+		DependsOn:   request.Module.DependsOn,
+		Generation:  request.Module.Generation,
 		Now:         time.Now(),
 	})
 	if err != nil {
 		return nil, otel.ReportError(span, fmt.Errorf("failed to insert module: %w", err))
 	}
 
-	return otel.ReportSuccess(span, loadModule(result)), nil
+	return otel.ReportSuccess(span, loadPublishedModule(result)), nil
 }
@@ -53,6 +53,22 @@ func TestModuleLoadSystem(t *testing.T) {
 		Required: []string{"title", "description"},
 	}
 
+	testModuleSchemaCompatible := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {
+				Type: "string",
+			},
+			"count": {
+				Type: "integer",
+			},
+			"description": {
+				Type: "string",
+			},
+		},
+		Required: []string{"title"},
+	}
+
 	testModuleUI := models.ModuleUi{
 		Component: "test-component",
 		Params:    map[string]any{"key": "value"},
@@ -82,6 +98,12 @@ func TestModuleLoadSystem(t *testing.T) {
 		err  error
 	}
 
+	type previousModuleMock struct {
+		version string
+		resp    *dao.Module
+		err     error
+	}
+
 	testCases := []struct {
 		name string
 
@@ -90,6 +112,8 @@ func TestModuleLoadSystem(t *testing.T) {
 		moduleInsertMock       *moduleInsertMock
 		moduleListVersionsMock *moduleListVersionsMock
 		moduleSelectMock       *moduleSelectMock
+		previousVersionsMock   *moduleListVersionsMock
+		previousModuleMock     *previousModuleMock
 
 		expect    *services.Module
 		expectErr error
@@ -109,6 +133,10 @@ func TestModuleLoadSystem(t *testing.T) {
 				DevMode: false,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				resp: &dao.Module{
 					ID:          "test-module",
@@ -150,6 +178,10 @@ func TestModuleLoadSystem(t *testing.T) {
 				resp: []*dao.ModuleVersion{},
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				resp: &dao.Module{
 					ID:          "test-module",
@@ -212,6 +244,10 @@ func TestModuleLoadSystem(t *testing.T) {
 				},
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				resp: &dao.Module{
 					ID:          "test-module",
@@ -274,6 +310,10 @@ func TestModuleLoadSystem(t *testing.T) {
 				},
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				resp: &dao.Module{
 					ID:          "test-module",
@@ -298,6 +338,75 @@ func TestModuleLoadSystem(t *testing.T) {
 				CreatedAt:   baseTime,
 			},
 		},
+		{
+			name: "Success/DevMode/ExistingVersionWithDifferentGeneration",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					Generation:  &models.ModuleGeneration{Instructions: "Keep it short."},
+				},
+				Version: "1.0.0",
+				DevMode: true,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{
+						Version:    "1.0.0",
+						Preversion: "old-preversion",
+						CreatedAt:  baseTime,
+					},
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Preversion:  "old-preversion",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Preversion:  "new-uuid-preversion",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					Generation:  &models.ModuleGeneration{Instructions: "Keep it short."},
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.0.0",
+				Preversion:  "new-uuid-preversion",
+				Description: validDescription,
+				Schema:      testModuleSchema,
+				UI:          testModuleUI,
+				Generation:  &models.ModuleGeneration{Instructions: "Keep it short."},
+				CreatedAt:   baseTime,
+			},
+		},
 		{
 			name: "Success/DevMode/ExistingVersionNoChanges",
 
@@ -383,6 +492,44 @@ func TestModuleLoadSystem(t *testing.T) {
 
 			expectErr: services.ErrInvalidRequest,
 		},
+		{
+			name: "Error/InvalidRequest/InvalidDependencies",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					DependsOn: []models.ModuleDependency{
+						{Module: "test-namespace:other-module@v1.0.0"},
+					},
+				},
+				Version: "1.0.0",
+				DevMode: false,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidGeneration",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					Generation:  &models.ModuleGeneration{Prompt: "{{.context"},
+				},
+				Version: "1.0.0",
+				DevMode: false,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
 		{
 			name: "Error/DevMode/ListVersionsFailure",
 
@@ -435,6 +582,207 @@ func TestModuleLoadSystem(t *testing.T) {
 
 			expectErr: errFoo,
 		},
+		{
+			name: "Success/CompatibleUpgrade",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchemaCompatible,
+					UI:          testModuleUI,
+				},
+				Version: "1.1.0",
+				DevMode: false,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.0.0",
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.1.0",
+					Description: validDescription,
+					Schema:      testModuleSchemaCompatible,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "1.1.0",
+				Description: validDescription,
+				Schema:      testModuleSchemaCompatible,
+				UI:          testModuleUI,
+				CreatedAt:   baseTime,
+			},
+		},
+		{
+			name: "Success/BreakingChangeMajorUpgrade",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchemaUpdated,
+					UI:          testModuleUI,
+				},
+				Version: "2.0.0",
+				DevMode: false,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.3.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.3.0",
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.3.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			moduleInsertMock: &moduleInsertMock{
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "2.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchemaUpdated,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expect: &services.Module{
+				ID:          "test-module",
+				Namespace:   "test-namespace",
+				Version:     "2.0.0",
+				Description: validDescription,
+				Schema:      testModuleSchemaUpdated,
+				UI:          testModuleUI,
+				CreatedAt:   baseTime,
+			},
+		},
+		{
+			name: "Error/BreakingChange",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchemaUpdated,
+					UI:          testModuleUI,
+				},
+				Version: "1.1.0",
+				DevMode: true,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.0.0",
+				resp: &dao.Module{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Version:     "1.0.0",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+					CreatedAt:   baseTime,
+				},
+			},
+
+			expectErr: services.ErrBreakingModuleChange,
+		},
+		{
+			name: "Error/PreviousVersionsFailure",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+				},
+				Version: "1.1.0",
+				DevMode: false,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/PreviousModuleFailure",
+
+			request: &services.ModuleLoadSystemRequest{
+				Module: modules.SystemModule{
+					ID:          "test-module",
+					Namespace:   "test-namespace",
+					Description: validDescription,
+					Schema:      testModuleSchema,
+					UI:          testModuleUI,
+				},
+				Version: "1.1.0",
+				DevMode: false,
+			},
+
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			previousModuleMock: &previousModuleMock{
+				version: "1.0.0",
+				err:     errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "Error/InsertFailure",
 
@@ -450,6 +798,10 @@ func TestModuleLoadSystem(t *testing.T) {
 				DevMode: false,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				err: errFoo,
 			},
@@ -471,6 +823,10 @@ func TestModuleLoadSystem(t *testing.T) {
 				DevMode: false,
 			},
 
+			previousVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{},
+			},
+
 			moduleInsertMock: &moduleInsertMock{
 				err: dao.ErrModuleInsertAlreadyExists,
 			},
@@ -513,6 +869,25 @@ func TestModuleLoadSystem(t *testing.T) {
 						Return(testCase.moduleSelectMock.resp, testCase.moduleSelectMock.err)
 				}
 
+				if testCase.previousVersionsMock != nil {
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        testCase.request.Module.ID,
+							Namespace: testCase.request.Module.Namespace,
+						}).
+						Return(testCase.previousVersionsMock.resp, testCase.previousVersionsMock.err)
+				}
+
+				if testCase.previousModuleMock != nil {
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:        testCase.request.Module.ID,
+							Namespace: testCase.request.Module.Namespace,
+							Version:   testCase.previousModuleMock.version,
+						}).
+						Return(testCase.previousModuleMock.resp, testCase.previousModuleMock.err)
+				}
+
 				if testCase.moduleInsertMock != nil {
 					moduleInsertRepository.EXPECT().
 						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ModuleInsertRequest) bool {
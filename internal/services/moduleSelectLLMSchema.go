@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+type ModuleSelectLLMSchemaRepository interface {
+	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
+}
+
+type ModuleSelectLLMSchemaRequest struct {
+	// Module is the module string in the format "namespace:module@version".
+	Module string `validate:"required,module,max=512"`
+}
+
+type ModuleSelectLLMSchema struct {
+	moduleSelectRepository ModuleSelectLLMSchemaRepository
+}
+
+func NewModuleSelectLLMSchema(
+	moduleSelectRepository ModuleSelectLLMSchemaRepository,
+) *ModuleSelectLLMSchema {
+	return &ModuleSelectLLMSchema{
+		moduleSelectRepository: moduleSelectRepository,
+	}
+}
+
+// Exec previews the schema of a module, as sent to the model when generating content for it.
+func (service *ModuleSelectLLMSchema) Exec(
+	ctx context.Context, request *ModuleSelectLLMSchemaRequest,
+) (*ModuleLLMSchema, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ModuleSelectLLMSchema")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	decodedModule := lib.DecodeModule(request.Module)
+
+	module, err := service.moduleSelectRepository.Exec(ctx, &dao.ModuleSelectRequest{
+		ID:         decodedModule.Module,
+		Namespace:  decodedModule.Namespace,
+		Version:    decodedModule.Version,
+		Preversion: decodedModule.Preversion,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, LoadModuleLLMSchema(&module.Schema)), nil
+}
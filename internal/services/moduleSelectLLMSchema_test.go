@@ -0,0 +1,182 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestModuleSelectLLMSchema(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type moduleSelectMock struct {
+		resp *dao.Module
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ModuleSelectLLMSchemaRequest
+
+		moduleSelectMock *moduleSelectMock
+
+		expect    *services.ModuleLLMSchema
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ModuleSelectLLMSchemaRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema: jsonschema.Schema{
+						Type: "object",
+						Properties: map[string]*jsonschema.Schema{
+							"title":   {Type: "string", Description: "The title."},
+							"website": {Type: "string", Format: "uri"},
+						},
+						Required: []string{"title"},
+					},
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ModuleLLMSchema{
+				Schema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"title": {Type: "string"},
+					},
+					Required:             []string{"title"},
+					AdditionalProperties: &jsonschema.Schema{Not: &jsonschema.Schema{}},
+				},
+				Report: lib.JSONSchemaLLMReport{
+					{Path: "/title", Kind: lib.JSONSchemaLLMChangeRewritten, Message: "ignored description"},
+					{Path: "/website", Kind: lib.JSONSchemaLLMChangeDropped, Message: `unsupported format "uri"`},
+				},
+			},
+		},
+		{
+			name: "Success/Unusable",
+
+			request: &services.ModuleSelectLLMSchemaRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema: jsonschema.Schema{
+						Type: "object",
+						Properties: map[string]*jsonschema.Schema{
+							"website": {Type: "string", Format: "uri"},
+						},
+					},
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ModuleLLMSchema{
+				Report: lib.JSONSchemaLLMReport{
+					{Path: "/website", Kind: lib.JSONSchemaLLMChangeDropped, Message: `unsupported format "uri"`},
+					{Path: "", Kind: lib.JSONSchemaLLMChangeDropped, Message: "object has no supported properties"},
+				},
+			},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.ModuleSelectLLMSchemaRequest{
+				Module: "invalid-module-format",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ModuleNotFound",
+
+			request: &services.ModuleSelectLLMSchemaRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				err: dao.ErrModuleSelectNotFound,
+			},
+
+			expectErr: dao.ErrModuleSelectNotFound,
+		},
+		{
+			name: "Error/RepositoryError",
+
+			request: &services.ModuleSelectLLMSchemaRequest{
+				Module: "test-namespace:test-module@v1.0.0",
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				moduleSelectRepository := servicesmocks.NewMockModuleSelectLLMSchemaRepository(t)
+
+				if testCase.moduleSelectMock != nil {
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:        "test-module",
+							Namespace: "test-namespace",
+							Version:   "1.0.0",
+						}).
+						Return(testCase.moduleSelectMock.resp, testCase.moduleSelectMock.err)
+				}
+
+				service := services.NewModuleSelectLLMSchema(moduleSelectRepository)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				if resp != nil {
+					require.Equal(t, testCase.expect.Schema != nil, resp.Usable())
+				}
+
+				moduleSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
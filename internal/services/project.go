@@ -1,18 +1,23 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 )
 
 var (
-	ErrUserDoesNotOwnProject = errors.New("user is not the owner of this project")
-	ErrModuleNotInProject    = errors.New("module is not in the project")
+	ErrProjectAccessDenied = errors.New("user does not have access to this project")
+	ErrModuleNotInProject  = errors.New("module is not in the project")
+	// ErrModuleRangeNotSatisfied is returned when no published stable version of a module matches a version range.
+	ErrModuleRangeNotSatisfied = errors.New("no published version of the module satisfies the range")
 )
 
 type Project struct {
@@ -23,6 +28,8 @@ type Project struct {
 	Workflow  []string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// ForkedFrom is the ID of the project this one was forked from, if any.
+	ForkedFrom *uuid.UUID
 }
 
 func loadProject(project *dao.Project) *Project {
@@ -34,6 +41,8 @@ func loadProject(project *dao.Project) *Project {
 		Workflow:  project.Workflow,
 		CreatedAt: project.CreatedAt,
 		UpdatedAt: project.UpdatedAt,
+
+		ForkedFrom: project.ForkedFrom,
 	}
 }
 
@@ -41,23 +50,131 @@ func loadProjectsMap(project *dao.Project, _ int) *Project {
 	return loadProject(project)
 }
 
-// VerifyProjectOwnership assess that the given user has the proper access authorizations to edit the project.
-func VerifyProjectOwnership(project *dao.Project, userID uuid.UUID) error {
-	if project.Owner != userID {
-		return ErrUserDoesNotOwnProject
+// ProjectRoleOwner is the role of the owner of a project. It includes the permissions of every member role, and
+// cannot be given to a member.
+const ProjectRoleOwner dao.ProjectMemberRole = "OWNER"
+
+// projectRoleRanks orders the roles on a project, each role including the permissions of the lower ones.
+var projectRoleRanks = map[dao.ProjectMemberRole]int{
+	dao.ProjectMemberRoleViewer:    1,
+	dao.ProjectMemberRoleEditor:    2,
+	dao.ProjectMemberRoleGenerator: 3,
+	dao.ProjectMemberRoleAdmin:     4,
+	ProjectRoleOwner:               5,
+}
+
+// ProjectAccessRepository retrieves the membership of a user on a project.
+type ProjectAccessRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+// ProjectRole returns the role of a user on a project. The owner of the project has the ProjectRoleOwner role, other
+// users have the role of their membership, if any.
+func ProjectRole(
+	ctx context.Context, repository ProjectAccessRepository, project *dao.Project, userID uuid.UUID,
+) (dao.ProjectMemberRole, error) {
+	if project.Owner == userID {
+		return ProjectRoleOwner, nil
+	}
+
+	member, err := repository.Exec(ctx, &dao.ProjectMemberSelectRequest{ProjectID: project.ID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, dao.ErrProjectMemberSelectNotFound) {
+			return "", ErrProjectAccessDenied
+		}
+
+		return "", fmt.Errorf("select project member: %w", err)
+	}
+
+	return member.Role, nil
+}
+
+// VerifyProjectAccess assess that the given user has at least the required role on the project. The owner of the
+// project is granted every role.
+func VerifyProjectAccess(
+	ctx context.Context,
+	repository ProjectAccessRepository,
+	project *dao.Project,
+	userID uuid.UUID,
+	role dao.ProjectMemberRole,
+) error {
+	userRole, err := ProjectRole(ctx, repository, project, userID)
+	if err != nil {
+		return err
+	}
+
+	if projectRoleRanks[userRole] < projectRoleRanks[role] {
+		return fmt.Errorf("%w: role %s required", ErrProjectAccessDenied, role)
 	}
 
 	return nil
 }
 
 // VerifyModule assess that the given module is part of the project's workflow.
-// The module parameter should be a full versioned module string (e.g., "namespace:module@v1.0.0").
+// The module parameter should be a full versioned module string (e.g., "namespace:module@v1.0.0"). It is also
+// accepted when the workflow references the module through a version range that this version satisfies.
 func VerifyModule(project *dao.Project, module string) error {
+	decodedModule := lib.DecodeModule(module)
+
 	for _, m := range project.Workflow {
 		if m == module {
 			return nil
 		}
+
+		decodedWorkflowModule := lib.DecodeModule(m)
+		if decodedWorkflowModule.Range != "" &&
+			decodedWorkflowModule.Namespace == decodedModule.Namespace &&
+			decodedWorkflowModule.Module == decodedModule.Module &&
+			lib.MatchModuleRange(decodedWorkflowModule.Range, decodedModule.Version, decodedModule.Preversion) {
+			return nil
+		}
 	}
 
 	return fmt.Errorf("module '%s': %w", module, ErrModuleNotInProject)
 }
+
+// WorkflowIncludesModule returns true if the workflow references the given module, in any version.
+//
+// Modules removed from a workflow keep their schemas, along with a tombstone version without data. Listing the latest
+// schemas of a project skips tombstones, and returns the last version with data instead: this function must be used
+// to filter out removed modules.
+func WorkflowIncludesModule(workflow []string, namespace, module string) bool {
+	return lo.ContainsBy(workflow, func(item string) bool {
+		decoded := lib.DecodeModule(item)
+
+		return decoded.Namespace == namespace && decoded.Module == module
+	})
+}
+
+// ResolveModule returns the exact module version a workflow entry points to. Exact module strings are returned
+// as-is. Version ranges resolve to the newest published stable version of the module that satisfies them.
+func ResolveModule(
+	ctx context.Context, repository ModuleListVersionsRepository, module string,
+) (lib.DecodedModule, error) {
+	decodedModule := lib.DecodeModule(module)
+	if decodedModule.Range == "" {
+		return decodedModule, nil
+	}
+
+	// Versions are sorted from the newest to the oldest, and only include stable releases.
+	versions, err := repository.Exec(ctx, &dao.ModuleListVersionsRequest{
+		ID:        decodedModule.Module,
+		Namespace: decodedModule.Namespace,
+	})
+	if err != nil {
+		return lib.DecodedModule{}, fmt.Errorf("list module versions: %w", err)
+	}
+
+	for _, version := range versions {
+		if lib.MatchModuleRange(decodedModule.Range, version.Version, version.Preversion) {
+			return lib.DecodedModule{
+				Namespace:  decodedModule.Namespace,
+				Module:     decodedModule.Module,
+				Version:    version.Version,
+				Preversion: version.Preversion,
+			}, nil
+		}
+	}
+
+	return lib.DecodedModule{}, fmt.Errorf("module '%s': %w", module, ErrModuleRangeNotSatisfied)
+}
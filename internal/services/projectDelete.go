@@ -19,6 +19,10 @@ type ProjectDeleteRepository interface {
 	Exec(ctx context.Context, request *dao.ProjectDeleteRequest) (*dao.Project, error)
 }
 
+type ProjectDeleteRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type ProjectDeleteRequest struct {
 	ID     uuid.UUID `validate:"required"`
 	UserID uuid.UUID `validate:"required"`
@@ -27,15 +31,18 @@ type ProjectDeleteRequest struct {
 type ProjectDelete struct {
 	projectDeleteRepositorySelect ProjectDeleteRepositorySelect
 	projectDeleteRepository       ProjectDeleteRepository
+	projectMemberSelectRepository ProjectDeleteRepositoryProjectMemberSelect
 }
 
 func NewProjectDelete(
 	projectDeleteRepository ProjectDeleteRepository,
 	projectDeleteRepositorySelect ProjectDeleteRepositorySelect,
+	projectMemberSelectRepository ProjectDeleteRepositoryProjectMemberSelect,
 ) *ProjectDelete {
 	return &ProjectDelete{
 		projectDeleteRepositorySelect: projectDeleteRepositorySelect,
 		projectDeleteRepository:       projectDeleteRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
 	}
 }
 
@@ -55,7 +62,7 @@ func (service *ProjectDelete) Exec(ctx context.Context, request *ProjectDeleteRe
 		return nil, otel.ReportError(span, err)
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(ctx, service.projectMemberSelectRepository, project, request.UserID, ProjectRoleOwner)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
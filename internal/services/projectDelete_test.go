@@ -39,13 +39,19 @@ func TestProjectDelete(t *testing.T) {
 		err  error
 	}
 
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.ProjectDeleteRequest
 
-		projectSelectMock *projectSelectMock
-		projectDeleteMock *projectDeleteMock
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectDeleteMock       *projectDeleteMock
 
 		expect    *services.Project
 		expectErr error
@@ -135,8 +141,33 @@ func TestProjectDelete(t *testing.T) {
 					UpdatedAt: baseTime,
 				},
 			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectDeleteRequest{
+				ID:     projectID,
+				UserID: otherUserID,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleAdmin},
+			},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
+			expectErr: services.ErrProjectAccessDenied,
 		},
 		{
 			name: "Error/ProjectDelete",
@@ -174,6 +205,7 @@ func TestProjectDelete(t *testing.T) {
 
 				projectDeleteRepositorySelect := servicesmocks.NewMockProjectDeleteRepositorySelect(t)
 				projectDeleteRepository := servicesmocks.NewMockProjectDeleteRepository(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectDeleteRepositoryProjectMemberSelect(t)
 
 				if testCase.projectSelectMock != nil {
 					projectDeleteRepositorySelect.EXPECT().
@@ -183,6 +215,15 @@ func TestProjectDelete(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
 				if testCase.projectDeleteMock != nil {
 					projectDeleteRepository.EXPECT().
 						Exec(mock.Anything, &dao.ProjectDeleteRequest{
@@ -191,7 +232,9 @@ func TestProjectDelete(t *testing.T) {
 						Return(testCase.projectDeleteMock.resp, testCase.projectDeleteMock.err)
 				}
 
-				service := services.NewProjectDelete(projectDeleteRepository, projectDeleteRepositorySelect)
+				service := services.NewProjectDelete(
+					projectDeleteRepository, projectDeleteRepositorySelect, projectMemberSelectRepository,
+				)
 
 				resp, err := service.Exec(ctx, testCase.request)
 				require.ErrorIs(t, err, testCase.expectErr)
@@ -199,6 +242,7 @@ func TestProjectDelete(t *testing.T) {
 
 				projectDeleteRepositorySelect.AssertExpectations(t)
 				projectDeleteRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
 			})
 		})
 	}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectForkRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error)
+}
+
+type ProjectForkRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectForkRepositorySchemaList interface {
+	Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)
+}
+
+type ProjectForkRepositorySchemaInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)
+}
+
+type ProjectForkRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectForkRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+type ProjectFork struct {
+	projectForkRepository         ProjectForkRepository
+	projectSelectRepository       ProjectForkRepositoryProjectSelect
+	schemaListRepository          ProjectForkRepositorySchemaList
+	schemaInsertRepository        ProjectForkRepositorySchemaInsert
+	projectMemberSelectRepository ProjectForkRepositoryProjectMemberSelect
+}
+
+func NewProjectFork(
+	projectForkRepository ProjectForkRepository,
+	projectSelectRepository ProjectForkRepositoryProjectSelect,
+	schemaListRepository ProjectForkRepositorySchemaList,
+	schemaInsertRepository ProjectForkRepositorySchemaInsert,
+	projectMemberSelectRepository ProjectForkRepositoryProjectMemberSelect,
+) *ProjectFork {
+	return &ProjectFork{
+		projectForkRepository:         projectForkRepository,
+		projectSelectRepository:       projectSelectRepository,
+		schemaListRepository:          schemaListRepository,
+		schemaInsertRepository:        schemaInsertRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec copies the workflow of a project, and the latest schema of each of its modules, into a new project owned by
+// the user. The new project and its schemas keep a reference to the ones they were copied from, and evolve
+// independently of them.
+func (service *ProjectFork) Exec(ctx context.Context, request *ProjectForkRequest) (*Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectFork")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// Forking does not alter the source project, so reading it is enough.
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	schemas, err := service.schemaListRepository.Exec(ctx, &dao.SchemaListRequest{ProjectID: request.ProjectID})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// Modules removed from the workflow are not part of the fork.
+	schemas = lo.Filter(schemas, func(item *dao.Schema, _ int) bool {
+		return WorkflowIncludesModule(project.Workflow, item.ModuleNamespace, item.ModuleID)
+	})
+
+	var output *dao.Project
+
+	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
+		now := time.Now().UTC()
+
+		output, err = service.projectForkRepository.Exec(ctx, &dao.ProjectInsertRequest{
+			ID:         uuid.New(),
+			Owner:      request.UserID,
+			Lang:       project.Lang,
+			Title:      project.Title,
+			Workflow:   project.Workflow,
+			ForkedFrom: &project.ID,
+			Now:        now,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, schema := range schemas {
+			_, err = service.schemaInsertRepository.Exec(ctx, &dao.SchemaInsertRequest{
+				ID:               uuid.New(),
+				ProjectID:        output.ID,
+				Owner:            &request.UserID,
+				ModuleID:         schema.ModuleID,
+				ModuleNamespace:  schema.ModuleNamespace,
+				ModuleVersion:    schema.ModuleVersion,
+				ModulePreversion: schema.ModulePreversion,
+				Source:           dao.SchemaSourceFork,
+				Data:             schema.Data,
+				ForkedFrom:       &schema.ID,
+				Now:              now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadProject(output)), nil
+}
@@ -0,0 +1,410 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectFork(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	viewerID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	forkProjectID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+	ideaSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	pitchSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000201")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "The Lighthouse",
+		Workflow:  []string{"test:idea@v1.0.0", "test:pitch@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testSchemas := []*dao.Schema{
+		{
+			ID:              ideaSchemaID,
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "idea",
+			ModuleNamespace: "test",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"title": "The Lighthouse"},
+			CreatedAt:       baseTime,
+		},
+		{
+			ID:              pitchSchemaID,
+			ProjectID:       projectID,
+			ModuleID:        "pitch",
+			ModuleNamespace: "test",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceAI,
+			Data:            map[string]any{"pitch": "A keeper finds a message in a bottle."},
+			CreatedAt:       baseTime,
+		},
+	}
+
+	testFork := func(owner uuid.UUID) *dao.Project {
+		return &dao.Project{
+			ID:         forkProjectID,
+			Owner:      owner,
+			Lang:       config.LangEN,
+			Title:      "The Lighthouse",
+			Workflow:   testProject.Workflow,
+			ForkedFrom: &projectID,
+			CreatedAt:  baseTime.Add(time.Hour),
+			UpdatedAt:  baseTime.Add(time.Hour),
+		}
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type schemaListMock struct {
+		resp []*dao.Schema
+		err  error
+	}
+
+	type projectForkMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type schemaInsertMock struct {
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectForkRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		schemaListMock          *schemaListMock
+		projectForkMock         *projectForkMock
+		schemaInsertMock        *schemaInsertMock
+
+		// expectForked lists the source schemas expected to be copied in the fork.
+		expectForked []*dao.Schema
+
+		expect    *services.Project
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{resp: testSchemas},
+			projectForkMock:   &projectForkMock{resp: testFork(ownerID)},
+			schemaInsertMock:  &schemaInsertMock{},
+
+			expectForked: testSchemas,
+
+			expect: &services.Project{
+				ID:         forkProjectID,
+				Owner:      ownerID,
+				Lang:       config.LangEN,
+				Title:      "The Lighthouse",
+				Workflow:   testProject.Workflow,
+				CreatedAt:  baseTime.Add(time.Hour),
+				UpdatedAt:  baseTime.Add(time.Hour),
+				ForkedFrom: &projectID,
+			},
+		},
+		{
+			name: "Success/Viewer",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    viewerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: viewerID, Role: dao.ProjectMemberRoleViewer},
+			},
+			schemaListMock:   &schemaListMock{resp: testSchemas},
+			projectForkMock:  &projectForkMock{resp: testFork(viewerID)},
+			schemaInsertMock: &schemaInsertMock{},
+
+			expectForked: testSchemas,
+
+			expect: &services.Project{
+				ID:         forkProjectID,
+				Owner:      viewerID,
+				Lang:       config.LangEN,
+				Title:      "The Lighthouse",
+				Workflow:   testProject.Workflow,
+				CreatedAt:  baseTime.Add(time.Hour),
+				UpdatedAt:  baseTime.Add(time.Hour),
+				ForkedFrom: &projectID,
+			},
+		},
+		{
+			name: "Success/RemovedModule",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			// The last version with data of a module removed from the workflow is not copied.
+			schemaListMock: &schemaListMock{resp: append(slices.Clone(testSchemas), &dao.Schema{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000202"),
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "plot",
+				ModuleNamespace: "test",
+				ModuleVersion:   "1.0.0",
+				Source:          dao.SchemaSourceUser,
+				Data:            map[string]any{"plot": "The storm."},
+				CreatedAt:       baseTime,
+			})},
+			projectForkMock:  &projectForkMock{resp: testFork(ownerID)},
+			schemaInsertMock: &schemaInsertMock{},
+
+			expectForked: testSchemas,
+
+			expect: &services.Project{
+				ID:         forkProjectID,
+				Owner:      ownerID,
+				Lang:       config.LangEN,
+				Title:      "The Lighthouse",
+				Workflow:   testProject.Workflow,
+				CreatedAt:  baseTime.Add(time.Hour),
+				UpdatedAt:  baseTime.Add(time.Hour),
+				ForkedFrom: &projectID,
+			},
+		},
+		{
+			name: "Success/NoSchemas",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{resp: []*dao.Schema{}},
+			projectForkMock:   &projectForkMock{resp: testFork(ownerID)},
+
+			expect: &services.Project{
+				ID:         forkProjectID,
+				Owner:      ownerID,
+				Lang:       config.LangEN,
+				Title:      "The Lighthouse",
+				Workflow:   testProject.Workflow,
+				CreatedAt:  baseTime.Add(time.Hour),
+				UpdatedAt:  baseTime.Add(time.Hour),
+				ForkedFrom: &projectID,
+			},
+		},
+		{
+			name: "Error/NotMember",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    viewerID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaList",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectFork",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{resp: testSchemas},
+			projectForkMock:   &projectForkMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaInsert",
+
+			request: &services.ProjectForkRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{resp: testSchemas},
+			projectForkMock:   &projectForkMock{resp: testFork(ownerID)},
+			schemaInsertMock:  &schemaInsertMock{err: errFoo},
+
+			expectForked: testSchemas[:1],
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.ProjectForkRequest{
+				UserID: ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectForkRepository := servicesmocks.NewMockProjectForkRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectForkRepositoryProjectSelect(t)
+				schemaListRepository := servicesmocks.NewMockProjectForkRepositorySchemaList(t)
+				schemaInsertRepository := servicesmocks.NewMockProjectForkRepositorySchemaInsert(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectForkRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{
+							ID: testCase.request.ProjectID,
+						}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.schemaListMock != nil {
+					schemaListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaListRequest{
+							ProjectID: testCase.request.ProjectID,
+						}).
+						Return(testCase.schemaListMock.resp, testCase.schemaListMock.err)
+				}
+
+				if testCase.projectForkMock != nil {
+					projectForkRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectInsertRequest) bool {
+							return req.ID != testCase.request.ProjectID &&
+								req.Owner == testCase.request.UserID &&
+								req.Lang == testProject.Lang &&
+								req.Title == testProject.Title &&
+								assert.Equal(t, testProject.Workflow, req.Workflow) &&
+								assert.Equal(t, &projectID, req.ForkedFrom) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.projectForkMock.resp, testCase.projectForkMock.err)
+				}
+
+				for _, forked := range testCase.expectForked {
+					schemaInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+							return req.ID != forked.ID &&
+								req.ProjectID == forkProjectID &&
+								lo.FromPtr(req.Owner) == testCase.request.UserID &&
+								req.ModuleID == forked.ModuleID &&
+								req.ModuleNamespace == forked.ModuleNamespace &&
+								req.ModuleVersion == forked.ModuleVersion &&
+								req.Source == dao.SchemaSourceFork &&
+								assert.Equal(t, forked.Data, req.Data) &&
+								assert.Equal(t, &forked.ID, req.ForkedFrom) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(nil, testCase.schemaInsertMock.err)
+				}
+
+				service := services.NewProjectFork(
+					projectForkRepository,
+					projectSelectRepository,
+					schemaListRepository,
+					schemaInsertRepository,
+					projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectForkRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				schemaListRepository.AssertExpectations(t)
+				schemaInsertRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -27,28 +27,35 @@ type ProjectInsertRepositoryModuleSelect interface {
 	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
 }
 
+type ProjectInsertRepositoryModuleListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
 type ProjectInitRequest struct {
 	Owner    uuid.UUID `validate:"required"`
 	Lang     string    `validate:"required,langs"`
 	Title    string    `validate:"required,min=1,max=256"`
-	Workflow []string  `validate:"required,min=1,max=64,dive,module,max=512"`
+	Workflow []string  `validate:"required,min=1,max=64,dive,workflowModule,max=512"`
 }
 
 type ProjectInit struct {
 	projectInsertRepository             ProjectInsertRepository
 	projectInsertRepositorySchemaInsert ProjectInsertRepositorySchemaInsert
 	moduleSelectRepository              ProjectInsertRepositoryModuleSelect
+	moduleListVersionsRepository        ProjectInsertRepositoryModuleListVersions
 }
 
 func NewProjectInit(
 	projectInsertRepository ProjectInsertRepository,
 	projectInsertRepositorySchemaInsert ProjectInsertRepositorySchemaInsert,
 	moduleSelectRepository ProjectInsertRepositoryModuleSelect,
+	moduleListVersionsRepository ProjectInsertRepositoryModuleListVersions,
 ) *ProjectInit {
 	return &ProjectInit{
 		projectInsertRepository:             projectInsertRepository,
 		projectInsertRepositorySchemaInsert: projectInsertRepositorySchemaInsert,
 		moduleSelectRepository:              moduleSelectRepository,
+		moduleListVersionsRepository:        moduleListVersionsRepository,
 	}
 }
 
@@ -61,9 +68,17 @@ func (service *ProjectInit) Exec(ctx context.Context, request *ProjectInitReques
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
-	// Validate that all modules in the workflow exist.
-	for _, module := range request.Workflow {
-		decodedModule := lib.DecodeModule(module)
+	// Validate that all modules in the workflow exist. Version ranges are resolved to the version the initial
+	// schemas are pinned to.
+	resolvedModules := make([]lib.DecodedModule, len(request.Workflow))
+
+	for i, module := range request.Workflow {
+		var decodedModule lib.DecodedModule
+
+		decodedModule, err = ResolveModule(ctx, service.moduleListVersionsRepository, module)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
 
 		_, err = service.moduleSelectRepository.Exec(ctx, &dao.ModuleSelectRequest{
 			ID:         decodedModule.Module,
@@ -74,6 +89,8 @@ func (service *ProjectInit) Exec(ctx context.Context, request *ProjectInitReques
 		if err != nil {
 			return nil, otel.ReportError(span, err)
 		}
+
+		resolvedModules[i] = decodedModule
 	}
 
 	var project *dao.Project
@@ -92,19 +109,18 @@ func (service *ProjectInit) Exec(ctx context.Context, request *ProjectInitReques
 		}
 
 		// Init the schemas.
-		for _, module := range request.Workflow {
-			decodedModule := lib.DecodeModule(module)
-
+		for _, decodedModule := range resolvedModules {
 			_, err = service.projectInsertRepositorySchemaInsert.Exec(ctx, &dao.SchemaInsertRequest{
-				ID:              uuid.New(),
-				ProjectID:       project.ID,
-				Owner:           &project.Owner,
-				ModuleID:        decodedModule.Module,
-				ModuleNamespace: decodedModule.Namespace,
-				ModuleVersion:   decodedModule.Version,
-				Source:          dao.SchemaSourceUser,
-				Data:            map[string]any{},
-				Now:             time.Now().UTC(),
+				ID:               uuid.New(),
+				ProjectID:        project.ID,
+				Owner:            &project.Owner,
+				ModuleID:         decodedModule.Module,
+				ModuleNamespace:  decodedModule.Namespace,
+				ModuleVersion:    decodedModule.Version,
+				ModulePreversion: decodedModule.Preversion,
+				Source:           dao.SchemaSourceUser,
+				Data:             map[string]any{},
+				Now:              time.Now().UTC(),
 			})
 			if err != nil {
 				return err
@@ -47,16 +47,24 @@ func TestProjectInit(t *testing.T) {
 		err  error
 	}
 
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.ProjectInitRequest
+		// resolvedWorkflow holds the exact modules the workflow resolves to, when it contains version ranges.
+		resolvedWorkflow []string
 
-		moduleSelectMocks  []*moduleSelectMock
-		expectModuleSelect int
-		projectInsertMock  *projectInsertMock
-		schemaInsertMocks  []*schemaInsertMock
-		expectSchemaInsert int
+		moduleListVersionsMock *moduleListVersionsMock
+		moduleSelectMocks      []*moduleSelectMock
+		expectModuleSelect     int
+		projectInsertMock      *projectInsertMock
+		schemaInsertMocks      []*schemaInsertMock
+		expectSchemaInsert     int
 
 		expect    *services.Project
 		expectErr error
@@ -206,6 +214,137 @@ func TestProjectInit(t *testing.T) {
 				UpdatedAt: baseTime,
 			},
 		},
+		{
+			name: "Success/VersionRange",
+
+			request: &services.ProjectInitRequest{
+				Owner:    ownerID,
+				Lang:     config.LangEN,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@^1.2"},
+			},
+			resolvedWorkflow: []string{"agora:idea@v1.10.1"},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "2.0.0"},
+					{Version: "1.10.1"},
+					{Version: "1.2.0"},
+				},
+			},
+
+			moduleSelectMocks: []*moduleSelectMock{
+				{
+					resp: &dao.Module{
+						ID:        "idea",
+						Namespace: "agora",
+						Version:   "1.10.1",
+					},
+				},
+			},
+			expectModuleSelect: 1,
+
+			projectInsertMock: &projectInsertMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@^1.2"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaInsertMocks: []*schemaInsertMock{
+				{
+					resp: &dao.Schema{
+						ID:              schema1ID,
+						ProjectID:       projectID,
+						Owner:           &ownerID,
+						ModuleID:        "idea",
+						ModuleNamespace: "agora",
+						ModuleVersion:   "1.10.1",
+						Source:          dao.SchemaSourceUser,
+						Data:            map[string]any{},
+						CreatedAt:       baseTime,
+					},
+				},
+			},
+			expectSchemaInsert: 1,
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@^1.2"},
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/WithPreversion",
+
+			request: &services.ProjectInitRequest{
+				Owner:    ownerID,
+				Lang:     config.LangEN,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@v1.0.0-beta-1"},
+			},
+
+			moduleSelectMocks: []*moduleSelectMock{
+				{
+					resp: &dao.Module{
+						ID:         "idea",
+						Namespace:  "agora",
+						Version:    "1.0.0",
+						Preversion: "-beta-1",
+					},
+				},
+			},
+			expectModuleSelect: 1,
+
+			projectInsertMock: &projectInsertMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0-beta-1"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaInsertMocks: []*schemaInsertMock{
+				{
+					resp: &dao.Schema{
+						ID:               schema1ID,
+						ProjectID:        projectID,
+						Owner:            &ownerID,
+						ModuleID:         "idea",
+						ModuleNamespace:  "agora",
+						ModuleVersion:    "1.0.0",
+						ModulePreversion: "-beta-1",
+						Source:           dao.SchemaSourceUser,
+						Data:             map[string]any{},
+						CreatedAt:        baseTime,
+					},
+				},
+			},
+			expectSchemaInsert: 1,
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v1.0.0-beta-1"},
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
 		{
 			name: "Error/InvalidRequest/MissingLang",
 
@@ -278,6 +417,53 @@ func TestProjectInit(t *testing.T) {
 
 			expectErr: services.ErrInvalidRequest,
 		},
+		{
+			name: "Error/InvalidRequest/InvalidModuleRange",
+
+			request: &services.ProjectInitRequest{
+				Owner:    ownerID,
+				Lang:     config.LangEN,
+				Title:    "Test Project",
+				Workflow: []string{"namespace:module@>=1.2.0"},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: &services.ProjectInitRequest{
+				Owner:    ownerID,
+				Lang:     config.LangEN,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@^1.2"},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "2.0.0"},
+					{Version: "1.1.0"},
+				},
+			},
+
+			expectErr: services.ErrModuleRangeNotSatisfied,
+		},
+		{
+			name: "Error/ModuleListVersions",
+
+			request: &services.ProjectInitRequest{
+				Owner:    ownerID,
+				Lang:     config.LangEN,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@^1.2"},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
 		{
 			name: "Error/ModuleNotFound",
 
@@ -445,11 +631,28 @@ func TestProjectInit(t *testing.T) {
 				projectInsertRepository := servicesmocks.NewMockProjectInsertRepository(t)
 				schemaInsertRepository := servicesmocks.NewMockProjectInsertRepositorySchemaInsert(t)
 				moduleSelectRepository := servicesmocks.NewMockProjectInsertRepositoryModuleSelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockProjectInsertRepositoryModuleListVersions(t)
+
+				resolvedWorkflow := testCase.resolvedWorkflow
+				if resolvedWorkflow == nil {
+					resolvedWorkflow = testCase.request.Workflow
+				}
+
+				if testCase.moduleListVersionsMock != nil {
+					decodedModule := lib.DecodeModule(testCase.request.Workflow[0])
+
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        decodedModule.Module,
+							Namespace: decodedModule.Namespace,
+						}).
+						Return(testCase.moduleListVersionsMock.resp, testCase.moduleListVersionsMock.err)
+				}
 
 				for i := range testCase.expectModuleSelect {
 					func(idx int) {
 						mockData := testCase.moduleSelectMocks[idx]
-						decodedModule := lib.DecodeModule(testCase.request.Workflow[idx])
+						decodedModule := lib.DecodeModule(resolvedWorkflow[idx])
 
 						moduleSelectRepository.EXPECT().
 							Exec(mock.Anything, mock.MatchedBy(func(req *dao.ModuleSelectRequest) bool {
@@ -479,7 +682,7 @@ func TestProjectInit(t *testing.T) {
 				for i := range testCase.expectSchemaInsert {
 					func(idx int) {
 						mockData := testCase.schemaInsertMocks[idx]
-						decodedModule := lib.DecodeModule(testCase.request.Workflow[idx])
+						decodedModule := lib.DecodeModule(resolvedWorkflow[idx])
 
 						schemaInsertRepository.EXPECT().
 							Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
@@ -489,6 +692,7 @@ func TestProjectInit(t *testing.T) {
 									req.ModuleID == decodedModule.Module &&
 									req.ModuleNamespace == decodedModule.Namespace &&
 									req.ModuleVersion == decodedModule.Version &&
+									req.ModulePreversion == decodedModule.Preversion &&
 									req.Source == dao.SchemaSourceUser &&
 									len(req.Data) == 0 &&
 									time.Since(req.Now) < time.Minute
@@ -498,7 +702,9 @@ func TestProjectInit(t *testing.T) {
 					}(i)
 				}
 
-				service := services.NewProjectInit(projectInsertRepository, schemaInsertRepository, moduleSelectRepository)
+				service := services.NewProjectInit(
+					projectInsertRepository, schemaInsertRepository, moduleSelectRepository, moduleListVersionsRepository,
+				)
 
 				resp, err := service.Exec(ctx, testCase.request)
 				require.ErrorIs(t, err, testCase.expectErr)
@@ -507,6 +713,7 @@ func TestProjectInit(t *testing.T) {
 				projectInsertRepository.AssertExpectations(t)
 				schemaInsertRepository.AssertExpectations(t)
 				moduleSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
 			})
 		})
 	}
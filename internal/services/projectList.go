@@ -34,6 +34,7 @@ func NewProjectList(
 	}
 }
 
+// Exec lists the projects owned by a user, and those shared with them, most recent first.
 func (service *ProjectList) Exec(ctx context.Context, request *ProjectListRequest) ([]*Project, error) {
 	ctx, span := otel.Tracer().Start(ctx, "service.ProjectList")
 	defer span.End()
@@ -44,7 +45,7 @@ func (service *ProjectList) Exec(ctx context.Context, request *ProjectListReques
 	}
 
 	projects, err := service.projectListRepository.Exec(ctx, &dao.ProjectListRequest{
-		Owner:  request.UserID,
+		UserID: request.UserID,
 		Limit:  request.Limit,
 		Offset: request.Offset,
 	})
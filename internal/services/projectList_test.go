@@ -266,7 +266,7 @@ func TestProjectList(t *testing.T) {
 				if testCase.projectListMock != nil {
 					projectListRepository.EXPECT().
 						Exec(mock.Anything, &dao.ProjectListRequest{
-							Owner:  testCase.request.UserID,
+							UserID: testCase.request.UserID,
 							Limit:  testCase.request.Limit,
 							Offset: testCase.request.Offset,
 						}).
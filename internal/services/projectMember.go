@@ -0,0 +1,36 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+// ErrProjectMemberIsOwner is returned when trying to make the owner of a project one of its members.
+var ErrProjectMemberIsOwner = errors.New("the owner of a project cannot be one of its members")
+
+// ProjectMember is a user a project is shared with, and their role on it.
+type ProjectMember struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+	Role      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func loadProjectMember(member *dao.ProjectMember) *ProjectMember {
+	return &ProjectMember{
+		ProjectID: member.ProjectID,
+		UserID:    member.UserID,
+		Role:      member.Role.String(),
+		CreatedAt: member.CreatedAt,
+		UpdatedAt: member.UpdatedAt,
+	}
+}
+
+func loadProjectMembersMap(member *dao.ProjectMember, _ int) *ProjectMember {
+	return loadProjectMember(member)
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectMemberDeleteRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberDeleteRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectMemberDeleteRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectMemberDeleteRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectMemberDeleteRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	// MemberID is the user to remove from the project.
+	MemberID uuid.UUID `validate:"required"`
+	// UserID is the user removing the member.
+	UserID uuid.UUID `validate:"required"`
+}
+
+type ProjectMemberDelete struct {
+	projectMemberDeleteRepository ProjectMemberDeleteRepository
+	projectSelectRepository       ProjectMemberDeleteRepositoryProjectSelect
+	projectMemberSelectRepository ProjectMemberDeleteRepositoryProjectMemberSelect
+}
+
+func NewProjectMemberDelete(
+	projectMemberDeleteRepository ProjectMemberDeleteRepository,
+	projectSelectRepository ProjectMemberDeleteRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectMemberDeleteRepositoryProjectMemberSelect,
+) *ProjectMemberDelete {
+	return &ProjectMemberDelete{
+		projectMemberDeleteRepository: projectMemberDeleteRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec removes a member from a project. Admins of the project can remove any member, and members can leave the
+// project by removing themselves.
+func (service *ProjectMemberDelete) Exec(
+	ctx context.Context, request *ProjectMemberDeleteRequest,
+) (*ProjectMember, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectMemberDelete")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	requiredRole := dao.ProjectMemberRoleAdmin
+	if request.MemberID == request.UserID {
+		requiredRole = dao.ProjectMemberRoleViewer
+	}
+
+	err = VerifyProjectAccess(ctx, service.projectMemberSelectRepository, project, request.UserID, requiredRole)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	member, err := service.projectMemberDeleteRepository.Exec(ctx, &dao.ProjectMemberDeleteRequest{
+		ProjectID: request.ProjectID,
+		UserID:    request.MemberID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadProjectMember(member)), nil
+}
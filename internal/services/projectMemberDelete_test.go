@@ -0,0 +1,223 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectMemberDelete(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	otherMemberID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testMember := &dao.ProjectMember{
+		ProjectID: projectID,
+		UserID:    memberID,
+		Role:      dao.ProjectMemberRoleViewer,
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testMemberResult := &services.ProjectMember{
+		ProjectID: projectID,
+		UserID:    memberID,
+		Role:      "VIEWER",
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectMemberDeleteMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectMemberDeleteRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectMemberDeleteMock *projectMemberDeleteMock
+
+		expect    *services.ProjectMember
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectMemberDeleteRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberDeleteMock: &projectMemberDeleteMock{resp: testMember},
+
+			expect: testMemberResult,
+		},
+		{
+			name: "Success/Leave",
+
+			request: &services.ProjectMemberDeleteRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				UserID:    memberID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{resp: testMember},
+			projectMemberDeleteMock: &projectMemberDeleteMock{resp: testMember},
+
+			expect: testMemberResult,
+		},
+		{
+			name: "Error/InvalidRequest/MissingMemberID",
+
+			request: &services.ProjectMemberDeleteRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectMemberDeleteRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectMemberDeleteRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				UserID:    otherMemberID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{
+					ProjectID: projectID,
+					UserID:    otherMemberID,
+					Role:      dao.ProjectMemberRoleGenerator,
+				},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/NotFound",
+
+			request: &services.ProjectMemberDeleteRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberDeleteMock: &projectMemberDeleteMock{err: dao.ErrProjectMemberDeleteNotFound},
+
+			expectErr: dao.ErrProjectMemberDeleteNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectMemberDeleteRepository := servicesmocks.NewMockProjectMemberDeleteRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectMemberDeleteRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectMemberDeleteRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.projectMemberDeleteMock != nil {
+					projectMemberDeleteRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberDeleteRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.MemberID,
+						}).
+						Return(testCase.projectMemberDeleteMock.resp, testCase.projectMemberDeleteMock.err)
+				}
+
+				service := services.NewProjectMemberDelete(
+					projectMemberDeleteRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectMemberDeleteRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectMemberListRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberListRequest) ([]*dao.ProjectMember, error)
+}
+
+type ProjectMemberListRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectMemberListRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectMemberListRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+type ProjectMemberList struct {
+	projectMemberListRepository   ProjectMemberListRepository
+	projectSelectRepository       ProjectMemberListRepositoryProjectSelect
+	projectMemberSelectRepository ProjectMemberListRepositoryProjectMemberSelect
+}
+
+func NewProjectMemberList(
+	projectMemberListRepository ProjectMemberListRepository,
+	projectSelectRepository ProjectMemberListRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectMemberListRepositoryProjectMemberSelect,
+) *ProjectMemberList {
+	return &ProjectMemberList{
+		projectMemberListRepository:   projectMemberListRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec lists the members a project is shared with. The owner of the project is not part of the list.
+func (service *ProjectMemberList) Exec(
+	ctx context.Context, request *ProjectMemberListRequest,
+) ([]*ProjectMember, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectMemberList")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	members, err := service.projectMemberListRepository.Exec(ctx, &dao.ProjectMemberListRequest{
+		ProjectID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, lo.Map(members, loadProjectMembersMap)), nil
+}
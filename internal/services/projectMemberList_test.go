@@ -0,0 +1,229 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectMemberList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testMember := &dao.ProjectMember{
+		ProjectID: projectID,
+		UserID:    memberID,
+		Role:      dao.ProjectMemberRoleViewer,
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectMemberListMock struct {
+		resp []*dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectMemberListRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectMemberListMock   *projectMemberListMock
+
+		expect    []*services.ProjectMember
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectMemberListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:     &projectSelectMock{resp: testProject},
+			projectMemberListMock: &projectMemberListMock{resp: []*dao.ProjectMember{testMember}},
+
+			expect: []*services.ProjectMember{
+				{
+					ProjectID: projectID,
+					UserID:    memberID,
+					Role:      "VIEWER",
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/Member",
+
+			request: &services.ProjectMemberListRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{resp: testMember},
+			projectMemberListMock:   &projectMemberListMock{resp: []*dao.ProjectMember{testMember}},
+
+			expect: []*services.ProjectMember{
+				{
+					ProjectID: projectID,
+					UserID:    memberID,
+					Role:      "VIEWER",
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &services.ProjectMemberListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:     &projectSelectMock{resp: testProject},
+			projectMemberListMock: &projectMemberListMock{resp: []*dao.ProjectMember{}},
+
+			expect: []*services.ProjectMember{},
+		},
+		{
+			name: "Error/InvalidRequest/MissingProjectID",
+
+			request: &services.ProjectMemberListRequest{
+				UserID: ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectMemberListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectAccessDenied",
+
+			request: &services.ProjectMemberListRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberList",
+
+			request: &services.ProjectMemberListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:     &projectSelectMock{resp: testProject},
+			projectMemberListMock: &projectMemberListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectMemberListRepository := servicesmocks.NewMockProjectMemberListRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectMemberListRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectMemberListRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.projectMemberListMock != nil {
+					projectMemberListRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberListRequest{ProjectID: testCase.request.ProjectID}).
+						Return(testCase.projectMemberListMock.resp, testCase.projectMemberListMock.err)
+				}
+
+				service := services.NewProjectMemberList(
+					projectMemberListRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectMemberListRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectMemberUpsertRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberUpsertRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectMemberUpsertRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectMemberUpsertRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectMemberUpsertRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	// MemberID is the user the project is shared with.
+	MemberID uuid.UUID `validate:"required"`
+	Role     string    `validate:"required,projectMemberRole"`
+	// UserID is the user sharing the project.
+	UserID uuid.UUID `validate:"required"`
+}
+
+type ProjectMemberUpsert struct {
+	projectMemberUpsertRepository ProjectMemberUpsertRepository
+	projectSelectRepository       ProjectMemberUpsertRepositoryProjectSelect
+	projectMemberSelectRepository ProjectMemberUpsertRepositoryProjectMemberSelect
+}
+
+func NewProjectMemberUpsert(
+	projectMemberUpsertRepository ProjectMemberUpsertRepository,
+	projectSelectRepository ProjectMemberUpsertRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectMemberUpsertRepositoryProjectMemberSelect,
+) *ProjectMemberUpsert {
+	return &ProjectMemberUpsert{
+		projectMemberUpsertRepository: projectMemberUpsertRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec shares a project with a user, or changes the role of an existing member. Only admins of the project can manage
+// its members.
+func (service *ProjectMemberUpsert) Exec(
+	ctx context.Context, request *ProjectMemberUpsertRequest,
+) (*ProjectMember, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectMemberUpsert")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleAdmin,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	if project.Owner == request.MemberID {
+		return nil, otel.ReportError(span, ErrProjectMemberIsOwner)
+	}
+
+	member, err := service.projectMemberUpsertRepository.Exec(ctx, &dao.ProjectMemberUpsertRequest{
+		ProjectID: request.ProjectID,
+		UserID:    request.MemberID,
+		Role:      dao.ProjectMemberRole(request.Role),
+		Now:       time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadProjectMember(member)), nil
+}
@@ -0,0 +1,270 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectMemberUpsert(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	adminID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectMemberUpsertMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectMemberUpsertRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectMemberUpsertMock *projectMemberUpsertMock
+
+		expect    *services.ProjectMember
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				Role:      "EDITOR",
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberUpsertMock: &projectMemberUpsertMock{
+				resp: &dao.ProjectMember{
+					ProjectID: projectID,
+					UserID:    memberID,
+					Role:      dao.ProjectMemberRoleEditor,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ProjectMember{
+				ProjectID: projectID,
+				UserID:    memberID,
+				Role:      "EDITOR",
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/Admin",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				Role:      "ADMIN",
+				UserID:    adminID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: adminID, Role: dao.ProjectMemberRoleAdmin},
+			},
+			projectMemberUpsertMock: &projectMemberUpsertMock{
+				resp: &dao.ProjectMember{
+					ProjectID: projectID,
+					UserID:    memberID,
+					Role:      dao.ProjectMemberRoleAdmin,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ProjectMember{
+				ProjectID: projectID,
+				UserID:    memberID,
+				Role:      "ADMIN",
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
+		{
+			name: "Error/InvalidRequest/MissingMemberID",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				Role:      "EDITOR",
+				UserID:    ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidRole",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				Role:      "OWNER",
+				UserID:    ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				Role:      "EDITOR",
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				Role:      "EDITOR",
+				UserID:    adminID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: adminID, Role: dao.ProjectMemberRoleGenerator},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/MemberIsOwner",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				MemberID:  ownerID,
+				Role:      "VIEWER",
+				UserID:    adminID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: adminID, Role: dao.ProjectMemberRoleAdmin},
+			},
+
+			expectErr: services.ErrProjectMemberIsOwner,
+		},
+		{
+			name: "Error/ProjectMemberUpsert",
+
+			request: &services.ProjectMemberUpsertRequest{
+				ProjectID: projectID,
+				MemberID:  memberID,
+				Role:      "EDITOR",
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberUpsertMock: &projectMemberUpsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectMemberUpsertRepository := servicesmocks.NewMockProjectMemberUpsertRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectMemberUpsertRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectMemberUpsertRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.projectMemberUpsertMock != nil {
+					projectMemberUpsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectMemberUpsertRequest) bool {
+							return req.ProjectID == testCase.request.ProjectID &&
+								req.UserID == testCase.request.MemberID &&
+								req.Role.String() == testCase.request.Role &&
+								!req.Now.IsZero()
+						})).
+						Return(testCase.projectMemberUpsertMock.resp, testCase.projectMemberUpsertMock.err)
+				}
+
+				service := services.NewProjectMemberUpsert(
+					projectMemberUpsertRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectMemberUpsertRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -15,20 +15,27 @@ type ProjectSelectRepository interface {
 	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
 }
 
+type ProjectSelectRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type ProjectSelectRequest struct {
 	ID     uuid.UUID `validate:"required"`
 	UserID uuid.UUID `validate:"required"`
 }
 
 type ProjectSelect struct {
-	projectSelectRepository ProjectSelectRepository
+	projectSelectRepository       ProjectSelectRepository
+	projectMemberSelectRepository ProjectSelectRepositoryProjectMemberSelect
 }
 
 func NewProjectSelect(
 	projectSelectRepository ProjectSelectRepository,
+	projectMemberSelectRepository ProjectSelectRepositoryProjectMemberSelect,
 ) *ProjectSelect {
 	return &ProjectSelect{
-		projectSelectRepository: projectSelectRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
 	}
 }
 
@@ -48,7 +55,9 @@ func (service *ProjectSelect) Exec(ctx context.Context, request *ProjectSelectRe
 		return nil, otel.ReportError(span, err)
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
@@ -34,12 +34,18 @@ func TestProjectSelect(t *testing.T) {
 		err  error
 	}
 
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.ProjectSelectRequest
 
-		projectSelectMock *projectSelectMock
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
 
 		expect    *services.Project
 		expectErr error
@@ -139,8 +145,9 @@ func TestProjectSelect(t *testing.T) {
 					UpdatedAt: baseTime,
 				},
 			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
+			expectErr: services.ErrProjectAccessDenied,
 		},
 	}
 
@@ -152,6 +159,7 @@ func TestProjectSelect(t *testing.T) {
 				t.Helper()
 
 				projectSelectRepository := servicesmocks.NewMockProjectSelectRepository(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectSelectRepositoryProjectMemberSelect(t)
 
 				if testCase.projectSelectMock != nil {
 					projectSelectRepository.EXPECT().
@@ -161,13 +169,23 @@ func TestProjectSelect(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
-				service := services.NewProjectSelect(projectSelectRepository)
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				service := services.NewProjectSelect(projectSelectRepository, projectMemberSelectRepository)
 
 				resp, err := service.Exec(ctx, testCase.request)
 				require.ErrorIs(t, err, testCase.expectErr)
 				require.Equal(t, testCase.expect, resp)
 
 				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
 			})
 		})
 	}
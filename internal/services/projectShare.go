@@ -0,0 +1,91 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+// ErrProjectShareInactive is returned when reading a project through a share link that was revoked, or has expired.
+var ErrProjectShareInactive = errors.New("share link is revoked or expired")
+
+// projectShareTokenLength is the number of random bytes of a share token.
+const projectShareTokenLength = 32
+
+// ProjectShare is a read-only link to a project, for users without an account.
+type ProjectShare struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	// Token grants access to the project. It is only known when the share is created, as only its hash is stored.
+	Token string
+	// Modules restricts the share to some modules of the project, in the "namespace:module" format. The whole project
+	// is shared when empty.
+	Modules   []string
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func loadProjectShare(share *dao.ProjectShare) *ProjectShare {
+	return &ProjectShare{
+		ID:        share.ID,
+		ProjectID: share.ProjectID,
+		Modules:   share.Modules,
+		ExpiresAt: share.ExpiresAt,
+		RevokedAt: share.RevokedAt,
+		CreatedAt: share.CreatedAt,
+	}
+}
+
+func loadProjectSharesMap(share *dao.ProjectShare, _ int) *ProjectShare {
+	return loadProjectShare(share)
+}
+
+// SharedProject is the content of a project, as seen through a share link.
+type SharedProject struct {
+	Project *Project
+	// Schemas are the latest schemas of the shared modules.
+	Schemas []*Schema
+}
+
+// HashProjectShareToken returns the value stored in place of a share token.
+func HashProjectShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func newProjectShareToken() (string, error) {
+	token := make([]byte, projectShareTokenLength)
+
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", fmt.Errorf("generate share token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// ProjectShareActive returns true if the share link can still be used to read the project.
+func ProjectShareActive(share *dao.ProjectShare, now time.Time) bool {
+	return share.RevokedAt == nil && (share.ExpiresAt == nil || share.ExpiresAt.After(now))
+}
+
+// ProjectShareIncludesModule returns true if the share link gives access to the given module.
+func ProjectShareIncludesModule(share *dao.ProjectShare, namespace, module string) bool {
+	if len(share.Modules) == 0 {
+		return true
+	}
+
+	return slices.Contains(share.Modules, lib.DecodedModule{Namespace: namespace, Module: module}.String())
+}
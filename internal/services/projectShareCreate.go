@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+type ProjectShareCreateRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectShareInsertRequest) (*dao.ProjectShare, error)
+}
+
+type ProjectShareCreateRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectShareCreateRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectShareCreateRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	// Modules restricts the share to some modules of the project workflow, in the "namespace:module" format. The
+	// whole project is shared when empty.
+	Modules []string `validate:"max=64,dive,versionlessModule,max=512"`
+	// ExpiresAt is the date after which the share can no longer be used. The share never expires when nil.
+	ExpiresAt *time.Time
+}
+
+type ProjectShareCreate struct {
+	projectShareCreateRepository  ProjectShareCreateRepository
+	projectSelectRepository       ProjectShareCreateRepositoryProjectSelect
+	projectMemberSelectRepository ProjectShareCreateRepositoryProjectMemberSelect
+}
+
+func NewProjectShareCreate(
+	projectShareCreateRepository ProjectShareCreateRepository,
+	projectSelectRepository ProjectShareCreateRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectShareCreateRepositoryProjectMemberSelect,
+) *ProjectShareCreate {
+	return &ProjectShareCreate{
+		projectShareCreateRepository:  projectShareCreateRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec creates a read-only share link for a project. Only the owner of the project can share it this way.
+//
+// The returned share is the only one to carry the token: it cannot be retrieved afterward.
+func (service *ProjectShareCreate) Exec(
+	ctx context.Context, request *ProjectShareCreateRequest,
+) (*ProjectShare, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectShareCreate")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	now := time.Now()
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(now) {
+		return nil, otel.ReportError(span, errors.Join(errors.New("expiration date is in the past"), ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(ctx, service.projectMemberSelectRepository, project, request.UserID, ProjectRoleOwner)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	for _, module := range request.Modules {
+		err = verifyWorkflowModuleName(project, module)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+	}
+
+	token, err := newProjectShareToken()
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	share, err := service.projectShareCreateRepository.Exec(ctx, &dao.ProjectShareInsertRequest{
+		ID:        uuid.New(),
+		ProjectID: project.ID,
+		TokenHash: HashProjectShareToken(token),
+		Modules:   request.Modules,
+		ExpiresAt: request.ExpiresAt,
+		Now:       now,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	output := loadProjectShare(share)
+	output.Token = token
+
+	return otel.ReportSuccess(span, output), nil
+}
+
+// verifyWorkflowModuleName assess that a module, given in the "namespace:module" format, is part of the project's
+// workflow, regardless of its version.
+func verifyWorkflowModuleName(project *dao.Project, module string) error {
+	ok := slices.ContainsFunc(project.Workflow, func(item string) bool {
+		decodedWorkflowModule := lib.DecodeModule(item)
+
+		return lib.DecodedModule{
+			Namespace: decodedWorkflowModule.Namespace,
+			Module:    decodedWorkflowModule.Module,
+		}.String() == module
+	})
+	if !ok {
+		return fmt.Errorf("module '%s': %w", module, ErrModuleNotInProject)
+	}
+
+	return nil
+}
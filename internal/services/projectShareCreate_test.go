@@ -0,0 +1,269 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectShareCreate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	shareID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:idea@v1.0.0", "test-namespace:characters@^1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectShareInsertMock struct {
+		resp *dao.ProjectShare
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectShareCreateRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectShareInsertMock  *projectShareInsertMock
+
+		expect    *services.ProjectShare
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectShareInsertMock: &projectShareInsertMock{
+				resp: &dao.ProjectShare{
+					ID:        shareID,
+					ProjectID: projectID,
+					TokenHash: "hash",
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ProjectShare{
+				ID:        shareID,
+				ProjectID: projectID,
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/Scoped",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Modules:   []string{"test-namespace:characters"},
+				ExpiresAt: &expiresAt,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectShareInsertMock: &projectShareInsertMock{
+				resp: &dao.ProjectShare{
+					ID:        shareID,
+					ProjectID: projectID,
+					TokenHash: "hash",
+					Modules:   []string{"test-namespace:characters"},
+					ExpiresAt: &expiresAt,
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ProjectShare{
+				ID:        shareID,
+				ProjectID: projectID,
+				Modules:   []string{"test-namespace:characters"},
+				ExpiresAt: &expiresAt,
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name: "Error/InvalidRequest/Module",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Modules:   []string{"test-namespace:characters@v1.0.0"},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/Expired",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				ExpiresAt: lo.ToPtr(baseTime),
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleAdmin},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ModuleNotInProject",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Modules:   []string{"test-namespace:places"},
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+
+			expectErr: services.ErrModuleNotInProject,
+		},
+		{
+			name: "Error/ProjectShareInsert",
+
+			request: &services.ProjectShareCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:      &projectSelectMock{resp: testProject},
+			projectShareInsertMock: &projectShareInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectShareInsertRepository := servicesmocks.NewMockProjectShareCreateRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectShareCreateRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectShareCreateRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				var tokenHash string
+
+				if testCase.projectShareInsertMock != nil {
+					projectShareInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectShareInsertRequest) bool {
+							tokenHash = req.TokenHash
+
+							return req.ID != uuid.Nil &&
+								req.ProjectID == testCase.request.ProjectID &&
+								len(req.TokenHash) == 64 &&
+								assert.Equal(t, testCase.request.Modules, req.Modules) &&
+								assert.Equal(t, testCase.request.ExpiresAt, req.ExpiresAt) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.projectShareInsertMock.resp, testCase.projectShareInsertMock.err)
+				}
+
+				service := services.NewProjectShareCreate(
+					projectShareInsertRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+
+				if resp != nil {
+					// The token is random, but only its hash is stored.
+					require.Equal(t, tokenHash, services.HashProjectShareToken(resp.Token))
+
+					resp.Token = ""
+				}
+
+				require.Equal(t, testCase.expect, resp)
+
+				projectShareInsertRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectShareListRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectShareListRequest) ([]*dao.ProjectShare, error)
+}
+
+type ProjectShareListRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectShareListRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectShareListRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+type ProjectShareList struct {
+	projectShareListRepository    ProjectShareListRepository
+	projectSelectRepository       ProjectShareListRepositoryProjectSelect
+	projectMemberSelectRepository ProjectShareListRepositoryProjectMemberSelect
+}
+
+func NewProjectShareList(
+	projectShareListRepository ProjectShareListRepository,
+	projectSelectRepository ProjectShareListRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectShareListRepositoryProjectMemberSelect,
+) *ProjectShareList {
+	return &ProjectShareList{
+		projectShareListRepository:    projectShareListRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec lists the share links of a project, including revoked and expired ones. Only the owner of the project can
+// list them. Tokens are not returned.
+func (service *ProjectShareList) Exec(
+	ctx context.Context, request *ProjectShareListRequest,
+) ([]*ProjectShare, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectShareList")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(ctx, service.projectMemberSelectRepository, project, request.UserID, ProjectRoleOwner)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	shares, err := service.projectShareListRepository.Exec(ctx, &dao.ProjectShareListRequest{
+		ProjectID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, lo.Map(shares, loadProjectSharesMap)), nil
+}
@@ -0,0 +1,210 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectShareList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	shareID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testShare := &dao.ProjectShare{
+		ID:        shareID,
+		ProjectID: projectID,
+		TokenHash: "hash",
+		Modules:   []string{"test-namespace:test-module"},
+		RevokedAt: &baseTime,
+		CreatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectShareListMock struct {
+		resp []*dao.ProjectShare
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectShareListRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectShareListMock    *projectShareListMock
+
+		expect    []*services.ProjectShare
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectShareListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:    &projectSelectMock{resp: testProject},
+			projectShareListMock: &projectShareListMock{resp: []*dao.ProjectShare{testShare}},
+
+			expect: []*services.ProjectShare{
+				{
+					ID:        shareID,
+					ProjectID: projectID,
+					Modules:   []string{"test-namespace:test-module"},
+					RevokedAt: &baseTime,
+					CreatedAt: baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &services.ProjectShareListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:    &projectSelectMock{resp: testProject},
+			projectShareListMock: &projectShareListMock{resp: []*dao.ProjectShare{}},
+
+			expect: []*services.ProjectShare{},
+		},
+		{
+			name: "Error/InvalidRequest/MissingProjectID",
+
+			request: &services.ProjectShareListRequest{
+				UserID: ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectShareListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectShareListRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleAdmin},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectShareList",
+
+			request: &services.ProjectShareListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:    &projectSelectMock{resp: testProject},
+			projectShareListMock: &projectShareListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectShareListRepository := servicesmocks.NewMockProjectShareListRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectShareListRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectShareListRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.projectShareListMock != nil {
+					projectShareListRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectShareListRequest{ProjectID: testCase.request.ProjectID}).
+						Return(testCase.projectShareListMock.resp, testCase.projectShareListMock.err)
+				}
+
+				service := services.NewProjectShareList(
+					projectShareListRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectShareListRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectShareRevokeRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectShareRevokeRequest) (*dao.ProjectShare, error)
+}
+
+type ProjectShareRevokeRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectShareRevokeRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectShareRevokeRequest struct {
+	// ID of the share link to revoke.
+	ID        uuid.UUID `validate:"required"`
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+type ProjectShareRevoke struct {
+	projectShareRevokeRepository  ProjectShareRevokeRepository
+	projectSelectRepository       ProjectShareRevokeRepositoryProjectSelect
+	projectMemberSelectRepository ProjectShareRevokeRepositoryProjectMemberSelect
+}
+
+func NewProjectShareRevoke(
+	projectShareRevokeRepository ProjectShareRevokeRepository,
+	projectSelectRepository ProjectShareRevokeRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectShareRevokeRepositoryProjectMemberSelect,
+) *ProjectShareRevoke {
+	return &ProjectShareRevoke{
+		projectShareRevokeRepository:  projectShareRevokeRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec revokes a share link of a project, which can no longer be used to read it. Only the owner of the project can
+// revoke its links.
+func (service *ProjectShareRevoke) Exec(
+	ctx context.Context, request *ProjectShareRevokeRequest,
+) (*ProjectShare, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectShareRevoke")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(ctx, service.projectMemberSelectRepository, project, request.UserID, ProjectRoleOwner)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	share, err := service.projectShareRevokeRepository.Exec(ctx, &dao.ProjectShareRevokeRequest{
+		ID:        request.ID,
+		ProjectID: request.ProjectID,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadProjectShare(share)), nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+type ProjectShareSelectRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectShareSelectRequest) (*dao.ProjectShare, error)
+}
+
+type ProjectShareSelectRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectShareSelectRepositorySchemaList interface {
+	Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)
+}
+
+type ProjectShareSelectRequest struct {
+	Token string `validate:"required,max=256"`
+}
+
+type ProjectShareSelect struct {
+	projectShareSelectRepository ProjectShareSelectRepository
+	projectSelectRepository      ProjectShareSelectRepositoryProjectSelect
+	schemaListRepository         ProjectShareSelectRepositorySchemaList
+}
+
+func NewProjectShareSelect(
+	projectShareSelectRepository ProjectShareSelectRepository,
+	projectSelectRepository ProjectShareSelectRepositoryProjectSelect,
+	schemaListRepository ProjectShareSelectRepositorySchemaList,
+) *ProjectShareSelect {
+	return &ProjectShareSelect{
+		projectShareSelectRepository: projectShareSelectRepository,
+		projectSelectRepository:      projectSelectRepository,
+		schemaListRepository:         schemaListRepository,
+	}
+}
+
+// Exec reads a project through a share link. It returns the project and the latest schema of each module of its
+// workflow. When the link is restricted to some modules, other modules are removed from both the workflow and the
+// schemas.
+func (service *ProjectShareSelect) Exec(
+	ctx context.Context, request *ProjectShareSelectRequest,
+) (*SharedProject, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectShareSelect")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	share, err := service.projectShareSelectRepository.Exec(ctx, &dao.ProjectShareSelectRequest{
+		TokenHash: HashProjectShareToken(request.Token),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	if !ProjectShareActive(share, time.Now()) {
+		return nil, otel.ReportError(span, ErrProjectShareInactive)
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: share.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	schemas, err := service.schemaListRepository.Exec(ctx, &dao.SchemaListRequest{
+		ProjectID: share.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	workflow := lo.Filter(project.Workflow, func(item string, _ int) bool {
+		decoded := lib.DecodeModule(item)
+
+		return ProjectShareIncludesModule(share, decoded.Namespace, decoded.Module)
+	})
+
+	output := &SharedProject{
+		Project: loadProject(project),
+		Schemas: lo.FilterMap(schemas, func(item *dao.Schema, _ int) (*Schema, bool) {
+			return loadSchema(item), WorkflowIncludesModule(workflow, item.ModuleNamespace, item.ModuleID)
+		}),
+	}
+
+	output.Project.Workflow = workflow
+
+	return otel.ReportSuccess(span, output), nil
+}
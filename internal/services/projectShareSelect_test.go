@@ -0,0 +1,321 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectShareSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	shareID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	ideaSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000301")
+	charactersSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000302")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:idea@v1.0.0", "test-namespace:characters@^1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testSchemas := []*dao.Schema{
+		{
+			ID:              ideaSchemaID,
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "idea",
+			ModuleNamespace: "test-namespace",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"idea": "a story"},
+			CreatedAt:       baseTime,
+		},
+		{
+			ID:              charactersSchemaID,
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "characters",
+			ModuleNamespace: "test-namespace",
+			ModuleVersion:   "1.2.0",
+			Source:          dao.SchemaSourceAI,
+			Data:            map[string]any{"characters": []any{"alice"}},
+			CreatedAt:       baseTime,
+		},
+	}
+
+	expectIdeaSchema := &services.Schema{
+		ID:              ideaSchemaID,
+		ProjectID:       projectID,
+		Owner:           &ownerID,
+		ModuleID:        "idea",
+		ModuleNamespace: "test-namespace",
+		ModuleVersion:   "1.0.0",
+		Source:          "USER",
+		Data:            map[string]any{"idea": "a story"},
+		CreatedAt:       baseTime,
+	}
+
+	expectCharactersSchema := &services.Schema{
+		ID:              charactersSchemaID,
+		ProjectID:       projectID,
+		Owner:           &ownerID,
+		ModuleID:        "characters",
+		ModuleNamespace: "test-namespace",
+		ModuleVersion:   "1.2.0",
+		Source:          "AI",
+		Data:            map[string]any{"characters": []any{"alice"}},
+		CreatedAt:       baseTime,
+	}
+
+	type projectShareSelectMock struct {
+		resp *dao.ProjectShare
+		err  error
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type schemaListMock struct {
+		resp []*dao.Schema
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectShareSelectRequest
+
+		projectShareSelectMock *projectShareSelectMock
+		projectSelectMock      *projectSelectMock
+		schemaListMock         *schemaListMock
+
+		expect    *services.SharedProject
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{
+				resp: &dao.ProjectShare{ID: shareID, ProjectID: projectID, CreatedAt: baseTime},
+			},
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{resp: testSchemas},
+
+			expect: &services.SharedProject{
+				Project: &services.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:idea@v1.0.0", "test-namespace:characters@^1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+				Schemas: []*services.Schema{expectIdeaSchema, expectCharactersSchema},
+			},
+		},
+		{
+			name: "Success/Scoped",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{
+				resp: &dao.ProjectShare{
+					ID:        shareID,
+					ProjectID: projectID,
+					Modules:   []string{"test-namespace:characters"},
+					ExpiresAt: lo.ToPtr(time.Now().Add(time.Hour)),
+					CreatedAt: baseTime,
+				},
+			},
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{resp: testSchemas},
+
+			expect: &services.SharedProject{
+				Project: &services.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:characters@^1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+				Schemas: []*services.Schema{expectCharactersSchema},
+			},
+		},
+		{
+			name: "Success/RemovedModule",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{
+				resp: &dao.ProjectShare{ID: shareID, ProjectID: projectID, CreatedAt: baseTime},
+			},
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			// The last version with data of a module removed from the workflow.
+			schemaListMock: &schemaListMock{resp: append(slices.Clone(testSchemas), &dao.Schema{
+				ID:              uuid.MustParse("00000000-0000-0000-0000-000000000303"),
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "plot",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          dao.SchemaSourceUser,
+				Data:            map[string]any{"plot": "removed"},
+				CreatedAt:       baseTime,
+			})},
+
+			expect: &services.SharedProject{
+				Project: &services.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:idea@v1.0.0", "test-namespace:characters@^1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+				Schemas: []*services.Schema{expectIdeaSchema, expectCharactersSchema},
+			},
+		},
+		{
+			name: "Error/InvalidRequest/MissingToken",
+
+			request: &services.ProjectShareSelectRequest{},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectShareSelect",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{err: dao.ErrProjectShareSelectNotFound},
+
+			expectErr: dao.ErrProjectShareSelectNotFound,
+		},
+		{
+			name: "Error/Revoked",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{
+				resp: &dao.ProjectShare{ID: shareID, ProjectID: projectID, RevokedAt: &baseTime, CreatedAt: baseTime},
+			},
+
+			expectErr: services.ErrProjectShareInactive,
+		},
+		{
+			name: "Error/Expired",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{
+				resp: &dao.ProjectShare{ID: shareID, ProjectID: projectID, ExpiresAt: &baseTime, CreatedAt: baseTime},
+			},
+
+			expectErr: services.ErrProjectShareInactive,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{
+				resp: &dao.ProjectShare{ID: shareID, ProjectID: projectID, CreatedAt: baseTime},
+			},
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaList",
+
+			request: &services.ProjectShareSelectRequest{Token: "token"},
+
+			projectShareSelectMock: &projectShareSelectMock{
+				resp: &dao.ProjectShare{ID: shareID, ProjectID: projectID, CreatedAt: baseTime},
+			},
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaListMock:    &schemaListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectShareSelectRepository := servicesmocks.NewMockProjectShareSelectRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectShareSelectRepositoryProjectSelect(t)
+				schemaListRepository := servicesmocks.NewMockProjectShareSelectRepositorySchemaList(t)
+
+				if testCase.projectShareSelectMock != nil {
+					projectShareSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectShareSelectRequest{
+							TokenHash: services.HashProjectShareToken(testCase.request.Token),
+						}).
+						Return(testCase.projectShareSelectMock.resp, testCase.projectShareSelectMock.err)
+				}
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: projectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.schemaListMock != nil {
+					schemaListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaListRequest{ProjectID: projectID}).
+						Return(testCase.schemaListMock.resp, testCase.schemaListMock.err)
+				}
+
+				service := services.NewProjectShareSelect(
+					projectShareSelectRepository, projectSelectRepository, schemaListRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectShareSelectRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				schemaListRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
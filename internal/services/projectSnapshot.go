@@ -0,0 +1,47 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+// ErrProjectSnapshotOutdated is returned when restoring a snapshot that references module versions the project
+// workflow no longer uses.
+var ErrProjectSnapshotOutdated = errors.New("snapshot uses module versions that are no longer in the project")
+
+// ProjectSnapshot is a named capture of a project, that references the latest version of each of its schemas at
+// the time it was taken.
+type ProjectSnapshot struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	// Owner is the user who took the snapshot.
+	Owner     uuid.UUID
+	Name      string
+	SchemaIDs []uuid.UUID
+	CreatedAt time.Time
+}
+
+func loadProjectSnapshot(snapshot *dao.ProjectSnapshot) *ProjectSnapshot {
+	return &ProjectSnapshot{
+		ID:        snapshot.ID,
+		ProjectID: snapshot.ProjectID,
+		Owner:     snapshot.Owner,
+		Name:      snapshot.Name,
+		SchemaIDs: snapshot.SchemaIDs,
+		CreatedAt: snapshot.CreatedAt,
+	}
+}
+
+func loadProjectSnapshotsMap(snapshot *dao.ProjectSnapshot, _ int) *ProjectSnapshot {
+	return loadProjectSnapshot(snapshot)
+}
+
+// ProjectSnapshotContent is a snapshot, along with the schema versions it captured.
+type ProjectSnapshotContent struct {
+	Snapshot *ProjectSnapshot
+	Schemas  []*Schema
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectSnapshotCreateRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectSnapshotInsertRequest) (*dao.ProjectSnapshot, error)
+}
+
+type ProjectSnapshotCreateRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectSnapshotCreateRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectSnapshotCreateRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	Name      string    `validate:"required,min=1,max=256"`
+}
+
+type ProjectSnapshotCreate struct {
+	projectSnapshotCreateRepository ProjectSnapshotCreateRepository
+	projectSelectRepository         ProjectSnapshotCreateRepositoryProjectSelect
+	projectMemberSelectRepository   ProjectSnapshotCreateRepositoryProjectMemberSelect
+}
+
+func NewProjectSnapshotCreate(
+	projectSnapshotCreateRepository ProjectSnapshotCreateRepository,
+	projectSelectRepository ProjectSnapshotCreateRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectSnapshotCreateRepositoryProjectMemberSelect,
+) *ProjectSnapshotCreate {
+	return &ProjectSnapshotCreate{
+		projectSnapshotCreateRepository: projectSnapshotCreateRepository,
+		projectSelectRepository:         projectSelectRepository,
+		projectMemberSelectRepository:   projectMemberSelectRepository,
+	}
+}
+
+// Exec takes a named snapshot of the current state of a project. The name must be unique within the project.
+func (service *ProjectSnapshotCreate) Exec(
+	ctx context.Context, request *ProjectSnapshotCreateRequest,
+) (*ProjectSnapshot, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectSnapshotCreate")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleEditor,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	snapshot, err := service.projectSnapshotCreateRepository.Exec(ctx, &dao.ProjectSnapshotInsertRequest{
+		ID:        uuid.New(),
+		ProjectID: project.ID,
+		Owner:     request.UserID,
+		Name:      request.Name,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadProjectSnapshot(snapshot)), nil
+}
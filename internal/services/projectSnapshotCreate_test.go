@@ -0,0 +1,253 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectSnapshotCreate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	snapshotID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectSnapshotCreateMock struct {
+		resp *dao.ProjectSnapshot
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectSnapshotCreateRequest
+
+		projectSelectMock         *projectSelectMock
+		projectMemberSelectMock   *projectMemberSelectMock
+		projectSnapshotCreateMock *projectSnapshotCreateMock
+
+		expect    *services.ProjectSnapshot
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectSnapshotCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Name:      "Sent to my publisher",
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectSnapshotCreateMock: &projectSnapshotCreateMock{
+				resp: &dao.ProjectSnapshot{
+					ID:        snapshotID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Name:      "Sent to my publisher",
+					SchemaIDs: []uuid.UUID{schemaID},
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ProjectSnapshot{
+				ID:        snapshotID,
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Name:      "Sent to my publisher",
+				SchemaIDs: []uuid.UUID{schemaID},
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/Editor",
+
+			request: &services.ProjectSnapshotCreateRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+				Name:      "Draft 2",
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleEditor},
+			},
+			projectSnapshotCreateMock: &projectSnapshotCreateMock{
+				resp: &dao.ProjectSnapshot{
+					ID:        snapshotID,
+					ProjectID: projectID,
+					Owner:     memberID,
+					Name:      "Draft 2",
+					SchemaIDs: []uuid.UUID{},
+					CreatedAt: baseTime,
+				},
+			},
+
+			expect: &services.ProjectSnapshot{
+				ID:        snapshotID,
+				ProjectID: projectID,
+				Owner:     memberID,
+				Name:      "Draft 2",
+				SchemaIDs: []uuid.UUID{},
+				CreatedAt: baseTime,
+			},
+		},
+		{
+			name: "Error/InvalidRequest/MissingName",
+
+			request: &services.ProjectSnapshotCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/NameTooLong",
+
+			request: &services.ProjectSnapshotCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Name:      strings.Repeat("a", 257),
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectSnapshotCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Name:      "Draft 2",
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectSnapshotCreateRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+				Name:      "Draft 2",
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleViewer},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/AlreadyExists",
+
+			request: &services.ProjectSnapshotCreateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Name:      "Draft 1",
+			},
+
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			projectSnapshotCreateMock: &projectSnapshotCreateMock{err: dao.ErrProjectSnapshotInsertAlreadyExists},
+
+			expectErr: dao.ErrProjectSnapshotInsertAlreadyExists,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectSnapshotCreateRepository := servicesmocks.NewMockProjectSnapshotCreateRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectSnapshotCreateRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectSnapshotCreateRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.projectSnapshotCreateMock != nil {
+					projectSnapshotCreateRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectSnapshotInsertRequest) bool {
+							return req.ID != uuid.Nil &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Owner == testCase.request.UserID &&
+								req.Name == testCase.request.Name &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.projectSnapshotCreateMock.resp, testCase.projectSnapshotCreateMock.err)
+				}
+
+				service := services.NewProjectSnapshotCreate(
+					projectSnapshotCreateRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectSnapshotCreateRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
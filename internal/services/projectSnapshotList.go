@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectSnapshotListRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectSnapshotListRequest) ([]*dao.ProjectSnapshot, error)
+}
+
+type ProjectSnapshotListRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectSnapshotListRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectSnapshotListRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+}
+
+type ProjectSnapshotList struct {
+	projectSnapshotListRepository ProjectSnapshotListRepository
+	projectSelectRepository       ProjectSnapshotListRepositoryProjectSelect
+	projectMemberSelectRepository ProjectSnapshotListRepositoryProjectMemberSelect
+}
+
+func NewProjectSnapshotList(
+	projectSnapshotListRepository ProjectSnapshotListRepository,
+	projectSelectRepository ProjectSnapshotListRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectSnapshotListRepositoryProjectMemberSelect,
+) *ProjectSnapshotList {
+	return &ProjectSnapshotList{
+		projectSnapshotListRepository: projectSnapshotListRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec lists the snapshots of a project, in the order they were taken.
+func (service *ProjectSnapshotList) Exec(
+	ctx context.Context, request *ProjectSnapshotListRequest,
+) ([]*ProjectSnapshot, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectSnapshotList")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	snapshots, err := service.projectSnapshotListRepository.Exec(ctx, &dao.ProjectSnapshotListRequest{
+		ProjectID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, lo.Map(snapshots, loadProjectSnapshotsMap)), nil
+}
@@ -0,0 +1,213 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectSnapshotList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	snapshotID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testSnapshot := &dao.ProjectSnapshot{
+		ID:        snapshotID,
+		ProjectID: projectID,
+		Owner:     ownerID,
+		Name:      "Draft 1",
+		SchemaIDs: []uuid.UUID{schemaID},
+		CreatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectSnapshotListMock struct {
+		resp []*dao.ProjectSnapshot
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectSnapshotListRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectSnapshotListMock *projectSnapshotListMock
+
+		expect    []*services.ProjectSnapshot
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectSnapshotListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectSnapshotListMock: &projectSnapshotListMock{resp: []*dao.ProjectSnapshot{testSnapshot}},
+
+			expect: []*services.ProjectSnapshot{
+				{
+					ID:        snapshotID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Name:      "Draft 1",
+					SchemaIDs: []uuid.UUID{schemaID},
+					CreatedAt: baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/Viewer",
+
+			request: &services.ProjectSnapshotListRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleViewer},
+			},
+			projectSnapshotListMock: &projectSnapshotListMock{resp: []*dao.ProjectSnapshot{}},
+
+			expect: []*services.ProjectSnapshot{},
+		},
+		{
+			name: "Error/InvalidRequest/MissingProjectID",
+
+			request: &services.ProjectSnapshotListRequest{
+				UserID: ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectSnapshotListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/NotMember",
+
+			request: &services.ProjectSnapshotListRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectSnapshotList",
+
+			request: &services.ProjectSnapshotListRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectSnapshotListMock: &projectSnapshotListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectSnapshotListRepository := servicesmocks.NewMockProjectSnapshotListRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectSnapshotListRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectSnapshotListRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.projectSnapshotListMock != nil {
+					projectSnapshotListRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSnapshotListRequest{ProjectID: testCase.request.ProjectID}).
+						Return(testCase.projectSnapshotListMock.resp, testCase.projectSnapshotListMock.err)
+				}
+
+				service := services.NewProjectSnapshotList(
+					projectSnapshotListRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectSnapshotListRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+type ProjectSnapshotRestoreRepository interface {
+	Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)
+}
+
+type ProjectSnapshotRestoreRepositoryProjectSnapshotSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error)
+}
+
+type ProjectSnapshotRestoreRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectSnapshotRestoreRepositorySchemaList interface {
+	Exec(ctx context.Context, request *dao.SchemaListByIDsRequest) ([]*dao.Schema, error)
+}
+
+type ProjectSnapshotRestoreRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectSnapshotRestoreRequest struct {
+	ID     uuid.UUID `validate:"required"`
+	UserID uuid.UUID `validate:"required"`
+}
+
+type ProjectSnapshotRestore struct {
+	projectSnapshotRestoreRepository ProjectSnapshotRestoreRepository
+	projectSnapshotSelectRepository  ProjectSnapshotRestoreRepositoryProjectSnapshotSelect
+	projectSelectRepository          ProjectSnapshotRestoreRepositoryProjectSelect
+	schemaListRepository             ProjectSnapshotRestoreRepositorySchemaList
+	projectMemberSelectRepository    ProjectSnapshotRestoreRepositoryProjectMemberSelect
+}
+
+func NewProjectSnapshotRestore(
+	projectSnapshotRestoreRepository ProjectSnapshotRestoreRepository,
+	projectSnapshotSelectRepository ProjectSnapshotRestoreRepositoryProjectSnapshotSelect,
+	projectSelectRepository ProjectSnapshotRestoreRepositoryProjectSelect,
+	schemaListRepository ProjectSnapshotRestoreRepositorySchemaList,
+	projectMemberSelectRepository ProjectSnapshotRestoreRepositoryProjectMemberSelect,
+) *ProjectSnapshotRestore {
+	return &ProjectSnapshotRestore{
+		projectSnapshotRestoreRepository: projectSnapshotRestoreRepository,
+		projectSnapshotSelectRepository:  projectSnapshotSelectRepository,
+		projectSelectRepository:          projectSelectRepository,
+		schemaListRepository:             schemaListRepository,
+		projectMemberSelectRepository:    projectMemberSelectRepository,
+	}
+}
+
+// Exec restores a project to the state captured by a snapshot. The data of each captured schema is saved as a new
+// version of its module, written by the user, so the history of the project is kept, and the restoration can itself
+// be undone.
+//
+// Modules removed from the workflow since the snapshot was taken are not restored, and modules added since are left
+// as is. Captured data is not migrated: the whole snapshot cannot be restored once any captured module has been
+// upgraded to another version, as its data may no longer match the module.
+func (service *ProjectSnapshotRestore) Exec(
+	ctx context.Context, request *ProjectSnapshotRestoreRequest,
+) ([]*Schema, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectSnapshotRestore")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	snapshot, err := service.projectSnapshotSelectRepository.Exec(ctx, &dao.ProjectSnapshotSelectRequest{
+		ID: request.ID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: snapshot.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleEditor,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	schemas, err := service.schemaListRepository.Exec(ctx, &dao.SchemaListByIDsRequest{
+		IDs: snapshot.SchemaIDs,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	restorable := make([]*dao.Schema, 0, len(schemas))
+
+	for _, schema := range schemas {
+		if verifyWorkflowModuleName(project, lib.DecodedModule{
+			Namespace: schema.ModuleNamespace,
+			Module:    schema.ModuleID,
+		}.String()) != nil {
+			continue
+		}
+
+		module := lib.DecodedModule{
+			Namespace:  schema.ModuleNamespace,
+			Module:     schema.ModuleID,
+			Version:    schema.ModuleVersion,
+			Preversion: schema.ModulePreversion,
+		}.String()
+
+		if VerifyModule(project, module) != nil {
+			return nil, otel.ReportError(span, fmt.Errorf("module '%s': %w", module, ErrProjectSnapshotOutdated))
+		}
+
+		restorable = append(restorable, schema)
+	}
+
+	output := make([]*Schema, 0, len(restorable))
+
+	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
+		now := time.Now().UTC()
+
+		for _, schema := range restorable {
+			var restored *dao.Schema
+
+			restored, err = service.projectSnapshotRestoreRepository.Exec(ctx, &dao.SchemaInsertRequest{
+				ID:               uuid.New(),
+				ProjectID:        project.ID,
+				Owner:            &request.UserID,
+				ModuleID:         schema.ModuleID,
+				ModuleNamespace:  schema.ModuleNamespace,
+				ModuleVersion:    schema.ModuleVersion,
+				ModulePreversion: schema.ModulePreversion,
+				Source:           dao.SchemaSourceUser,
+				Data:             schema.Data,
+				Now:              now,
+			})
+			if err != nil {
+				return err
+			}
+
+			output = append(output, loadSchema(restored))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, output), nil
+}
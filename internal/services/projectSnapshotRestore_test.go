@@ -0,0 +1,367 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	snapshotID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	ideaSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+	pitchSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000301")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test:idea@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testSnapshot := &dao.ProjectSnapshot{
+		ID:        snapshotID,
+		ProjectID: projectID,
+		Owner:     ownerID,
+		Name:      "Draft 1",
+		SchemaIDs: []uuid.UUID{ideaSchemaID, pitchSchemaID},
+		CreatedAt: baseTime,
+	}
+
+	testIdeaSchema := &dao.Schema{
+		ID:              ideaSchemaID,
+		ProjectID:       projectID,
+		Owner:           &ownerID,
+		ModuleID:        "idea",
+		ModuleNamespace: "test",
+		ModuleVersion:   "1.0.0",
+		Source:          dao.SchemaSourceAI,
+		Data:            map[string]any{"title": "Draft 1"},
+		CreatedAt:       baseTime,
+	}
+
+	// The pitch module was removed from the workflow after the snapshot was taken.
+	testPitchSchema := &dao.Schema{
+		ID:              pitchSchemaID,
+		ProjectID:       projectID,
+		Owner:           &ownerID,
+		ModuleID:        "pitch",
+		ModuleNamespace: "test",
+		ModuleVersion:   "1.0.0",
+		Source:          dao.SchemaSourceUser,
+		Data:            map[string]any{"pitch": "A story."},
+		CreatedAt:       baseTime,
+	}
+
+	type projectSnapshotSelectMock struct {
+		resp *dao.ProjectSnapshot
+		err  error
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type schemaListMock struct {
+		resp []*dao.Schema
+		err  error
+	}
+
+	type projectSnapshotRestoreMock struct {
+		err error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectSnapshotRestoreRequest
+
+		projectSnapshotSelectMock  *projectSnapshotSelectMock
+		projectSelectMock          *projectSelectMock
+		projectMemberSelectMock    *projectMemberSelectMock
+		schemaListMock             *schemaListMock
+		projectSnapshotRestoreMock *projectSnapshotRestoreMock
+
+		// expectRestored lists the captured schemas expected to be saved as new versions.
+		expectRestored []*dao.Schema
+
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock:  &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:          &projectSelectMock{resp: testProject},
+			schemaListMock:             &schemaListMock{resp: []*dao.Schema{testIdeaSchema, testPitchSchema}},
+			projectSnapshotRestoreMock: &projectSnapshotRestoreMock{},
+
+			expectRestored: []*dao.Schema{testIdeaSchema},
+		},
+		{
+			name: "Success/Editor",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: memberID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleEditor},
+			},
+			schemaListMock:             &schemaListMock{resp: []*dao.Schema{testIdeaSchema}},
+			projectSnapshotRestoreMock: &projectSnapshotRestoreMock{},
+
+			expectRestored: []*dao.Schema{testIdeaSchema},
+		},
+		{
+			name: "Error/InvalidRequest/MissingID",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				UserID: ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSnapshotSelect",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{err: dao.ErrProjectSnapshotSelectNotFound},
+
+			expectErr: dao.ErrProjectSnapshotSelectNotFound,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: memberID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleViewer},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/SchemaList",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			schemaListMock:            &schemaListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Outdated",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test:idea@v2.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			schemaListMock: &schemaListMock{resp: []*dao.Schema{testIdeaSchema}},
+
+			expectErr: services.ErrProjectSnapshotOutdated,
+		},
+		{
+			name: "Error/SchemaInsert",
+
+			request: &services.ProjectSnapshotRestoreRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock:  &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:          &projectSelectMock{resp: testProject},
+			schemaListMock:             &schemaListMock{resp: []*dao.Schema{testIdeaSchema}},
+			projectSnapshotRestoreMock: &projectSnapshotRestoreMock{err: errFoo},
+
+			expectRestored: []*dao.Schema{testIdeaSchema},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectSnapshotRestoreRepository := servicesmocks.NewMockProjectSnapshotRestoreRepository(t)
+				projectSnapshotSelectRepository := servicesmocks.
+					NewMockProjectSnapshotRestoreRepositoryProjectSnapshotSelect(t)
+				projectSelectRepository := servicesmocks.NewMockProjectSnapshotRestoreRepositoryProjectSelect(t)
+				schemaListRepository := servicesmocks.NewMockProjectSnapshotRestoreRepositorySchemaList(t)
+				projectMemberSelectRepository := servicesmocks.
+					NewMockProjectSnapshotRestoreRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSnapshotSelectMock != nil {
+					projectSnapshotSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSnapshotSelectRequest{ID: testCase.request.ID}).
+						Return(testCase.projectSnapshotSelectMock.resp, testCase.projectSnapshotSelectMock.err)
+				}
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: projectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: projectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.schemaListMock != nil {
+					schemaListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaListByIDsRequest{IDs: testSnapshot.SchemaIDs}).
+						Return(testCase.schemaListMock.resp, testCase.schemaListMock.err)
+				}
+
+				for _, restored := range testCase.expectRestored {
+					projectSnapshotRestoreRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+							return req.ID != restored.ID &&
+								req.ProjectID == projectID &&
+								lo.FromPtr(req.Owner) == testCase.request.UserID &&
+								req.ModuleID == restored.ModuleID &&
+								req.ModuleNamespace == restored.ModuleNamespace &&
+								req.ModuleVersion == restored.ModuleVersion &&
+								// Restored versions are written by the user.
+								req.Source == dao.SchemaSourceUser &&
+								assert.Equal(t, restored.Data, req.Data) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						RunAndReturn(func(_ context.Context, req *dao.SchemaInsertRequest) (*dao.Schema, error) {
+							if testCase.projectSnapshotRestoreMock.err != nil {
+								return nil, testCase.projectSnapshotRestoreMock.err
+							}
+
+							return &dao.Schema{
+								ID:              req.ID,
+								ProjectID:       req.ProjectID,
+								Owner:           req.Owner,
+								ModuleID:        req.ModuleID,
+								ModuleNamespace: req.ModuleNamespace,
+								ModuleVersion:   req.ModuleVersion,
+								Source:          req.Source,
+								Data:            req.Data,
+								CreatedAt:       req.Now,
+							}, nil
+						})
+				}
+
+				service := services.NewProjectSnapshotRestore(
+					projectSnapshotRestoreRepository,
+					projectSnapshotSelectRepository,
+					projectSelectRepository,
+					schemaListRepository,
+					projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+
+				if testCase.expectErr == nil {
+					require.Len(t, resp, len(testCase.expectRestored))
+
+					for i, restored := range testCase.expectRestored {
+						require.NotEqual(t, restored.ID, resp[i].ID)
+						require.Equal(t, testCase.request.UserID, lo.FromPtr(resp[i].Owner))
+						require.Equal(t, restored.ModuleID, resp[i].ModuleID)
+						require.Equal(t, dao.SchemaSourceUser.String(), resp[i].Source)
+						require.Equal(t, restored.Data, resp[i].Data)
+					}
+				}
+
+				projectSnapshotRestoreRepository.AssertExpectations(t)
+				projectSnapshotSelectRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				schemaListRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
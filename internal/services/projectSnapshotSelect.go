@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectSnapshotSelectRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectSnapshotSelectRequest) (*dao.ProjectSnapshot, error)
+}
+
+type ProjectSnapshotSelectRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectSnapshotSelectRepositorySchemaList interface {
+	Exec(ctx context.Context, request *dao.SchemaListByIDsRequest) ([]*dao.Schema, error)
+}
+
+type ProjectSnapshotSelectRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectSnapshotSelectRequest struct {
+	ID     uuid.UUID `validate:"required"`
+	UserID uuid.UUID `validate:"required"`
+}
+
+type ProjectSnapshotSelect struct {
+	projectSnapshotSelectRepository ProjectSnapshotSelectRepository
+	projectSelectRepository         ProjectSnapshotSelectRepositoryProjectSelect
+	schemaListRepository            ProjectSnapshotSelectRepositorySchemaList
+	projectMemberSelectRepository   ProjectSnapshotSelectRepositoryProjectMemberSelect
+}
+
+func NewProjectSnapshotSelect(
+	projectSnapshotSelectRepository ProjectSnapshotSelectRepository,
+	projectSelectRepository ProjectSnapshotSelectRepositoryProjectSelect,
+	schemaListRepository ProjectSnapshotSelectRepositorySchemaList,
+	projectMemberSelectRepository ProjectSnapshotSelectRepositoryProjectMemberSelect,
+) *ProjectSnapshotSelect {
+	return &ProjectSnapshotSelect{
+		projectSnapshotSelectRepository: projectSnapshotSelectRepository,
+		projectSelectRepository:         projectSelectRepository,
+		schemaListRepository:            schemaListRepository,
+		projectMemberSelectRepository:   projectMemberSelectRepository,
+	}
+}
+
+// Exec returns a snapshot, along with the schema versions it captured.
+func (service *ProjectSnapshotSelect) Exec(
+	ctx context.Context, request *ProjectSnapshotSelectRequest,
+) (*ProjectSnapshotContent, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectSnapshotSelect")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	snapshot, err := service.projectSnapshotSelectRepository.Exec(ctx, &dao.ProjectSnapshotSelectRequest{
+		ID: request.ID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: snapshot.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	schemas, err := service.schemaListRepository.Exec(ctx, &dao.SchemaListByIDsRequest{
+		IDs: snapshot.SchemaIDs,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, &ProjectSnapshotContent{
+		Snapshot: loadProjectSnapshot(snapshot),
+		Schemas:  lo.Map(schemas, loadSchemasMap),
+	}), nil
+}
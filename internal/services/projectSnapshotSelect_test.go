@@ -0,0 +1,255 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectSnapshotSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	snapshotID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testSnapshot := &dao.ProjectSnapshot{
+		ID:        snapshotID,
+		ProjectID: projectID,
+		Owner:     ownerID,
+		Name:      "Draft 1",
+		SchemaIDs: []uuid.UUID{schemaID},
+		CreatedAt: baseTime,
+	}
+
+	testSchema := &dao.Schema{
+		ID:              schemaID,
+		ProjectID:       projectID,
+		Owner:           &ownerID,
+		ModuleID:        "test-module",
+		ModuleNamespace: "test-namespace",
+		ModuleVersion:   "1.0.0",
+		Source:          dao.SchemaSourceUser,
+		Data:            map[string]any{"title": "Draft 1"},
+		CreatedAt:       baseTime,
+	}
+
+	type projectSnapshotSelectMock struct {
+		resp *dao.ProjectSnapshot
+		err  error
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type schemaListMock struct {
+		resp []*dao.Schema
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectSnapshotSelectRequest
+
+		projectSnapshotSelectMock *projectSnapshotSelectMock
+		projectSelectMock         *projectSelectMock
+		projectMemberSelectMock   *projectMemberSelectMock
+		schemaListMock            *schemaListMock
+
+		expect    *services.ProjectSnapshotContent
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectSnapshotSelectRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			schemaListMock:            &schemaListMock{resp: []*dao.Schema{testSchema}},
+
+			expect: &services.ProjectSnapshotContent{
+				Snapshot: &services.ProjectSnapshot{
+					ID:        snapshotID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Name:      "Draft 1",
+					SchemaIDs: []uuid.UUID{schemaID},
+					CreatedAt: baseTime,
+				},
+				Schemas: []*services.Schema{
+					{
+						ID:              schemaID,
+						ProjectID:       projectID,
+						Owner:           &ownerID,
+						ModuleID:        "test-module",
+						ModuleNamespace: "test-namespace",
+						ModuleVersion:   "1.0.0",
+						Source:          "USER",
+						Data:            map[string]any{"title": "Draft 1"},
+						CreatedAt:       baseTime,
+					},
+				},
+			},
+		},
+		{
+			name: "Error/InvalidRequest/MissingID",
+
+			request: &services.ProjectSnapshotSelectRequest{
+				UserID: ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSnapshotSelect",
+
+			request: &services.ProjectSnapshotSelectRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{err: dao.ErrProjectSnapshotSelectNotFound},
+
+			expectErr: dao.ErrProjectSnapshotSelectNotFound,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectSnapshotSelectRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/NotMember",
+
+			request: &services.ProjectSnapshotSelectRequest{
+				ID:     snapshotID,
+				UserID: memberID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			projectMemberSelectMock:   &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/SchemaList",
+
+			request: &services.ProjectSnapshotSelectRequest{
+				ID:     snapshotID,
+				UserID: ownerID,
+			},
+
+			projectSnapshotSelectMock: &projectSnapshotSelectMock{resp: testSnapshot},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			schemaListMock:            &schemaListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectSnapshotSelectRepository := servicesmocks.NewMockProjectSnapshotSelectRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectSnapshotSelectRepositoryProjectSelect(t)
+				schemaListRepository := servicesmocks.NewMockProjectSnapshotSelectRepositorySchemaList(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectSnapshotSelectRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSnapshotSelectMock != nil {
+					projectSnapshotSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSnapshotSelectRequest{ID: testCase.request.ID}).
+						Return(testCase.projectSnapshotSelectMock.resp, testCase.projectSnapshotSelectMock.err)
+				}
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: projectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: projectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.schemaListMock != nil {
+					schemaListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaListByIDsRequest{IDs: testSnapshot.SchemaIDs}).
+						Return(testCase.schemaListMock.resp, testCase.schemaListMock.err)
+				}
+
+				service := services.NewProjectSnapshotSelect(
+					projectSnapshotSelectRepository,
+					projectSelectRepository,
+					schemaListRepository,
+					projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectSnapshotSelectRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				schemaListRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
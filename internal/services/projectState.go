@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type ProjectStateRepository interface {
+	Exec(ctx context.Context, request *dao.SchemaListAtRequest) ([]*dao.Schema, error)
+}
+
+type ProjectStateRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectStateRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectStateRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	At        time.Time `validate:"required"`
+}
+
+type ProjectState struct {
+	projectStateRepository        ProjectStateRepository
+	projectSelectRepository       ProjectStateRepositoryProjectSelect
+	projectMemberSelectRepository ProjectStateRepositoryProjectMemberSelect
+}
+
+func NewProjectState(
+	projectStateRepository ProjectStateRepository,
+	projectSelectRepository ProjectStateRepositoryProjectSelect,
+	projectMemberSelectRepository ProjectStateRepositoryProjectMemberSelect,
+) *ProjectState {
+	return &ProjectState{
+		projectStateRepository:        projectStateRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec returns the latest schema of each module of a project, as it was at the requested time. Modules that were
+// removed from the workflow at that time are left out.
+func (service *ProjectState) Exec(ctx context.Context, request *ProjectStateRequest) ([]*Schema, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectState")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	schemas, err := service.projectStateRepository.Exec(ctx, &dao.SchemaListAtRequest{
+		ProjectID: request.ProjectID,
+		At:        request.At,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, lo.Map(schemas, loadSchemasMap)), nil
+}
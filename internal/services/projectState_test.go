@@ -0,0 +1,228 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectState(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	memberID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := baseTime.Add(24 * time.Hour)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testSchema := &dao.Schema{
+		ID:              schemaID,
+		ProjectID:       projectID,
+		Owner:           &ownerID,
+		ModuleID:        "test-module",
+		ModuleNamespace: "test-namespace",
+		ModuleVersion:   "1.0.0",
+		Source:          dao.SchemaSourceUser,
+		Data:            map[string]any{"title": "The Lighthouse"},
+		CreatedAt:       baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	type projectStateMock struct {
+		resp []*dao.Schema
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectStateRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		projectStateMock        *projectStateMock
+
+		expect    []*services.Schema
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectStateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				At:        at,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectStateMock:  &projectStateMock{resp: []*dao.Schema{testSchema}},
+
+			expect: []*services.Schema{
+				{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser.String(),
+					Data:            map[string]any{"title": "The Lighthouse"},
+					CreatedAt:       baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/Viewer",
+
+			request: &services.ProjectStateRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+				At:        at,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{ProjectID: projectID, UserID: memberID, Role: dao.ProjectMemberRoleViewer},
+			},
+			projectStateMock: &projectStateMock{},
+
+			expect: []*services.Schema{},
+		},
+		{
+			name: "Error/InvalidRequest/MissingAt",
+
+			request: &services.ProjectStateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectStateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				At:        at,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/NotMember",
+
+			request: &services.ProjectStateRequest{
+				ProjectID: projectID,
+				UserID:    memberID,
+				At:        at,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectState",
+
+			request: &services.ProjectStateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				At:        at,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectStateMock:  &projectStateMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectStateRepository := servicesmocks.NewMockProjectStateRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectStateRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectStateRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.projectStateMock != nil {
+					projectStateRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaListAtRequest{
+							ProjectID: testCase.request.ProjectID,
+							At:        testCase.request.At,
+						}).
+						Return(testCase.projectStateMock.resp, testCase.projectStateMock.err)
+				}
+
+				service := services.NewProjectState(
+					projectStateRepository, projectSelectRepository, projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectStateRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
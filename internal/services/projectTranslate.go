@@ -0,0 +1,418 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+// ProjectTranslateConcurrency is the maximum number of modules translated at the same time.
+const ProjectTranslateConcurrency = 4
+
+var ErrProjectAlreadyInLang = errors.New("project is already in the target language")
+
+type ProjectTranslateRepository interface {
+	Exec(ctx context.Context, request *dao.ModuleTranslateRequest) (*dao.ModuleGeneration, error)
+}
+
+type ProjectTranslateRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectTranslateRepositoryProjectInsert interface {
+	Exec(ctx context.Context, request *dao.ProjectInsertRequest) (*dao.Project, error)
+}
+
+type ProjectTranslateRepositoryProjectUpdateLang interface {
+	Exec(ctx context.Context, request *dao.ProjectUpdateLangRequest) (*dao.Project, error)
+}
+
+type ProjectTranslateRepositorySchemaList interface {
+	Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)
+}
+
+type ProjectTranslateRepositorySchemaInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)
+}
+
+type ProjectTranslateRepositorySchemaGenerationInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)
+}
+
+type ProjectTranslateRepositoryModuleSelect interface {
+	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
+}
+
+type ProjectTranslateRepositoryTokenUsageList interface {
+	Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)
+}
+
+type ProjectTranslateRepositoryTokenUsageInsert interface {
+	Exec(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)
+}
+
+type ProjectTranslateRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectTranslateRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	// Roles of the user, which set their token quota.
+	Roles []string
+	Lang  string `validate:"required,langs"`
+	// Copy, when true, saves the translation as a new project, and leaves the source project untouched. Otherwise,
+	// the translated schemas are saved as new versions, and the language of the project is changed.
+	Copy bool
+}
+
+type ProjectTranslate struct {
+	projectTranslateRepository       ProjectTranslateRepository
+	projectSelectRepository          ProjectTranslateRepositoryProjectSelect
+	projectInsertRepository          ProjectTranslateRepositoryProjectInsert
+	projectUpdateLangRepository      ProjectTranslateRepositoryProjectUpdateLang
+	schemaListRepository             ProjectTranslateRepositorySchemaList
+	schemaInsertRepository           ProjectTranslateRepositorySchemaInsert
+	schemaGenerationInsertRepository ProjectTranslateRepositorySchemaGenerationInsert
+	moduleSelectRepository           ProjectTranslateRepositoryModuleSelect
+	tokenUsageListRepository         ProjectTranslateRepositoryTokenUsageList
+	tokenUsageInsertRepository       ProjectTranslateRepositoryTokenUsageInsert
+	projectMemberSelectRepository    ProjectTranslateRepositoryProjectMemberSelect
+	usageQuotas                      config.UsageQuotas
+}
+
+func NewProjectTranslate(
+	projectTranslateRepository ProjectTranslateRepository,
+	projectSelectRepository ProjectTranslateRepositoryProjectSelect,
+	projectInsertRepository ProjectTranslateRepositoryProjectInsert,
+	projectUpdateLangRepository ProjectTranslateRepositoryProjectUpdateLang,
+	schemaListRepository ProjectTranslateRepositorySchemaList,
+	schemaInsertRepository ProjectTranslateRepositorySchemaInsert,
+	schemaGenerationInsertRepository ProjectTranslateRepositorySchemaGenerationInsert,
+	moduleSelectRepository ProjectTranslateRepositoryModuleSelect,
+	tokenUsageListRepository ProjectTranslateRepositoryTokenUsageList,
+	tokenUsageInsertRepository ProjectTranslateRepositoryTokenUsageInsert,
+	projectMemberSelectRepository ProjectTranslateRepositoryProjectMemberSelect,
+	usageQuotas config.UsageQuotas,
+) *ProjectTranslate {
+	return &ProjectTranslate{
+		projectTranslateRepository:       projectTranslateRepository,
+		projectSelectRepository:          projectSelectRepository,
+		projectInsertRepository:          projectInsertRepository,
+		projectUpdateLangRepository:      projectUpdateLangRepository,
+		schemaListRepository:             schemaListRepository,
+		schemaInsertRepository:           schemaInsertRepository,
+		schemaGenerationInsertRepository: schemaGenerationInsertRepository,
+		moduleSelectRepository:           moduleSelectRepository,
+		tokenUsageListRepository:         tokenUsageListRepository,
+		tokenUsageInsertRepository:       tokenUsageInsertRepository,
+		projectMemberSelectRepository:    projectMemberSelectRepository,
+		usageQuotas:                      usageQuotas,
+	}
+}
+
+// projectTranslation is the translation of the latest schema of a module.
+type projectTranslation struct {
+	source *dao.Schema
+	data   map[string]any
+	// result is nil when the schema had nothing to translate.
+	result *dao.ModuleGeneration
+}
+
+// Exec translates the latest schema of every module of a project into another language.
+//
+// The model only translates the parts of the data it can generate, using structured outputs: the structure of the
+// data, and the values restricted by the module schema (such as enums), are kept from the source data. Schemas
+// with nothing to translate are copied as is in a new project, and left untouched otherwise.
+func (service *ProjectTranslate) Exec(ctx context.Context, request *ProjectTranslateRequest) (*Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectTranslate")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// Translating a project in place changes its language, which is a setting of the project. Copies only require
+	// the permission to generate.
+	requiredRole := dao.ProjectMemberRoleAdmin
+	if request.Copy {
+		requiredRole = dao.ProjectMemberRoleGenerator
+	}
+
+	err = VerifyProjectAccess(ctx, service.projectMemberSelectRepository, project, request.UserID, requiredRole)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	if project.Lang == request.Lang {
+		return nil, otel.ReportError(span, ErrProjectAlreadyInLang)
+	}
+
+	err = VerifyUsageQuota(ctx, service.tokenUsageListRepository, service.usageQuotas, request.UserID, request.Roles)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	schemas, err := service.schemaListRepository.Exec(ctx, &dao.SchemaListRequest{ProjectID: request.ProjectID})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// Translating the schemas of removed modules would bring them back.
+	schemas = lo.Filter(schemas, func(item *dao.Schema, _ int) bool {
+		return WorkflowIncludesModule(project.Workflow, item.ModuleNamespace, item.ModuleID)
+	})
+
+	// =================================================================================================================
+	// Translate.
+	// =================================================================================================================
+
+	// Modules are translated concurrently, so the duration of the request does not grow with the size of the
+	// project. The first failure cancels the remaining translations.
+	translateCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*projectTranslation, len(schemas))
+	errs := make([]error, len(schemas))
+	slots := make(chan struct{}, ProjectTranslateConcurrency)
+
+	var wg sync.WaitGroup
+
+	for i, schema := range schemas {
+		wg.Go(func() {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			// Another translation already failed.
+			if translateCtx.Err() != nil {
+				return
+			}
+
+			results[i], errs[i] = service.translate(translateCtx, schema, request.Lang)
+			if errs[i] != nil {
+				cancel()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	translations := lo.Compact(results)
+	translateErr := errors.Join(errs...)
+
+	// Bill every completed module, even if another one failed. The request context may have expired during the
+	// translation, so it is not used for billing.
+	for _, translation := range translations {
+		if translation.result == nil {
+			continue
+		}
+
+		_, err = service.tokenUsageInsertRepository.Exec(
+			context.WithoutCancel(ctx), moduleGenerationUsage(request.UserID, request.ProjectID, translation.result),
+		)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+	}
+
+	if translateErr != nil {
+		return nil, otel.ReportError(span, translateErr)
+	}
+
+	// =================================================================================================================
+	// Save the translation.
+	// =================================================================================================================
+
+	var output *dao.Project
+
+	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
+		now := time.Now().UTC()
+
+		if request.Copy {
+			output, err = service.projectInsertRepository.Exec(ctx, &dao.ProjectInsertRequest{
+				ID:       uuid.New(),
+				Owner:    request.UserID,
+				Lang:     request.Lang,
+				Title:    project.Title,
+				Workflow: project.Workflow,
+				Now:      now,
+			})
+		} else {
+			output, err = service.projectUpdateLangRepository.Exec(ctx, &dao.ProjectUpdateLangRequest{
+				ID:   request.ProjectID,
+				Lang: request.Lang,
+				Now:  now,
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, translation := range translations {
+			err = service.save(ctx, output.ID, request, translation, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadProject(output)), nil
+}
+
+// translate prompts the model for the translation of a schema. Schemas whose module cannot be generated by the
+// model have nothing to translate. Translations that do not satisfy the module schema are returned along with
+// ErrInvalidGeneration.
+func (service *ProjectTranslate) translate(
+	ctx context.Context, schema *dao.Schema, lang string,
+) (*projectTranslation, error) {
+	translation := &projectTranslation{source: schema, data: schema.Data}
+
+	if len(schema.Data) == 0 {
+		return translation, nil
+	}
+
+	moduleContent, err := service.moduleSelectRepository.Exec(ctx, &dao.ModuleSelectRequest{
+		ID:         schema.ModuleID,
+		Namespace:  schema.ModuleNamespace,
+		Version:    schema.ModuleVersion,
+		Preversion: schema.ModulePreversion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sourceSchema := moduleContent.Schema
+
+	llmSchema := LoadModuleLLMSchema(&sourceSchema)
+	if !llmSchema.Usable() {
+		return translation, nil
+	}
+
+	moduleSchema, err := llmSchema.Schema.Resolve(&jsonschema.ResolveOptions{
+		ValidateDefaults: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	moduleContent.Schema = *moduleSchema.Schema()
+
+	translation.result, err = service.projectTranslateRepository.Exec(ctx, &dao.ModuleTranslateRequest{
+		Module: moduleContent,
+		Lang:   lang,
+		Data:   schema.Data,
+	})
+	if err != nil {
+		// Interrupted translations still report the tokens they used.
+		return lo.Ternary(translation.result != nil, translation, nil), err
+	}
+
+	translation.data = lib.MergeTranslatedModuleData(&sourceSchema, schema.Data, translation.result.Data)
+
+	// The translation is returned along the error, so the spent tokens can be billed.
+	err = validateTranslation(&sourceSchema, schema.Data, translation.data)
+	if err != nil {
+		return translation, err
+	}
+
+	return translation, nil
+}
+
+// validateTranslation checks translated data against the source schema of its module, as translated text may no
+// longer satisfy its length or pattern constraints. Errors the source data already had, as drafts can, are ignored.
+func validateTranslation(schema *jsonschema.Schema, source, translated map[string]any) error {
+	var validationErrors lib.JSONSchemaValidationErrors
+	if !errors.As(lib.ValidateJSONSchema(schema, translated, false), &validationErrors) {
+		return nil
+	}
+
+	var sourceErrors lib.JSONSchemaValidationErrors
+
+	errors.As(lib.ValidateJSONSchema(schema, source, false), &sourceErrors)
+
+	validationErrors = lo.Reject(validationErrors, func(item *lib.JSONSchemaValidationError, _ int) bool {
+		return lo.ContainsBy(sourceErrors, func(sourceError *lib.JSONSchemaValidationError) bool {
+			return sourceError.Path == item.Path
+		})
+	})
+	if len(validationErrors) == 0 {
+		return nil
+	}
+
+	return errors.Join(validationErrors, ErrInvalidGeneration)
+}
+
+// save inserts a translated schema in the given project, along with its generation record. Schemas with nothing to
+// translate are only saved when the project is copied.
+func (service *ProjectTranslate) save(
+	ctx context.Context,
+	projectID uuid.UUID,
+	request *ProjectTranslateRequest,
+	translation *projectTranslation,
+	now time.Time,
+) error {
+	if translation.result == nil && !request.Copy {
+		return nil
+	}
+
+	source := translation.source.Source
+	if translation.result != nil {
+		source = dao.SchemaSourceAI
+	}
+
+	schema, err := service.schemaInsertRepository.Exec(ctx, &dao.SchemaInsertRequest{
+		ID:               uuid.New(),
+		ProjectID:        projectID,
+		Owner:            &request.UserID,
+		ModuleID:         translation.source.ModuleID,
+		ModuleNamespace:  translation.source.ModuleNamespace,
+		ModuleVersion:    translation.source.ModuleVersion,
+		ModulePreversion: translation.source.ModulePreversion,
+		Source:           source,
+		Data:             translation.data,
+		Now:              now,
+	})
+	if err != nil {
+		return err
+	}
+
+	if translation.result == nil {
+		return nil
+	}
+
+	// The source schema is recorded as the base of the translation.
+	generation := &schemaGeneration{current: translation.source}
+
+	_, err = service.schemaGenerationInsertRepository.Exec(
+		ctx, generation.record(schema.ID, projectID, translation.result, now),
+	)
+
+	return err
+}
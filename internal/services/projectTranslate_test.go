@@ -0,0 +1,673 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectTranslate(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	copyProjectID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+	ideaSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	pitchSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000201")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testModuleSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title":  {Type: "string", MaxLength: lo.ToPtr(16)},
+			"medium": {Type: "string", Enum: []any{"FILM", "NOVEL"}},
+		},
+		Required: []string{"title", "medium"},
+	}
+
+	testUsageQuotas := config.UsageQuotas{
+		Roles: map[string]config.UsageQuotasRole{
+			"user": {Quota: &config.UsageQuota{Daily: 1000, Monthly: 10000}},
+		},
+	}
+
+	testTranslation := &dao.ModuleGeneration{
+		Model:           "test-model",
+		Messages:        []lib.CompletionMessage{{Role: lib.CompletionRoleUser, Content: "Translate."}},
+		TemplateVersion: "translate:0123456789ab",
+		Usage:           lib.CompletionUsage{PromptTokens: 120, CompletionTokens: 30},
+		Latency:         1500 * time.Millisecond,
+	}
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "The Lighthouse",
+		Workflow:  []string{"test:idea@v1.0.0", "test:pitch@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testSchemas := []*dao.Schema{
+		{
+			ID:              ideaSchemaID,
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "idea",
+			ModuleNamespace: "test",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{"title": "The Lighthouse", "medium": "NOVEL"},
+			CreatedAt:       baseTime,
+		},
+		{
+			ID:              pitchSchemaID,
+			ProjectID:       projectID,
+			Owner:           &ownerID,
+			ModuleID:        "pitch",
+			ModuleNamespace: "test",
+			ModuleVersion:   "1.0.0",
+			Source:          dao.SchemaSourceUser,
+			Data:            map[string]any{},
+			CreatedAt:       baseTime,
+		},
+	}
+
+	// testRemovedSchema is the last version with data of a module removed from the workflow.
+	testRemovedSchema := &dao.Schema{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000202"),
+		ProjectID:       projectID,
+		Owner:           &ownerID,
+		ModuleID:        "plot",
+		ModuleNamespace: "test",
+		ModuleVersion:   "1.0.0",
+		Source:          dao.SchemaSourceUser,
+		Data:            map[string]any{"title": "The Storm"},
+		CreatedAt:       baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type tokenUsageListMock struct {
+		resp []*dao.TokenUsageProject
+		err  error
+	}
+
+	type schemaListMock struct {
+		resp []*dao.Schema
+		err  error
+	}
+
+	type projectTranslateMock struct {
+		resp map[string]any
+		// partial reports the tokens spent by an interrupted translation, along with the error.
+		partial bool
+		err     error
+	}
+
+	type tokenUsageInsertMock struct {
+		err error
+	}
+
+	type projectSaveMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type schemaInsertMock struct {
+		err error
+	}
+
+	type schemaGenerationInsertMock struct {
+		err error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	// savedSchema is a schema expected to be inserted by the translation.
+	type savedSchema struct {
+		module string
+		source dao.SchemaSource
+		data   map[string]any
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectTranslateRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		tokenUsageListMock      *tokenUsageListMock
+		schemaListMock          *schemaListMock
+		projectTranslateMock    *projectTranslateMock
+		tokenUsageInsertMock    *tokenUsageInsertMock
+		// projectInsertMock creates the copy of the project, if requested.
+		projectInsertMock *projectSaveMock
+		// projectUpdateLangMock changes the language of the project, when it is not copied.
+		projectUpdateLangMock      *projectSaveMock
+		schemaInsertMock           *schemaInsertMock
+		schemaGenerationInsertMock *schemaGenerationInsertMock
+
+		expectSaved []*savedSchema
+
+		expect    *services.Project
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock:  &projectSelectMock{resp: testProject},
+			tokenUsageListMock: &tokenUsageListMock{resp: []*dao.TokenUsageProject{}},
+			// Removed modules are not translated.
+			schemaListMock: &schemaListMock{resp: append(slices.Clone(testSchemas), testRemovedSchema)},
+			projectTranslateMock: &projectTranslateMock{
+				resp: map[string]any{"title": "Le Phare", "medium": "ROMAN"},
+			},
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+			projectUpdateLangMock: &projectSaveMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangFR,
+					Title:     "The Lighthouse",
+					Workflow:  testProject.Workflow,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime.Add(time.Hour),
+				},
+			},
+			schemaInsertMock:           &schemaInsertMock{},
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{},
+
+			// Empty schemas have nothing to translate, and enums are kept as is.
+			expectSaved: []*savedSchema{
+				{
+					module: "idea",
+					source: dao.SchemaSourceAI,
+					data:   map[string]any{"title": "Le Phare", "medium": "NOVEL"},
+				},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangFR,
+				Title:     "The Lighthouse",
+				Workflow:  testProject.Workflow,
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime.Add(time.Hour),
+			},
+		},
+		{
+			name: "Success/Copy",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Lang:      config.LangFR,
+				Copy:      true,
+			},
+
+			projectSelectMock:  &projectSelectMock{resp: testProject},
+			tokenUsageListMock: &tokenUsageListMock{resp: []*dao.TokenUsageProject{}},
+			// Removed modules are not copied.
+			schemaListMock: &schemaListMock{resp: append(slices.Clone(testSchemas), testRemovedSchema)},
+			projectTranslateMock: &projectTranslateMock{
+				resp: map[string]any{"title": "Le Phare", "medium": "FILM"},
+			},
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+			projectInsertMock: &projectSaveMock{
+				resp: &dao.Project{
+					ID:        copyProjectID,
+					Owner:     ownerID,
+					Lang:      config.LangFR,
+					Title:     "The Lighthouse",
+					Workflow:  testProject.Workflow,
+					CreatedAt: baseTime.Add(time.Hour),
+					UpdatedAt: baseTime.Add(time.Hour),
+				},
+			},
+			schemaInsertMock:           &schemaInsertMock{},
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{},
+
+			expectSaved: []*savedSchema{
+				{
+					module: "idea",
+					source: dao.SchemaSourceAI,
+					data:   map[string]any{"title": "Le Phare", "medium": "NOVEL"},
+				},
+				{
+					module: "pitch",
+					source: dao.SchemaSourceUser,
+					data:   map[string]any{},
+				},
+			},
+
+			expect: &services.Project{
+				ID:        copyProjectID,
+				Owner:     ownerID,
+				Lang:      config.LangFR,
+				Title:     "The Lighthouse",
+				Workflow:  testProject.Workflow,
+				CreatedAt: baseTime.Add(time.Hour),
+				UpdatedAt: baseTime.Add(time.Hour),
+			},
+		},
+		{
+			name: "Error/SameLang",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+
+			expectErr: services.ErrProjectAlreadyInLang,
+		},
+		{
+			name: "Error/NotOwner",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleGenerator},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/QuotaExceeded",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			tokenUsageListMock: &tokenUsageListMock{
+				resp: []*dao.TokenUsageProject{{ProjectID: projectID, PromptTokens: 900, CompletionTokens: 100}},
+			},
+
+			expectErr: services.ErrUsageQuotaExceeded,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock: &projectSelectMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Translate",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock:    &projectSelectMock{resp: testProject},
+			tokenUsageListMock:   &tokenUsageListMock{resp: []*dao.TokenUsageProject{}},
+			schemaListMock:       &schemaListMock{resp: testSchemas},
+			projectTranslateMock: &projectTranslateMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/Translate/PartialUsage",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock:    &projectSelectMock{resp: testProject},
+			tokenUsageListMock:   &tokenUsageListMock{resp: []*dao.TokenUsageProject{}},
+			schemaListMock:       &schemaListMock{resp: testSchemas},
+			projectTranslateMock: &projectTranslateMock{partial: true, err: errFoo},
+			// The tokens spent before the failure are billed.
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidTranslation",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock:  &projectSelectMock{resp: testProject},
+			tokenUsageListMock: &tokenUsageListMock{resp: []*dao.TokenUsageProject{}},
+			schemaListMock:     &schemaListMock{resp: testSchemas},
+			// The translated title exceeds the maximum length of the schema.
+			projectTranslateMock: &projectTranslateMock{
+				resp: map[string]any{"title": "Le Phare au bout du monde", "medium": "NOVEL"},
+			},
+			// The tokens are billed, even though the translation is not saved.
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			expectErr: services.ErrInvalidGeneration,
+		},
+		{
+			name: "Error/SchemaInsert",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock:  &projectSelectMock{resp: testProject},
+			tokenUsageListMock: &tokenUsageListMock{resp: []*dao.TokenUsageProject{}},
+			schemaListMock:     &schemaListMock{resp: testSchemas},
+			projectTranslateMock: &projectTranslateMock{
+				resp: map[string]any{"title": "Le Phare", "medium": "NOVEL"},
+			},
+			// The tokens are billed, even though the translation is not saved.
+			tokenUsageInsertMock:  &tokenUsageInsertMock{},
+			projectUpdateLangMock: &projectSaveMock{resp: testProject},
+			schemaInsertMock:      &schemaInsertMock{err: errFoo},
+
+			expectSaved: []*savedSchema{
+				{
+					module: "idea",
+					source: dao.SchemaSourceAI,
+					data:   map[string]any{"title": "Le Phare", "medium": "NOVEL"},
+				},
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.ProjectTranslateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Lang:      "xx",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectTranslateRepository := servicesmocks.NewMockProjectTranslateRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectTranslateRepositoryProjectSelect(t)
+				projectInsertRepository := servicesmocks.NewMockProjectTranslateRepositoryProjectInsert(t)
+				projectUpdateLangRepository := servicesmocks.NewMockProjectTranslateRepositoryProjectUpdateLang(t)
+				schemaListRepository := servicesmocks.NewMockProjectTranslateRepositorySchemaList(t)
+				schemaInsertRepository := servicesmocks.NewMockProjectTranslateRepositorySchemaInsert(t)
+				schemaGenerationInsertRepository := servicesmocks.NewMockProjectTranslateRepositorySchemaGenerationInsert(t)
+				moduleSelectRepository := servicesmocks.NewMockProjectTranslateRepositoryModuleSelect(t)
+				tokenUsageListRepository := servicesmocks.NewMockProjectTranslateRepositoryTokenUsageList(t)
+				tokenUsageInsertRepository := servicesmocks.NewMockProjectTranslateRepositoryTokenUsageInsert(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectTranslateRepositoryProjectMemberSelect(t)
+
+				// targetProjectID is the project the translated schemas are saved in.
+				targetProjectID := testCase.request.ProjectID
+				if testCase.projectInsertMock != nil && testCase.projectInsertMock.resp != nil {
+					targetProjectID = testCase.projectInsertMock.resp.ID
+				}
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{
+							ID: testCase.request.ProjectID,
+						}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.tokenUsageListMock != nil {
+					tokenUsageListRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageListRequest) bool {
+							return req.UserID == testCase.request.UserID && time.Since(req.Since) < 32*24*time.Hour
+						})).
+						Return(testCase.tokenUsageListMock.resp, testCase.tokenUsageListMock.err)
+				}
+
+				if testCase.schemaListMock != nil {
+					schemaListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaListRequest{
+							ProjectID: testCase.request.ProjectID,
+						}).
+						Return(testCase.schemaListMock.resp, testCase.schemaListMock.err)
+				}
+
+				if testCase.projectTranslateMock != nil {
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:        "idea",
+							Namespace: "test",
+							Version:   "1.0.0",
+						}).
+						Return(&dao.Module{
+							ID:        "idea",
+							Namespace: "test",
+							Version:   "1.0.0",
+							Schema:    testModuleSchema,
+						}, nil)
+
+					var translateResult *dao.ModuleGeneration
+
+					if testCase.projectTranslateMock.resp != nil || testCase.projectTranslateMock.partial {
+						translateResult = lo.ToPtr(*testTranslation)
+						translateResult.Data = testCase.projectTranslateMock.resp
+					}
+
+					projectTranslateRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ModuleTranslateRequest) bool {
+							return req.Module.ID == "idea" &&
+								req.Lang == testCase.request.Lang &&
+								// Enums are sent to the model, so it keeps their values.
+								len(req.Module.Schema.Properties["medium"].Enum) == 2 &&
+								assert.Equal(t, testSchemas[0].Data, req.Data)
+						})).
+						Return(translateResult, testCase.projectTranslateMock.err)
+				}
+
+				if testCase.tokenUsageInsertMock != nil {
+					tokenUsageInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageInsertRequest) bool {
+							return req.UserID == testCase.request.UserID &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Model == testTranslation.Model &&
+								req.PromptTokens == testTranslation.Usage.PromptTokens &&
+								req.CompletionTokens == testTranslation.Usage.CompletionTokens &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(nil, testCase.tokenUsageInsertMock.err)
+				}
+
+				if testCase.projectInsertMock != nil {
+					projectInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectInsertRequest) bool {
+							return req.ID != testCase.request.ProjectID &&
+								req.Owner == testCase.request.UserID &&
+								req.Lang == testCase.request.Lang &&
+								req.Title == testProject.Title &&
+								assert.Equal(t, testProject.Workflow, req.Workflow) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.projectInsertMock.resp, testCase.projectInsertMock.err)
+				}
+
+				if testCase.projectUpdateLangMock != nil {
+					projectUpdateLangRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectUpdateLangRequest) bool {
+							return req.ID == testCase.request.ProjectID &&
+								req.Lang == testCase.request.Lang &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.projectUpdateLangMock.resp, testCase.projectUpdateLangMock.err)
+				}
+
+				for _, saved := range testCase.expectSaved {
+					schemaInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+							return req.ProjectID == targetProjectID &&
+								lo.FromPtr(req.Owner) == testCase.request.UserID &&
+								req.ModuleID == saved.module &&
+								req.ModuleNamespace == "test" &&
+								req.ModuleVersion == "1.0.0" &&
+								req.Source == saved.source &&
+								assert.Equal(t, saved.data, req.Data) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						RunAndReturn(func(_ context.Context, req *dao.SchemaInsertRequest) (*dao.Schema, error) {
+							if testCase.schemaInsertMock.err != nil {
+								return nil, testCase.schemaInsertMock.err
+							}
+
+							return &dao.Schema{
+								ID:              req.ID,
+								ProjectID:       req.ProjectID,
+								Owner:           req.Owner,
+								ModuleID:        req.ModuleID,
+								ModuleNamespace: req.ModuleNamespace,
+								ModuleVersion:   req.ModuleVersion,
+								Source:          req.Source,
+								Data:            req.Data,
+								CreatedAt:       req.Now,
+							}, nil
+						})
+				}
+
+				if testCase.schemaGenerationInsertMock != nil {
+					schemaGenerationInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaGenerationInsertRequest) bool {
+							return req.ProjectID == targetProjectID &&
+								req.Model == testTranslation.Model &&
+								req.TemplateVersion == testTranslation.TemplateVersion &&
+								req.PromptTokens == testTranslation.Usage.PromptTokens &&
+								req.CompletionTokens == testTranslation.Usage.CompletionTokens &&
+								req.Latency == testTranslation.Latency &&
+								// The translation starts from the source schema.
+								assert.Equal(t, &ideaSchemaID, req.BaseSchemaID) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(nil, testCase.schemaGenerationInsertMock.err)
+				}
+
+				service := services.NewProjectTranslate(
+					projectTranslateRepository,
+					projectSelectRepository,
+					projectInsertRepository,
+					projectUpdateLangRepository,
+					schemaListRepository,
+					schemaInsertRepository,
+					schemaGenerationInsertRepository,
+					moduleSelectRepository,
+					tokenUsageListRepository,
+					tokenUsageInsertRepository,
+					projectMemberSelectRepository,
+					testUsageQuotas,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectTranslateRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectInsertRepository.AssertExpectations(t)
+				projectUpdateLangRepository.AssertExpectations(t)
+				schemaListRepository.AssertExpectations(t)
+				schemaInsertRepository.AssertExpectations(t)
+				schemaGenerationInsertRepository.AssertExpectations(t)
+				moduleSelectRepository.AssertExpectations(t)
+				tokenUsageListRepository.AssertExpectations(t)
+				tokenUsageInsertRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -16,7 +16,7 @@ import (
 )
 
 //nolint:lll
-var ErrForbiddenModuleUpgrade = errors.New("forbidden module upgrade: you must create a new schema with the updated module version, and provide compatible data")
+var ErrForbiddenModuleUpgrade = errors.New("forbidden module upgrade: module versions must be changed through the module upgrade operation, which migrates the project data")
 
 type ProjectUpdateRepositorySelect interface {
 	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
@@ -30,14 +30,26 @@ type ProjectUpdateRepositorySchemaInsert interface {
 	Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)
 }
 
+type ProjectUpdateRepositorySchemaSelect interface {
+	Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)
+}
+
 type ProjectUpdateRepositoryModuleSelect interface {
 	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
 }
 
+type ProjectUpdateRepositoryModuleListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
+type ProjectUpdateRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type ProjectUpdateRequest struct {
 	ID       uuid.UUID `validate:"required"`
 	UserID   uuid.UUID `validate:"required"`
-	Workflow []string  `validate:"required,min=1,max=64,dive,module,max=512"`
+	Workflow []string  `validate:"required,min=1,max=64,dive,workflowModule,max=512"`
 	Title    string    `validate:"required,min=1,max=256"`
 }
 
@@ -45,20 +57,29 @@ type ProjectUpdate struct {
 	projectUpdateRepositorySelect       ProjectUpdateRepositorySelect
 	projectUpdateRepository             ProjectUpdateRepository
 	projectUpdateRepositorySchemaInsert ProjectUpdateRepositorySchemaInsert
+	schemaSelectRepository              ProjectUpdateRepositorySchemaSelect
 	moduleSelectRepository              ProjectUpdateRepositoryModuleSelect
+	moduleListVersionsRepository        ProjectUpdateRepositoryModuleListVersions
+	projectMemberSelectRepository       ProjectUpdateRepositoryProjectMemberSelect
 }
 
 func NewProjectUpdate(
 	projectUpdateRepository ProjectUpdateRepository,
 	projectUpdateRepositorySelect ProjectUpdateRepositorySelect,
 	projectUpdateRepositorySchemaInsert ProjectUpdateRepositorySchemaInsert,
+	schemaSelectRepository ProjectUpdateRepositorySchemaSelect,
 	moduleSelectRepository ProjectUpdateRepositoryModuleSelect,
+	moduleListVersionsRepository ProjectUpdateRepositoryModuleListVersions,
+	projectMemberSelectRepository ProjectUpdateRepositoryProjectMemberSelect,
 ) *ProjectUpdate {
 	return &ProjectUpdate{
 		projectUpdateRepositorySelect:       projectUpdateRepositorySelect,
 		projectUpdateRepository:             projectUpdateRepository,
 		projectUpdateRepositorySchemaInsert: projectUpdateRepositorySchemaInsert,
+		schemaSelectRepository:              schemaSelectRepository,
 		moduleSelectRepository:              moduleSelectRepository,
+		moduleListVersionsRepository:        moduleListVersionsRepository,
+		projectMemberSelectRepository:       projectMemberSelectRepository,
 	}
 }
 
@@ -78,14 +99,24 @@ func (service *ProjectUpdate) Exec(ctx context.Context, request *ProjectUpdateRe
 		return nil, otel.ReportError(span, err)
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleAdmin,
+	)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
 
-	// Validate that all modules in the workflow exist.
+	// Validate that all modules in the workflow exist. Version ranges must be satisfied by at least one published
+	// version.
+	resolvedModules := map[string]lib.DecodedModule{}
+
 	for _, module := range request.Workflow {
-		decodedModule := lib.DecodeModule(module)
+		var decodedModule lib.DecodedModule
+
+		decodedModule, err = ResolveModule(ctx, service.moduleListVersionsRepository, module)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
 
 		_, err = service.moduleSelectRepository.Exec(ctx, &dao.ModuleSelectRequest{
 			ID:         decodedModule.Module,
@@ -96,6 +127,8 @@ func (service *ProjectUpdate) Exec(ctx context.Context, request *ProjectUpdateRe
 		if err != nil {
 			return nil, otel.ReportError(span, err)
 		}
+
+		resolvedModules[module] = decodedModule
 	}
 
 	// Check for changes in the project workflow and update schemas accordingly.
@@ -109,21 +142,52 @@ func (service *ProjectUpdate) Exec(ctx context.Context, request *ProjectUpdateRe
 		requestModules[lib.VersionlessModule(module)] = module
 	}
 
-	var addedModules, removedModules []string
+	var (
+		addedModules   []string
+		removedModules []*dao.Schema
+	)
 
 	for rModule, rFullModule := range requestModules {
-		if fullModule, exists := projectModules[rModule]; !exists {
+		fullModule, exists := projectModules[rModule]
+		if !exists {
 			addedModules = append(addedModules, rFullModule)
-		} else if fullModule != rFullModule {
-			// Make sure no module has been upgraded, as this operation should be performed using its own update
-			// mechanism.
-			return nil, ErrForbiddenModuleUpgrade
+
+			continue
+		}
+
+		if fullModule == rFullModule {
+			continue
+		}
+
+		var latestSchema *dao.Schema
+
+		latestSchema, err = service.selectLatestSchema(ctx, project.ID, fullModule)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		// Make sure no module has been upgraded or downgraded, as this operation should be performed using its own
+		// update mechanism.
+		if !isCompatibleWorkflowChange(fullModule, rFullModule, latestSchema) {
+			return nil, otel.ReportError(span, ErrForbiddenModuleUpgrade)
 		}
 	}
 
-	for pModule, pVersion := range projectModules {
-		if _, exists := requestModules[pModule]; !exists {
-			removedModules = append(removedModules, pVersion)
+	for pModule, pFullModule := range projectModules {
+		if _, exists := requestModules[pModule]; exists {
+			continue
+		}
+
+		var latestSchema *dao.Schema
+
+		latestSchema, err = service.selectLatestSchema(ctx, project.ID, pFullModule)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		// Modules that never received any data have nothing to remove.
+		if latestSchema != nil {
+			removedModules = append(removedModules, latestSchema)
 		}
 	}
 
@@ -141,37 +205,38 @@ func (service *ProjectUpdate) Exec(ctx context.Context, request *ProjectUpdateRe
 		}
 
 		for _, module := range addedModules {
-			decodedModule := lib.DecodeModule(module)
+			decodedModule := resolvedModules[module]
 
 			_, err = service.projectUpdateRepositorySchemaInsert.Exec(ctx, &dao.SchemaInsertRequest{
-				ID:              uuid.New(),
-				ProjectID:       project.ID,
-				Owner:           &project.Owner,
-				ModuleID:        decodedModule.Module,
-				ModuleNamespace: decodedModule.Namespace,
-				ModuleVersion:   decodedModule.Version,
-				Source:          dao.SchemaSourceUser,
-				Data:            map[string]any{},
-				Now:             time.Now().UTC(),
+				ID:               uuid.New(),
+				ProjectID:        project.ID,
+				Owner:            &project.Owner,
+				ModuleID:         decodedModule.Module,
+				ModuleNamespace:  decodedModule.Namespace,
+				ModuleVersion:    decodedModule.Version,
+				ModulePreversion: decodedModule.Preversion,
+				Source:           dao.SchemaSourceUser,
+				Data:             map[string]any{},
+				Now:              time.Now().UTC(),
 			})
 			if err != nil {
 				return err
 			}
 		}
 
-		for _, module := range removedModules {
-			decodedModule := lib.DecodeModule(module)
-
+		// Removed modules are recorded with the version their data was last saved with.
+		for _, latestSchema := range removedModules {
 			_, err = service.projectUpdateRepositorySchemaInsert.Exec(ctx, &dao.SchemaInsertRequest{
-				ID:              uuid.New(),
-				ProjectID:       project.ID,
-				Owner:           &project.Owner,
-				ModuleID:        decodedModule.Module,
-				ModuleNamespace: decodedModule.Namespace,
-				ModuleVersion:   decodedModule.Version,
-				Source:          dao.SchemaSourceUser,
-				Data:            nil,
-				Now:             time.Now().UTC(),
+				ID:               uuid.New(),
+				ProjectID:        project.ID,
+				Owner:            &project.Owner,
+				ModuleID:         latestSchema.ModuleID,
+				ModuleNamespace:  latestSchema.ModuleNamespace,
+				ModuleVersion:    latestSchema.ModuleVersion,
+				ModulePreversion: latestSchema.ModulePreversion,
+				Source:           dao.SchemaSourceUser,
+				Data:             nil,
+				Now:              time.Now().UTC(),
 			})
 			if err != nil {
 				return err
@@ -186,3 +251,51 @@ func (service *ProjectUpdate) Exec(ctx context.Context, request *ProjectUpdateRe
 
 	return otel.ReportSuccess(span, loadProject(updatedProject)), nil
 }
+
+// selectLatestSchema returns the latest schema saved for a module of the project, or nil if the module has no data.
+func (service *ProjectUpdate) selectLatestSchema(
+	ctx context.Context, projectID uuid.UUID, module string,
+) (*dao.Schema, error) {
+	decodedModule := lib.DecodeModule(module)
+
+	schema, err := service.schemaSelectRepository.Exec(ctx, &dao.SchemaSelectRequest{
+		ProjectID:       projectID,
+		ModuleID:        decodedModule.Module,
+		ModuleNamespace: decodedModule.Namespace,
+	})
+	if errors.Is(err, dao.ErrSchemaSelectNotFound) {
+		return nil, nil
+	}
+
+	return schema, err
+}
+
+// isCompatibleWorkflowChange returns true if a workflow entry can be replaced without going through the module
+// upgrade operation. This is the case when an exact version is replaced by a range that includes it, or when a
+// range is replaced by one of the versions it includes. In the latter case, the exact version cannot be lower than
+// the one the latest schema was saved with, as the data would otherwise be downgraded without any migration.
+func isCompatibleWorkflowChange(current, next string, latestSchema *dao.Schema) bool {
+	decodedCurrent := lib.DecodeModule(current)
+	decodedNext := lib.DecodeModule(next)
+
+	switch {
+	case decodedCurrent.Range == "" && decodedNext.Range != "":
+		return lib.MatchModuleRange(decodedNext.Range, decodedCurrent.Version, decodedCurrent.Preversion)
+	case decodedCurrent.Range != "" && decodedNext.Range == "":
+		if !lib.MatchModuleRange(decodedCurrent.Range, decodedNext.Version, decodedNext.Preversion) {
+			return false
+		}
+
+		if latestSchema == nil {
+			return true
+		}
+
+		versionCmp := lib.CompareModuleVersions(decodedNext.Version, latestSchema.ModuleVersion)
+
+		// A stable release cannot go back to a pre-version of the same version.
+		return versionCmp > 0 ||
+			(versionCmp == 0 && (latestSchema.ModulePreversion != "" || decodedNext.Preversion == ""))
+	default:
+		return false
+	}
+}
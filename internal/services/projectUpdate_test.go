@@ -40,7 +40,15 @@ func TestProjectUpdate(t *testing.T) {
 		err  error
 	}
 
+	type schemaSelectMock struct {
+		resp *dao.Schema
+		err  error
+	}
+
 	type schemaInsertMock struct {
+		// expectModuleVersion, when set, is the module version the schema must be recorded with.
+		expectModuleVersion string
+
 		resp *dao.Schema
 		err  error
 	}
@@ -50,16 +58,29 @@ func TestProjectUpdate(t *testing.T) {
 		err  error
 	}
 
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.ProjectUpdateRequest
 
-		projectSelectMock  *projectSelectMock
-		moduleSelectMocks  []moduleSelectMock
-		expectModuleSelect int
-		projectUpdateMock  *projectUpdateMock
-		schemaInsertMocks  []schemaInsertMock
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		moduleListVersionsMocks []moduleListVersionsMock
+		moduleSelectMocks       []moduleSelectMock
+		expectModuleSelect      int
+		schemaSelectMocks       []schemaSelectMock
+		projectUpdateMock       *projectUpdateMock
+		schemaInsertMocks       []schemaInsertMock
 
 		expect    *services.Project
 		expectErr error
@@ -206,6 +227,10 @@ func TestProjectUpdate(t *testing.T) {
 			},
 			expectModuleSelect: 1,
 
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "concept", ModuleNamespace: "agora", ModuleVersion: "1.0.0"}},
+			},
+
 			projectUpdateMock: &projectUpdateMock{
 				resp: &dao.Project{
 					ID:        projectID,
@@ -220,6 +245,7 @@ func TestProjectUpdate(t *testing.T) {
 
 			schemaInsertMocks: []schemaInsertMock{
 				{
+					expectModuleVersion: "1.0.0",
 					resp: &dao.Schema{
 						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000200"),
 						ProjectID:       projectID,
@@ -244,6 +270,131 @@ func TestProjectUpdate(t *testing.T) {
 				UpdatedAt: updatedTime,
 			},
 		},
+		{
+			name: "Success/RemoveModule/VersionRange",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@v1.0.0"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@^1"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMocks: []moduleSelectMock{
+				{resp: &dao.Module{ID: "idea", Namespace: "agora", Version: "1.0.0"}},
+			},
+			expectModuleSelect: 1,
+
+			// Newer versions may match the range, but the data was saved with an older one.
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "concept", ModuleNamespace: "agora", ModuleVersion: "1.1.0"}},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			schemaInsertMocks: []schemaInsertMock{
+				{
+					expectModuleVersion: "1.1.0",
+					resp: &dao.Schema{
+						ID:              uuid.MustParse("00000000-0000-0000-0000-000000000200"),
+						ProjectID:       projectID,
+						Owner:           &ownerID,
+						ModuleID:        "concept",
+						ModuleNamespace: "agora",
+						ModuleVersion:   "1.1.0",
+						Source:          dao.SchemaSourceUser,
+						Data:            nil,
+						CreatedAt:       updatedTime,
+					},
+				},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Success/RemoveModule/NoData",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@v1.0.0"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMocks: []moduleSelectMock{
+				{resp: &dao.Module{ID: "idea", Namespace: "agora", Version: "1.0.0"}},
+			},
+			expectModuleSelect: 1,
+
+			// The module has no data to remove, so no schema version is created.
+			schemaSelectMocks: []schemaSelectMock{
+				{err: dao.ErrSchemaSelectNotFound},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
 		{
 			name: "Success/AddAndRemoveModules",
 
@@ -272,6 +423,10 @@ func TestProjectUpdate(t *testing.T) {
 			},
 			expectModuleSelect: 2,
 
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "concept", ModuleNamespace: "agora", ModuleVersion: "1.0.0"}},
+			},
+
 			projectUpdateMock: &projectUpdateMock{
 				resp: &dao.Project{
 					ID:        projectID,
@@ -323,6 +478,115 @@ func TestProjectUpdate(t *testing.T) {
 				UpdatedAt: updatedTime,
 			},
 		},
+		{
+			name: "Success/ExactToRange",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@^1.2"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.2.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMocks: []moduleListVersionsMock{
+				{resp: []*dao.ModuleVersion{{Version: "1.3.0"}, {Version: "1.2.0"}}},
+			},
+			moduleSelectMocks: []moduleSelectMock{
+				{resp: &dao.Module{ID: "idea", Namespace: "agora", Version: "1.3.0"}},
+			},
+			expectModuleSelect: 1,
+
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "idea", ModuleNamespace: "agora", ModuleVersion: "1.2.0"}},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@^1.2"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@^1.2"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Success/RangeToExact",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@v1.3.0"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@^1.2"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMocks: []moduleSelectMock{
+				{resp: &dao.Module{ID: "idea", Namespace: "agora", Version: "1.3.0"}},
+			},
+			expectModuleSelect: 1,
+
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "idea", ModuleNamespace: "agora", ModuleVersion: "1.2.0"}},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.3.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v1.3.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
 		{
 			name: "Error/InvalidRequest/EmptyWorkflow",
 
@@ -412,8 +676,36 @@ func TestProjectUpdate(t *testing.T) {
 					UpdatedAt: baseTime,
 				},
 			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   otherUserID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@v1.0.0"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleGenerator},
+			},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
+			expectErr: services.ErrProjectAccessDenied,
 		},
 		{
 			name: "Error/ModuleNotFound",
@@ -471,8 +763,143 @@ func TestProjectUpdate(t *testing.T) {
 			},
 			expectModuleSelect: 1,
 
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "idea", ModuleNamespace: "agora", ModuleVersion: "1.0.0"}},
+			},
+
+			expectErr: services.ErrForbiddenModuleUpgrade,
+		},
+		{
+			name: "Error/ForbiddenModuleUpgrade/RangeMismatch",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@^2"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMocks: []moduleListVersionsMock{
+				{resp: []*dao.ModuleVersion{{Version: "2.1.0"}, {Version: "1.0.0"}}},
+			},
+			moduleSelectMocks: []moduleSelectMock{
+				{resp: &dao.Module{ID: "idea", Namespace: "agora", Version: "2.1.0"}},
+			},
+			expectModuleSelect: 1,
+
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "idea", ModuleNamespace: "agora", ModuleVersion: "1.0.0"}},
+			},
+
+			expectErr: services.ErrForbiddenModuleUpgrade,
+		},
+		{
+			name: "Error/ForbiddenModuleUpgrade/RangeToLowerVersion",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@v1.0.0"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@^1"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMocks: []moduleSelectMock{
+				{resp: &dao.Module{ID: "idea", Namespace: "agora", Version: "1.0.0"}},
+			},
+			expectModuleSelect: 1,
+
+			// The version is included in the range, but is older than the one the data was saved with.
+			schemaSelectMocks: []schemaSelectMock{
+				{resp: &dao.Schema{ModuleID: "idea", ModuleNamespace: "agora", ModuleVersion: "1.3.0"}},
+			},
+
 			expectErr: services.ErrForbiddenModuleUpgrade,
 		},
+		{
+			name: "Error/SchemaSelect",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@v1.0.0"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMocks: []moduleSelectMock{
+				{resp: &dao.Module{ID: "idea", Namespace: "agora", Version: "1.0.0"}},
+			},
+			expectModuleSelect: 1,
+
+			schemaSelectMocks: []schemaSelectMock{
+				{err: errFoo},
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: &services.ProjectUpdateRequest{
+				ID:       projectID,
+				UserID:   ownerID,
+				Title:    "Test Project",
+				Workflow: []string{"agora:idea@~1.4"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMocks: []moduleListVersionsMock{
+				{resp: []*dao.ModuleVersion{{Version: "1.5.0"}, {Version: "1.3.2"}}},
+			},
+
+			expectErr: services.ErrModuleRangeNotSatisfied,
+		},
 		{
 			name: "Error/ProjectUpdate",
 
@@ -566,7 +993,10 @@ func TestProjectUpdate(t *testing.T) {
 				projectUpdateRepositorySelect := servicesmocks.NewMockProjectUpdateRepositorySelect(t)
 				projectUpdateRepository := servicesmocks.NewMockProjectUpdateRepository(t)
 				projectUpdateRepositorySchemaInsert := servicesmocks.NewMockProjectUpdateRepositorySchemaInsert(t)
+				schemaSelectRepository := servicesmocks.NewMockProjectUpdateRepositorySchemaSelect(t)
 				moduleSelectRepository := servicesmocks.NewMockProjectUpdateRepositoryModuleSelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockProjectUpdateRepositoryModuleListVersions(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectUpdateRepositoryProjectMemberSelect(t)
 
 				if testCase.projectSelectMock != nil {
 					projectUpdateRepositorySelect.EXPECT().
@@ -576,6 +1006,22 @@ func TestProjectUpdate(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				for _, moduleListVersionsMock := range testCase.moduleListVersionsMocks {
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, mock.Anything).
+						Return(moduleListVersionsMock.resp, moduleListVersionsMock.err).
+						Once()
+				}
+
 				for i := range testCase.expectModuleSelect {
 					moduleSelectRepository.EXPECT().
 						Exec(mock.Anything, mock.Anything).
@@ -583,6 +1029,15 @@ func TestProjectUpdate(t *testing.T) {
 						Once()
 				}
 
+				for _, schemaSelectMock := range testCase.schemaSelectMocks {
+					schemaSelectRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaSelectRequest) bool {
+							return req.ProjectID == testCase.request.ID
+						})).
+						Return(schemaSelectMock.resp, schemaSelectMock.err).
+						Once()
+				}
+
 				if testCase.projectUpdateMock != nil {
 					projectUpdateRepository.EXPECT().
 						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectUpdateRequest) bool {
@@ -595,7 +1050,10 @@ func TestProjectUpdate(t *testing.T) {
 
 				for _, schemaInsertMock := range testCase.schemaInsertMocks {
 					projectUpdateRepositorySchemaInsert.EXPECT().
-						Exec(mock.Anything, mock.Anything).
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+							return schemaInsertMock.expectModuleVersion == "" ||
+								req.ModuleVersion == schemaInsertMock.expectModuleVersion
+						})).
 						Return(schemaInsertMock.resp, schemaInsertMock.err).
 						Once()
 				}
@@ -604,7 +1062,10 @@ func TestProjectUpdate(t *testing.T) {
 					projectUpdateRepository,
 					projectUpdateRepositorySelect,
 					projectUpdateRepositorySchemaInsert,
+					schemaSelectRepository,
 					moduleSelectRepository,
+					moduleListVersionsRepository,
+					projectMemberSelectRepository,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -614,7 +1075,10 @@ func TestProjectUpdate(t *testing.T) {
 				projectUpdateRepositorySelect.AssertExpectations(t)
 				projectUpdateRepository.AssertExpectations(t)
 				projectUpdateRepositorySchemaInsert.AssertExpectations(t)
+				schemaSelectRepository.AssertExpectations(t)
 				moduleSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
 			})
 		})
 	}
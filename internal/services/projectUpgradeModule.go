@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+var ErrModuleUpgradeNotNewer = errors.New("module upgrade must target a newer version of the module")
+
+type ProjectUpgradeModuleRepository interface {
+	Exec(ctx context.Context, request *dao.ProjectUpdateRequest) (*dao.Project, error)
+}
+
+type ProjectUpgradeModuleRepositorySelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type ProjectUpgradeModuleRepositoryModuleSelect interface {
+	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
+}
+
+type ProjectUpgradeModuleRepositorySchemaSelect interface {
+	Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)
+}
+
+type ProjectUpgradeModuleRepositorySchemaInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)
+}
+
+type ProjectUpgradeModuleRepositoryModuleListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
+type ProjectUpgradeModuleRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type ProjectUpgradeModuleRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	// Module is the full module string of the target version, or a version range. The project workflow must
+	// contain an earlier version of the same module. When a range is given, it replaces the workflow entry as-is,
+	// and the project data is migrated to the latest version that satisfies it.
+	Module string `validate:"required,workflowModule,max=512"`
+}
+
+type ProjectUpgradeModule struct {
+	projectUpdateRepository       ProjectUpgradeModuleRepository
+	projectSelectRepository       ProjectUpgradeModuleRepositorySelect
+	moduleSelectRepository        ProjectUpgradeModuleRepositoryModuleSelect
+	schemaSelectRepository        ProjectUpgradeModuleRepositorySchemaSelect
+	schemaInsertRepository        ProjectUpgradeModuleRepositorySchemaInsert
+	moduleListVersionsRepository  ProjectUpgradeModuleRepositoryModuleListVersions
+	projectMemberSelectRepository ProjectUpgradeModuleRepositoryProjectMemberSelect
+}
+
+func NewProjectUpgradeModule(
+	projectUpdateRepository ProjectUpgradeModuleRepository,
+	projectSelectRepository ProjectUpgradeModuleRepositorySelect,
+	moduleSelectRepository ProjectUpgradeModuleRepositoryModuleSelect,
+	schemaSelectRepository ProjectUpgradeModuleRepositorySchemaSelect,
+	schemaInsertRepository ProjectUpgradeModuleRepositorySchemaInsert,
+	moduleListVersionsRepository ProjectUpgradeModuleRepositoryModuleListVersions,
+	projectMemberSelectRepository ProjectUpgradeModuleRepositoryProjectMemberSelect,
+) *ProjectUpgradeModule {
+	return &ProjectUpgradeModule{
+		projectUpdateRepository:       projectUpdateRepository,
+		projectSelectRepository:       projectSelectRepository,
+		moduleSelectRepository:        moduleSelectRepository,
+		schemaSelectRepository:        schemaSelectRepository,
+		schemaInsertRepository:        schemaInsertRepository,
+		moduleListVersionsRepository:  moduleListVersionsRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+// Exec moves a module of the project workflow to a newer version.
+//
+// The latest data saved for the module is carried forward, using the migration rules shipped with the target
+// version, and recorded as a new schema version with the USER source, as the upgrade is requested by a user. The
+// migrated data is validated in draft mode: the new version may introduce required properties that the project has
+// not filled yet, but migrated values must have the right types. If the module has no data yet, only the workflow
+// is updated.
+func (service *ProjectUpgradeModule) Exec(ctx context.Context, request *ProjectUpgradeModuleRequest) (*Project, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.ProjectUpgradeModule")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleAdmin,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// Find the workflow entry to upgrade.
+	workflowIndex := slices.IndexFunc(project.Workflow, func(module string) bool {
+		return lib.VersionlessModule(module) == lib.VersionlessModule(request.Module)
+	})
+	if workflowIndex < 0 {
+		return nil, otel.ReportError(span, ErrModuleNotInProject)
+	}
+
+	currentModule := lib.DecodeModule(project.Workflow[workflowIndex])
+
+	targetModule, err := ResolveModule(ctx, service.moduleListVersionsRepository, request.Module)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	var currentData map[string]any
+
+	currentSchema, err := service.schemaSelectRepository.Exec(ctx, &dao.SchemaSelectRequest{
+		ProjectID:       project.ID,
+		ModuleID:        currentModule.Module,
+		ModuleNamespace: currentModule.Namespace,
+	})
+
+	switch {
+	case errors.Is(err, dao.ErrSchemaSelectNotFound):
+		// Nothing to carry forward.
+	case err != nil:
+		return nil, otel.ReportError(span, err)
+	default:
+		currentData = currentSchema.Data
+	}
+
+	// When the workflow references the module through a range, the version the project data is pinned to is the
+	// one of the latest schema.
+	if currentModule.Range != "" {
+		currentModule.Version, currentModule.Preversion = "", ""
+
+		if currentSchema != nil {
+			currentModule.Version = currentSchema.ModuleVersion
+			currentModule.Preversion = currentSchema.ModulePreversion
+		}
+	}
+
+	// A module can only move forward. Within the same version, a pre-version may be replaced by another pre-version
+	// or by the stable release, but a stable release cannot go back to a pre-version.
+	if currentModule.Version != "" {
+		versionCmp := lib.CompareModuleVersions(targetModule.Version, currentModule.Version)
+		if versionCmp < 0 ||
+			(versionCmp == 0 && (currentModule.Preversion == "" || currentModule.Preversion == targetModule.Preversion)) {
+			return nil, otel.ReportError(span, ErrModuleUpgradeNotNewer)
+		}
+	}
+
+	module, err := service.moduleSelectRepository.Exec(ctx, &dao.ModuleSelectRequest{
+		ID:         targetModule.Module,
+		Namespace:  targetModule.Namespace,
+		Version:    targetModule.Version,
+		Preversion: targetModule.Preversion,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// =================================================================================================================
+	// Data migration
+	// =================================================================================================================
+
+	var migratedData map[string]any
+
+	// Without a prior schema, there is no data to carry forward, and only the workflow is updated.
+	if currentSchema != nil {
+		migratedData, err = lib.MigrateModuleData(currentData, module.Migrations)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+
+		err = lib.ValidateJSONSchema(&module.Schema, migratedData, true)
+		if err != nil {
+			return nil, otel.ReportError(span, errors.Join(err, ErrInvalidData))
+		}
+	}
+
+	// =================================================================================================================
+	// Upgrade
+	// =================================================================================================================
+
+	workflow := slices.Clone(project.Workflow)
+	workflow[workflowIndex] = request.Module
+
+	var updatedProject *dao.Project
+
+	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
+		updatedProject, err = service.projectUpdateRepository.Exec(ctx, &dao.ProjectUpdateRequest{
+			ID:       project.ID,
+			Title:    project.Title,
+			Workflow: workflow,
+			Now:      time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if currentSchema == nil {
+			return nil
+		}
+
+		_, err = service.schemaInsertRepository.Exec(ctx, &dao.SchemaInsertRequest{
+			ID:               uuid.New(),
+			ProjectID:        project.ID,
+			Owner:            &request.UserID,
+			ModuleID:         module.ID,
+			ModuleNamespace:  module.Namespace,
+			ModuleVersion:    module.Version,
+			ModulePreversion: module.Preversion,
+			Source:           dao.SchemaSourceUser,
+			Data:             migratedData,
+			Now:              time.Now().UTC(),
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadProject(updatedProject)), nil
+}
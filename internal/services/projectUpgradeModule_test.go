@@ -0,0 +1,1158 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestProjectUpgradeModule(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedTime := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	testModuleSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {Type: "string"},
+			"genre": {Type: "string", Enum: []any{"DRAMA", "COMEDY"}},
+		},
+		Required: []string{"title", "genre"},
+	}
+
+	testMigrations := []models.ModuleMigration{
+		{Op: models.ModuleMigrationOpRename, Path: "/name", To: "title"},
+		{Op: models.ModuleMigrationOpDefault, Path: "/genre", Value: "DRAMA"},
+		{Op: models.ModuleMigrationOpDrop, Path: "/legacy"},
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type moduleSelectMock struct {
+		resp *dao.Module
+		err  error
+	}
+
+	type schemaSelectMock struct {
+		resp *dao.Schema
+		err  error
+	}
+
+	type projectUpdateMock struct {
+		expectWorkflow []string
+
+		resp *dao.Project
+		err  error
+	}
+
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
+	type schemaInsertMock struct {
+		expectPreversion string
+		expectSource     dao.SchemaSource
+		expectData       map[string]any
+
+		resp *dao.Schema
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.ProjectUpgradeModuleRequest
+		// resolvedModule is the exact module the request resolves to, when it targets a version range.
+		resolvedModule string
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		moduleListVersionsMock  *moduleListVersionsMock
+		moduleSelectMock        *moduleSelectMock
+		schemaSelectMock        *schemaSelectMock
+		projectUpdateMock       *projectUpdateMock
+		schemaInsertMock        *schemaInsertMock
+
+		expect    *services.Project
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.0.0",
+					Preversion: "",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"name": "Dune", "legacy": true},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			// The migrated data is written by the user, whatever the source of the previous version.
+			schemaInsertMock: &schemaInsertMock{
+				expectPreversion: "",
+				expectSource:     dao.SchemaSourceUser,
+				expectData:       map[string]any{"title": "Dune", "genre": "DRAMA"},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Success/NoPreviousSchema",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.0.0",
+					Preversion: "",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			// There is no data to carry forward, so no schema version is created.
+			schemaSelectMock: &schemaSelectMock{
+				err: dao.ErrSchemaSelectNotFound,
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Success/Member",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleAdmin},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.0.0",
+					Preversion: "",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"name": "Dune"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			// The new version is owned by the member who ran the upgrade.
+			schemaInsertMock: &schemaInsertMock{
+				expectPreversion: "",
+				expectSource:     dao.SchemaSourceUser,
+				expectData:       map[string]any{"title": "Dune", "genre": "DRAMA"},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Success/PreversionToStable",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v1.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0-beta-1"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "1.0.0",
+					Preversion: "",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"title": "Dune"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@v1.0.0"},
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			schemaInsertMock: &schemaInsertMock{
+				expectPreversion: "",
+				expectSource:     dao.SchemaSourceUser,
+				expectData:       map[string]any{"title": "Dune", "genre": "DRAMA"},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Success/ToPreversion",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0-beta-1",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.0.0",
+					Preversion: "-beta-1",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"name": "Dune"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@v2.0.0-beta-1", "agora:concept@v1.0.0"},
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v2.0.0-beta-1", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			schemaInsertMock: &schemaInsertMock{
+				expectPreversion: "-beta-1",
+				expectSource:     dao.SchemaSourceUser,
+				expectData:       map[string]any{"title": "Dune", "genre": "DRAMA"},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@v2.0.0-beta-1", "agora:concept@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Success/VersionRange",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@^2",
+			},
+			resolvedModule: "agora:idea@v2.1.0",
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@^1.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{{Version: "3.0.0"}, {Version: "2.1.0"}, {Version: "1.5.0"}},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.5.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"name": "Dune"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.1.0",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@^2", "agora:concept@v1.0.0"},
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@^2", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			schemaInsertMock: &schemaInsertMock{
+				expectSource: dao.SchemaSourceUser,
+				expectData:   map[string]any{"title": "Dune", "genre": "DRAMA"},
+			},
+
+			expect: &services.Project{
+				ID:        projectID,
+				Owner:     ownerID,
+				Lang:      config.LangEN,
+				Title:     "Test Project",
+				Workflow:  []string{"agora:idea@^2", "agora:concept@v1.0.0"},
+				CreatedAt: baseTime,
+				UpdatedAt: updatedTime,
+			},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/UserDoesNotOwnProject",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     otherUserID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     otherUserID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: ownerID, Role: dao.ProjectMemberRoleGenerator},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ModuleNotInProject",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:scenes@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrModuleNotInProject,
+		},
+		{
+			name: "Error/NotNewer/OlderVersion",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v0.9.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				err: dao.ErrSchemaSelectNotFound,
+			},
+
+			expectErr: services.ErrModuleUpgradeNotNewer,
+		},
+		{
+			name: "Error/NotNewer/SameVersion",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v1.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				err: dao.ErrSchemaSelectNotFound,
+			},
+
+			expectErr: services.ErrModuleUpgradeNotNewer,
+		},
+		{
+			name: "Error/NotNewer/StableToPreversion",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v1.0.0-beta-1",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				err: dao.ErrSchemaSelectNotFound,
+			},
+
+			expectErr: services.ErrModuleUpgradeNotNewer,
+		},
+		{
+			name: "Error/NotNewer/VersionRangeEntry",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v1.2.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@^1.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.5.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"name": "Dune"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			expectErr: services.ErrModuleUpgradeNotNewer,
+		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@^2",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{{Version: "1.5.0"}},
+			},
+
+			expectErr: services.ErrModuleRangeNotSatisfied,
+		},
+		{
+			name: "Error/ModuleSelect",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				err: dao.ErrSchemaSelectNotFound,
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				err: dao.ErrModuleSelectNotFound,
+			},
+
+			expectErr: dao.ErrModuleSelectNotFound,
+		},
+		{
+			name: "Error/SchemaSelect",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidData",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.0.0",
+					Preversion: "",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"name": 42},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			expectErr: services.ErrInvalidData,
+		},
+		{
+			name: "Error/ProjectUpdate",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.0.0",
+					Preversion: "",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"name": "Dune"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				err:            errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaInsert",
+
+			request: &services.ProjectUpgradeModuleRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "agora:idea@v2.0.0",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v1.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "idea",
+					Namespace:  "agora",
+					Version:    "2.0.0",
+					Preversion: "",
+					Schema:     testModuleSchema,
+					Migrations: testMigrations,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "idea",
+					ModuleNamespace: "agora",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"name": "Dune"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectUpdateMock: &projectUpdateMock{
+				expectWorkflow: []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"agora:idea@v2.0.0", "agora:concept@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: updatedTime,
+				},
+			},
+
+			schemaInsertMock: &schemaInsertMock{
+				expectPreversion: "",
+				expectSource:     dao.SchemaSourceUser,
+				expectData:       map[string]any{"title": "Dune", "genre": "DRAMA"},
+				err:              errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				projectUpdateRepository := servicesmocks.NewMockProjectUpgradeModuleRepository(t)
+				projectSelectRepository := servicesmocks.NewMockProjectUpgradeModuleRepositorySelect(t)
+				moduleSelectRepository := servicesmocks.NewMockProjectUpgradeModuleRepositoryModuleSelect(t)
+				schemaSelectRepository := servicesmocks.NewMockProjectUpgradeModuleRepositorySchemaSelect(t)
+				schemaInsertRepository := servicesmocks.NewMockProjectUpgradeModuleRepositorySchemaInsert(t)
+				moduleListVersionsRepository := servicesmocks.NewMockProjectUpgradeModuleRepositoryModuleListVersions(t)
+				projectMemberSelectRepository := servicesmocks.NewMockProjectUpgradeModuleRepositoryProjectMemberSelect(t)
+
+				resolvedModule := testCase.resolvedModule
+				if resolvedModule == "" {
+					resolvedModule = testCase.request.Module
+				}
+
+				decodedModule := lib.DecodeModule(resolvedModule)
+
+				if testCase.moduleListVersionsMock != nil {
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        decodedModule.Module,
+							Namespace: decodedModule.Namespace,
+						}).
+						Return(testCase.moduleListVersionsMock.resp, testCase.moduleListVersionsMock.err)
+				}
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{
+							ID: testCase.request.ProjectID,
+						}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.moduleSelectMock != nil {
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:         decodedModule.Module,
+							Namespace:  decodedModule.Namespace,
+							Version:    decodedModule.Version,
+							Preversion: decodedModule.Preversion,
+						}).
+						Return(testCase.moduleSelectMock.resp, testCase.moduleSelectMock.err)
+				}
+
+				if testCase.schemaSelectMock != nil {
+					schemaSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaSelectRequest{
+							ProjectID:       testCase.request.ProjectID,
+							ModuleID:        decodedModule.Module,
+							ModuleNamespace: decodedModule.Namespace,
+						}).
+						Return(testCase.schemaSelectMock.resp, testCase.schemaSelectMock.err)
+				}
+
+				if testCase.projectUpdateMock != nil {
+					projectUpdateRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ProjectUpdateRequest) bool {
+							return req.ID == testCase.request.ProjectID &&
+								req.Title == testCase.projectSelectMock.resp.Title &&
+								assert.Equal(t, testCase.projectUpdateMock.expectWorkflow, req.Workflow) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.projectUpdateMock.resp, testCase.projectUpdateMock.err)
+				}
+
+				if testCase.schemaInsertMock != nil {
+					schemaInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+							return req.ProjectID == testCase.request.ProjectID &&
+								*req.Owner == testCase.request.UserID &&
+								req.ModuleID == decodedModule.Module &&
+								req.ModuleNamespace == decodedModule.Namespace &&
+								req.ModuleVersion == decodedModule.Version &&
+								req.ModulePreversion == testCase.schemaInsertMock.expectPreversion &&
+								req.Source == testCase.schemaInsertMock.expectSource &&
+								assert.Equal(t, testCase.schemaInsertMock.expectData, req.Data) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.schemaInsertMock.resp, testCase.schemaInsertMock.err)
+				}
+
+				service := services.NewProjectUpgradeModule(
+					projectUpdateRepository,
+					projectSelectRepository,
+					moduleSelectRepository,
+					schemaSelectRepository,
+					schemaInsertRepository,
+					moduleListVersionsRepository,
+					projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				projectUpdateRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				moduleSelectRepository.AssertExpectations(t)
+				schemaSelectRepository.AssertExpectations(t)
+				schemaInsertRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
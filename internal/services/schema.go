@@ -1,11 +1,18 @@
 package services
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
 )
 
 type Schema struct {
@@ -17,8 +24,14 @@ type Schema struct {
 	ModuleVersion    string
 	ModulePreversion string
 	Source           string
-	Data             map[string]any
-	CreatedAt        time.Time
+	// Instructions the user gave to steer the generation of this version, if any.
+	Instructions string
+	Data         map[string]any
+	// ForkedFrom is the ID of the schema this version was copied from, when the project was forked.
+	ForkedFrom *uuid.UUID
+	CreatedAt  time.Time
+	// Generation describes how the data was generated, for versions generated by AI.
+	Generation *SchemaGeneration
 }
 
 func loadSchema(schema *dao.Schema) *Schema {
@@ -31,23 +44,285 @@ func loadSchema(schema *dao.Schema) *Schema {
 		ModuleVersion:    schema.ModuleVersion,
 		ModulePreversion: schema.ModulePreversion,
 		Source:           schema.Source.String(),
+		Instructions:     schema.Instructions,
 		Data:             schema.Data,
+		ForkedFrom:       schema.ForkedFrom,
 		CreatedAt:        schema.CreatedAt,
 	}
 }
 
+func loadSchemasMap(item *dao.Schema, _ int) *Schema {
+	return loadSchema(item)
+}
+
+// SchemaCandidate is an alternative content generated for a module, pending selection. It only becomes part of the
+// history of the project once promoted.
+type SchemaCandidate struct {
+	ID               uuid.UUID
+	ProjectID        uuid.UUID
+	Owner            uuid.UUID
+	ModuleID         string
+	ModuleNamespace  string
+	ModuleVersion    string
+	ModulePreversion string
+	Instructions     string
+	Data             map[string]any
+	CreatedAt        time.Time
+}
+
+func loadSchemaCandidate(candidate *dao.SchemaCandidate) *SchemaCandidate {
+	return &SchemaCandidate{
+		ID:               candidate.ID,
+		ProjectID:        candidate.ProjectID,
+		Owner:            candidate.Owner,
+		ModuleID:         candidate.ModuleID,
+		ModuleNamespace:  candidate.ModuleNamespace,
+		ModuleVersion:    candidate.ModuleVersion,
+		ModulePreversion: candidate.ModulePreversion,
+		Instructions:     candidate.Instructions,
+		Data:             candidate.Data,
+		CreatedAt:        candidate.CreatedAt,
+	}
+}
+
+func loadSchemaCandidatesMap(item *dao.SchemaCandidate, _ int) *SchemaCandidate {
+	return loadSchemaCandidate(item)
+}
+
 type SchemaVersion struct {
-	ID        uuid.UUID
-	CreatedAt time.Time
+	ID           uuid.UUID
+	Instructions string
+	CreatedAt    time.Time
+	// Generation describes how the data was generated, for versions generated by AI. Messages are not loaded.
+	Generation *SchemaGeneration
 }
 
 func loadSchemaVersion(s *dao.SchemaVersion) *SchemaVersion {
 	return &SchemaVersion{
-		ID:        s.ID,
-		CreatedAt: s.CreatedAt,
+		ID:           s.ID,
+		Instructions: s.Instructions,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+// SchemaGenerationContextCut describes a schema of the context that could not be sent to the model in full.
+type SchemaGenerationContextCut struct {
+	SchemaID uuid.UUID
+	// Module is the module of the schema, as "<namespace>:<id>".
+	Module string
+	// Action is either "truncated" or "dropped".
+	Action string
+	// Tokens is the estimated number of tokens of the full schema.
+	Tokens int64
+	// KeptTokens is the estimated number of tokens actually sent.
+	KeptTokens int64
+}
+
+// SchemaGeneration records how an AI output was produced.
+type SchemaGeneration struct {
+	Model            string
+	Messages         []lib.CompletionMessage
+	TemplateVersion  string
+	PromptTokens     int64
+	CompletionTokens int64
+	Latency          time.Duration
+	ContextSchemaIDs []uuid.UUID
+	// ContextCuts explains which schemas of the context were truncated or dropped to fit the budget of the model.
+	ContextCuts  []*SchemaGenerationContextCut
+	BaseSchemaID *uuid.UUID
+}
+
+func loadSchemaGeneration(generation *dao.SchemaGeneration) *SchemaGeneration {
+	var messages []lib.CompletionMessage
+
+	for _, message := range generation.Messages {
+		messages = append(messages, lib.CompletionMessage{Role: lib.CompletionRole(message.Role), Content: message.Content})
+	}
+
+	var contextCuts []*SchemaGenerationContextCut
+
+	for _, cut := range generation.ContextCuts {
+		contextCuts = append(contextCuts, &SchemaGenerationContextCut{
+			SchemaID:   cut.SchemaID,
+			Module:     cut.Module,
+			Action:     cut.Action,
+			Tokens:     cut.Tokens,
+			KeptTokens: cut.KeptTokens,
+		})
+	}
+
+	return &SchemaGeneration{
+		Model:            generation.Model,
+		Messages:         messages,
+		TemplateVersion:  generation.TemplateVersion,
+		PromptTokens:     generation.PromptTokens,
+		CompletionTokens: generation.CompletionTokens,
+		Latency:          time.Duration(generation.LatencyMs) * time.Millisecond,
+		ContextSchemaIDs: generation.ContextSchemaIDs,
+		ContextCuts:      contextCuts,
+		BaseSchemaID:     generation.BaseSchemaID,
 	}
 }
 
 func loadSchemaVersionsMap(item *dao.SchemaVersion, _ int) *SchemaVersion {
 	return loadSchemaVersion(item)
 }
+
+// GenerationContext selects, among the latest schemas of a project, the data sent as context when generating content
+// for a module.
+//
+// When the module declares dependencies, only the data of those modules is kept, narrowed to the declared paths, and
+// in the order of declaration. Dependencies the project has no data for are skipped. Modules that declare no
+// dependency receive the data of every other module of the project, starting with the closest ones in the workflow.
+//
+// In both cases, schemas are sorted by priority, as expected by FitGenerationContext.
+func GenerationContext(
+	schemas []*dao.Schema, module lib.DecodedModule, workflow []string, dependencies []models.ModuleDependency,
+) ([]*dao.Schema, error) {
+	if len(dependencies) == 0 {
+		output := lo.Filter(schemas, func(item *dao.Schema, _ int) bool {
+			return item.ModuleNamespace != module.Namespace || item.ModuleID != module.Module
+		})
+
+		slices.SortStableFunc(output, func(a, b *dao.Schema) int {
+			return workflowDistance(workflow, module, a) - workflowDistance(workflow, module, b)
+		})
+
+		return output, nil
+	}
+
+	output := make([]*dao.Schema, 0, len(dependencies))
+
+	for _, dependency := range dependencies {
+		schema, ok := lo.Find(schemas, func(item *dao.Schema) bool {
+			return lib.DecodedModule{Namespace: item.ModuleNamespace, Module: item.ModuleID}.String() == dependency.Module
+		})
+		if !ok {
+			continue
+		}
+
+		data, err := lib.SliceModuleData(schema.Data, dependency.Paths)
+		if err != nil {
+			return nil, fmt.Errorf("slice data of module '%s': %w", dependency.Module, err)
+		}
+
+		sliced := *schema
+		sliced.Data = data
+		output = append(output, &sliced)
+	}
+
+	return output, nil
+}
+
+// workflowDistance ranks a schema by how far its module is from a target module, in the workflow of a project. At
+// equal distance, modules that precede the target come first, as content usually builds on the previous steps.
+// Modules that are not part of the workflow come last.
+func workflowDistance(workflow []string, target lib.DecodedModule, schema *dao.Schema) int {
+	position := func(namespace, id string) int {
+		return slices.IndexFunc(workflow, func(item string) bool {
+			decoded := lib.DecodeModule(item)
+
+			return decoded.Namespace == namespace && decoded.Module == id
+		})
+	}
+
+	targetPosition := position(target.Namespace, target.Module)
+	schemaPosition := position(schema.ModuleNamespace, schema.ModuleID)
+
+	if targetPosition < 0 || schemaPosition < 0 {
+		return math.MaxInt32
+	}
+
+	if schemaPosition < targetPosition {
+		return 2 * (targetPosition - schemaPosition)
+	}
+
+	return 2*(schemaPosition-targetPosition) + 1
+}
+
+// Bounds of the length strings are truncated to, when the context does not fit the budget.
+const (
+	contextTruncateMaxLength = 1024
+	contextTruncateMinLength = 64
+)
+
+// FitGenerationContext fits the context of a generation into a budget of tokens. Schemas must be sorted by priority:
+// they are kept as is while they fit. Once the budget runs low, the strings of the next schemas are truncated, as
+// little as possible, so they fit the remaining budget. Schemas that still do not fit are dropped, which leaves the
+// remaining budget to the next ones.
+//
+// The returned cuts explain every change made to the context. A budget of zero or less means no limit.
+func FitGenerationContext(
+	schemas []*dao.Schema, budget int64,
+) ([]*dao.Schema, []dao.SchemaGenerationContextCut, error) {
+	if budget <= 0 {
+		return schemas, nil, nil
+	}
+
+	var (
+		output []*dao.Schema
+		cuts   []dao.SchemaGenerationContextCut
+	)
+
+	remaining := budget
+
+	for _, schema := range schemas {
+		tokens, err := estimateSchemaTokens(schema)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if tokens <= remaining {
+			output = append(output, schema)
+			remaining -= tokens
+
+			continue
+		}
+
+		cut := dao.SchemaGenerationContextCut{
+			SchemaID: schema.ID,
+			Module:   lib.DecodedModule{Namespace: schema.ModuleNamespace, Module: schema.ModuleID}.String(),
+			Action:   dao.SchemaGenerationContextCutDropped,
+			Tokens:   tokens,
+		}
+
+		for maxLength := contextTruncateMaxLength; maxLength >= contextTruncateMinLength; maxLength /= 2 {
+			data, truncated := lib.TruncateModuleData(schema.Data, maxLength)
+			if !truncated {
+				continue
+			}
+
+			truncatedSchema := *schema
+			truncatedSchema.Data = data
+
+			truncatedTokens, err := estimateSchemaTokens(&truncatedSchema)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if truncatedTokens <= remaining {
+				output = append(output, &truncatedSchema)
+				remaining -= truncatedTokens
+
+				cut.Action = dao.SchemaGenerationContextCutTruncated
+				cut.KeptTokens = truncatedTokens
+
+				break
+			}
+		}
+
+		cuts = append(cuts, cut)
+	}
+
+	return output, cuts, nil
+}
+
+// estimateSchemaTokens estimates the tokens a schema uses in the prompt, where it is sent as JSON.
+func estimateSchemaTokens(schema *dao.Schema) (int64, error) {
+	encoded, err := json.Marshal(schema)
+	if err != nil {
+		return 0, fmt.Errorf("marshal context schema: %w", err)
+	}
+
+	return lib.EstimateTokens(string(encoded)), nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type SchemaCandidateListRepository interface {
+	Exec(ctx context.Context, request *dao.SchemaCandidateListRequest) ([]*dao.SchemaCandidate, error)
+}
+
+type SchemaCandidateListRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type SchemaCandidateListRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type SchemaCandidateListRequest struct {
+	ProjectID       uuid.UUID `validate:"required"`
+	UserID          uuid.UUID `validate:"required"`
+	ModuleID        string    `validate:"required,max=128,moduleName"`
+	ModuleNamespace string    `validate:"required,max=128,moduleName"`
+}
+
+type SchemaCandidateList struct {
+	schemaCandidateListRepository SchemaCandidateListRepository
+	projectSelectRepository       SchemaCandidateListRepositoryProjectSelect
+	projectMemberSelectRepository SchemaCandidateListRepositoryProjectMemberSelect
+}
+
+func NewSchemaCandidateList(
+	schemaCandidateListRepository SchemaCandidateListRepository,
+	projectSelectRepository SchemaCandidateListRepositoryProjectSelect,
+	projectMemberSelectRepository SchemaCandidateListRepositoryProjectMemberSelect,
+) *SchemaCandidateList {
+	return &SchemaCandidateList{
+		schemaCandidateListRepository: schemaCandidateListRepository,
+		projectSelectRepository:       projectSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+	}
+}
+
+func (service *SchemaCandidateList) Exec(
+	ctx context.Context, request *SchemaCandidateListRequest,
+) ([]*SchemaCandidate, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.SchemaCandidateList")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	// =================================================================================================================
+	// Project validation
+	// =================================================================================================================
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// =================================================================================================================
+	// List candidates
+	// =================================================================================================================
+
+	candidates, err := service.schemaCandidateListRepository.Exec(ctx, &dao.SchemaCandidateListRequest{
+		ProjectID:       request.ProjectID,
+		ModuleID:        request.ModuleID,
+		ModuleNamespace: request.ModuleNamespace,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, lo.Map(candidates, loadSchemaCandidatesMap)), nil
+}
@@ -0,0 +1,259 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestSchemaCandidateList(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	candidateID1 := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	candidateID2 := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     userID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type schemaCandidateListMock struct {
+		resp []*dao.SchemaCandidate
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.SchemaCandidateListRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		schemaCandidateListMock *schemaCandidateListMock
+
+		expect    []*services.SchemaCandidate
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.SchemaCandidateListRequest{
+				ProjectID:       projectID,
+				UserID:          userID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			schemaCandidateListMock: &schemaCandidateListMock{
+				resp: []*dao.SchemaCandidate{
+					{
+						ID:              candidateID1,
+						ProjectID:       projectID,
+						Owner:           userID,
+						ModuleID:        "test-module",
+						ModuleNamespace: "test-namespace",
+						ModuleVersion:   "1.0.0",
+						Data:            map[string]any{"title": "The Lighthouse"},
+						CreatedAt:       baseTime,
+					},
+					{
+						ID:              candidateID2,
+						ProjectID:       projectID,
+						Owner:           userID,
+						ModuleID:        "test-module",
+						ModuleNamespace: "test-namespace",
+						ModuleVersion:   "1.0.0",
+						Instructions:    "Keep it short.",
+						Data:            map[string]any{"title": "The Keeper"},
+						CreatedAt:       baseTime,
+					},
+				},
+			},
+
+			expect: []*services.SchemaCandidate{
+				{
+					ID:              candidateID1,
+					ProjectID:       projectID,
+					Owner:           userID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Data:            map[string]any{"title": "The Lighthouse"},
+					CreatedAt:       baseTime,
+				},
+				{
+					ID:              candidateID2,
+					ProjectID:       projectID,
+					Owner:           userID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Instructions:    "Keep it short.",
+					Data:            map[string]any{"title": "The Keeper"},
+					CreatedAt:       baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/Empty",
+
+			request: &services.SchemaCandidateListRequest{
+				ProjectID:       projectID,
+				UserID:          userID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			schemaCandidateListMock: &schemaCandidateListMock{resp: []*dao.SchemaCandidate{}},
+
+			expect: []*services.SchemaCandidate{},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.SchemaCandidateListRequest{
+				ProjectID:       projectID,
+				UserID:          userID,
+				ModuleID:        "Invalid Module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.SchemaCandidateListRequest{
+				ProjectID:       projectID,
+				UserID:          userID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			projectSelectMock: &projectSelectMock{err: dao.ErrProjectSelectNotFound},
+
+			expectErr: dao.ErrProjectSelectNotFound,
+		},
+		{
+			name: "Error/ProjectOwnership",
+
+			request: &services.SchemaCandidateListRequest{
+				ProjectID:       projectID,
+				UserID:          otherUserID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/SchemaCandidateList",
+
+			request: &services.SchemaCandidateListRequest{
+				ProjectID:       projectID,
+				UserID:          userID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			schemaCandidateListMock: &schemaCandidateListMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				schemaCandidateListRepository := servicesmocks.NewMockSchemaCandidateListRepository(t)
+				projectSelectRepository := servicesmocks.NewMockSchemaCandidateListRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaCandidateListRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{
+							ID: testCase.request.ProjectID,
+						}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.schemaCandidateListMock != nil {
+					schemaCandidateListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaCandidateListRequest{
+							ProjectID:       testCase.request.ProjectID,
+							ModuleID:        testCase.request.ModuleID,
+							ModuleNamespace: testCase.request.ModuleNamespace,
+						}).
+						Return(testCase.schemaCandidateListMock.resp, testCase.schemaCandidateListMock.err)
+				}
+
+				service := services.NewSchemaCandidateList(
+					schemaCandidateListRepository,
+					projectSelectRepository,
+					projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				schemaCandidateListRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
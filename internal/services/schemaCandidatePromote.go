@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+)
+
+type SchemaCandidatePromoteRepository interface {
+	Exec(ctx context.Context, request *dao.SchemaCandidateSelectRequest) (*dao.SchemaCandidate, error)
+}
+
+type SchemaCandidatePromoteRepositorySchemaCandidateDelete interface {
+	Exec(ctx context.Context, request *dao.SchemaCandidateDeleteRequest) ([]*dao.SchemaCandidate, error)
+}
+
+type SchemaCandidatePromoteRepositorySchemaInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)
+}
+
+type SchemaCandidatePromoteRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type SchemaCandidatePromoteRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type SchemaCandidatePromoteRequest struct {
+	ID     uuid.UUID `validate:"required"`
+	UserID uuid.UUID `validate:"required"`
+}
+
+// SchemaCandidatePromote saves a pending candidate as the latest version of its module. The other candidates of the
+// module are discarded.
+type SchemaCandidatePromote struct {
+	schemaCandidateSelectRepository SchemaCandidatePromoteRepository
+	schemaCandidateDeleteRepository SchemaCandidatePromoteRepositorySchemaCandidateDelete
+	schemaInsertRepository          SchemaCandidatePromoteRepositorySchemaInsert
+	projectSelectRepository         SchemaCandidatePromoteRepositoryProjectSelect
+	projectMemberSelectRepository   SchemaCandidatePromoteRepositoryProjectMemberSelect
+}
+
+func NewSchemaCandidatePromote(
+	schemaCandidateSelectRepository SchemaCandidatePromoteRepository,
+	schemaCandidateDeleteRepository SchemaCandidatePromoteRepositorySchemaCandidateDelete,
+	schemaInsertRepository SchemaCandidatePromoteRepositorySchemaInsert,
+	projectSelectRepository SchemaCandidatePromoteRepositoryProjectSelect,
+	projectMemberSelectRepository SchemaCandidatePromoteRepositoryProjectMemberSelect,
+) *SchemaCandidatePromote {
+	return &SchemaCandidatePromote{
+		schemaCandidateSelectRepository: schemaCandidateSelectRepository,
+		schemaCandidateDeleteRepository: schemaCandidateDeleteRepository,
+		schemaInsertRepository:          schemaInsertRepository,
+		projectSelectRepository:         projectSelectRepository,
+		projectMemberSelectRepository:   projectMemberSelectRepository,
+	}
+}
+
+func (service *SchemaCandidatePromote) Exec(
+	ctx context.Context, request *SchemaCandidatePromoteRequest,
+) (*Schema, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.SchemaCandidatePromote")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	candidate, err := service.schemaCandidateSelectRepository.Exec(ctx, &dao.SchemaCandidateSelectRequest{
+		ID: request.ID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// =================================================================================================================
+	// Project validation
+	// =================================================================================================================
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: candidate.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleEditor,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// The workflow of the project may have been upgraded since the candidate was generated.
+	err = VerifyModule(project, lib.DecodedModule{
+		Namespace:  candidate.ModuleNamespace,
+		Module:     candidate.ModuleID,
+		Version:    candidate.ModuleVersion,
+		Preversion: candidate.ModulePreversion,
+	}.String())
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// =================================================================================================================
+	// Promote
+	// =================================================================================================================
+
+	var schema *dao.Schema
+
+	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
+		schema, err = service.schemaInsertRepository.Exec(ctx, &dao.SchemaInsertRequest{
+			// The schema reuses the ID of the candidate, so the record of its generation follows it.
+			ID:               candidate.ID,
+			ProjectID:        candidate.ProjectID,
+			Owner:            &request.UserID,
+			ModuleID:         candidate.ModuleID,
+			ModuleNamespace:  candidate.ModuleNamespace,
+			ModuleVersion:    candidate.ModuleVersion,
+			ModulePreversion: candidate.ModulePreversion,
+			Source:           dao.SchemaSourceAI,
+			Instructions:     candidate.Instructions,
+			Data:             candidate.Data,
+			Now:              time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = service.schemaCandidateDeleteRepository.Exec(ctx, &dao.SchemaCandidateDeleteRequest{
+			ProjectID:       candidate.ProjectID,
+			ModuleID:        candidate.ModuleID,
+			ModuleNamespace: candidate.ModuleNamespace,
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadSchema(schema)), nil
+}
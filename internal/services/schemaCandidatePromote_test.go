@@ -0,0 +1,325 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestSchemaCandidatePromote(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	candidateID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCandidate := &dao.SchemaCandidate{
+		ID:              candidateID,
+		ProjectID:       projectID,
+		Owner:           ownerID,
+		ModuleID:        "test-module",
+		ModuleNamespace: "test-namespace",
+		ModuleVersion:   "1.0.0",
+		Instructions:    "Keep it short.",
+		Data:            map[string]any{"title": "The Lighthouse"},
+		CreatedAt:       baseTime,
+	}
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type schemaCandidateSelectMock struct {
+		resp *dao.SchemaCandidate
+		err  error
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type schemaInsertMock struct {
+		resp *dao.Schema
+		err  error
+	}
+
+	type schemaCandidateDeleteMock struct {
+		err error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.SchemaCandidatePromoteRequest
+
+		schemaCandidateSelectMock *schemaCandidateSelectMock
+		projectSelectMock         *projectSelectMock
+		projectMemberSelectMock   *projectMemberSelectMock
+		schemaInsertMock          *schemaInsertMock
+		schemaCandidateDeleteMock *schemaCandidateDeleteMock
+
+		expect    *services.Schema
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				ID:     candidateID,
+				UserID: ownerID,
+			},
+
+			schemaCandidateSelectMock: &schemaCandidateSelectMock{resp: testCandidate},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Instructions:    "Keep it short.",
+					Data:            map[string]any{"title": "The Lighthouse"},
+					CreatedAt:       baseTime,
+				},
+			},
+			schemaCandidateDeleteMock: &schemaCandidateDeleteMock{},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "AI",
+				Instructions:    "Keep it short.",
+				Data:            map[string]any{"title": "The Lighthouse"},
+				CreatedAt:       baseTime,
+			},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				UserID: ownerID,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/SchemaCandidateSelect",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				ID:     candidateID,
+				UserID: ownerID,
+			},
+
+			schemaCandidateSelectMock: &schemaCandidateSelectMock{err: dao.ErrSchemaCandidateSelectNotFound},
+
+			expectErr: dao.ErrSchemaCandidateSelectNotFound,
+		},
+		{
+			name: "Error/ProjectOwnership",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				ID:     candidateID,
+				UserID: otherUserID,
+			},
+
+			schemaCandidateSelectMock: &schemaCandidateSelectMock{resp: testCandidate},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			projectMemberSelectMock:   &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				ID:     candidateID,
+				UserID: otherUserID,
+			},
+
+			schemaCandidateSelectMock: &schemaCandidateSelectMock{resp: testCandidate},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleViewer},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ModuleNotInProject",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				ID:     candidateID,
+				UserID: ownerID,
+			},
+
+			schemaCandidateSelectMock: &schemaCandidateSelectMock{resp: testCandidate},
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v2.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrModuleNotInProject,
+		},
+		{
+			name: "Error/SchemaInsert",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				ID:     candidateID,
+				UserID: ownerID,
+			},
+
+			schemaCandidateSelectMock: &schemaCandidateSelectMock{resp: testCandidate},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			schemaInsertMock:          &schemaInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaCandidateDelete",
+
+			request: &services.SchemaCandidatePromoteRequest{
+				ID:     candidateID,
+				UserID: ownerID,
+			},
+
+			schemaCandidateSelectMock: &schemaCandidateSelectMock{resp: testCandidate},
+			projectSelectMock:         &projectSelectMock{resp: testProject},
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{ID: schemaID, ProjectID: projectID, Source: dao.SchemaSourceAI},
+			},
+			schemaCandidateDeleteMock: &schemaCandidateDeleteMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				schemaCandidateSelectRepository := servicesmocks.NewMockSchemaCandidatePromoteRepository(t)
+				schemaCandidateDeleteRepository := servicesmocks.
+					NewMockSchemaCandidatePromoteRepositorySchemaCandidateDelete(t)
+				schemaInsertRepository := servicesmocks.NewMockSchemaCandidatePromoteRepositorySchemaInsert(t)
+				projectSelectRepository := servicesmocks.NewMockSchemaCandidatePromoteRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaCandidatePromoteRepositoryProjectMemberSelect(t)
+
+				if testCase.schemaCandidateSelectMock != nil {
+					schemaCandidateSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaCandidateSelectRequest{ID: testCase.request.ID}).
+						Return(testCase.schemaCandidateSelectMock.resp, testCase.schemaCandidateSelectMock.err)
+				}
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: projectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: projectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.schemaInsertMock != nil {
+					schemaInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+							return req.ID == testCase.schemaCandidateSelectMock.resp.ID &&
+								req.ProjectID == testCandidate.ProjectID &&
+								lo.FromPtr(req.Owner) == testCase.request.UserID &&
+								req.ModuleID == testCandidate.ModuleID &&
+								req.ModuleNamespace == testCandidate.ModuleNamespace &&
+								req.ModuleVersion == testCandidate.ModuleVersion &&
+								req.ModulePreversion == testCandidate.ModulePreversion &&
+								req.Source == dao.SchemaSourceAI &&
+								req.Instructions == testCandidate.Instructions &&
+								assert.Equal(t, testCandidate.Data, req.Data) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.schemaInsertMock.resp, testCase.schemaInsertMock.err)
+				}
+
+				if testCase.schemaCandidateDeleteMock != nil {
+					schemaCandidateDeleteRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaCandidateDeleteRequest{
+							ProjectID:       testCandidate.ProjectID,
+							ModuleID:        testCandidate.ModuleID,
+							ModuleNamespace: testCandidate.ModuleNamespace,
+						}).
+						Return([]*dao.SchemaCandidate{testCandidate}, testCase.schemaCandidateDeleteMock.err)
+				}
+
+				service := services.NewSchemaCandidatePromote(
+					schemaCandidateSelectRepository,
+					schemaCandidateDeleteRepository,
+					schemaInsertRepository,
+					projectSelectRepository,
+					projectMemberSelectRepository,
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				schemaCandidateSelectRepository.AssertExpectations(t)
+				schemaCandidateDeleteRepository.AssertExpectations(t)
+				schemaInsertRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -25,30 +25,46 @@ type SchemaCreateRepositoryModuleSelect interface {
 	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
 }
 
+type SchemaCreateRepositoryModuleListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
+type SchemaCreateRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type SchemaCreateRequest struct {
 	ID        uuid.UUID      `validate:"required"`
 	ProjectID uuid.UUID      `validate:"required"`
 	UserID    uuid.UUID      `validate:"required"`
-	Module    string         `validate:"required,module,max=512"`
+	Module    string         `validate:"required,workflowModule,max=512"`
 	Source    string         `validate:"required,schemaSource,max=64"`
 	Data      map[string]any `validate:"required"`
+	// Draft only checks the types and enum values of the data, so partially filled schemas can be saved.
+	Draft bool
 }
 
 type SchemaCreate struct {
-	schemaCreateRepository  SchemaCreateRepository
-	projectSelectRepository SchemaCreateRepositoryProjectSelect
-	moduleSelectRepository  SchemaCreateRepositoryModuleSelect
+	schemaCreateRepository        SchemaCreateRepository
+	projectSelectRepository       SchemaCreateRepositoryProjectSelect
+	moduleSelectRepository        SchemaCreateRepositoryModuleSelect
+	moduleListVersionsRepository  SchemaCreateRepositoryModuleListVersions
+	projectMemberSelectRepository SchemaCreateRepositoryProjectMemberSelect
 }
 
 func NewSchemaCreate(
 	schemaCreateRepository SchemaCreateRepository,
 	projectSelectRepository SchemaCreateRepositoryProjectSelect,
 	moduleSelectRepository SchemaCreateRepositoryModuleSelect,
+	moduleListVersionsRepository SchemaCreateRepositoryModuleListVersions,
+	projectMemberSelectRepository SchemaCreateRepositoryProjectMemberSelect,
 ) *SchemaCreate {
 	return &SchemaCreate{
-		schemaCreateRepository:  schemaCreateRepository,
-		projectSelectRepository: projectSelectRepository,
-		moduleSelectRepository:  moduleSelectRepository,
+		schemaCreateRepository:        schemaCreateRepository,
+		projectSelectRepository:       projectSelectRepository,
+		moduleSelectRepository:        moduleSelectRepository,
+		moduleListVersionsRepository:  moduleListVersionsRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
 	}
 }
 
@@ -61,8 +77,6 @@ func (service *SchemaCreate) Exec(ctx context.Context, request *SchemaCreateRequ
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
-	decodedModule := lib.DecodeModule(request.Module)
-
 	// =================================================================================================================
 	// Project validation
 	// =================================================================================================================
@@ -74,7 +88,9 @@ func (service *SchemaCreate) Exec(ctx context.Context, request *SchemaCreateRequ
 		return nil, otel.ReportError(span, err)
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleEditor,
+	)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
@@ -83,7 +99,13 @@ func (service *SchemaCreate) Exec(ctx context.Context, request *SchemaCreateRequ
 	// Module validation
 	// =================================================================================================================
 
-	err = VerifyModule(project, request.Module)
+	// The module can be given as a version range, in which case the latest version that satisfies it is used.
+	decodedModule, err := ResolveModule(ctx, service.moduleListVersionsRepository, request.Module)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyModule(project, decodedModule.String())
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
@@ -98,6 +120,11 @@ func (service *SchemaCreate) Exec(ctx context.Context, request *SchemaCreateRequ
 		return nil, otel.ReportError(span, err)
 	}
 
+	err = lib.ValidateJSONSchema(&moduleContent.Schema, request.Data, request.Draft)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidData))
+	}
+
 	// =================================================================================================================
 	// Create data.
 	// =================================================================================================================
@@ -60,14 +60,28 @@ func TestSchemaCreate(t *testing.T) {
 		err  error
 	}
 
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.SchemaCreateRequest
+		// resolvedModule is the exact module the request resolves to, when it targets a version range.
+		resolvedModule string
 
-		schemaInsertMock  *schemaInsertMock
-		projectSelectMock *projectSelectMock
-		moduleSelectMock  *moduleSelectMock
+		schemaInsertMock        *schemaInsertMock
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		moduleListVersionsMock  *moduleListVersionsMock
+		moduleSelectMock        *moduleSelectMock
 
 		expect    *services.Schema
 		expectErr error
@@ -195,6 +209,131 @@ func TestSchemaCreate(t *testing.T) {
 				CreatedAt:        baseTime,
 			},
 		},
+		{
+			name: "Success/VersionRange",
+
+			request: &services.SchemaCreateRequest{
+				ID:        schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@^1.0",
+				Source:    "USER",
+				Data:      map[string]any{"title": "Test Title"},
+			},
+			resolvedModule: "test-namespace:test-module@v1.2.0",
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@^1.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{{Version: "2.0.0"}, {Version: "1.2.0"}},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.2.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.2.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Test Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.2.0",
+				Source:          "USER",
+				Data:            map[string]any{"title": "Test Title"},
+				CreatedAt:       baseTime,
+			},
+		},
+		{
+			name: "Success/ExactVersionInRange",
+
+			request: &services.SchemaCreateRequest{
+				ID:        schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.2.0",
+				Source:    "USER",
+				Data:      map[string]any{"title": "Test Title"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@^1.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.2.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.2.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Test Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.2.0",
+				Source:          "USER",
+				Data:            map[string]any{"title": "Test Title"},
+				CreatedAt:       baseTime,
+			},
+		},
 		{
 			name: "Error/InvalidRequest/MissingModule",
 
@@ -306,8 +445,38 @@ func TestSchemaCreate(t *testing.T) {
 					UpdatedAt: baseTime,
 				},
 			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.SchemaCreateRequest{
+				ID:        schemaID,
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Source:    "USER",
+				Data:      map[string]any{"title": "Test Title"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleViewer},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
 		},
 		{
 			name: "Error/ModuleNotInProject",
@@ -335,6 +504,62 @@ func TestSchemaCreate(t *testing.T) {
 
 			expectErr: services.ErrModuleNotInProject,
 		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: &services.SchemaCreateRequest{
+				ID:        schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@^1.0",
+				Source:    "USER",
+				Data:      map[string]any{"title": "Test Title"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@^1.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{{Version: "2.0.0"}, {Version: "0.9.0"}},
+			},
+
+			expectErr: services.ErrModuleRangeNotSatisfied,
+		},
+		{
+			name: "Error/ModuleNotInProject/OutsideRange",
+
+			request: &services.SchemaCreateRequest{
+				ID:        schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v2.0.0",
+				Source:    "USER",
+				Data:      map[string]any{"title": "Test Title"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@^1.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrModuleNotInProject,
+		},
 		{
 			name: "Error/ModuleSelect",
 
@@ -419,7 +644,79 @@ func TestSchemaCreate(t *testing.T) {
 			expectErr: services.ErrInvalidRequest,
 		},
 		{
-			name: "Success/EmptyData",
+			name: "Error/InvalidData/MissingRequired",
+
+			request: &services.SchemaCreateRequest{
+				ID:        schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Source:    "USER",
+				Data:      map[string]any{},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrInvalidData,
+		},
+		{
+			name: "Error/InvalidData/Type",
+
+			request: &services.SchemaCreateRequest{
+				ID:        schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Source:    "USER",
+				Data:      map[string]any{"title": 42},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrInvalidData,
+		},
+		{
+			name: "Success/EmptyData/Draft",
 
 			request: &services.SchemaCreateRequest{
 				ID:        schemaID,
@@ -428,6 +725,7 @@ func TestSchemaCreate(t *testing.T) {
 				Module:    "test-namespace:test-module@v1.0.0",
 				Source:    "USER",
 				Data:      map[string]any{},
+				Draft:     true,
 			},
 
 			projectSelectMock: &projectSelectMock{
@@ -490,6 +788,13 @@ func TestSchemaCreate(t *testing.T) {
 				schemaInsertRepository := servicesmocks.NewMockSchemaCreateRepository(t)
 				projectSelectRepository := servicesmocks.NewMockSchemaCreateRepositoryProjectSelect(t)
 				moduleSelectRepository := servicesmocks.NewMockSchemaCreateRepositoryModuleSelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockSchemaCreateRepositoryModuleListVersions(t)
+
+				resolvedModule := testCase.resolvedModule
+				if resolvedModule == "" {
+					resolvedModule = testCase.request.Module
+				}
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaCreateRepositoryProjectMemberSelect(t)
 
 				if testCase.schemaInsertMock != nil {
 					schemaInsertRepository.EXPECT().
@@ -515,8 +820,27 @@ func TestSchemaCreate(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
-				if testCase.moduleSelectMock != nil {
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.moduleListVersionsMock != nil {
 					decodedModule := lib.DecodeModule(testCase.request.Module)
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        decodedModule.Module,
+							Namespace: decodedModule.Namespace,
+						}).
+						Return(testCase.moduleListVersionsMock.resp, testCase.moduleListVersionsMock.err)
+				}
+
+				if testCase.moduleSelectMock != nil {
+					decodedModule := lib.DecodeModule(resolvedModule)
 					moduleSelectRepository.EXPECT().
 						Exec(mock.Anything, &dao.ModuleSelectRequest{
 							ID:         decodedModule.Module,
@@ -531,6 +855,8 @@ func TestSchemaCreate(t *testing.T) {
 					schemaInsertRepository,
 					projectSelectRepository,
 					moduleSelectRepository,
+					moduleListVersionsRepository,
+					projectMemberSelectRepository,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -540,6 +866,8 @@ func TestSchemaCreate(t *testing.T) {
 				schemaInsertRepository.AssertExpectations(t)
 				projectSelectRepository.AssertExpectations(t)
 				moduleSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
 			})
 		})
 	}
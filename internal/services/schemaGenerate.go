@@ -3,20 +3,37 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"github.com/uptrace/bun"
 
 	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
 
+	"github.com/a-novel/service-narrative-engine/internal/config"
 	"github.com/a-novel/service-narrative-engine/internal/dao"
 	"github.com/a-novel/service-narrative-engine/internal/lib"
 )
 
+var (
+	ErrNoSchemaToRegenerate = errors.New("paths can only be regenerated for modules that already have data")
+	// ErrInvalidGeneration is returned when the model keeps generating data that does not satisfy the schema of the
+	// module.
+	ErrInvalidGeneration = errors.New("generated data does not satisfy the module schema")
+)
+
+// SchemaGenerationMaxAttempts is the number of times the model is prompted for a single output. Outputs that do not
+// satisfy the schema of the module are sent back to the model, along with their errors, until one is valid or no
+// attempt is left.
+const SchemaGenerationMaxAttempts = 3
+
 type SchemaGenerateRepository interface {
-	Exec(ctx context.Context, request *dao.ModuleGenerateRequest) (map[string]any, error)
+	Exec(ctx context.Context, request *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error)
 }
 
 type SchemaGenerateRepositorySchemaList interface {
@@ -27,6 +44,18 @@ type SchemaGenerateRepositorySchemaInsert interface {
 	Exec(ctx context.Context, request *dao.SchemaInsertRequest) (*dao.Schema, error)
 }
 
+type SchemaGenerateRepositorySchemaGenerationInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)
+}
+
+type SchemaGenerateRepositoryTokenUsageList interface {
+	Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)
+}
+
+type SchemaGenerateRepositoryTokenUsageInsert interface {
+	Exec(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)
+}
+
 type SchemaGenerateRepositoryProjectSelect interface {
 	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
 }
@@ -35,34 +64,75 @@ type SchemaGenerateRepositoryModuleSelect interface {
 	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
 }
 
+type SchemaGenerateRepositoryModuleListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
+type SchemaGenerateRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type SchemaGenerateRequest struct {
 	ProjectID uuid.UUID `validate:"required"`
 	UserID    uuid.UUID `validate:"required"`
-	Module    string    `validate:"required,module,max=512"`
-	Lang      string    `validate:"required,langs"`
+	// Roles of the user, which set their token quota.
+	Roles  []string
+	Module string `validate:"required,workflowModule,max=512"`
+	Lang   string `validate:"required,langs"`
+	// Paths, when set, restricts the generation to the values they target (as JSON Pointers). Every other value of
+	// the latest data of the module is kept as is.
+	Paths []string `validate:"max=64,dive,max=1024,jsonPointer"`
+	// Instructions are free-text instructions to steer the generation. They are saved with the generated schema.
+	Instructions string `validate:"max=2048"`
+
+	// OnDelta, when set, receives the raw JSON output of the model as it is generated. The final data is only
+	// returned once the generation completes and the schema is saved. Only the first output is streamed: when it
+	// must be corrected, the final data differs from the streamed one.
+	OnDelta func(delta string)
 }
 
 type SchemaGenerate struct {
-	schemaGenerateRepository SchemaGenerateRepository
-	schemaListRepository     SchemaGenerateRepositorySchemaList
-	schemaInsertRepository   SchemaGenerateRepositorySchemaInsert
-	projectSelectRepository  SchemaGenerateRepositoryProjectSelect
-	moduleSelectRepository   SchemaGenerateRepositoryModuleSelect
+	schemaGenerateRepository         SchemaGenerateRepository
+	schemaListRepository             SchemaGenerateRepositorySchemaList
+	schemaInsertRepository           SchemaGenerateRepositorySchemaInsert
+	schemaGenerationInsertRepository SchemaGenerateRepositorySchemaGenerationInsert
+	projectSelectRepository          SchemaGenerateRepositoryProjectSelect
+	moduleSelectRepository           SchemaGenerateRepositoryModuleSelect
+	moduleListVersionsRepository     SchemaGenerateRepositoryModuleListVersions
+	tokenUsageListRepository         SchemaGenerateRepositoryTokenUsageList
+	tokenUsageInsertRepository       SchemaGenerateRepositoryTokenUsageInsert
+	projectMemberSelectRepository    SchemaGenerateRepositoryProjectMemberSelect
+	usageQuotas                      config.UsageQuotas
+	contextBudgets                   config.GenerationContextBudgets
 }
 
 func NewSchemaGenerate(
 	schemaGenerateRepository SchemaGenerateRepository,
 	schemaListRepository SchemaGenerateRepositorySchemaList,
 	schemaInsertRepository SchemaGenerateRepositorySchemaInsert,
+	schemaGenerationInsertRepository SchemaGenerateRepositorySchemaGenerationInsert,
 	projectSelectRepository SchemaGenerateRepositoryProjectSelect,
 	moduleSelectRepository SchemaGenerateRepositoryModuleSelect,
+	moduleListVersionsRepository SchemaGenerateRepositoryModuleListVersions,
+	tokenUsageListRepository SchemaGenerateRepositoryTokenUsageList,
+	tokenUsageInsertRepository SchemaGenerateRepositoryTokenUsageInsert,
+	projectMemberSelectRepository SchemaGenerateRepositoryProjectMemberSelect,
+	usageQuotas config.UsageQuotas,
+	contextBudgets config.GenerationContextBudgets,
 ) *SchemaGenerate {
 	return &SchemaGenerate{
-		schemaGenerateRepository: schemaGenerateRepository,
-		schemaListRepository:     schemaListRepository,
-		schemaInsertRepository:   schemaInsertRepository,
-		projectSelectRepository:  projectSelectRepository,
-		moduleSelectRepository:   moduleSelectRepository,
+		schemaGenerateRepository:         schemaGenerateRepository,
+		schemaListRepository:             schemaListRepository,
+		schemaInsertRepository:           schemaInsertRepository,
+		schemaGenerationInsertRepository: schemaGenerationInsertRepository,
+		projectSelectRepository:          projectSelectRepository,
+		moduleSelectRepository:           moduleSelectRepository,
+		moduleListVersionsRepository:     moduleListVersionsRepository,
+		tokenUsageListRepository:         tokenUsageListRepository,
+		tokenUsageInsertRepository:       tokenUsageInsertRepository,
+		projectMemberSelectRepository:    projectMemberSelectRepository,
+		usageQuotas:                      usageQuotas,
+		contextBudgets:                   contextBudgets,
 	}
 }
 
@@ -75,54 +145,351 @@ func (service *SchemaGenerate) Exec(ctx context.Context, request *SchemaGenerate
 		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
 	}
 
-	decodedModule := lib.DecodeModule(request.Module)
+	generation, err := prepareSchemaGeneration(ctx, &schemaGenerationRepositories{
+		schemaList:          service.schemaListRepository,
+		projectSelect:       service.projectSelectRepository,
+		projectMemberSelect: service.projectMemberSelectRepository,
+		moduleSelect:        service.moduleSelectRepository,
+		moduleListVersions:  service.moduleListVersionsRepository,
+	}, service.contextBudgets, request)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyUsageQuota(ctx, service.tokenUsageListRepository, service.usageQuotas, request.UserID, request.Roles)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// =================================================================================================================
+	// Generate.
+	// =================================================================================================================
+
+	result, data, generateErr := generation.generate(ctx, service.schemaGenerateRepository, request.OnDelta)
+
+	// The tokens are spent, whether the schema is saved or not. The request may have been canceled by the client,
+	// or timed out, so usage is recorded regardless of the request context.
+	if result != nil {
+		_, err = service.tokenUsageInsertRepository.Exec(
+			context.WithoutCancel(ctx), moduleGenerationUsage(request.UserID, request.ProjectID, result),
+		)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+	}
+
+	if generateErr != nil {
+		return nil, otel.ReportError(span, generateErr)
+	}
+
+	// =================================================================================================================
+	// Save the schema, along with its generation record.
+	// =================================================================================================================
+
+	var output *Schema
+
+	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
+		now := time.Now()
+
+		schema, err := service.schemaInsertRepository.Exec(ctx, &dao.SchemaInsertRequest{
+			ID:               uuid.New(),
+			ProjectID:        request.ProjectID,
+			Owner:            &request.UserID,
+			ModuleID:         generation.module.ID,
+			ModuleNamespace:  generation.module.Namespace,
+			ModuleVersion:    generation.module.Version,
+			ModulePreversion: generation.module.Preversion,
+			Source:           dao.SchemaSourceAI,
+			Instructions:     request.Instructions,
+			Data:             data,
+			Now:              now,
+		})
+		if err != nil {
+			return err
+		}
+
+		record, err := service.schemaGenerationInsertRepository.Exec(
+			ctx, generation.record(schema.ID, request.ProjectID, result, now),
+		)
+		if err != nil {
+			return err
+		}
+
+		output = loadSchema(schema)
+		output.Generation = loadSchemaGeneration(record)
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, output), nil
+}
+
+// schemaGenerationRepositories are the repositories used to prepare the generation of a schema.
+type schemaGenerationRepositories struct {
+	schemaList          SchemaGenerateRepositorySchemaList
+	projectSelect       SchemaGenerateRepositoryProjectSelect
+	projectMemberSelect SchemaGenerateRepositoryProjectMemberSelect
+	moduleSelect        SchemaGenerateRepositoryModuleSelect
+	moduleListVersions  SchemaGenerateRepositoryModuleListVersions
+}
+
+// schemaGeneration is a generation request, ready to be sent to the model.
+type schemaGeneration struct {
+	// module is the resolved module, whose schema is restricted to the parts to generate.
+	module *dao.Module
+	// schema is the source schema of the module, that generated data must satisfy.
+	schema *jsonschema.Schema
+	// dropped lists the parts of the source schema that the model cannot generate.
+	dropped lib.JSONSchemaLLMReport
+	// current is the latest schema of the module, if any.
+	current *dao.Schema
+	// contextSchemaIDs are the schemas given to the model as context.
+	contextSchemaIDs []uuid.UUID
+	// contextCuts lists the schemas of the context that were truncated or dropped to fit the budget of the model.
+	contextCuts []dao.SchemaGenerationContextCut
+	paths       []string
+	request     *dao.ModuleGenerateRequest
+}
+
+// record returns the request that saves how an output was generated, once saved under the given ID.
+func (generation *schemaGeneration) record(
+	id, projectID uuid.UUID, result *dao.ModuleGeneration, now time.Time,
+) *dao.SchemaGenerationInsertRequest {
+	var baseSchemaID *uuid.UUID
+	if generation.current != nil {
+		baseSchemaID = &generation.current.ID
+	}
+
+	return &dao.SchemaGenerationInsertRequest{
+		SchemaID:  id,
+		ProjectID: projectID,
+		Model:     result.Model,
+		Messages: lo.Map(result.Messages, func(item lib.CompletionMessage, _ int) dao.SchemaGenerationMessage {
+			return dao.SchemaGenerationMessage{Role: string(item.Role), Content: item.Content}
+		}),
+		TemplateVersion:  result.TemplateVersion,
+		PromptTokens:     result.Usage.PromptTokens,
+		CompletionTokens: result.Usage.CompletionTokens,
+		Latency:          result.Latency,
+		ContextSchemaIDs: generation.contextSchemaIDs,
+		ContextCuts:      generation.contextCuts,
+		BaseSchemaID:     baseSchemaID,
+		Now:              now,
+	}
+}
+
+// moduleGenerationUsage returns the request that bills the tokens spent on an output to a user.
+func moduleGenerationUsage(userID, projectID uuid.UUID, result *dao.ModuleGeneration) *dao.TokenUsageInsertRequest {
+	return &dao.TokenUsageInsertRequest{
+		ID:               uuid.New(),
+		UserID:           userID,
+		ProjectID:        projectID,
+		Model:            result.Model,
+		PromptTokens:     result.Usage.PromptTokens,
+		CompletionTokens: result.Usage.CompletionTokens,
+		Now:              time.Now(),
+	}
+}
+
+// generate prompts the model for an output, and returns the data to save. Outputs are checked against the source
+// schema of the module, and sent back to the model along with their errors, up to SchemaGenerationMaxAttempts times.
+//
+// Only the first output is streamed to onDelta. The returned generation sums the usage of every attempt, and records
+// the messages of the last one. It is returned as soon as one attempt completed, even along an error, so the spent
+// tokens can be billed.
+func (generation *schemaGeneration) generate(
+	ctx context.Context, repository SchemaGenerateRepository, onDelta func(delta string),
+) (*dao.ModuleGeneration, map[string]any, error) {
+	request := *generation.request
+	request.OnDelta = onDelta
+
+	var (
+		output           *dao.ModuleGeneration
+		validationErrors lib.JSONSchemaValidationErrors
+	)
+
+	for range SchemaGenerationMaxAttempts {
+		result, err := repository.Exec(ctx, &request)
+		if err != nil {
+			// Interrupted attempts may still have spent tokens.
+			if result != nil {
+				output = sumModuleGenerations(output, result)
+			}
+
+			return output, nil, err
+		}
+
+		output = sumModuleGenerations(output, result)
+
+		data, err := generation.merge(lib.StripJSONSchemaLLMNulls(generation.schema, result.Data))
+		if err != nil {
+			return output, nil, err
+		}
+
+		validationErrors = generation.validate(data)
+		if len(validationErrors) == 0 {
+			return output, data, nil
+		}
+
+		request.OnDelta = nil
+		request.Corrections = append(request.Corrections, &dao.ModuleGenerateCorrection{
+			Output: result.Data,
+			Errors: validationErrors,
+		})
+	}
+
+	return output, nil, errors.Join(validationErrors, ErrInvalidGeneration)
+}
+
+// validate checks generated data against the source schema of the module. Errors the model cannot fix are ignored:
+// those on parts of the schema it cannot generate and, when the generation is restricted to some paths, those
+// outside of these paths.
+func (generation *schemaGeneration) validate(data map[string]any) lib.JSONSchemaValidationErrors {
+	var validationErrors lib.JSONSchemaValidationErrors
+	if !errors.As(lib.ValidateJSONSchema(generation.schema, data, false), &validationErrors) {
+		return nil
+	}
+
+	return lo.Filter(validationErrors, func(item *lib.JSONSchemaValidationError, _ int) bool {
+		if lo.ContainsBy(generation.dropped, func(change *lib.JSONSchemaLLMChange) bool {
+			return matchJSONPointer(change.Path, item.Path)
+		}) {
+			return false
+		}
+
+		return len(generation.paths) == 0 || lo.ContainsBy(generation.paths, func(path string) bool {
+			return matchJSONPointer(path, item.Path)
+		})
+	})
+}
+
+// matchJSONPointer returns true if a JSON Pointer targets the value at pattern, or one of its children. The "*"
+// token of the pattern matches any array item.
+func matchJSONPointer(pattern, pointer string) bool {
+	patternTokens := strings.Split(pattern, "/")
+	pointerTokens := strings.Split(pointer, "/")
+
+	if len(pointerTokens) < len(patternTokens) {
+		return false
+	}
 
+	for i, token := range patternTokens {
+		if token != pointerTokens[i] && token != "*" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sumModuleGenerations adds the usage and latency of a new attempt to the previous ones. The rest of the generation
+// is taken from the new attempt, whose messages include the previous outputs.
+func sumModuleGenerations(previous, next *dao.ModuleGeneration) *dao.ModuleGeneration {
+	output := *next
+
+	if previous != nil {
+		output.Usage.PromptTokens += previous.Usage.PromptTokens
+		output.Usage.CompletionTokens += previous.Usage.CompletionTokens
+		output.Latency += previous.Latency
+	}
+
+	return &output
+}
+
+// merge returns the data to save for a generated output. When the generation is restricted to some paths, the
+// generated values are merged into the current data of the module.
+func (generation *schemaGeneration) merge(data map[string]any) (map[string]any, error) {
+	if len(generation.paths) == 0 {
+		return data, nil
+	}
+
+	data, err := lib.MergeModuleData(generation.current.Data, data, generation.paths)
+	if err != nil {
+		return nil, fmt.Errorf("merge generated data: %w", err)
+	}
+
+	return data, nil
+}
+
+// prepareSchemaGeneration checks a validated generation request against the project, and builds the request sent to
+// the model.
+//
+// The context sent to the model is fitted into the budget of the model that generates the module.
+func prepareSchemaGeneration(
+	ctx context.Context,
+	repositories *schemaGenerationRepositories,
+	contextBudgets config.GenerationContextBudgets,
+	request *SchemaGenerateRequest,
+) (*schemaGeneration, error) {
 	// =================================================================================================================
 	// Project validation
 	// =================================================================================================================
 
-	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+	project, err := repositories.projectSelect.Exec(ctx, &dao.ProjectSelectRequest{
 		ID: request.ProjectID,
 	})
 	if err != nil {
-		return nil, otel.ReportError(span, err)
+		return nil, err
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(
+		ctx, repositories.projectMemberSelect, project, request.UserID, dao.ProjectMemberRoleGenerator,
+	)
 	if err != nil {
-		return nil, otel.ReportError(span, err)
+		return nil, err
 	}
 
 	// =================================================================================================================
 	// Module preparation.
 	// =================================================================================================================
 
-	err = VerifyModule(project, request.Module)
+	// The module can be given as a version range, in which case the latest version that satisfies it is used.
+	decodedModule, err := ResolveModule(ctx, repositories.moduleListVersions, request.Module)
 	if err != nil {
-		return nil, otel.ReportError(span, err)
+		return nil, err
+	}
+
+	err = VerifyModule(project, decodedModule.String())
+	if err != nil {
+		return nil, err
 	}
 
-	moduleContent, err := service.moduleSelectRepository.Exec(ctx, &dao.ModuleSelectRequest{
+	moduleContent, err := repositories.moduleSelect.Exec(ctx, &dao.ModuleSelectRequest{
 		ID:         decodedModule.Module,
 		Namespace:  decodedModule.Namespace,
 		Version:    decodedModule.Version,
 		Preversion: decodedModule.Preversion,
 	})
 	if err != nil {
-		return nil, otel.ReportError(span, err)
+		return nil, err
 	}
 
-	ok := lib.JSONSchemaLLM(&moduleContent.Schema)
+	sourceSchema := moduleContent.Schema
+
+	llmSchema := LoadModuleLLMSchema(&sourceSchema)
 	// Should not happen.
-	if !ok {
-		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidData, ErrInvalidRequest))
+	if !llmSchema.Usable() {
+		return nil, errors.Join(ErrInvalidData, ErrInvalidRequest)
+	}
+
+	generationSchema := llmSchema.Schema
+
+	// Paths that target parts of the schema that cannot be generated are rejected.
+	if len(request.Paths) > 0 {
+		generationSchema, err = lib.SliceModuleSchema(llmSchema.Schema, request.Paths)
+		if err != nil {
+			return nil, errors.Join(err, ErrInvalidRequest)
+		}
 	}
 
-	moduleSchema, err := moduleContent.Schema.Resolve(&jsonschema.ResolveOptions{
+	moduleSchema, err := generationSchema.Resolve(&jsonschema.ResolveOptions{
 		ValidateDefaults: true,
 	})
 	if err != nil {
-		return nil, otel.ReportError(span, err)
+		return nil, err
 	}
 
 	moduleContent.Schema = *moduleSchema.Schema()
@@ -131,54 +498,61 @@ func (service *SchemaGenerate) Exec(ctx context.Context, request *SchemaGenerate
 	// Prepare context.
 	// =================================================================================================================
 
-	schemas, err := service.schemaListRepository.Exec(ctx, &dao.SchemaListRequest{ProjectID: request.ProjectID})
+	schemas, err := repositories.schemaList.Exec(ctx, &dao.SchemaListRequest{ProjectID: request.ProjectID})
 	if err != nil {
-		return nil, otel.ReportError(span, err)
+		return nil, err
 	}
 
-	// Ignore the module we want to generate.
-	contextSchemas := lo.Filter(schemas, func(item *dao.Schema, _ int) bool {
-		return item.ModuleNamespace != decodedModule.Namespace || item.ModuleID != decodedModule.Module
-	})
+	contextSchemas, err := GenerationContext(schemas, decodedModule, project.Workflow, moduleContent.DependsOn)
+	if err != nil {
+		return nil, err
+	}
+
+	contextSchemas, contextCuts, err := FitGenerationContext(
+		contextSchemas, contextBudgets.For(lo.FromPtr(moduleContent.Generation).Model),
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	currentSchema, _ := lo.Find(schemas, func(item *dao.Schema) bool {
 		return item.ModuleNamespace == decodedModule.Namespace && item.ModuleID == decodedModule.Module
 	})
 
+	if len(request.Paths) > 0 && currentSchema == nil {
+		return nil, ErrNoSchemaToRegenerate
+	}
+
 	var prefilled map[string]any
 	if currentSchema != nil {
 		prefilled = currentSchema.Data
 	}
 
-	// =================================================================================================================
-	// Generate.
-	// =================================================================================================================
-
-	data, err := service.schemaGenerateRepository.Exec(ctx, &dao.ModuleGenerateRequest{
-		Module:    moduleContent,
-		Lang:      request.Lang,
-		Context:   contextSchemas,
-		Prefilled: prefilled,
-	})
-	if err != nil {
-		return nil, otel.ReportError(span, err)
-	}
-
-	schema, err := service.schemaInsertRepository.Exec(ctx, &dao.SchemaInsertRequest{
-		ID:               uuid.New(),
-		ProjectID:        request.ProjectID,
-		Owner:            &request.UserID,
-		ModuleID:         moduleContent.ID,
-		ModuleNamespace:  moduleContent.Namespace,
-		ModuleVersion:    moduleContent.Version,
-		ModulePreversion: moduleContent.Preversion,
-		Source:           dao.SchemaSourceAI,
-		Data:             data,
-		Now:              time.Now(),
-	})
-	if err != nil {
-		return nil, otel.ReportError(span, err)
+	// Targeted values are left out, so the model generates them again. The other values are still sent, so the new
+	// content fits with them.
+	if len(request.Paths) > 0 {
+		prefilled, err = lib.OmitModuleData(currentSchema.Data, request.Paths)
+		if err != nil {
+			return nil, errors.Join(err, ErrInvalidRequest)
+		}
 	}
 
-	return otel.ReportSuccess(span, loadSchema(schema)), nil
+	return &schemaGeneration{
+		module:  moduleContent,
+		schema:  &sourceSchema,
+		dropped: llmSchema.Report.Dropped(),
+		current: currentSchema,
+		contextSchemaIDs: lo.Map(contextSchemas, func(item *dao.Schema, _ int) uuid.UUID {
+			return item.ID
+		}),
+		contextCuts: contextCuts,
+		paths:       request.Paths,
+		request: &dao.ModuleGenerateRequest{
+			Module:       moduleContent,
+			Lang:         request.Lang,
+			Context:      contextSchemas,
+			Prefilled:    prefilled,
+			Instructions: request.Instructions,
+		},
+	}, nil
 }
@@ -0,0 +1,245 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/a-novel-kit/golib/otel"
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type SchemaGenerateCandidatesRepository interface {
+	Exec(ctx context.Context, request *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error)
+}
+
+type SchemaGenerateCandidatesRepositorySchemaList interface {
+	Exec(ctx context.Context, request *dao.SchemaListRequest) ([]*dao.Schema, error)
+}
+
+type SchemaGenerateCandidatesRepositorySchemaCandidateInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaCandidateInsertRequest) (*dao.SchemaCandidate, error)
+}
+
+type SchemaGenerateCandidatesRepositorySchemaGenerationInsert interface {
+	Exec(ctx context.Context, request *dao.SchemaGenerationInsertRequest) (*dao.SchemaGeneration, error)
+}
+
+type SchemaGenerateCandidatesRepositoryTokenUsageList interface {
+	Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)
+}
+
+type SchemaGenerateCandidatesRepositoryTokenUsageInsert interface {
+	Exec(ctx context.Context, request *dao.TokenUsageInsertRequest) (*dao.TokenUsage, error)
+}
+
+type SchemaGenerateCandidatesRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type SchemaGenerateCandidatesRepositoryModuleSelect interface {
+	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
+}
+
+type SchemaGenerateCandidatesRepositoryModuleListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
+type SchemaGenerateCandidatesRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type SchemaGenerateCandidatesRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	// Roles of the user, which set their token quota.
+	Roles        []string
+	Module       string   `validate:"required,workflowModule,max=512"`
+	Lang         string   `validate:"required,langs"`
+	Paths        []string `validate:"max=64,dive,max=1024,jsonPointer"`
+	Instructions string   `validate:"max=2048"`
+	// Count is the number of alternatives to generate.
+	Count int `validate:"required,min=1,max=5"`
+}
+
+// SchemaGenerateCandidates generates alternative contents for a module, like SchemaGenerate. Instead of becoming
+// the latest version of the module, the alternatives are saved as pending candidates, until one of them is promoted
+// using SchemaCandidatePromote.
+type SchemaGenerateCandidates struct {
+	schemaGenerateRepository         SchemaGenerateCandidatesRepository
+	schemaListRepository             SchemaGenerateCandidatesRepositorySchemaList
+	schemaCandidateInsertRepository  SchemaGenerateCandidatesRepositorySchemaCandidateInsert
+	schemaGenerationInsertRepository SchemaGenerateCandidatesRepositorySchemaGenerationInsert
+	projectSelectRepository          SchemaGenerateCandidatesRepositoryProjectSelect
+	moduleSelectRepository           SchemaGenerateCandidatesRepositoryModuleSelect
+	moduleListVersionsRepository     SchemaGenerateCandidatesRepositoryModuleListVersions
+	tokenUsageListRepository         SchemaGenerateCandidatesRepositoryTokenUsageList
+	tokenUsageInsertRepository       SchemaGenerateCandidatesRepositoryTokenUsageInsert
+	projectMemberSelectRepository    SchemaGenerateCandidatesRepositoryProjectMemberSelect
+	usageQuotas                      config.UsageQuotas
+	contextBudgets                   config.GenerationContextBudgets
+}
+
+func NewSchemaGenerateCandidates(
+	schemaGenerateRepository SchemaGenerateCandidatesRepository,
+	schemaListRepository SchemaGenerateCandidatesRepositorySchemaList,
+	schemaCandidateInsertRepository SchemaGenerateCandidatesRepositorySchemaCandidateInsert,
+	schemaGenerationInsertRepository SchemaGenerateCandidatesRepositorySchemaGenerationInsert,
+	projectSelectRepository SchemaGenerateCandidatesRepositoryProjectSelect,
+	moduleSelectRepository SchemaGenerateCandidatesRepositoryModuleSelect,
+	moduleListVersionsRepository SchemaGenerateCandidatesRepositoryModuleListVersions,
+	tokenUsageListRepository SchemaGenerateCandidatesRepositoryTokenUsageList,
+	tokenUsageInsertRepository SchemaGenerateCandidatesRepositoryTokenUsageInsert,
+	projectMemberSelectRepository SchemaGenerateCandidatesRepositoryProjectMemberSelect,
+	usageQuotas config.UsageQuotas,
+	contextBudgets config.GenerationContextBudgets,
+) *SchemaGenerateCandidates {
+	return &SchemaGenerateCandidates{
+		schemaGenerateRepository:         schemaGenerateRepository,
+		schemaListRepository:             schemaListRepository,
+		schemaCandidateInsertRepository:  schemaCandidateInsertRepository,
+		schemaGenerationInsertRepository: schemaGenerationInsertRepository,
+		projectSelectRepository:          projectSelectRepository,
+		moduleSelectRepository:           moduleSelectRepository,
+		moduleListVersionsRepository:     moduleListVersionsRepository,
+		tokenUsageListRepository:         tokenUsageListRepository,
+		tokenUsageInsertRepository:       tokenUsageInsertRepository,
+		projectMemberSelectRepository:    projectMemberSelectRepository,
+		usageQuotas:                      usageQuotas,
+		contextBudgets:                   contextBudgets,
+	}
+}
+
+func (service *SchemaGenerateCandidates) Exec(
+	ctx context.Context, request *SchemaGenerateCandidatesRequest,
+) ([]*SchemaCandidate, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.SchemaGenerateCandidates")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	generation, err := prepareSchemaGeneration(ctx, &schemaGenerationRepositories{
+		schemaList:          service.schemaListRepository,
+		projectSelect:       service.projectSelectRepository,
+		projectMemberSelect: service.projectMemberSelectRepository,
+		moduleSelect:        service.moduleSelectRepository,
+		moduleListVersions:  service.moduleListVersionsRepository,
+	}, service.contextBudgets, &SchemaGenerateRequest{
+		ProjectID:    request.ProjectID,
+		UserID:       request.UserID,
+		Module:       request.Module,
+		Lang:         request.Lang,
+		Paths:        request.Paths,
+		Instructions: request.Instructions,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyUsageQuota(ctx, service.tokenUsageListRepository, service.usageQuotas, request.UserID, request.Roles)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// =================================================================================================================
+	// Generate.
+	// =================================================================================================================
+
+	// Alternatives are generated in parallel. If any of them fails, the others are canceled.
+	generateCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*dao.ModuleGeneration, request.Count)
+	data := make([]map[string]any, request.Count)
+	errs := make([]error, request.Count)
+
+	var wg sync.WaitGroup
+
+	for i := range results {
+		wg.Go(func() {
+			results[i], data[i], errs[i] = generation.generate(generateCtx, service.schemaGenerateRepository, nil)
+			if errs[i] != nil {
+				cancel()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	// Alternatives that completed have spent their tokens, even if the others failed. Usage is recorded even if the
+	// request timed out meanwhile.
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		_, err = service.tokenUsageInsertRepository.Exec(
+			context.WithoutCancel(ctx), moduleGenerationUsage(request.UserID, request.ProjectID, result),
+		)
+		if err != nil {
+			return nil, otel.ReportError(span, err)
+		}
+	}
+
+	err = errors.Join(errs...)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// =================================================================================================================
+	// Save candidates.
+	// =================================================================================================================
+
+	candidates := make([]*SchemaCandidate, len(results))
+	now := time.Now()
+
+	err = postgres.RunInTx(ctx, nil, func(ctx context.Context, _ bun.IDB) error {
+		for i, result := range results {
+			var candidate *dao.SchemaCandidate
+
+			candidate, err = service.schemaCandidateInsertRepository.Exec(ctx, &dao.SchemaCandidateInsertRequest{
+				ID:               uuid.New(),
+				ProjectID:        request.ProjectID,
+				Owner:            request.UserID,
+				ModuleID:         generation.module.ID,
+				ModuleNamespace:  generation.module.Namespace,
+				ModuleVersion:    generation.module.Version,
+				ModulePreversion: generation.module.Preversion,
+				Instructions:     request.Instructions,
+				Data:             data[i],
+				Now:              now,
+			})
+			if err != nil {
+				return err
+			}
+
+			// The record follows the candidate once promoted. It is kept otherwise, so the cost of discarded
+			// candidates can still be accounted for.
+			_, err = service.schemaGenerationInsertRepository.Exec(
+				ctx, generation.record(candidate.ID, request.ProjectID, result, now),
+			)
+			if err != nil {
+				return err
+			}
+
+			candidates[i] = loadSchemaCandidate(candidate)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, candidates), nil
+}
@@ -0,0 +1,624 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestSchemaGenerateCandidates(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	testModule := &dao.Module{
+		ID:        "test-module",
+		Namespace: "test-namespace",
+		Version:   "1.0.0",
+		Schema: jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"title":   {Type: "string"},
+				"what_if": {Type: "string"},
+			},
+			Required: []string{"title", "what_if"},
+		},
+		CreatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type moduleSelectMock struct {
+		resp *dao.Module
+		err  error
+	}
+
+	type schemaListMock struct {
+		resp []*dao.Schema
+		err  error
+	}
+
+	type schemaGenerateMock struct {
+		// resp is returned by successive calls. As candidates are generated in parallel, the order of the
+		// candidates is not guaranteed.
+		resp []map[string]any
+		err  error
+	}
+
+	type tokenUsageListMock struct {
+		resp []*dao.TokenUsageProject
+		err  error
+	}
+
+	type tokenUsageInsertMock struct {
+		err error
+	}
+
+	type schemaCandidateInsertMock struct {
+		err error
+	}
+
+	type schemaGenerationInsertMock struct {
+		err error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.SchemaGenerateCandidatesRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		moduleSelectMock        *moduleSelectMock
+		schemaListMock          *schemaListMock
+		tokenUsageListMock      *tokenUsageListMock
+		schemaGenerateMock      *schemaGenerateMock
+		// tokenUsageInsertMock records the tokens spent by each candidate.
+		tokenUsageInsertMock      *tokenUsageInsertMock
+		schemaCandidateInsertMock *schemaCandidateInsertMock
+		// schemaGenerationInsertMock records how each candidate was generated.
+		schemaGenerationInsertMock *schemaGenerationInsertMock
+
+		// expect lists the returned candidates, in any order. Their IDs are ignored.
+		expect    []*services.SchemaCandidate
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID:    projectID,
+				UserID:       ownerID,
+				Module:       "test-namespace:test-module@v1.0.0",
+				Lang:         config.LangEN,
+				Instructions: "Keep it short.",
+				Count:        2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			moduleSelectMock:  &moduleSelectMock{resp: testModule},
+			schemaListMock:    &schemaListMock{resp: []*dao.Schema{}},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: []map[string]any{
+					{"title": "The Lighthouse", "what_if": "What if the light never went out?"},
+					{"title": "The Keeper", "what_if": "What if the keeper was a ghost?"},
+				},
+			},
+
+			tokenUsageInsertMock:       &tokenUsageInsertMock{},
+			schemaCandidateInsertMock:  &schemaCandidateInsertMock{},
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{},
+
+			expect: []*services.SchemaCandidate{
+				{
+					ProjectID:       projectID,
+					Owner:           ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Instructions:    "Keep it short.",
+					Data:            map[string]any{"title": "The Lighthouse", "what_if": "What if the light never went out?"},
+					CreatedAt:       baseTime,
+				},
+				{
+					ProjectID:       projectID,
+					Owner:           ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Instructions:    "Keep it short.",
+					Data:            map[string]any{"title": "The Keeper", "what_if": "What if the keeper was a ghost?"},
+					CreatedAt:       baseTime,
+				},
+			},
+		},
+		{
+			name: "Success/Paths",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Paths:     []string{"/what_if"},
+				Count:     2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			moduleSelectMock:  &moduleSelectMock{resp: testModule},
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{
+					{
+						ID:              schemaID,
+						ProjectID:       projectID,
+						ModuleID:        "test-module",
+						ModuleNamespace: "test-namespace",
+						ModuleVersion:   "1.0.0",
+						Source:          dao.SchemaSourceUser,
+						Data:            map[string]any{"title": "The Lighthouse", "what_if": "What if?"},
+						CreatedAt:       baseTime,
+					},
+				},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: []map[string]any{
+					{"what_if": "What if the light never went out?"},
+					{"what_if": "What if the keeper was a ghost?"},
+				},
+			},
+
+			tokenUsageInsertMock:       &tokenUsageInsertMock{},
+			schemaCandidateInsertMock:  &schemaCandidateInsertMock{},
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{},
+
+			// Candidates keep the values outside the targeted paths.
+			expect: []*services.SchemaCandidate{
+				{
+					ProjectID:       projectID,
+					Owner:           ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Data:            map[string]any{"title": "The Lighthouse", "what_if": "What if the light never went out?"},
+					CreatedAt:       baseTime,
+				},
+				{
+					ProjectID:       projectID,
+					Owner:           ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Data:            map[string]any{"title": "The Lighthouse", "what_if": "What if the keeper was a ghost?"},
+					CreatedAt:       baseTime,
+				},
+			},
+		},
+		{
+			name: "Error/InvalidRequest/MissingCount",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/TooManyCandidates",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     6,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectOwnership",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     2,
+			},
+
+			projectSelectMock:       &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleEditor},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/QuotaExceeded",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			moduleSelectMock:  &moduleSelectMock{resp: testModule},
+			schemaListMock:    &schemaListMock{resp: []*dao.Schema{}},
+
+			tokenUsageListMock: &tokenUsageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 800, CompletionTokens: 200},
+				},
+			},
+
+			expectErr: services.ErrUsageQuotaExceeded,
+		},
+		{
+			name: "Error/SchemaGenerate",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			moduleSelectMock:  &moduleSelectMock{resp: testModule},
+			schemaListMock:    &schemaListMock{resp: []*dao.Schema{}},
+
+			schemaGenerateMock: &schemaGenerateMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/TokenUsageInsert",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			moduleSelectMock:  &moduleSelectMock{resp: testModule},
+			schemaListMock:    &schemaListMock{resp: []*dao.Schema{}},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: []map[string]any{
+					{"title": "The Lighthouse", "what_if": "What if the light never went out?"},
+					{"title": "The Keeper", "what_if": "What if the keeper was a ghost?"},
+				},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaCandidateInsert",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			moduleSelectMock:  &moduleSelectMock{resp: testModule},
+			schemaListMock:    &schemaListMock{resp: []*dao.Schema{}},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: []map[string]any{
+					{"title": "The Lighthouse", "what_if": "What if the light never went out?"},
+					{"title": "The Keeper", "what_if": "What if the keeper was a ghost?"},
+				},
+			},
+
+			tokenUsageInsertMock:      &tokenUsageInsertMock{},
+			schemaCandidateInsertMock: &schemaCandidateInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaGenerationInsert",
+
+			request: &services.SchemaGenerateCandidatesRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Count:     2,
+			},
+
+			projectSelectMock: &projectSelectMock{resp: testProject},
+			moduleSelectMock:  &moduleSelectMock{resp: testModule},
+			schemaListMock:    &schemaListMock{resp: []*dao.Schema{}},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: []map[string]any{
+					{"title": "The Lighthouse", "what_if": "What if the light never went out?"},
+					{"title": "The Keeper", "what_if": "What if the keeper was a ghost?"},
+				},
+			},
+
+			tokenUsageInsertMock:       &tokenUsageInsertMock{},
+			schemaCandidateInsertMock:  &schemaCandidateInsertMock{},
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{err: errFoo},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				schemaGenerateRepository := servicesmocks.NewMockSchemaGenerateCandidatesRepository(t)
+				schemaListRepository := servicesmocks.NewMockSchemaGenerateCandidatesRepositorySchemaList(t)
+				schemaCandidateInsertRepository := servicesmocks.
+					NewMockSchemaGenerateCandidatesRepositorySchemaCandidateInsert(t)
+				projectSelectRepository := servicesmocks.NewMockSchemaGenerateCandidatesRepositoryProjectSelect(t)
+				moduleSelectRepository := servicesmocks.NewMockSchemaGenerateCandidatesRepositoryModuleSelect(t)
+				moduleListVersionsRepository := servicesmocks.
+					NewMockSchemaGenerateCandidatesRepositoryModuleListVersions(t)
+				schemaGenerationInsertRepository := servicesmocks.
+					NewMockSchemaGenerateCandidatesRepositorySchemaGenerationInsert(t)
+				tokenUsageListRepository := servicesmocks.NewMockSchemaGenerateCandidatesRepositoryTokenUsageList(t)
+				tokenUsageInsertRepository := servicesmocks.NewMockSchemaGenerateCandidatesRepositoryTokenUsageInsert(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaGenerateCandidatesRepositoryProjectMemberSelect(t)
+
+				// Candidates are saved one after the other, each followed by its generation record.
+				var lastCandidateID uuid.UUID
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{ID: testCase.request.ProjectID}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.moduleSelectMock != nil {
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:        "test-module",
+							Namespace: "test-namespace",
+							Version:   "1.0.0",
+						}).
+						Return(testCase.moduleSelectMock.resp, testCase.moduleSelectMock.err)
+				}
+
+				if testCase.schemaListMock != nil {
+					schemaListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaListRequest{ProjectID: testCase.request.ProjectID}).
+						Return(testCase.schemaListMock.resp, testCase.schemaListMock.err)
+				}
+
+				if testCase.schemaGenerateMock != nil {
+					matchRequest := mock.MatchedBy(func(req *dao.ModuleGenerateRequest) bool {
+						return req.Module.ID == testCase.moduleSelectMock.resp.ID &&
+							req.Lang == testCase.request.Lang &&
+							req.Instructions == testCase.request.Instructions &&
+							req.OnDelta == nil
+					})
+
+					if testCase.schemaGenerateMock.err != nil {
+						schemaGenerateRepository.EXPECT().
+							Exec(mock.Anything, matchRequest).
+							Return(nil, testCase.schemaGenerateMock.err)
+					}
+
+					for _, resp := range testCase.schemaGenerateMock.resp {
+						schemaGenerateRepository.EXPECT().
+							Exec(mock.Anything, matchRequest).
+							Return(&dao.ModuleGeneration{
+								Data:            resp,
+								Model:           "test-model",
+								TemplateVersion: "default:0123456789ab",
+								Usage:           lib.CompletionUsage{PromptTokens: 120, CompletionTokens: 30},
+							}, nil).
+							Once()
+					}
+				}
+
+				if testCase.tokenUsageListMock != nil {
+					tokenUsageListRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageListRequest) bool {
+							return req.UserID == testCase.request.UserID
+						})).
+						Return(testCase.tokenUsageListMock.resp, testCase.tokenUsageListMock.err)
+				}
+
+				if testCase.tokenUsageInsertMock != nil {
+					// The first failure stops the recording.
+					times := len(testCase.schemaGenerateMock.resp)
+					if testCase.tokenUsageInsertMock.err != nil {
+						times = 1
+					}
+
+					tokenUsageInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageInsertRequest) bool {
+							return req.UserID == testCase.request.UserID &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Model == "test-model" &&
+								req.PromptTokens == 120 &&
+								req.CompletionTokens == 30
+						})).
+						Return(nil, testCase.tokenUsageInsertMock.err).
+						Times(times)
+				}
+
+				if testCase.schemaCandidateInsertMock != nil {
+					schemaCandidateInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaCandidateInsertRequest) bool {
+							return req.ID != uuid.Nil &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Owner == testCase.request.UserID &&
+								req.ModuleID == testCase.moduleSelectMock.resp.ID &&
+								req.ModuleNamespace == testCase.moduleSelectMock.resp.Namespace &&
+								req.ModuleVersion == testCase.moduleSelectMock.resp.Version &&
+								req.Instructions == testCase.request.Instructions &&
+								time.Since(req.Now) < time.Minute
+						})).
+						RunAndReturn(func(
+							_ context.Context, req *dao.SchemaCandidateInsertRequest,
+						) (*dao.SchemaCandidate, error) {
+							if testCase.schemaCandidateInsertMock.err != nil {
+								return nil, testCase.schemaCandidateInsertMock.err
+							}
+
+							lastCandidateID = req.ID
+
+							return &dao.SchemaCandidate{
+								ID:               req.ID,
+								ProjectID:        req.ProjectID,
+								Owner:            req.Owner,
+								ModuleID:         req.ModuleID,
+								ModuleNamespace:  req.ModuleNamespace,
+								ModuleVersion:    req.ModuleVersion,
+								ModulePreversion: req.ModulePreversion,
+								Instructions:     req.Instructions,
+								Data:             req.Data,
+								CreatedAt:        baseTime,
+							}, nil
+						})
+				}
+
+				if testCase.schemaGenerationInsertMock != nil {
+					schemaGenerationInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaGenerationInsertRequest) bool {
+							return req.SchemaID == lastCandidateID &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Model == "test-model" &&
+								req.TemplateVersion == "default:0123456789ab" &&
+								req.PromptTokens == 120 &&
+								req.CompletionTokens == 30 &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(nil, testCase.schemaGenerationInsertMock.err)
+				}
+
+				service := services.NewSchemaGenerateCandidates(
+					schemaGenerateRepository,
+					schemaListRepository,
+					schemaCandidateInsertRepository,
+					schemaGenerationInsertRepository,
+					projectSelectRepository,
+					moduleSelectRepository,
+					moduleListVersionsRepository,
+					tokenUsageListRepository,
+					tokenUsageInsertRepository,
+					projectMemberSelectRepository,
+					config.UsageQuotas{
+						Roles: map[string]config.UsageQuotasRole{
+							"user": {Quota: &config.UsageQuota{Daily: 1000}},
+						},
+					},
+					config.GenerationContextBudgets{},
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+
+				for _, candidate := range resp {
+					assert.NotEqual(t, uuid.Nil, candidate.ID)
+					candidate.ID = uuid.Nil
+				}
+
+				require.ElementsMatch(t, testCase.expect, resp)
+
+				schemaGenerateRepository.AssertExpectations(t)
+				schemaListRepository.AssertExpectations(t)
+				schemaCandidateInsertRepository.AssertExpectations(t)
+				schemaGenerationInsertRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				moduleSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
+				tokenUsageListRepository.AssertExpectations(t)
+				tokenUsageInsertRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
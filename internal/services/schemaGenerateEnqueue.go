@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type SchemaGenerateEnqueueRepository interface {
+	Exec(ctx context.Context, request *dao.GenerationJobInsertRequest) (*dao.GenerationJob, error)
+}
+
+type SchemaGenerateEnqueueRepositoryProjectSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
+}
+
+type SchemaGenerateEnqueueRepositoryModuleListVersions interface {
+	Exec(ctx context.Context, request *dao.ModuleListVersionsRequest) ([]*dao.ModuleVersion, error)
+}
+
+type SchemaGenerateEnqueueRepositoryTokenUsageList interface {
+	Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)
+}
+
+type SchemaGenerateEnqueueRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
+type SchemaGenerateEnqueueRequest struct {
+	ProjectID uuid.UUID `validate:"required"`
+	UserID    uuid.UUID `validate:"required"`
+	// Roles of the user, which set their token quota. They are saved with the job, so the quota is enforced again
+	// when it runs.
+	Roles  []string
+	Module string   `validate:"required,workflowModule,max=512"`
+	Lang   string   `validate:"required,langs"`
+	Paths  []string `validate:"max=64,dive,max=1024,jsonPointer"`
+	// Instructions are free-text instructions to steer the generation.
+	Instructions string `validate:"max=2048"`
+}
+
+type SchemaGenerateEnqueue struct {
+	generationJobInsertRepository SchemaGenerateEnqueueRepository
+	projectSelectRepository       SchemaGenerateEnqueueRepositoryProjectSelect
+	moduleListVersionsRepository  SchemaGenerateEnqueueRepositoryModuleListVersions
+	tokenUsageListRepository      SchemaGenerateEnqueueRepositoryTokenUsageList
+	projectMemberSelectRepository SchemaGenerateEnqueueRepositoryProjectMemberSelect
+	usageQuotas                   config.UsageQuotas
+}
+
+func NewSchemaGenerateEnqueue(
+	generationJobInsertRepository SchemaGenerateEnqueueRepository,
+	projectSelectRepository SchemaGenerateEnqueueRepositoryProjectSelect,
+	moduleListVersionsRepository SchemaGenerateEnqueueRepositoryModuleListVersions,
+	tokenUsageListRepository SchemaGenerateEnqueueRepositoryTokenUsageList,
+	projectMemberSelectRepository SchemaGenerateEnqueueRepositoryProjectMemberSelect,
+	usageQuotas config.UsageQuotas,
+) *SchemaGenerateEnqueue {
+	return &SchemaGenerateEnqueue{
+		generationJobInsertRepository: generationJobInsertRepository,
+		projectSelectRepository:       projectSelectRepository,
+		moduleListVersionsRepository:  moduleListVersionsRepository,
+		tokenUsageListRepository:      tokenUsageListRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
+		usageQuotas:                   usageQuotas,
+	}
+}
+
+// Exec queues the generation of a schema, to be processed later by a worker. The request is checked beforehand, so
+// obviously invalid requests fail right away instead of creating failed jobs.
+func (service *SchemaGenerateEnqueue) Exec(
+	ctx context.Context, request *SchemaGenerateEnqueueRequest,
+) (*GenerationJob, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.SchemaGenerateEnqueue")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	project, err := service.projectSelectRepository.Exec(ctx, &dao.ProjectSelectRequest{
+		ID: request.ProjectID,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleGenerator,
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	decodedModule, err := ResolveModule(ctx, service.moduleListVersionsRepository, request.Module)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyModule(project, decodedModule.String())
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = VerifyUsageQuota(ctx, service.tokenUsageListRepository, service.usageQuotas, request.UserID, request.Roles)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	// The module is stored as requested: version ranges are resolved again when the job runs.
+	job, err := service.generationJobInsertRepository.Exec(ctx, &dao.GenerationJobInsertRequest{
+		ID:           uuid.New(),
+		ProjectID:    request.ProjectID,
+		Owner:        request.UserID,
+		Module:       request.Module,
+		Lang:         request.Lang,
+		Paths:        request.Paths,
+		Instructions: request.Instructions,
+		Roles:        request.Roles,
+		Now:          time.Now(),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, loadGenerationJob(job)), nil
+}
@@ -0,0 +1,557 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestSchemaGenerateEnqueue(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	jobID := uuid.MustParse("00000000-0000-0000-0000-000000000300")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testProject := &dao.Project{
+		ID:        projectID,
+		Owner:     ownerID,
+		Lang:      config.LangEN,
+		Title:     "Test Project",
+		Workflow:  []string{"test-namespace:test-module@v1.0.0", "test-namespace:other-module@^1"},
+		CreatedAt: baseTime,
+		UpdatedAt: baseTime,
+	}
+
+	type projectSelectMock struct {
+		resp *dao.Project
+		err  error
+	}
+
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
+	type tokenUsageListMock struct {
+		resp []*dao.TokenUsageProject
+		err  error
+	}
+
+	type generationJobInsertMock struct {
+		resp *dao.GenerationJob
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.SchemaGenerateEnqueueRequest
+
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		moduleListVersionsMock  *moduleListVersionsMock
+		tokenUsageListMock      *tokenUsageListMock
+		generationJobInsertMock *generationJobInsertMock
+
+		expect    *services.GenerationJob
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			generationJobInsertMock: &generationJobInsertMock{
+				resp: &dao.GenerationJob{
+					ID:        jobID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Module:    "test-namespace:test-module@v1.0.0",
+					Lang:      config.LangEN,
+					Status:    dao.GenerationJobStatusQueued,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:        jobID,
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Status:    dao.GenerationJobStatusQueued.String(),
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/Paths",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Paths:     []string{"/exploration/what_if"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			generationJobInsertMock: &generationJobInsertMock{
+				resp: &dao.GenerationJob{
+					ID:        jobID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Module:    "test-namespace:test-module@v1.0.0",
+					Lang:      config.LangEN,
+					Paths:     []string{"/exploration/what_if"},
+					Status:    dao.GenerationJobStatusQueued,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:        jobID,
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Paths:     []string{"/exploration/what_if"},
+				Status:    dao.GenerationJobStatusQueued.String(),
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
+		{
+			name: "Success/Instructions",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID:    projectID,
+				UserID:       ownerID,
+				Module:       "test-namespace:test-module@v1.0.0",
+				Lang:         config.LangEN,
+				Instructions: "Keep it short.",
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			generationJobInsertMock: &generationJobInsertMock{
+				resp: &dao.GenerationJob{
+					ID:           jobID,
+					ProjectID:    projectID,
+					Owner:        ownerID,
+					Module:       "test-namespace:test-module@v1.0.0",
+					Lang:         config.LangEN,
+					Instructions: "Keep it short.",
+					Status:       dao.GenerationJobStatusQueued,
+					CreatedAt:    baseTime,
+					UpdatedAt:    baseTime,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:           jobID,
+				ProjectID:    projectID,
+				Owner:        ownerID,
+				Module:       "test-namespace:test-module@v1.0.0",
+				Lang:         config.LangEN,
+				Instructions: "Keep it short.",
+				Status:       dao.GenerationJobStatusQueued.String(),
+				CreatedAt:    baseTime,
+				UpdatedAt:    baseTime,
+			},
+		},
+		{
+			name: "Success/VersionRange",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:other-module@^1",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "1.2.0", CreatedAt: baseTime},
+				},
+			},
+
+			generationJobInsertMock: &generationJobInsertMock{
+				resp: &dao.GenerationJob{
+					ID:        jobID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Module:    "test-namespace:other-module@^1",
+					Lang:      config.LangEN,
+					Status:    dao.GenerationJobStatusQueued,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:        jobID,
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Module:    "test-namespace:other-module@^1",
+				Lang:      config.LangEN,
+				Status:    dao.GenerationJobStatusQueued.String(),
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "invalid module",
+				Lang:      config.LangEN,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/Paths",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Paths:     []string{"exploration/what_if"},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InstructionsTooLong",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID:    projectID,
+				UserID:       ownerID,
+				Module:       "test-namespace:test-module@v1.0.0",
+				Lang:         config.LangEN,
+				Instructions: strings.Repeat("a", 2049),
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/UserDoesNotOwnProject",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleEditor},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ModuleNotInProject",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v2.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			expectErr: services.ErrModuleNotInProject,
+		},
+		{
+			name: "Error/ModuleRangeNotSatisfied",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:other-module@^1",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{
+					{Version: "2.0.0", CreatedAt: baseTime},
+				},
+			},
+
+			expectErr: services.ErrModuleRangeNotSatisfied,
+		},
+		{
+			name: "Success/WithinQuota",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			tokenUsageListMock: &tokenUsageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 600, CompletionTokens: 200},
+				},
+			},
+
+			generationJobInsertMock: &generationJobInsertMock{
+				resp: &dao.GenerationJob{
+					ID:        jobID,
+					ProjectID: projectID,
+					Owner:     ownerID,
+					Module:    "test-namespace:test-module@v1.0.0",
+					Lang:      config.LangEN,
+					Roles:     []string{"user"},
+					Status:    dao.GenerationJobStatusQueued,
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expect: &services.GenerationJob{
+				ID:        jobID,
+				ProjectID: projectID,
+				Owner:     ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Status:    dao.GenerationJobStatusQueued.String(),
+				CreatedAt: baseTime,
+				UpdatedAt: baseTime,
+			},
+		},
+		{
+			name: "Error/QuotaExceeded",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			tokenUsageListMock: &tokenUsageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 800, CompletionTokens: 200},
+				},
+			},
+
+			expectErr: services.ErrUsageQuotaExceeded,
+		},
+		{
+			name: "Error/GenerationJobInsert",
+
+			request: &services.SchemaGenerateEnqueueRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: testProject,
+			},
+
+			generationJobInsertMock: &generationJobInsertMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				generationJobInsertRepository := servicesmocks.NewMockSchemaGenerateEnqueueRepository(t)
+				projectSelectRepository := servicesmocks.NewMockSchemaGenerateEnqueueRepositoryProjectSelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockSchemaGenerateEnqueueRepositoryModuleListVersions(t)
+				tokenUsageListRepository := servicesmocks.NewMockSchemaGenerateEnqueueRepositoryTokenUsageList(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaGenerateEnqueueRepositoryProjectMemberSelect(t)
+
+				if testCase.projectSelectMock != nil {
+					projectSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectSelectRequest{
+							ID: testCase.request.ProjectID,
+						}).
+						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
+				}
+
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.moduleListVersionsMock != nil {
+					decodedModule := lib.DecodeModule(testCase.request.Module)
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        decodedModule.Module,
+							Namespace: decodedModule.Namespace,
+						}).
+						Return(testCase.moduleListVersionsMock.resp, testCase.moduleListVersionsMock.err)
+				}
+
+				if testCase.tokenUsageListMock != nil {
+					tokenUsageListRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageListRequest) bool {
+							return req.UserID == testCase.request.UserID
+						})).
+						Return(testCase.tokenUsageListMock.resp, testCase.tokenUsageListMock.err)
+				}
+
+				if testCase.generationJobInsertMock != nil {
+					generationJobInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.GenerationJobInsertRequest) bool {
+							return req.ID != uuid.Nil &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Owner == testCase.request.UserID &&
+								req.Module == testCase.request.Module &&
+								req.Lang == testCase.request.Lang &&
+								slices.Equal(req.Paths, testCase.request.Paths) &&
+								req.Instructions == testCase.request.Instructions &&
+								slices.Equal(req.Roles, testCase.request.Roles) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.generationJobInsertMock.resp, testCase.generationJobInsertMock.err)
+				}
+
+				service := services.NewSchemaGenerateEnqueue(
+					generationJobInsertRepository,
+					projectSelectRepository,
+					moduleListVersionsRepository,
+					tokenUsageListRepository,
+					projectMemberSelectRepository,
+					config.UsageQuotas{
+						Roles: map[string]config.UsageQuotasRole{
+							"user": {Quota: &config.UsageQuota{Daily: 1000}},
+						},
+					},
+				)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				generationJobInsertRepository.AssertExpectations(t)
+				projectSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
+				tokenUsageListRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -3,9 +3,11 @@ package services_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/goccy/go-yaml"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -18,6 +20,8 @@ import (
 	"github.com/a-novel/service-narrative-engine/internal/config"
 	"github.com/a-novel/service-narrative-engine/internal/dao"
 	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+	"github.com/a-novel/service-narrative-engine/internal/models/modules"
 	"github.com/a-novel/service-narrative-engine/internal/services"
 	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
 )
@@ -32,6 +36,7 @@ func TestSchemaGenerate(t *testing.T) {
 	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
 	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
 	otherSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000201")
+	thirdSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000202")
 
 	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
 
@@ -46,11 +51,91 @@ func TestSchemaGenerate(t *testing.T) {
 		Required: []string{"title"},
 	}
 
+	testNestedModuleSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title": {
+				Type: "string",
+			},
+			"exploration": {
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"what_if": {Type: "string"},
+					"themes":  {Type: "string"},
+				},
+				Required: []string{"what_if", "themes"},
+			},
+		},
+		Required: []string{"title", "exploration"},
+	}
+
+	testUsageQuotas := config.UsageQuotas{
+		Roles: map[string]config.UsageQuotasRole{
+			"user": {Quota: &config.UsageQuota{Daily: 1000, Monthly: 10000}},
+		},
+	}
+
+	// testGeneration is how every successful output of the model is generated.
+	testGeneration := &dao.ModuleGeneration{
+		Model:           "test-model",
+		Messages:        []lib.CompletionMessage{{Role: lib.CompletionRoleUser, Content: "Generate."}},
+		TemplateVersion: "default:0123456789ab",
+		Usage:           lib.CompletionUsage{PromptTokens: 120, CompletionTokens: 30},
+		Latency:         1500 * time.Millisecond,
+	}
+
+	testGenerationRecord := &dao.SchemaGeneration{
+		SchemaID:         schemaID,
+		ProjectID:        projectID,
+		Model:            "test-model",
+		Messages:         []dao.SchemaGenerationMessage{{Role: "user", Content: "Generate."}},
+		TemplateVersion:  "default:0123456789ab",
+		PromptTokens:     120,
+		CompletionTokens: 30,
+		LatencyMs:        1500,
+		ContextSchemaIDs: []uuid.UUID{},
+		CreatedAt:        baseTime,
+	}
+
+	testSchemaGeneration := &services.SchemaGeneration{
+		Model:            "test-model",
+		Messages:         []lib.CompletionMessage{{Role: lib.CompletionRoleUser, Content: "Generate."}},
+		TemplateVersion:  "default:0123456789ab",
+		PromptTokens:     120,
+		CompletionTokens: 30,
+		Latency:          1500 * time.Millisecond,
+		ContextSchemaIDs: []uuid.UUID{},
+	}
+
+	testNestedData := map[string]any{
+		"title": "The Lighthouse",
+		"exploration": map[string]any{
+			"what_if": "What if the light never went out?",
+			"themes":  "solitude",
+		},
+	}
+
 	type schemaGenerateMock struct {
 		resp map[string]any
+		// deltas are sent to the OnDelta callback of the request, when streaming.
+		deltas []string
+		err    error
+	}
+
+	type schemaGenerationInsertMock struct {
+		resp *dao.SchemaGeneration
 		err  error
 	}
 
+	type tokenUsageListMock struct {
+		resp []*dao.TokenUsageProject
+		err  error
+	}
+
+	type tokenUsageInsertMock struct {
+		err error
+	}
+
 	type schemaListMock struct {
 		resp []*dao.Schema
 		err  error
@@ -71,16 +156,47 @@ func TestSchemaGenerate(t *testing.T) {
 		err  error
 	}
 
+	type moduleListVersionsMock struct {
+		resp []*dao.ModuleVersion
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.SchemaGenerateRequest
-
-		schemaGenerateMock *schemaGenerateMock
-		schemaListMock     *schemaListMock
-		schemaInsertMock   *schemaInsertMock
-		projectSelectMock  *projectSelectMock
-		moduleSelectMock   *moduleSelectMock
+		// resolvedModule is the exact module the request resolves to, when it targets a version range.
+		resolvedModule string
+		// stream sets an OnDelta callback on the request.
+		stream bool
+
+		// tokenUsageListMock returns the usage of the user, for every period.
+		tokenUsageListMock   *tokenUsageListMock
+		schemaGenerateMock   *schemaGenerateMock
+		tokenUsageInsertMock *tokenUsageInsertMock
+		schemaListMock       *schemaListMock
+		schemaInsertMock     *schemaInsertMock
+		// schemaGenerationInsertMock records how the schema was generated.
+		schemaGenerationInsertMock *schemaGenerationInsertMock
+		projectSelectMock          *projectSelectMock
+		projectMemberSelectMock    *projectMemberSelectMock
+		moduleListVersionsMock     *moduleListVersionsMock
+		moduleSelectMock           *moduleSelectMock
+		// expectContext, when set, is the exact context sent for generation.
+		expectContext []*dao.Schema
+		// expectPrefilled, when set, is the exact prefilled data sent for generation.
+		expectPrefilled map[string]any
+		// expectProperties, when set, lists the top-level properties of the schema sent for generation.
+		expectProperties []string
+		// expectData, when set, is the data saved, instead of the generated one.
+		expectData map[string]any
+		// expectBaseSchemaID is the schema the generation started from, if any.
+		expectBaseSchemaID *uuid.UUID
 
 		expect    *services.Schema
 		expectErr error
@@ -125,6 +241,8 @@ func TestSchemaGenerate(t *testing.T) {
 				resp: map[string]any{"title": "Generated Title"},
 			},
 
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
 			schemaInsertMock: &schemaInsertMock{
 				resp: &dao.Schema{
 					ID:              schemaID,
@@ -139,6 +257,10 @@ func TestSchemaGenerate(t *testing.T) {
 				},
 			},
 
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
 			expect: &services.Schema{
 				ID:              schemaID,
 				ProjectID:       projectID,
@@ -149,25 +271,27 @@ func TestSchemaGenerate(t *testing.T) {
 				Source:          "AI",
 				Data:            map[string]any{"title": "Generated Title"},
 				CreatedAt:       baseTime,
+				Generation:      testSchemaGeneration,
 			},
 		},
 		{
-			name: "Success/WithPreversion",
+			name: "Success/Stream",
 
 			request: &services.SchemaGenerateRequest{
 				ProjectID: projectID,
 				UserID:    ownerID,
-				Module:    "test-namespace:test-module@v1.0.0-beta-1",
-				Lang:      config.LangFR,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
 			},
+			stream: true,
 
 			projectSelectMock: &projectSelectMock{
 				resp: &dao.Project{
 					ID:        projectID,
 					Owner:     ownerID,
-					Lang:      config.LangFR,
+					Lang:      config.LangEN,
 					Title:     "Test Project",
-					Workflow:  []string{"test-namespace:test-module@v1.0.0-beta-1"},
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
 					CreatedAt: baseTime,
 					UpdatedAt: baseTime,
 				},
@@ -175,12 +299,11 @@ func TestSchemaGenerate(t *testing.T) {
 
 			moduleSelectMock: &moduleSelectMock{
 				resp: &dao.Module{
-					ID:         "test-module",
-					Namespace:  "test-namespace",
-					Version:    "1.0.0",
-					Preversion: "-beta-1",
-					Schema:     testModuleSchema,
-					CreatedAt:  baseTime,
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
 				},
 			},
 
@@ -189,45 +312,52 @@ func TestSchemaGenerate(t *testing.T) {
 			},
 
 			schemaGenerateMock: &schemaGenerateMock{
-				resp: map[string]any{"title": "Beta Generated"},
+				resp:   map[string]any{"title": "Generated Title"},
+				deltas: []string{`{"title":`, `"Generated `, `Title"}`},
 			},
 
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
 			schemaInsertMock: &schemaInsertMock{
 				resp: &dao.Schema{
-					ID:               schemaID,
-					ProjectID:        projectID,
-					Owner:            &ownerID,
-					ModuleID:         "test-module",
-					ModuleNamespace:  "test-namespace",
-					ModuleVersion:    "1.0.0",
-					ModulePreversion: "-beta-1",
-					Source:           dao.SchemaSourceAI,
-					Data:             map[string]any{"title": "Beta Generated"},
-					CreatedAt:        baseTime,
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"title": "Generated Title"},
+					CreatedAt:       baseTime,
 				},
 			},
 
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
 			expect: &services.Schema{
-				ID:               schemaID,
-				ProjectID:        projectID,
-				Owner:            &ownerID,
-				ModuleID:         "test-module",
-				ModuleNamespace:  "test-namespace",
-				ModuleVersion:    "1.0.0",
-				ModulePreversion: "-beta-1",
-				Source:           "AI",
-				Data:             map[string]any{"title": "Beta Generated"},
-				CreatedAt:        baseTime,
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "AI",
+				Data:            map[string]any{"title": "Generated Title"},
+				CreatedAt:       baseTime,
+				Generation:      testSchemaGeneration,
 			},
 		},
 		{
-			name: "Success/WithContext",
+			name: "Success/Instructions",
 
 			request: &services.SchemaGenerateRequest{
-				ProjectID: projectID,
-				UserID:    ownerID,
-				Module:    "test-namespace:test-module@v1.0.0",
-				Lang:      config.LangEN,
+				ProjectID:    projectID,
+				UserID:       ownerID,
+				Module:       "test-namespace:test-module@v1.0.0",
+				Lang:         config.LangEN,
+				Instructions: "Make the title sound like a pulp novel.",
 			},
 
 			projectSelectMock: &projectSelectMock{
@@ -236,7 +366,7 @@ func TestSchemaGenerate(t *testing.T) {
 					Owner:     ownerID,
 					Lang:      config.LangEN,
 					Title:     "Test Project",
-					Workflow:  []string{"test-namespace:test-module@v1.0.0", "other-namespace:other-module@v1.0.0"},
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
 					CreatedAt: baseTime,
 					UpdatedAt: baseTime,
 				},
@@ -253,25 +383,15 @@ func TestSchemaGenerate(t *testing.T) {
 			},
 
 			schemaListMock: &schemaListMock{
-				resp: []*dao.Schema{
-					{
-						ID:              otherSchemaID,
-						ProjectID:       projectID,
-						Owner:           &ownerID,
-						ModuleID:        "other-module",
-						ModuleNamespace: "other-namespace",
-						ModuleVersion:   "2.0.0",
-						Source:          dao.SchemaSourceUser,
-						Data:            map[string]any{"content": "Other content"},
-						CreatedAt:       baseTime,
-					},
-				},
+				resp: []*dao.Schema{},
 			},
 
 			schemaGenerateMock: &schemaGenerateMock{
-				resp: map[string]any{"title": "Generated with Context"},
+				resp: map[string]any{"title": "The Terror of the Lighthouse!"},
 			},
 
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
 			schemaInsertMock: &schemaInsertMock{
 				resp: &dao.Schema{
 					ID:              schemaID,
@@ -281,11 +401,16 @@ func TestSchemaGenerate(t *testing.T) {
 					ModuleNamespace: "test-namespace",
 					ModuleVersion:   "1.0.0",
 					Source:          dao.SchemaSourceAI,
-					Data:            map[string]any{"title": "Generated with Context"},
+					Instructions:    "Make the title sound like a pulp novel.",
+					Data:            map[string]any{"title": "The Terror of the Lighthouse!"},
 					CreatedAt:       baseTime,
 				},
 			},
 
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
 			expect: &services.Schema{
 				ID:              schemaID,
 				ProjectID:       projectID,
@@ -294,104 +419,134 @@ func TestSchemaGenerate(t *testing.T) {
 				ModuleNamespace: "test-namespace",
 				ModuleVersion:   "1.0.0",
 				Source:          "AI",
-				Data:            map[string]any{"title": "Generated with Context"},
+				Instructions:    "Make the title sound like a pulp novel.",
+				Data:            map[string]any{"title": "The Terror of the Lighthouse!"},
 				CreatedAt:       baseTime,
+				Generation:      testSchemaGeneration,
 			},
 		},
 		{
-			name: "Error/InvalidRequest/MissingProjectID",
-
-			request: &services.SchemaGenerateRequest{
-				UserID: ownerID,
-				Module: "test-namespace:test-module@v1.0.0",
-				Lang:   config.LangEN,
-			},
-
-			expectErr: services.ErrInvalidRequest,
-		},
-		{
-			name: "Error/InvalidRequest/MissingUserID",
+			name: "Success/Paths",
 
 			request: &services.SchemaGenerateRequest{
 				ProjectID: projectID,
+				UserID:    ownerID,
 				Module:    "test-namespace:test-module@v1.0.0",
 				Lang:      config.LangEN,
+				Paths:     []string{"/exploration/what_if"},
 			},
 
-			expectErr: services.ErrInvalidRequest,
-		},
-		{
-			name: "Error/InvalidRequest/MissingModule",
-
-			request: &services.SchemaGenerateRequest{
-				ProjectID: projectID,
-				UserID:    ownerID,
-				Module:    "",
-				Lang:      config.LangEN,
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
 			},
 
-			expectErr: services.ErrInvalidRequest,
-		},
-		{
-			name: "Error/InvalidRequest/InvalidModuleFormat",
-
-			request: &services.SchemaGenerateRequest{
-				ProjectID: projectID,
-				UserID:    ownerID,
-				Module:    "invalid-module-format",
-				Lang:      config.LangEN,
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testNestedModuleSchema,
+					CreatedAt: baseTime,
+				},
 			},
 
-			expectErr: services.ErrInvalidRequest,
-		},
-		{
-			name: "Error/InvalidRequest/MissingLang",
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{
+					{
+						ID:              otherSchemaID,
+						ProjectID:       projectID,
+						ModuleID:        "test-module",
+						ModuleNamespace: "test-namespace",
+						ModuleVersion:   "1.0.0",
+						Source:          dao.SchemaSourceUser,
+						Data:            testNestedData,
+						CreatedAt:       baseTime,
+					},
+				},
+			},
 
-			request: &services.SchemaGenerateRequest{
-				ProjectID: projectID,
-				UserID:    ownerID,
-				Module:    "test-namespace:test-module@v1.0.0",
-				Lang:      "",
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{
+					"exploration": map[string]any{"what_if": "What if the keeper was a ghost?"},
+				},
 			},
 
-			expectErr: services.ErrInvalidRequest,
-		},
-		{
-			name: "Error/InvalidRequest/InvalidLang",
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
 
-			request: &services.SchemaGenerateRequest{
-				ProjectID: projectID,
-				UserID:    ownerID,
-				Module:    "test-namespace:test-module@v1.0.0",
-				Lang:      "invalid",
+			expectPrefilled: map[string]any{
+				"title":       "The Lighthouse",
+				"exploration": map[string]any{"themes": "solitude"},
+			},
+			expectProperties:   []string{"exploration"},
+			expectBaseSchemaID: &otherSchemaID,
+			expectData: map[string]any{
+				"title": "The Lighthouse",
+				"exploration": map[string]any{
+					"what_if": "What if the keeper was a ghost?",
+					"themes":  "solitude",
+				},
 			},
 
-			expectErr: services.ErrInvalidRequest,
-		},
-		{
-			name: "Error/ProjectSelect",
-
-			request: &services.SchemaGenerateRequest{
-				ProjectID: projectID,
-				UserID:    ownerID,
-				Module:    "test-namespace:test-module@v1.0.0",
-				Lang:      config.LangEN,
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data: map[string]any{
+						"title": "The Lighthouse",
+						"exploration": map[string]any{
+							"what_if": "What if the keeper was a ghost?",
+							"themes":  "solitude",
+						},
+					},
+					CreatedAt: baseTime,
+				},
 			},
 
-			projectSelectMock: &projectSelectMock{
-				err: errFoo,
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
 			},
 
-			expectErr: errFoo,
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "AI",
+				Data: map[string]any{
+					"title": "The Lighthouse",
+					"exploration": map[string]any{
+						"what_if": "What if the keeper was a ghost?",
+						"themes":  "solitude",
+					},
+				},
+				CreatedAt:  baseTime,
+				Generation: testSchemaGeneration,
+			},
 		},
 		{
-			name: "Error/ProjectOwnership",
+			name: "Error/Paths/NoSchema",
 
 			request: &services.SchemaGenerateRequest{
 				ProjectID: projectID,
-				UserID:    otherUserID,
+				UserID:    ownerID,
 				Module:    "test-namespace:test-module@v1.0.0",
 				Lang:      config.LangEN,
+				Paths:     []string{"/exploration/what_if"},
 			},
 
 			projectSelectMock: &projectSelectMock{
@@ -406,38 +561,873 @@ func TestSchemaGenerate(t *testing.T) {
 				},
 			},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
-		},
-		{
-			name: "Error/ModuleNotInProject",
-
-			request: &services.SchemaGenerateRequest{
-				ProjectID: projectID,
-				UserID:    ownerID,
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testNestedModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			expectErr: services.ErrNoSchemaToRegenerate,
+		},
+		{
+			name: "Error/Paths/UnknownPath",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Paths:     []string{"/exploration/unknown"},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testNestedModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/Paths",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+				Paths:     []string{"exploration"},
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Success/WithPreversion",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0-beta-1",
+				Lang:      config.LangFR,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangFR,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0-beta-1"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:         "test-module",
+					Namespace:  "test-namespace",
+					Version:    "1.0.0",
+					Preversion: "-beta-1",
+					Schema:     testModuleSchema,
+					CreatedAt:  baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{"title": "Beta Generated"},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:               schemaID,
+					ProjectID:        projectID,
+					Owner:            &ownerID,
+					ModuleID:         "test-module",
+					ModuleNamespace:  "test-namespace",
+					ModuleVersion:    "1.0.0",
+					ModulePreversion: "-beta-1",
+					Source:           dao.SchemaSourceAI,
+					Data:             map[string]any{"title": "Beta Generated"},
+					CreatedAt:        baseTime,
+				},
+			},
+
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
+			expect: &services.Schema{
+				ID:               schemaID,
+				ProjectID:        projectID,
+				Owner:            &ownerID,
+				ModuleID:         "test-module",
+				ModuleNamespace:  "test-namespace",
+				ModuleVersion:    "1.0.0",
+				ModulePreversion: "-beta-1",
+				Source:           "AI",
+				Data:             map[string]any{"title": "Beta Generated"},
+				CreatedAt:        baseTime,
+				Generation:       testSchemaGeneration,
+			},
+		},
+		{
+			name: "Success/WithContext",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0", "other-namespace:other-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{
+					{
+						ID:              otherSchemaID,
+						ProjectID:       projectID,
+						Owner:           &ownerID,
+						ModuleID:        "other-module",
+						ModuleNamespace: "other-namespace",
+						ModuleVersion:   "2.0.0",
+						Source:          dao.SchemaSourceUser,
+						Data:            map[string]any{"content": "Other content"},
+						CreatedAt:       baseTime,
+					},
+				},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{"title": "Generated with Context"},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"title": "Generated with Context"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "AI",
+				Data:            map[string]any{"title": "Generated with Context"},
+				CreatedAt:       baseTime,
+				Generation:      testSchemaGeneration,
+			},
+		},
+		{
+			name: "Success/WithDependencies",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:    projectID,
+					Owner: ownerID,
+					Lang:  config.LangEN,
+					Title: "Test Project",
+					Workflow: []string{
+						"other-namespace:other-module@v2.0.0",
+						"other-namespace:third-module@v1.0.0",
+						"test-namespace:test-module@v1.0.0",
+					},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					DependsOn: []models.ModuleDependency{
+						{Module: "other-namespace:missing-module"},
+						{Module: "other-namespace:other-module", Paths: []string{"/intent/promise"}},
+					},
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{
+					{
+						ID:              otherSchemaID,
+						ProjectID:       projectID,
+						Owner:           &ownerID,
+						ModuleID:        "other-module",
+						ModuleNamespace: "other-namespace",
+						ModuleVersion:   "2.0.0",
+						Source:          dao.SchemaSourceUser,
+						Data: map[string]any{
+							"intent": map[string]any{"promise": "Space opera", "tone": "dark"},
+							"notes":  "Unrelated notes",
+						},
+						CreatedAt: baseTime,
+					},
+					{
+						ID:              thirdSchemaID,
+						ProjectID:       projectID,
+						Owner:           &ownerID,
+						ModuleID:        "third-module",
+						ModuleNamespace: "other-namespace",
+						ModuleVersion:   "1.0.0",
+						Source:          dao.SchemaSourceUser,
+						Data:            map[string]any{"content": "Unrelated content"},
+						CreatedAt:       baseTime,
+					},
+				},
+			},
+
+			expectContext: []*dao.Schema{
+				{
+					ID:              otherSchemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "other-module",
+					ModuleNamespace: "other-namespace",
+					ModuleVersion:   "2.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"intent": map[string]any{"promise": "Space opera"}},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{"title": "Generated with Dependencies"},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"title": "Generated with Dependencies"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "AI",
+				Data:            map[string]any{"title": "Generated with Dependencies"},
+				CreatedAt:       baseTime,
+				Generation:      testSchemaGeneration,
+			},
+		},
+		{
+			name: "Success/VersionRange",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@~1.1",
+				Lang:      config.LangEN,
+			},
+			resolvedModule: "test-namespace:test-module@v1.1.4",
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@~1.1"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{{Version: "1.2.0"}, {Version: "1.1.4"}, {Version: "1.1.0"}},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.1.4",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{"title": "Generated Title"},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.1.4",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"title": "Generated Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.1.4",
+				Source:          "AI",
+				Data:            map[string]any{"title": "Generated Title"},
+				CreatedAt:       baseTime,
+				Generation:      testSchemaGeneration,
+			},
+		},
+		{
+			name: "Error/InvalidRequest/MissingProjectID",
+
+			request: &services.SchemaGenerateRequest{
+				UserID: ownerID,
+				Module: "test-namespace:test-module@v1.0.0",
+				Lang:   config.LangEN,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InstructionsTooLong",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID:    projectID,
+				UserID:       ownerID,
+				Module:       "test-namespace:test-module@v1.0.0",
+				Lang:         config.LangEN,
+				Instructions: strings.Repeat("a", 2049),
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/MissingUserID",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/MissingModule",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "",
+				Lang:      config.LangEN,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidModuleFormat",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "invalid-module-format",
+				Lang:      config.LangEN,
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/MissingLang",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      "",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/InvalidRequest/InvalidLang",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      "invalid",
+			},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/ProjectSelect",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/ProjectOwnership",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    otherUserID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleEditor},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ModuleNotInProject",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"other-namespace:other-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			expectErr: services.ErrModuleNotInProject,
+		},
+		{
+			name: "Error/VersionRangeNotSatisfied",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@~1.1",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@~1.1"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleListVersionsMock: &moduleListVersionsMock{
+				resp: []*dao.ModuleVersion{{Version: "1.2.0"}},
+			},
+
+			expectErr: services.ErrModuleRangeNotSatisfied,
+		},
+		{
+			name: "Error/ModuleSelect",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaList",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaGenerate",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Success/WithinQuota",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
+				Module:    "test-namespace:test-module@v1.0.0",
+				Lang:      config.LangEN,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			tokenUsageListMock: &tokenUsageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 600, CompletionTokens: 200},
+				},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{"title": "Generated Title"},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"title": "Generated Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
+				resp: testGenerationRecord,
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "AI",
+				Data:            map[string]any{"title": "Generated Title"},
+				CreatedAt:       baseTime,
+				Generation:      testSchemaGeneration,
+			},
+		},
+		{
+			name: "Error/QuotaExceeded",
+
+			request: &services.SchemaGenerateRequest{
+				ProjectID: projectID,
+				UserID:    ownerID,
+				Roles:     []string{"user"},
 				Module:    "test-namespace:test-module@v1.0.0",
 				Lang:      config.LangEN,
 			},
 
-			projectSelectMock: &projectSelectMock{
-				resp: &dao.Project{
-					ID:        projectID,
-					Owner:     ownerID,
-					Lang:      config.LangEN,
-					Title:     "Test Project",
-					Workflow:  []string{"other-namespace:other-module@v1.0.0"},
-					CreatedAt: baseTime,
-					UpdatedAt: baseTime,
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-namespace:test-module@v1.0.0"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			tokenUsageListMock: &tokenUsageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 800, CompletionTokens: 200},
 				},
 			},
 
-			expectErr: services.ErrModuleNotInProject,
+			expectErr: services.ErrUsageQuotaExceeded,
 		},
 		{
-			name: "Error/ModuleSelect",
+			name: "Error/TokenUsageList",
 
 			request: &services.SchemaGenerateRequest{
 				ProjectID: projectID,
 				UserID:    ownerID,
+				Roles:     []string{"user"},
 				Module:    "test-namespace:test-module@v1.0.0",
 				Lang:      config.LangEN,
 			},
@@ -455,17 +1445,32 @@ func TestSchemaGenerate(t *testing.T) {
 			},
 
 			moduleSelectMock: &moduleSelectMock{
+				resp: &dao.Module{
+					ID:        "test-module",
+					Namespace: "test-namespace",
+					Version:   "1.0.0",
+					Schema:    testModuleSchema,
+					CreatedAt: baseTime,
+				},
+			},
+
+			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			tokenUsageListMock: &tokenUsageListMock{
 				err: errFoo,
 			},
 
 			expectErr: errFoo,
 		},
 		{
-			name: "Error/SchemaList",
+			name: "Error/TokenUsageInsert",
 
 			request: &services.SchemaGenerateRequest{
 				ProjectID: projectID,
 				UserID:    ownerID,
+				Roles:     []string{"user"},
 				Module:    "test-namespace:test-module@v1.0.0",
 				Lang:      config.LangEN,
 			},
@@ -493,13 +1498,25 @@ func TestSchemaGenerate(t *testing.T) {
 			},
 
 			schemaListMock: &schemaListMock{
+				resp: []*dao.Schema{},
+			},
+
+			tokenUsageListMock: &tokenUsageListMock{
+				resp: []*dao.TokenUsageProject{},
+			},
+
+			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{"title": "Generated Title"},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{
 				err: errFoo,
 			},
 
 			expectErr: errFoo,
 		},
 		{
-			name: "Error/SchemaGenerate",
+			name: "Error/SchemaInsert",
 
 			request: &services.SchemaGenerateRequest{
 				ProjectID: projectID,
@@ -535,13 +1552,19 @@ func TestSchemaGenerate(t *testing.T) {
 			},
 
 			schemaGenerateMock: &schemaGenerateMock{
+				resp: map[string]any{"title": "Generated Title"},
+			},
+
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
+			schemaInsertMock: &schemaInsertMock{
 				err: errFoo,
 			},
 
 			expectErr: errFoo,
 		},
 		{
-			name: "Error/SchemaInsert",
+			name: "Error/SchemaGenerationInsert",
 
 			request: &services.SchemaGenerateRequest{
 				ProjectID: projectID,
@@ -580,7 +1603,23 @@ func TestSchemaGenerate(t *testing.T) {
 				resp: map[string]any{"title": "Generated Title"},
 			},
 
+			tokenUsageInsertMock: &tokenUsageInsertMock{},
+
 			schemaInsertMock: &schemaInsertMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceAI,
+					Data:            map[string]any{"title": "Generated Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			schemaGenerationInsertMock: &schemaGenerationInsertMock{
 				err: errFoo,
 			},
 
@@ -596,10 +1635,34 @@ func TestSchemaGenerate(t *testing.T) {
 				t.Helper()
 
 				schemaGenerateRepository := servicesmocks.NewMockSchemaGenerateRepository(t)
+				tokenUsageListRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageList(t)
+				tokenUsageInsertRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageInsert(t)
 				schemaListRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaList(t)
 				schemaInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaInsert(t)
+				schemaGenerationInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaGenerationInsert(t)
 				projectSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectSelect(t)
 				moduleSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleSelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleListVersions(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectMemberSelect(t)
+
+				resolvedModule := testCase.resolvedModule
+				if resolvedModule == "" {
+					resolvedModule = testCase.request.Module
+				}
+
+				var (
+					deltas []string
+					// generationContext is the context sent to the model.
+					generationContext []*dao.Schema
+				)
+
+				request := testCase.request
+				if testCase.stream {
+					request = lo.ToPtr(*testCase.request)
+					request.OnDelta = func(delta string) {
+						deltas = append(deltas, delta)
+					}
+				}
 
 				if testCase.projectSelectMock != nil {
 					projectSelectRepository.EXPECT().
@@ -609,8 +1672,27 @@ func TestSchemaGenerate(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
-				if testCase.moduleSelectMock != nil {
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.moduleListVersionsMock != nil {
 					decodedModule := lib.DecodeModule(testCase.request.Module)
+					moduleListVersionsRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleListVersionsRequest{
+							ID:        decodedModule.Module,
+							Namespace: decodedModule.Namespace,
+						}).
+						Return(testCase.moduleListVersionsMock.resp, testCase.moduleListVersionsMock.err)
+				}
+
+				if testCase.moduleSelectMock != nil {
+					decodedModule := lib.DecodeModule(resolvedModule)
 					moduleSelectRepository.EXPECT().
 						Exec(mock.Anything, &dao.ModuleSelectRequest{
 							ID:         decodedModule.Module,
@@ -630,6 +1712,13 @@ func TestSchemaGenerate(t *testing.T) {
 				}
 
 				if testCase.schemaGenerateMock != nil {
+					var generateResult *dao.ModuleGeneration
+
+					if testCase.schemaGenerateMock.resp != nil {
+						generateResult = lo.ToPtr(*testGeneration)
+						generateResult.Data = testCase.schemaGenerateMock.resp
+					}
+
 					decodedModule := lib.DecodeModule(testCase.request.Module)
 					schemaGenerateRepository.EXPECT().
 						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ModuleGenerateRequest) bool {
@@ -638,15 +1727,55 @@ func TestSchemaGenerate(t *testing.T) {
 								req.Module.Version == testCase.moduleSelectMock.resp.Version &&
 								req.Module.Preversion == testCase.moduleSelectMock.resp.Preversion &&
 								req.Lang == testCase.request.Lang &&
+								req.Instructions == testCase.request.Instructions &&
+								(req.OnDelta != nil) == testCase.stream &&
 								// Verify that the context excludes the module being generated.
 								!lo.ContainsBy(req.Context.([]*dao.Schema), func(s *dao.Schema) bool {
 									return s.ModuleNamespace == decodedModule.Namespace && s.ModuleID == decodedModule.Module
-								})
+								}) &&
+								(testCase.expectContext == nil || assert.Equal(t, testCase.expectContext, req.Context)) &&
+								(testCase.expectPrefilled == nil ||
+									assert.Equal(t, testCase.expectPrefilled, req.Prefilled)) &&
+								(testCase.expectProperties == nil ||
+									assert.ElementsMatch(t, testCase.expectProperties, lo.Keys(req.Module.Schema.Properties)))
+						})).
+						Run(func(_ context.Context, req *dao.ModuleGenerateRequest) {
+							generationContext = req.Context.([]*dao.Schema)
+
+							for _, delta := range testCase.schemaGenerateMock.deltas {
+								req.OnDelta(delta)
+							}
+						}).
+						Return(generateResult, testCase.schemaGenerateMock.err)
+				}
+
+				if testCase.tokenUsageListMock != nil {
+					tokenUsageListRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageListRequest) bool {
+							return req.UserID == testCase.request.UserID && time.Since(req.Since) < 32*24*time.Hour
+						})).
+						Return(testCase.tokenUsageListMock.resp, testCase.tokenUsageListMock.err)
+				}
+
+				if testCase.tokenUsageInsertMock != nil {
+					tokenUsageInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageInsertRequest) bool {
+							return req.UserID == testCase.request.UserID &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Model == testGeneration.Model &&
+								req.PromptTokens == testGeneration.Usage.PromptTokens &&
+								req.CompletionTokens == testGeneration.Usage.CompletionTokens &&
+								time.Since(req.Now) < time.Minute
 						})).
-						Return(testCase.schemaGenerateMock.resp, testCase.schemaGenerateMock.err)
+						Return(nil, testCase.tokenUsageInsertMock.err)
 				}
 
 				if testCase.schemaInsertMock != nil {
+					expectData := testCase.expectData
+					if expectData == nil {
+						expectData = testCase.schemaGenerateMock.resp
+					}
+
 					schemaInsertRepository.EXPECT().
 						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
 							return req.ProjectID == testCase.request.ProjectID &&
@@ -656,29 +1785,560 @@ func TestSchemaGenerate(t *testing.T) {
 								req.ModuleVersion == testCase.moduleSelectMock.resp.Version &&
 								req.ModulePreversion == testCase.moduleSelectMock.resp.Preversion &&
 								req.Source == dao.SchemaSourceAI &&
-								assert.Equal(t, req.Data, testCase.schemaGenerateMock.resp) &&
+								req.Instructions == testCase.request.Instructions &&
+								assert.Equal(t, expectData, req.Data) &&
 								time.Since(req.Now) < time.Minute
 						})).
 						Return(testCase.schemaInsertMock.resp, testCase.schemaInsertMock.err)
 				}
 
+				if testCase.schemaGenerationInsertMock != nil {
+					schemaGenerationInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaGenerationInsertRequest) bool {
+							contextSchemaIDs := lo.Map(generationContext, func(item *dao.Schema, _ int) uuid.UUID {
+								return item.ID
+							})
+
+							return req.SchemaID == testCase.schemaInsertMock.resp.ID &&
+								req.ProjectID == testCase.request.ProjectID &&
+								req.Model == testGeneration.Model &&
+								assert.Equal(t, []dao.SchemaGenerationMessage{{Role: "user", Content: "Generate."}}, req.Messages) &&
+								req.TemplateVersion == testGeneration.TemplateVersion &&
+								req.PromptTokens == testGeneration.Usage.PromptTokens &&
+								req.CompletionTokens == testGeneration.Usage.CompletionTokens &&
+								req.Latency == testGeneration.Latency &&
+								assert.Equal(t, contextSchemaIDs, req.ContextSchemaIDs) &&
+								assert.Equal(t, testCase.expectBaseSchemaID, req.BaseSchemaID) &&
+								time.Since(req.Now) < time.Minute
+						})).
+						Return(testCase.schemaGenerationInsertMock.resp, testCase.schemaGenerationInsertMock.err)
+				}
+
 				service := services.NewSchemaGenerate(
 					schemaGenerateRepository,
 					schemaListRepository,
 					schemaInsertRepository,
+					schemaGenerationInsertRepository,
 					projectSelectRepository,
 					moduleSelectRepository,
+					moduleListVersionsRepository,
+					tokenUsageListRepository,
+					tokenUsageInsertRepository,
+					projectMemberSelectRepository,
+					testUsageQuotas,
+					config.GenerationContextBudgets{},
 				)
 
-				resp, err := service.Exec(ctx, testCase.request)
+				resp, err := service.Exec(ctx, request)
 				require.ErrorIs(t, err, testCase.expectErr)
 				require.Equal(t, testCase.expect, resp)
 
+				if testCase.stream {
+					require.Equal(t, testCase.schemaGenerateMock.deltas, deltas)
+				}
+
 				schemaGenerateRepository.AssertExpectations(t)
 				schemaListRepository.AssertExpectations(t)
 				schemaInsertRepository.AssertExpectations(t)
+				schemaGenerationInsertRepository.AssertExpectations(t)
 				projectSelectRepository.AssertExpectations(t)
 				moduleSelectRepository.AssertExpectations(t)
+				moduleListVersionsRepository.AssertExpectations(t)
+				tokenUsageListRepository.AssertExpectations(t)
+				tokenUsageInsertRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
+
+func TestSchemaGenerate_Corrections(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// The pattern is not enforced by the model, and the optional subtitle is generated as null when empty.
+	testModuleSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title":    {Type: "string", Pattern: "^[A-Z]"},
+			"subtitle": {Type: "string"},
+		},
+		Required: []string{"title"},
+	}
+
+	testGeneration := func(data map[string]any) *dao.ModuleGeneration {
+		return &dao.ModuleGeneration{
+			Data:            data,
+			Model:           "test-model",
+			Messages:        []lib.CompletionMessage{{Role: lib.CompletionRoleUser, Content: "Generate."}},
+			TemplateVersion: "default:0123456789ab",
+			Usage:           lib.CompletionUsage{PromptTokens: 120, CompletionTokens: 30},
+			Latency:         1500 * time.Millisecond,
+		}
+	}
+
+	testCases := []struct {
+		name string
+
+		// outputs are the successive outputs of the model.
+		outputs []map[string]any
+
+		expectData map[string]any
+		expectErr  error
+	}{
+		{
+			name: "Success/StripNulls",
+
+			outputs: []map[string]any{
+				{"title": "The Lighthouse", "subtitle": nil},
+			},
+
+			expectData: map[string]any{"title": "The Lighthouse"},
+		},
+		{
+			name: "Success/Corrected",
+
+			outputs: []map[string]any{
+				{"title": "the lighthouse", "subtitle": nil},
+				{"title": "The Lighthouse", "subtitle": "A ghost story"},
+			},
+
+			expectData: map[string]any{"title": "The Lighthouse", "subtitle": "A ghost story"},
+		},
+		{
+			name: "Error/TooManyAttempts",
+
+			outputs: []map[string]any{
+				{"title": "the lighthouse", "subtitle": nil},
+				{"title": "the lighthouse", "subtitle": nil},
+				{"title": "the lighthouse", "subtitle": nil},
+			},
+
+			expectErr: services.ErrInvalidGeneration,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				schemaGenerateRepository := servicesmocks.NewMockSchemaGenerateRepository(t)
+				tokenUsageListRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageList(t)
+				tokenUsageInsertRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageInsert(t)
+				schemaListRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaList(t)
+				schemaInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaInsert(t)
+				schemaGenerationInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaGenerationInsert(t)
+				projectSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectMemberSelect(t)
+				moduleSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleSelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleListVersions(t)
+
+				projectSelectRepository.EXPECT().
+					Exec(mock.Anything, &dao.ProjectSelectRequest{ID: projectID}).
+					Return(&dao.Project{
+						ID:       projectID,
+						Owner:    ownerID,
+						Lang:     config.LangEN,
+						Workflow: []string{"test-namespace:test-module@v1.0.0"},
+					}, nil)
+
+				moduleSelectRepository.EXPECT().
+					Exec(mock.Anything, &dao.ModuleSelectRequest{
+						ID:        "test-module",
+						Namespace: "test-namespace",
+						Version:   "1.0.0",
+					}).
+					Return(&dao.Module{
+						ID:        "test-module",
+						Namespace: "test-namespace",
+						Version:   "1.0.0",
+						Schema:    testModuleSchema,
+					}, nil)
+
+				schemaListRepository.EXPECT().
+					Exec(mock.Anything, &dao.SchemaListRequest{ProjectID: projectID}).
+					Return([]*dao.Schema{}, nil)
+
+				for i, output := range testCase.outputs {
+					schemaGenerateRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.ModuleGenerateRequest) bool {
+							// Each attempt is sent the previous outputs, along with their errors.
+							return len(req.Corrections) == i &&
+								lo.EveryBy(req.Corrections, func(item *dao.ModuleGenerateCorrection) bool {
+									return assert.Equal(t, lib.JSONSchemaValidationErrors{
+										{Path: "/title", Message: `pattern: "the lighthouse" does not match regular expression "^[A-Z]"`},
+									}, item.Errors)
+								})
+						})).
+						Return(testGeneration(output), nil).
+						Once()
+				}
+
+				// Every attempt is billed.
+				attempts := int64(len(testCase.outputs))
+
+				tokenUsageInsertRepository.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(req *dao.TokenUsageInsertRequest) bool {
+						return req.UserID == ownerID &&
+							req.PromptTokens == 120*attempts &&
+							req.CompletionTokens == 30*attempts
+					})).
+					Return(nil, nil)
+
+				if testCase.expectErr == nil {
+					schemaInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+							return assert.Equal(t, testCase.expectData, req.Data)
+						})).
+						Return(&dao.Schema{
+							ID:              schemaID,
+							ProjectID:       projectID,
+							Owner:           &ownerID,
+							ModuleID:        "test-module",
+							ModuleNamespace: "test-namespace",
+							ModuleVersion:   "1.0.0",
+							Source:          dao.SchemaSourceAI,
+							Data:            testCase.expectData,
+							CreatedAt:       baseTime,
+						}, nil)
+
+					schemaGenerationInsertRepository.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaGenerationInsertRequest) bool {
+							return req.PromptTokens == 120*attempts &&
+								req.CompletionTokens == 30*attempts &&
+								req.Latency == time.Duration(attempts)*1500*time.Millisecond
+						})).
+						Return(&dao.SchemaGeneration{SchemaID: schemaID, ProjectID: projectID}, nil)
+				}
+
+				service := services.NewSchemaGenerate(
+					schemaGenerateRepository,
+					schemaListRepository,
+					schemaInsertRepository,
+					schemaGenerationInsertRepository,
+					projectSelectRepository,
+					moduleSelectRepository,
+					moduleListVersionsRepository,
+					tokenUsageListRepository,
+					tokenUsageInsertRepository,
+					projectMemberSelectRepository,
+					config.UsageQuotas{},
+					config.GenerationContextBudgets{},
+				)
+
+				resp, err := service.Exec(ctx, &services.SchemaGenerateRequest{
+					ProjectID: projectID,
+					UserID:    ownerID,
+					Module:    "test-namespace:test-module@v1.0.0",
+					Lang:      config.LangEN,
+				})
+				require.ErrorIs(t, err, testCase.expectErr)
+
+				if testCase.expectErr == nil {
+					require.Equal(t, testCase.expectData, resp.Data)
+				}
+
+				schemaGenerateRepository.AssertExpectations(t)
+				schemaInsertRepository.AssertExpectations(t)
+				schemaGenerationInsertRepository.AssertExpectations(t)
+				tokenUsageInsertRepository.AssertExpectations(t)
+			})
+		})
+	}
+}
+
+func TestSchemaGenerate_ClientDisconnect(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+
+	testModuleSchema := jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"title":   {Type: "string"},
+			"summary": {Type: "string"},
+		},
+		Required: []string{"title", "summary"},
+	}
+
+	postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+		t.Helper()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		schemaGenerateRepository := servicesmocks.NewMockSchemaGenerateRepository(t)
+		tokenUsageListRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageList(t)
+		tokenUsageInsertRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageInsert(t)
+		schemaListRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaList(t)
+		schemaInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaInsert(t)
+		schemaGenerationInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaGenerationInsert(t)
+		projectSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectSelect(t)
+		projectMemberSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectMemberSelect(t)
+		moduleSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleSelect(t)
+		moduleListVersionsRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleListVersions(t)
+
+		projectSelectRepository.EXPECT().
+			Exec(mock.Anything, &dao.ProjectSelectRequest{ID: projectID}).
+			Return(&dao.Project{
+				ID:       projectID,
+				Owner:    ownerID,
+				Lang:     config.LangEN,
+				Workflow: []string{"test-namespace:test-module@v1.0.0"},
+			}, nil)
+
+		moduleSelectRepository.EXPECT().
+			Exec(mock.Anything, &dao.ModuleSelectRequest{
+				ID:        "test-module",
+				Namespace: "test-namespace",
+				Version:   "1.0.0",
+			}).
+			Return(&dao.Module{
+				ID:        "test-module",
+				Namespace: "test-namespace",
+				Version:   "1.0.0",
+				Schema:    testModuleSchema,
+			}, nil)
+
+		schemaListRepository.EXPECT().
+			Exec(mock.Anything, &dao.SchemaListRequest{ProjectID: projectID}).
+			Return([]*dao.Schema{}, nil)
+
+		schemaGenerateRepository.EXPECT().
+			Exec(mock.Anything, mock.Anything).
+			RunAndReturn(dao.NewModuleGenerate(lib.NewFakeProvider()).Exec).
+			Once()
+
+		// The tokens streamed before the client disconnected are billed, even though the request is canceled.
+		tokenUsageInsertRepository.EXPECT().
+			Exec(
+				mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil }),
+				mock.MatchedBy(func(req *dao.TokenUsageInsertRequest) bool {
+					return req.UserID == ownerID &&
+						req.ProjectID == projectID &&
+						req.PromptTokens > 0 &&
+						req.CompletionTokens > 0
+				}),
+			).
+			Return(nil, nil)
+
+		service := services.NewSchemaGenerate(
+			schemaGenerateRepository,
+			schemaListRepository,
+			schemaInsertRepository,
+			schemaGenerationInsertRepository,
+			projectSelectRepository,
+			moduleSelectRepository,
+			moduleListVersionsRepository,
+			tokenUsageListRepository,
+			tokenUsageInsertRepository,
+			projectMemberSelectRepository,
+			config.UsageQuotas{},
+			config.GenerationContextBudgets{},
+		)
+
+		_, err := service.Exec(ctx, &services.SchemaGenerateRequest{
+			ProjectID: projectID,
+			UserID:    ownerID,
+			Module:    "test-namespace:test-module@v1.0.0",
+			Lang:      config.LangEN,
+			// Disconnect after the first delta.
+			OnDelta: func(_ string) { cancel() },
+		})
+		require.ErrorIs(t, err, context.Canceled)
+
+		schemaGenerateRepository.AssertExpectations(t)
+		schemaInsertRepository.AssertExpectations(t)
+		schemaGenerationInsertRepository.AssertExpectations(t)
+		tokenUsageInsertRepository.AssertExpectations(t)
+	})
+}
+
+// loadAgoraModule reads a system module of the agora namespace, as published by the init command.
+func loadAgoraModule(t *testing.T, id string) *dao.Module {
+	t.Helper()
+
+	data, err := modules.AgoraModules.ReadFile("agora/" + id + ".yaml")
+	require.NoError(t, err)
+
+	var module modules.SystemModule
+
+	require.NoError(t, yaml.Unmarshal(data, &module))
+
+	return &dao.Module{
+		ID:         module.ID,
+		Namespace:  module.Namespace,
+		Version:    "1.0.0",
+		Schema:     module.Schema,
+		DependsOn:  module.DependsOn,
+		Generation: module.Generation,
+	}
+}
+
+func TestSchemaGenerate_AgoraIdea(t *testing.T) {
+	t.Parallel()
+
+	ownerID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+
+	// The source schema of the module, to check the generated data against.
+	ideaSchema := loadAgoraModule(t, "idea").Schema
+
+	testCases := []struct {
+		name string
+
+		// model builds the repository that generates the data, in a single attempt.
+		model func(t *testing.T) services.SchemaGenerateRepository
+	}{
+		{
+			name: "StructuredOutputs",
+
+			// The model follows the schema it is sent, as structured outputs do. The allowed values must be part of
+			// it for the first output to be valid.
+			model: func(t *testing.T) services.SchemaGenerateRepository {
+				t.Helper()
+
+				repository := servicesmocks.NewMockSchemaGenerateRepository(t)
+
+				repository.EXPECT().
+					Exec(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, req *dao.ModuleGenerateRequest) (*dao.ModuleGeneration, error) {
+						targets := req.Module.Schema.Properties["targets"]
+						require.Equal(t, []any{"FILM", "SERIES", "NOVEL", "GAME", "VN", "COMIC"},
+							targets.Properties["target_medium"].Enum)
+						require.Equal(t, []any{"G", "PG", "PG-13", "R", "16+", "18+"},
+							targets.Properties["age_rating"].Enum)
+
+						data, ok := lib.JSONSchemaExample(&req.Module.Schema).(map[string]any)
+						require.True(t, ok)
+
+						return &dao.ModuleGeneration{
+							Data:  data,
+							Model: "test-model",
+							Usage: lib.CompletionUsage{PromptTokens: 120, CompletionTokens: 30},
+						}, nil
+					}).
+					Once()
+
+				return repository
+			},
+		},
+		{
+			name: "FakeProvider",
+
+			// The fake provider, used when running the service without a model.
+			model: func(t *testing.T) services.SchemaGenerateRepository {
+				t.Helper()
+
+				repository := servicesmocks.NewMockSchemaGenerateRepository(t)
+
+				repository.EXPECT().
+					Exec(mock.Anything, mock.Anything).
+					RunAndReturn(dao.NewModuleGenerate(lib.NewFakeProvider()).Exec).
+					Once()
+
+				return repository
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				tokenUsageListRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageList(t)
+				tokenUsageInsertRepository := servicesmocks.NewMockSchemaGenerateRepositoryTokenUsageInsert(t)
+				schemaListRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaList(t)
+				schemaInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaInsert(t)
+				schemaGenerationInsertRepository := servicesmocks.NewMockSchemaGenerateRepositorySchemaGenerationInsert(t)
+				projectSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryProjectMemberSelect(t)
+				moduleSelectRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleSelect(t)
+				moduleListVersionsRepository := servicesmocks.NewMockSchemaGenerateRepositoryModuleListVersions(t)
+
+				projectSelectRepository.EXPECT().
+					Exec(mock.Anything, &dao.ProjectSelectRequest{ID: projectID}).
+					Return(&dao.Project{
+						ID:       projectID,
+						Owner:    ownerID,
+						Lang:     config.LangEN,
+						Workflow: []string{"agora:idea@v1.0.0"},
+					}, nil)
+
+				moduleSelectRepository.EXPECT().
+					Exec(mock.Anything, &dao.ModuleSelectRequest{ID: "idea", Namespace: "agora", Version: "1.0.0"}).
+					Return(loadAgoraModule(t, "idea"), nil)
+
+				schemaListRepository.EXPECT().
+					Exec(mock.Anything, &dao.SchemaListRequest{ProjectID: projectID}).
+					Return([]*dao.Schema{}, nil)
+
+				tokenUsageInsertRepository.EXPECT().
+					Exec(mock.Anything, mock.Anything).
+					Return(nil, nil)
+
+				schemaInsertRepository.EXPECT().
+					Exec(mock.Anything, mock.MatchedBy(func(req *dao.SchemaInsertRequest) bool {
+						return req.ModuleID == "idea" &&
+							req.Source == dao.SchemaSourceAI &&
+							assert.NoError(t, lib.ValidateJSONSchema(&ideaSchema, req.Data, false))
+					})).
+					RunAndReturn(func(_ context.Context, req *dao.SchemaInsertRequest) (*dao.Schema, error) {
+						return &dao.Schema{
+							ID:              schemaID,
+							ProjectID:       projectID,
+							Owner:           &ownerID,
+							ModuleID:        "idea",
+							ModuleNamespace: "agora",
+							ModuleVersion:   "1.0.0",
+							Source:          dao.SchemaSourceAI,
+							Data:            req.Data,
+						}, nil
+					})
+
+				schemaGenerationInsertRepository.EXPECT().
+					Exec(mock.Anything, mock.Anything).
+					Return(&dao.SchemaGeneration{SchemaID: schemaID, ProjectID: projectID}, nil)
+
+				service := services.NewSchemaGenerate(
+					testCase.model(t),
+					schemaListRepository,
+					schemaInsertRepository,
+					schemaGenerationInsertRepository,
+					projectSelectRepository,
+					moduleSelectRepository,
+					moduleListVersionsRepository,
+					tokenUsageListRepository,
+					tokenUsageInsertRepository,
+					projectMemberSelectRepository,
+					config.UsageQuotas{},
+					config.GenerationContextBudgets{},
+				)
+
+				resp, err := service.Exec(ctx, &services.SchemaGenerateRequest{
+					ProjectID: projectID,
+					UserID:    ownerID,
+					Module:    "agora:idea@v1.0.0",
+					Lang:      config.LangEN,
+				})
+				require.NoError(t, err)
+				require.NoError(t, lib.ValidateJSONSchema(&ideaSchema, resp.Data, false))
+
+				schemaInsertRepository.AssertExpectations(t)
+				schemaGenerationInsertRepository.AssertExpectations(t)
 			})
 		})
 	}
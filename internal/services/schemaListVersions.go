@@ -16,10 +16,18 @@ type SchemaListVersionsRepository interface {
 	Exec(ctx context.Context, request *dao.SchemaListVersionsRequest) ([]*dao.SchemaVersion, error)
 }
 
+type SchemaListVersionsRepositorySchemaGenerationList interface {
+	Exec(ctx context.Context, request *dao.SchemaGenerationListRequest) ([]*dao.SchemaGeneration, error)
+}
+
 type SchemaListVersionsRepositoryProjectSelect interface {
 	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
 }
 
+type SchemaListVersionsRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type SchemaListVersionsRequest struct {
 	ProjectID       uuid.UUID `validate:"required"`
 	UserID          uuid.UUID `validate:"required"`
@@ -30,17 +38,23 @@ type SchemaListVersionsRequest struct {
 }
 
 type SchemaListVersions struct {
-	schemaListVersionsRepository SchemaListVersionsRepository
-	projectSelectRepository      SchemaListVersionsRepositoryProjectSelect
+	schemaListVersionsRepository   SchemaListVersionsRepository
+	schemaGenerationListRepository SchemaListVersionsRepositorySchemaGenerationList
+	projectSelectRepository        SchemaListVersionsRepositoryProjectSelect
+	projectMemberSelectRepository  SchemaListVersionsRepositoryProjectMemberSelect
 }
 
 func NewSchemaListVersions(
 	schemaListVersionsRepository SchemaListVersionsRepository,
+	schemaGenerationListRepository SchemaListVersionsRepositorySchemaGenerationList,
 	projectSelectRepository SchemaListVersionsRepositoryProjectSelect,
+	projectMemberSelectRepository SchemaListVersionsRepositoryProjectMemberSelect,
 ) *SchemaListVersions {
 	return &SchemaListVersions{
-		schemaListVersionsRepository: schemaListVersionsRepository,
-		projectSelectRepository:      projectSelectRepository,
+		schemaListVersionsRepository:   schemaListVersionsRepository,
+		schemaGenerationListRepository: schemaGenerationListRepository,
+		projectSelectRepository:        projectSelectRepository,
+		projectMemberSelectRepository:  projectMemberSelectRepository,
 	}
 }
 
@@ -66,7 +80,9 @@ func (service *SchemaListVersions) Exec(
 		return nil, otel.ReportError(span, err)
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
@@ -86,5 +102,25 @@ func (service *SchemaListVersions) Exec(
 		return nil, otel.ReportError(span, err)
 	}
 
-	return otel.ReportSuccess(span, lo.Map(versions, loadSchemaVersionsMap)), nil
+	// =================================================================================================================
+	// Attach generation records
+	// =================================================================================================================
+
+	generations, err := service.schemaGenerationListRepository.Exec(ctx, &dao.SchemaGenerationListRequest{
+		SchemaIDs: lo.Map(versions, func(item *dao.SchemaVersion, _ int) uuid.UUID { return item.ID }),
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	generationsByID := lo.KeyBy(generations, func(item *dao.SchemaGeneration) uuid.UUID { return item.SchemaID })
+
+	output := lo.Map(versions, loadSchemaVersionsMap)
+	for _, version := range output {
+		if generation, ok := generationsByID[version.ID]; ok {
+			version.Generation = loadSchemaGeneration(generation)
+		}
+	}
+
+	return otel.ReportSuccess(span, output), nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -41,13 +42,25 @@ func TestSchemaListVersions(t *testing.T) {
 		err  error
 	}
 
+	type schemaGenerationListMock struct {
+		resp []*dao.SchemaGeneration
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.SchemaListVersionsRequest
 
-		projectSelectMock      *projectSelectMock
-		schemaListVersionsMock *schemaListVersionsMock
+		projectSelectMock        *projectSelectMock
+		projectMemberSelectMock  *projectMemberSelectMock
+		schemaListVersionsMock   *schemaListVersionsMock
+		schemaGenerationListMock *schemaGenerationListMock
 
 		expect    []*services.SchemaVersion
 		expectErr error
@@ -80,6 +93,10 @@ func TestSchemaListVersions(t *testing.T) {
 				},
 			},
 
+			schemaGenerationListMock: &schemaGenerationListMock{
+				resp: []*dao.SchemaGeneration{},
+			},
+
 			expect: []*services.SchemaVersion{
 				{
 					ID:        schemaID1,
@@ -119,6 +136,22 @@ func TestSchemaListVersions(t *testing.T) {
 				},
 			},
 
+			schemaGenerationListMock: &schemaGenerationListMock{
+				resp: []*dao.SchemaGeneration{
+					{
+						SchemaID:         schemaID2,
+						ProjectID:        projectID,
+						Model:            "test-model",
+						TemplateVersion:  "default:0123456789ab",
+						PromptTokens:     120,
+						CompletionTokens: 30,
+						LatencyMs:        1500,
+						ContextSchemaIDs: []uuid.UUID{schemaID1},
+						CreatedAt:        baseTime.Add(time.Hour),
+					},
+				},
+			},
+
 			expect: []*services.SchemaVersion{
 				{
 					ID:        schemaID1,
@@ -127,6 +160,14 @@ func TestSchemaListVersions(t *testing.T) {
 				{
 					ID:        schemaID2,
 					CreatedAt: baseTime.Add(time.Hour),
+					Generation: &services.SchemaGeneration{
+						Model:            "test-model",
+						TemplateVersion:  "default:0123456789ab",
+						PromptTokens:     120,
+						CompletionTokens: 30,
+						Latency:          1500 * time.Millisecond,
+						ContextSchemaIDs: []uuid.UUID{schemaID1},
+					},
 				},
 			},
 		},
@@ -158,6 +199,10 @@ func TestSchemaListVersions(t *testing.T) {
 				},
 			},
 
+			schemaGenerationListMock: &schemaGenerationListMock{
+				resp: []*dao.SchemaGeneration{},
+			},
+
 			expect: []*services.SchemaVersion{
 				{
 					ID:        schemaID1,
@@ -188,6 +233,10 @@ func TestSchemaListVersions(t *testing.T) {
 				resp: []*dao.SchemaVersion{},
 			},
 
+			schemaGenerationListMock: &schemaGenerationListMock{
+				resp: []*dao.SchemaGeneration{},
+			},
+
 			expect: []*services.SchemaVersion{},
 		},
 		{
@@ -384,8 +433,9 @@ func TestSchemaListVersions(t *testing.T) {
 					Owner: otherUserID,
 				},
 			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
+			expectErr: services.ErrProjectAccessDenied,
 		},
 		{
 			name: "Error/SchemaListVersionsRepositoryError",
@@ -410,6 +460,40 @@ func TestSchemaListVersions(t *testing.T) {
 				err: errFoo,
 			},
 
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaGenerationListRepositoryError",
+
+			request: &services.SchemaListVersionsRequest{
+				ProjectID:       projectID,
+				UserID:          userID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				Limit:           10,
+				Offset:          0,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:    projectID,
+					Owner: userID,
+				},
+			},
+
+			schemaListVersionsMock: &schemaListVersionsMock{
+				resp: []*dao.SchemaVersion{
+					{
+						ID:        schemaID1,
+						CreatedAt: baseTime,
+					},
+				},
+			},
+
+			schemaGenerationListMock: &schemaGenerationListMock{
+				err: errFoo,
+			},
+
 			expectErr: errFoo,
 		},
 	}
@@ -422,7 +506,9 @@ func TestSchemaListVersions(t *testing.T) {
 				t.Helper()
 
 				schemaListVersionsRepository := servicesmocks.NewMockSchemaListVersionsRepository(t)
+				schemaGenerationListRepository := servicesmocks.NewMockSchemaListVersionsRepositorySchemaGenerationList(t)
 				projectSelectRepository := servicesmocks.NewMockSchemaListVersionsRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaListVersionsRepositoryProjectMemberSelect(t)
 
 				if testCase.projectSelectMock != nil {
 					projectSelectRepository.EXPECT().
@@ -432,6 +518,15 @@ func TestSchemaListVersions(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
 				if testCase.schemaListVersionsMock != nil {
 					schemaListVersionsRepository.EXPECT().
 						Exec(mock.Anything, &dao.SchemaListVersionsRequest{
@@ -444,9 +539,22 @@ func TestSchemaListVersions(t *testing.T) {
 						Return(testCase.schemaListVersionsMock.resp, testCase.schemaListVersionsMock.err)
 				}
 
+				if testCase.schemaGenerationListMock != nil {
+					schemaGenerationListRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaGenerationListRequest{
+							SchemaIDs: lo.Map(
+								testCase.schemaListVersionsMock.resp,
+								func(item *dao.SchemaVersion, _ int) uuid.UUID { return item.ID },
+							),
+						}).
+						Return(testCase.schemaGenerationListMock.resp, testCase.schemaGenerationListMock.err)
+				}
+
 				service := services.NewSchemaListVersions(
 					schemaListVersionsRepository,
+					schemaGenerationListRepository,
 					projectSelectRepository,
+					projectMemberSelectRepository,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -454,7 +562,9 @@ func TestSchemaListVersions(t *testing.T) {
 				require.Equal(t, testCase.expect, resp)
 
 				schemaListVersionsRepository.AssertExpectations(t)
+				schemaGenerationListRepository.AssertExpectations(t)
 				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
 			})
 		})
 	}
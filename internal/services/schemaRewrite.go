@@ -10,6 +10,7 @@ import (
 	"github.com/a-novel-kit/golib/otel"
 
 	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
 )
 
 type SchemaRewriteRepository interface {
@@ -24,28 +25,44 @@ type SchemaRewriteRepositorySchemaSelect interface {
 	Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)
 }
 
+type SchemaRewriteRepositoryModuleSelect interface {
+	Exec(ctx context.Context, request *dao.ModuleSelectRequest) (*dao.Module, error)
+}
+
+type SchemaRewriteRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type SchemaRewriteRequest struct {
 	ID     uuid.UUID      `validate:"required"`
 	UserID uuid.UUID      `validate:"required"`
 	Data   map[string]any `validate:"required"`
-	Now    time.Time
+	// Draft only checks the types and enum values of the data, so partially filled schemas can be saved.
+	Draft bool
+	Now   time.Time
 }
 
 type SchemaRewrite struct {
-	schemaRewriteRepository SchemaRewriteRepository
-	projectSelectRepository SchemaRewriteRepositoryProjectSelect
-	schemaSelectRepository  SchemaRewriteRepositorySchemaSelect
+	schemaRewriteRepository       SchemaRewriteRepository
+	projectSelectRepository       SchemaRewriteRepositoryProjectSelect
+	schemaSelectRepository        SchemaRewriteRepositorySchemaSelect
+	moduleSelectRepository        SchemaRewriteRepositoryModuleSelect
+	projectMemberSelectRepository SchemaRewriteRepositoryProjectMemberSelect
 }
 
 func NewSchemaRewrite(
 	schemaRewriteRepository SchemaRewriteRepository,
 	projectSelectRepository SchemaRewriteRepositoryProjectSelect,
 	schemaSelectRepository SchemaRewriteRepositorySchemaSelect,
+	moduleSelectRepository SchemaRewriteRepositoryModuleSelect,
+	projectMemberSelectRepository SchemaRewriteRepositoryProjectMemberSelect,
 ) *SchemaRewrite {
 	return &SchemaRewrite{
-		schemaRewriteRepository: schemaRewriteRepository,
-		projectSelectRepository: projectSelectRepository,
-		schemaSelectRepository:  schemaSelectRepository,
+		schemaRewriteRepository:       schemaRewriteRepository,
+		projectSelectRepository:       projectSelectRepository,
+		schemaSelectRepository:        schemaSelectRepository,
+		moduleSelectRepository:        moduleSelectRepository,
+		projectMemberSelectRepository: projectMemberSelectRepository,
 	}
 }
 
@@ -83,11 +100,32 @@ func (service *SchemaRewrite) Exec(ctx context.Context, request *SchemaRewriteRe
 		return nil, otel.ReportError(span, err)
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleEditor,
+	)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
 
+	// =================================================================================================================
+	// Module validation
+	// =================================================================================================================
+
+	moduleContent, err := service.moduleSelectRepository.Exec(ctx, &dao.ModuleSelectRequest{
+		ID:         currentSchema.ModuleID,
+		Namespace:  currentSchema.ModuleNamespace,
+		Version:    currentSchema.ModuleVersion,
+		Preversion: currentSchema.ModulePreversion,
+	})
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	err = lib.ValidateJSONSchema(&moduleContent.Schema, request.Data, request.Draft)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidData))
+	}
+
 	// =================================================================================================================
 	// Rewrite data.
 	// =================================================================================================================
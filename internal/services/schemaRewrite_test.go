@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -46,14 +48,39 @@ func TestSchemaRewrite(t *testing.T) {
 		err  error
 	}
 
+	type moduleSelectMock struct {
+		resp *dao.Module
+		err  error
+	}
+
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
+	testModule := &dao.Module{
+		ID:        "test-module",
+		Namespace: "test-namespace",
+		Version:   "1.0.0",
+		Schema: jsonschema.Schema{
+			Type: "object",
+			Properties: map[string]*jsonschema.Schema{
+				"title": {Type: "string", MinLength: lo.ToPtr(1)},
+			},
+		},
+		CreatedAt: baseTime,
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.SchemaRewriteRequest
 
-		schemaRewriteMock *schemaRewriteMock
-		projectSelectMock *projectSelectMock
-		schemaSelectMock  *schemaSelectMock
+		schemaRewriteMock       *schemaRewriteMock
+		projectSelectMock       *projectSelectMock
+		projectMemberSelectMock *projectMemberSelectMock
+		schemaSelectMock        *schemaSelectMock
+		moduleSelectMock        *moduleSelectMock
 
 		expect    *services.Schema
 		expectErr error
@@ -94,6 +121,10 @@ func TestSchemaRewrite(t *testing.T) {
 				},
 			},
 
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
 			schemaRewriteMock: &schemaRewriteMock{
 				resp: &dao.Schema{
 					ID:              schemaID,
@@ -157,6 +188,10 @@ func TestSchemaRewrite(t *testing.T) {
 				},
 			},
 
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
 			schemaRewriteMock: &schemaRewriteMock{
 				resp: &dao.Schema{
 					ID:               schemaID,
@@ -292,8 +327,50 @@ func TestSchemaRewrite(t *testing.T) {
 					UpdatedAt: baseTime,
 				},
 			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
+			expectErr: services.ErrProjectAccessDenied,
+		},
+		{
+			name: "Error/ProjectMemberRole",
+
+			request: &services.SchemaRewriteRequest{
+				ID:     schemaID,
+				UserID: otherUserID,
+				Data:   map[string]any{"title": "Updated Title"},
+				Now:    updateTime,
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Original Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleViewer},
+			},
+
+			expectErr: services.ErrProjectAccessDenied,
 		},
 		{
 			name: "Error/SchemaRewrite",
@@ -331,6 +408,10 @@ func TestSchemaRewrite(t *testing.T) {
 				},
 			},
 
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
 			schemaRewriteMock: &schemaRewriteMock{
 				err: errFoo,
 			},
@@ -384,6 +465,10 @@ func TestSchemaRewrite(t *testing.T) {
 				},
 			},
 
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
 			schemaRewriteMock: &schemaRewriteMock{
 				resp: &dao.Schema{
 					ID:              schemaID,
@@ -410,6 +495,242 @@ func TestSchemaRewrite(t *testing.T) {
 				CreatedAt:       baseTime,
 			},
 		},
+		{
+			name: "Success/Draft",
+
+			request: &services.SchemaRewriteRequest{
+				ID:     schemaID,
+				UserID: ownerID,
+				Data:   map[string]any{"title": ""},
+				Draft:  true,
+				Now:    updateTime,
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Original Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
+			schemaRewriteMock: &schemaRewriteMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": ""},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "USER",
+				Data:            map[string]any{"title": ""},
+				CreatedAt:       baseTime,
+			},
+		},
+		{
+			name: "Error/ModuleSelect",
+
+			request: &services.SchemaRewriteRequest{
+				ID:     schemaID,
+				UserID: ownerID,
+				Data:   map[string]any{"title": "Updated Title"},
+				Now:    updateTime,
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Original Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/InvalidData/Type",
+
+			request: &services.SchemaRewriteRequest{
+				ID:     schemaID,
+				UserID: ownerID,
+				Data:   map[string]any{"title": 42},
+				Now:    updateTime,
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Original Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
+			expectErr: services.ErrInvalidData,
+		},
+		{
+			name: "Error/InvalidData/Constraints",
+
+			request: &services.SchemaRewriteRequest{
+				ID:     schemaID,
+				UserID: ownerID,
+				Data:   map[string]any{"title": ""},
+				Now:    updateTime,
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Original Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
+			expectErr: services.ErrInvalidData,
+		},
+		{
+			name: "Error/InvalidData/DraftType",
+
+			request: &services.SchemaRewriteRequest{
+				ID:     schemaID,
+				UserID: ownerID,
+				Data:   map[string]any{"title": 42},
+				Draft:  true,
+				Now:    updateTime,
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Original Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			moduleSelectMock: &moduleSelectMock{
+				resp: testModule,
+			},
+
+			expectErr: services.ErrInvalidData,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -422,6 +743,8 @@ func TestSchemaRewrite(t *testing.T) {
 				schemaRewriteRepository := servicesmocks.NewMockSchemaRewriteRepository(t)
 				projectSelectRepository := servicesmocks.NewMockSchemaRewriteRepositoryProjectSelect(t)
 				schemaSelectRepository := servicesmocks.NewMockSchemaRewriteRepositorySchemaSelect(t)
+				moduleSelectRepository := servicesmocks.NewMockSchemaRewriteRepositoryModuleSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaRewriteRepositoryProjectMemberSelect(t)
 
 				if testCase.schemaSelectMock != nil {
 					schemaSelectRepository.EXPECT().
@@ -440,6 +763,27 @@ func TestSchemaRewrite(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.schemaSelectMock.resp.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
+				if testCase.moduleSelectMock != nil {
+					schemaSelectResp := testCase.schemaSelectMock.resp
+					moduleSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ModuleSelectRequest{
+							ID:         schemaSelectResp.ModuleID,
+							Namespace:  schemaSelectResp.ModuleNamespace,
+							Version:    schemaSelectResp.ModuleVersion,
+							Preversion: schemaSelectResp.ModulePreversion,
+						}).
+						Return(testCase.moduleSelectMock.resp, testCase.moduleSelectMock.err)
+				}
+
 				if testCase.schemaRewriteMock != nil {
 					schemaRewriteRepository.EXPECT().
 						Exec(mock.Anything, &dao.SchemaUpdateRequest{
@@ -454,6 +798,8 @@ func TestSchemaRewrite(t *testing.T) {
 					schemaRewriteRepository,
 					projectSelectRepository,
 					schemaSelectRepository,
+					moduleSelectRepository,
+					projectMemberSelectRepository,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -463,6 +809,8 @@ func TestSchemaRewrite(t *testing.T) {
 				schemaRewriteRepository.AssertExpectations(t)
 				projectSelectRepository.AssertExpectations(t)
 				schemaSelectRepository.AssertExpectations(t)
+				moduleSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
 			})
 		})
 	}
@@ -16,29 +16,43 @@ type SchemaSelectRepository interface {
 	Exec(ctx context.Context, request *dao.SchemaSelectRequest) (*dao.Schema, error)
 }
 
+type SchemaSelectRepositorySchemaGenerationSelect interface {
+	Exec(ctx context.Context, request *dao.SchemaGenerationSelectRequest) (*dao.SchemaGeneration, error)
+}
+
 type SchemaSelectRepositoryProjectSelect interface {
 	Exec(ctx context.Context, request *dao.ProjectSelectRequest) (*dao.Project, error)
 }
 
+type SchemaSelectRepositoryProjectMemberSelect interface {
+	Exec(ctx context.Context, request *dao.ProjectMemberSelectRequest) (*dao.ProjectMember, error)
+}
+
 type SchemaSelectRequest struct {
 	ID        *uuid.UUID `validate:"required_without=ProjectID"`
 	ProjectID uuid.UUID  `validate:"required_without=ID"`
-	Module    string     `validate:"required_without=ID,omitempty,module,max=512"`
+	Module    string     `validate:"required_without=ID,omitempty,workflowModule,max=512"`
 	UserID    uuid.UUID  `validate:"required"`
 }
 
 type SchemaSelect struct {
-	schemaSelectRepository  SchemaSelectRepository
-	projectSelectRepository SchemaSelectRepositoryProjectSelect
+	schemaSelectRepository           SchemaSelectRepository
+	schemaGenerationSelectRepository SchemaSelectRepositorySchemaGenerationSelect
+	projectSelectRepository          SchemaSelectRepositoryProjectSelect
+	projectMemberSelectRepository    SchemaSelectRepositoryProjectMemberSelect
 }
 
 func NewSchemaSelect(
 	schemaSelectRepository SchemaSelectRepository,
+	schemaGenerationSelectRepository SchemaSelectRepositorySchemaGenerationSelect,
 	projectSelectRepository SchemaSelectRepositoryProjectSelect,
+	projectMemberSelectRepository SchemaSelectRepositoryProjectMemberSelect,
 ) *SchemaSelect {
 	return &SchemaSelect{
-		schemaSelectRepository:  schemaSelectRepository,
-		projectSelectRepository: projectSelectRepository,
+		schemaSelectRepository:           schemaSelectRepository,
+		schemaGenerationSelectRepository: schemaGenerationSelectRepository,
+		projectSelectRepository:          projectSelectRepository,
+		projectMemberSelectRepository:    projectMemberSelectRepository,
 	}
 }
 
@@ -62,7 +76,9 @@ func (service *SchemaSelect) Exec(ctx context.Context, request *SchemaSelectRequ
 		return nil, otel.ReportError(span, err)
 	}
 
-	err = VerifyProjectOwnership(project, request.UserID)
+	err = VerifyProjectAccess(
+		ctx, service.projectMemberSelectRepository, project, request.UserID, dao.ProjectMemberRoleViewer,
+	)
 	if err != nil {
 		return nil, otel.ReportError(span, err)
 	}
@@ -87,5 +103,29 @@ func (service *SchemaSelect) Exec(ctx context.Context, request *SchemaSelectRequ
 		return nil, otel.ReportError(span, err)
 	}
 
-	return otel.ReportSuccess(span, loadSchema(schema)), nil
+	output := loadSchema(schema)
+
+	// =================================================================================================================
+	// Fetch generation record
+	// =================================================================================================================
+
+	if schema.Source != dao.SchemaSourceAI {
+		return otel.ReportSuccess(span, output), nil
+	}
+
+	generation, err := service.schemaGenerationSelectRepository.Exec(ctx, &dao.SchemaGenerationSelectRequest{
+		SchemaID: schema.ID,
+	})
+	// Schemas generated before records were kept have none.
+	if errors.Is(err, dao.ErrSchemaGenerationSelectNotFound) {
+		return otel.ReportSuccess(span, output), nil
+	}
+
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	output.Generation = loadSchemaGeneration(generation)
+
+	return otel.ReportSuccess(span, output), nil
 }
@@ -28,6 +28,7 @@ func TestSchemaSelect(t *testing.T) {
 	otherUserID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
 	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
 	schemaID := uuid.MustParse("00000000-0000-0000-0000-000000000200")
+	otherSchemaID := uuid.MustParse("00000000-0000-0000-0000-000000000201")
 
 	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
 
@@ -36,18 +37,30 @@ func TestSchemaSelect(t *testing.T) {
 		err  error
 	}
 
+	type schemaGenerationSelectMock struct {
+		resp *dao.SchemaGeneration
+		err  error
+	}
+
 	type projectSelectMock struct {
 		resp *dao.Project
 		err  error
 	}
 
+	type projectMemberSelectMock struct {
+		resp *dao.ProjectMember
+		err  error
+	}
+
 	testCases := []struct {
 		name string
 
 		request *services.SchemaSelectRequest
 
-		schemaSelectMock  *schemaSelectMock
-		projectSelectMock *projectSelectMock
+		schemaSelectMock           *schemaSelectMock
+		schemaGenerationSelectMock *schemaGenerationSelectMock
+		projectSelectMock          *projectSelectMock
+		projectMemberSelectMock    *projectMemberSelectMock
 
 		expect    *services.Schema
 		expectErr error
@@ -99,6 +112,56 @@ func TestSchemaSelect(t *testing.T) {
 				CreatedAt:       baseTime,
 			},
 		},
+		{
+			name: "Success/ProjectMember",
+
+			request: &services.SchemaSelectRequest{
+				ProjectID: projectID,
+				Module:    "test-namespace:test-module@v1.0.0",
+				UserID:    otherUserID,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+			projectMemberSelectMock: &projectMemberSelectMock{
+				resp: &dao.ProjectMember{UserID: otherUserID, Role: dao.ProjectMemberRoleViewer},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:              schemaID,
+					ProjectID:       projectID,
+					Owner:           &ownerID,
+					ModuleID:        "test-module",
+					ModuleNamespace: "test-namespace",
+					ModuleVersion:   "1.0.0",
+					Source:          dao.SchemaSourceUser,
+					Data:            map[string]any{"title": "Test Title"},
+					CreatedAt:       baseTime,
+				},
+			},
+
+			expect: &services.Schema{
+				ID:              schemaID,
+				ProjectID:       projectID,
+				Owner:           &ownerID,
+				ModuleID:        "test-module",
+				ModuleNamespace: "test-namespace",
+				ModuleVersion:   "1.0.0",
+				Source:          "USER",
+				Data:            map[string]any{"title": "Test Title"},
+				CreatedAt:       baseTime,
+			},
+		},
 		{
 			name: "Success/ByID",
 
@@ -135,6 +198,83 @@ func TestSchemaSelect(t *testing.T) {
 				},
 			},
 
+			schemaGenerationSelectMock: &schemaGenerationSelectMock{
+				resp: &dao.SchemaGeneration{
+					SchemaID:         schemaID,
+					ProjectID:        projectID,
+					Model:            "test-model",
+					Messages:         []dao.SchemaGenerationMessage{{Role: "user", Content: "Generate."}},
+					TemplateVersion:  "default:0123456789ab",
+					PromptTokens:     120,
+					CompletionTokens: 30,
+					LatencyMs:        1500,
+					ContextSchemaIDs: []uuid.UUID{otherSchemaID},
+					CreatedAt:        baseTime,
+				},
+			},
+
+			expect: &services.Schema{
+				ID:               schemaID,
+				ProjectID:        projectID,
+				Owner:            &ownerID,
+				ModuleID:         "test-module",
+				ModuleNamespace:  "test-namespace",
+				ModuleVersion:    "1.0.0",
+				ModulePreversion: "-beta-1",
+				Source:           "AI",
+				Data:             map[string]any{"title": "Test Title"},
+				CreatedAt:        baseTime,
+				Generation: &services.SchemaGeneration{
+					Model:            "test-model",
+					Messages:         []lib.CompletionMessage{{Role: lib.CompletionRoleUser, Content: "Generate."}},
+					TemplateVersion:  "default:0123456789ab",
+					PromptTokens:     120,
+					CompletionTokens: 30,
+					Latency:          1500 * time.Millisecond,
+					ContextSchemaIDs: []uuid.UUID{otherSchemaID},
+				},
+			},
+		},
+		{
+			name: "Success/ByID/NoGeneration",
+
+			request: &services.SchemaSelectRequest{
+				ID:        &schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:               schemaID,
+					ProjectID:        projectID,
+					Owner:            &ownerID,
+					ModuleID:         "test-module",
+					ModuleNamespace:  "test-namespace",
+					ModuleVersion:    "1.0.0",
+					ModulePreversion: "-beta-1",
+					Source:           dao.SchemaSourceAI,
+					Data:             map[string]any{"title": "Test Title"},
+					CreatedAt:        baseTime,
+				},
+			},
+
+			schemaGenerationSelectMock: &schemaGenerationSelectMock{
+				err: dao.ErrSchemaGenerationSelectNotFound,
+			},
+
 			expect: &services.Schema{
 				ID:               schemaID,
 				ProjectID:        projectID,
@@ -224,8 +364,9 @@ func TestSchemaSelect(t *testing.T) {
 					UpdatedAt: baseTime,
 				},
 			},
+			projectMemberSelectMock: &projectMemberSelectMock{err: dao.ErrProjectMemberSelectNotFound},
 
-			expectErr: services.ErrUserDoesNotOwnProject,
+			expectErr: services.ErrProjectAccessDenied,
 		},
 		{
 			name: "Error/SchemaSelect",
@@ -252,6 +393,48 @@ func TestSchemaSelect(t *testing.T) {
 				err: errFoo,
 			},
 
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/SchemaGenerationSelect",
+
+			request: &services.SchemaSelectRequest{
+				ID:        &schemaID,
+				ProjectID: projectID,
+				UserID:    ownerID,
+			},
+
+			projectSelectMock: &projectSelectMock{
+				resp: &dao.Project{
+					ID:        projectID,
+					Owner:     ownerID,
+					Lang:      config.LangEN,
+					Title:     "Test Project",
+					Workflow:  []string{"test-module"},
+					CreatedAt: baseTime,
+					UpdatedAt: baseTime,
+				},
+			},
+
+			schemaSelectMock: &schemaSelectMock{
+				resp: &dao.Schema{
+					ID:               schemaID,
+					ProjectID:        projectID,
+					Owner:            &ownerID,
+					ModuleID:         "test-module",
+					ModuleNamespace:  "test-namespace",
+					ModuleVersion:    "1.0.0",
+					ModulePreversion: "-beta-1",
+					Source:           dao.SchemaSourceAI,
+					Data:             map[string]any{"title": "Test Title"},
+					CreatedAt:        baseTime,
+				},
+			},
+
+			schemaGenerationSelectMock: &schemaGenerationSelectMock{
+				err: errFoo,
+			},
+
 			expectErr: errFoo,
 		},
 	}
@@ -264,7 +447,9 @@ func TestSchemaSelect(t *testing.T) {
 				t.Helper()
 
 				schemaSelectRepository := servicesmocks.NewMockSchemaSelectRepository(t)
+				schemaGenerationSelectRepository := servicesmocks.NewMockSchemaSelectRepositorySchemaGenerationSelect(t)
 				projectSelectRepository := servicesmocks.NewMockSchemaSelectRepositoryProjectSelect(t)
+				projectMemberSelectRepository := servicesmocks.NewMockSchemaSelectRepositoryProjectMemberSelect(t)
 
 				if testCase.projectSelectMock != nil {
 					projectSelectRepository.EXPECT().
@@ -274,6 +459,15 @@ func TestSchemaSelect(t *testing.T) {
 						Return(testCase.projectSelectMock.resp, testCase.projectSelectMock.err)
 				}
 
+				if testCase.projectMemberSelectMock != nil {
+					projectMemberSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.ProjectMemberSelectRequest{
+							ProjectID: testCase.request.ProjectID,
+							UserID:    testCase.request.UserID,
+						}).
+						Return(testCase.projectMemberSelectMock.resp, testCase.projectMemberSelectMock.err)
+				}
+
 				if testCase.schemaSelectMock != nil {
 					expectedDAORequest := &dao.SchemaSelectRequest{
 						ID:        testCase.request.ID,
@@ -291,9 +485,19 @@ func TestSchemaSelect(t *testing.T) {
 						Return(testCase.schemaSelectMock.resp, testCase.schemaSelectMock.err)
 				}
 
+				if testCase.schemaGenerationSelectMock != nil {
+					schemaGenerationSelectRepository.EXPECT().
+						Exec(mock.Anything, &dao.SchemaGenerationSelectRequest{
+							SchemaID: testCase.schemaSelectMock.resp.ID,
+						}).
+						Return(testCase.schemaGenerationSelectMock.resp, testCase.schemaGenerationSelectMock.err)
+				}
+
 				service := services.NewSchemaSelect(
 					schemaSelectRepository,
+					schemaGenerationSelectRepository,
 					projectSelectRepository,
+					projectMemberSelectRepository,
 				)
 
 				resp, err := service.Exec(ctx, testCase.request)
@@ -301,7 +505,9 @@ func TestSchemaSelect(t *testing.T) {
 				require.Equal(t, testCase.expect, resp)
 
 				schemaSelectRepository.AssertExpectations(t)
+				schemaGenerationSelectRepository.AssertExpectations(t)
 				projectSelectRepository.AssertExpectations(t)
+				projectMemberSelectRepository.AssertExpectations(t)
 			})
 		})
 	}
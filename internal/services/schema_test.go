@@ -0,0 +1,180 @@
+package services_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/lib"
+	"github.com/a-novel/service-narrative-engine/internal/models"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestGenerationContext(t *testing.T) {
+	t.Parallel()
+
+	schema := func(id, module string) *dao.Schema {
+		return &dao.Schema{
+			ID:              uuid.MustParse(id),
+			ModuleID:        module,
+			ModuleNamespace: "test",
+			Data:            map[string]any{"title": module},
+		}
+	}
+
+	schemas := []*dao.Schema{
+		schema("00000000-0000-0000-0000-000000000005", "e"),
+		schema("00000000-0000-0000-0000-000000000004", "d"),
+		schema("00000000-0000-0000-0000-000000000003", "c"),
+		schema("00000000-0000-0000-0000-000000000002", "b"),
+		schema("00000000-0000-0000-0000-000000000001", "a"),
+		schema("00000000-0000-0000-0000-000000000006", "target"),
+	}
+
+	workflow := []string{"test:a@v1.0.0", "test:b@v1.0.0", "test:target@v1.0.0", "test:c@v1.0.0", "test:d@v1.0.0"}
+
+	t.Run("WorkflowProximity", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := services.GenerationContext(schemas, lib.DecodeModule("test:target@v1.0.0"), workflow, nil)
+		require.NoError(t, err)
+
+		// Closest modules first, previous steps before the next ones, then modules out of the workflow.
+		require.Equal(t, []string{"b", "c", "a", "d", "e"}, lo.Map(res, func(item *dao.Schema, _ int) string {
+			return item.ModuleID
+		}))
+	})
+
+	t.Run("Dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := services.GenerationContext(
+			schemas, lib.DecodeModule("test:target@v1.0.0"), workflow, []models.ModuleDependency{
+				{Module: "test:d"},
+				{Module: "test:a"},
+			},
+		)
+		require.NoError(t, err)
+
+		// Declaration order prevails.
+		require.Equal(t, []string{"d", "a"}, lo.Map(res, func(item *dao.Schema, _ int) string {
+			return item.ModuleID
+		}))
+	})
+}
+
+func TestFitGenerationContext(t *testing.T) {
+	t.Parallel()
+
+	schemaA := &dao.Schema{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ModuleID:        "a",
+		ModuleNamespace: "test",
+		Data:            map[string]any{"title": "The Lighthouse"},
+	}
+	// Long text, along with values that cannot be truncated.
+	schemaB := &dao.Schema{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		ModuleID:        "b",
+		ModuleNamespace: "test",
+		Data: map[string]any{
+			"text":    strings.Repeat("a", 4000),
+			"weights": lo.RepeatBy(100, func(i int) any { return float64(i) }),
+		},
+	}
+	schemaC := &dao.Schema{
+		ID:              uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		ModuleID:        "c",
+		ModuleNamespace: "test",
+		Data:            map[string]any{"title": "The Keeper"},
+	}
+
+	tokens := func(schema *dao.Schema) int64 {
+		encoded, err := json.Marshal(schema)
+		require.NoError(t, err)
+
+		return lib.EstimateTokens(string(encoded))
+	}
+
+	truncated := func(schema *dao.Schema, maxLength int) *dao.Schema {
+		data, _ := lib.TruncateModuleData(schema.Data, maxLength)
+		output := *schema
+		output.Data = data
+
+		return &output
+	}
+
+	schemas := []*dao.Schema{schemaA, schemaB, schemaC}
+
+	testCases := []struct {
+		name string
+
+		budget int64
+
+		expect     []*dao.Schema
+		expectCuts []dao.SchemaGenerationContextCut
+	}{
+		{
+			name: "NoLimit",
+
+			budget: 0,
+
+			expect: schemas,
+		},
+		{
+			name: "Fits",
+
+			budget: tokens(schemaA) + tokens(schemaB) + tokens(schemaC),
+
+			expect: schemas,
+		},
+		{
+			name: "Truncated",
+
+			budget: tokens(schemaA) + tokens(truncated(schemaB, 512)) + tokens(schemaC),
+
+			expect: []*dao.Schema{schemaA, truncated(schemaB, 512), schemaC},
+			expectCuts: []dao.SchemaGenerationContextCut{
+				{
+					SchemaID:   schemaB.ID,
+					Module:     "test:b",
+					Action:     dao.SchemaGenerationContextCutTruncated,
+					Tokens:     tokens(schemaB),
+					KeptTokens: tokens(truncated(schemaB, 512)),
+				},
+			},
+		},
+		{
+			// The budget left by the dropped schema is used by the next ones.
+			name: "Dropped",
+
+			budget: tokens(schemaA) + tokens(schemaC),
+
+			expect: []*dao.Schema{schemaA, schemaC},
+			expectCuts: []dao.SchemaGenerationContextCut{
+				{
+					SchemaID: schemaB.ID,
+					Module:   "test:b",
+					Action:   dao.SchemaGenerationContextCutDropped,
+					Tokens:   tokens(schemaB),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, cuts, err := services.FitGenerationContext(schemas, testCase.budget)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expect, res)
+			require.Equal(t, testCase.expectCuts, cuts)
+		})
+	}
+}
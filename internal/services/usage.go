@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+// ErrUsageQuotaExceeded is returned when a user has no tokens left to spend on AI generation.
+var ErrUsageQuotaExceeded = errors.New("token quota exceeded")
+
+// UsageWindow is the usage of a user over a quota period.
+type UsageWindow struct {
+	// Limit is the number of tokens the user can spend over the period. It is nil when there is no limit.
+	Limit *int64
+	// Used is the number of tokens spent since the start of the period.
+	Used int64
+	// Remaining is the number of tokens left to spend. It is nil when there is no limit.
+	Remaining *int64
+	// ResetsAt is the end of the period.
+	ResetsAt time.Time
+}
+
+// Exceeded returns true if no token is left to spend over the period.
+func (window *UsageWindow) Exceeded() bool {
+	return window.Remaining != nil && *window.Remaining <= 0
+}
+
+// UsageProject is the number of tokens spent for a project.
+type UsageProject struct {
+	ProjectID        uuid.UUID
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+type Usage struct {
+	Daily   UsageWindow
+	Monthly UsageWindow
+	// Projects lists the tokens spent this month, for each project.
+	Projects []*UsageProject
+}
+
+func loadUsageProject(usage *dao.TokenUsageProject, _ int) *UsageProject {
+	return &UsageProject{
+		ProjectID:        usage.ProjectID,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+	}
+}
+
+// ResolveUsageQuota returns the token quota of a user, given their roles. Roles without a quota of their own use the
+// quotas of the roles they inherit from.
+//
+// When a user has multiple roles, the most generous limit applies, for each period. Limits set to zero are the most
+// generous, as they remove the limit. If none of the roles has a quota, the user has no limit.
+func ResolveUsageQuota(quotas config.UsageQuotas, roles []string) config.UsageQuota {
+	var resolved []config.UsageQuota
+
+	for _, role := range roles {
+		quota, ok := resolveRoleUsageQuota(quotas, role, map[string]bool{})
+		if ok {
+			resolved = append(resolved, quota)
+		}
+	}
+
+	return mergeUsageQuotas(resolved)
+}
+
+func resolveRoleUsageQuota(quotas config.UsageQuotas, role string, visited map[string]bool) (config.UsageQuota, bool) {
+	// Prevent infinite loops on circular inheritance.
+	if visited[role] {
+		return config.UsageQuota{}, false
+	}
+
+	visited[role] = true
+
+	roleConfig, ok := quotas.Roles[role]
+	if !ok {
+		return config.UsageQuota{}, false
+	}
+
+	if roleConfig.Quota != nil {
+		return *roleConfig.Quota, true
+	}
+
+	var inherited []config.UsageQuota
+
+	for _, parent := range roleConfig.Inherits {
+		quota, ok := resolveRoleUsageQuota(quotas, parent, visited)
+		if ok {
+			inherited = append(inherited, quota)
+		}
+	}
+
+	if len(inherited) == 0 {
+		return config.UsageQuota{}, false
+	}
+
+	return mergeUsageQuotas(inherited), true
+}
+
+// mergeUsageQuotas keeps the most generous limit of each period.
+func mergeUsageQuotas(quotas []config.UsageQuota) config.UsageQuota {
+	if len(quotas) == 0 {
+		return config.UsageQuota{}
+	}
+
+	output := quotas[0]
+
+	for _, quota := range quotas[1:] {
+		output.Daily = mergeUsageLimit(output.Daily, quota.Daily)
+		output.Monthly = mergeUsageLimit(output.Monthly, quota.Monthly)
+	}
+
+	return output
+}
+
+func mergeUsageLimit(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return max(a, b)
+}
+
+// usagePeriods returns the start of the current day and month, in UTC.
+func usagePeriods(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return day, month
+}
+
+func newUsageWindow(limit, used int64, resetsAt time.Time) UsageWindow {
+	window := UsageWindow{Used: used, ResetsAt: resetsAt}
+
+	if limit > 0 {
+		window.Limit = &limit
+		window.Remaining = lo.ToPtr(max(limit-used, 0))
+	}
+
+	return window
+}
+
+// LoadUsage computes the usage of a user against their quota.
+func LoadUsage(
+	ctx context.Context, repository UsageSelectRepository, quota config.UsageQuota, userID uuid.UUID, now time.Time,
+) (*Usage, error) {
+	day, month := usagePeriods(now)
+
+	monthly, err := repository.Exec(ctx, &dao.TokenUsageListRequest{UserID: userID, Since: month})
+	if err != nil {
+		return nil, fmt.Errorf("list monthly usage: %w", err)
+	}
+
+	daily, err := repository.Exec(ctx, &dao.TokenUsageListRequest{UserID: userID, Since: day})
+	if err != nil {
+		return nil, fmt.Errorf("list daily usage: %w", err)
+	}
+
+	sumUsage := func(item *dao.TokenUsageProject) int64 { return item.Total() }
+
+	return &Usage{
+		Daily:    newUsageWindow(quota.Daily, lo.SumBy(daily, sumUsage), day.AddDate(0, 0, 1)),
+		Monthly:  newUsageWindow(quota.Monthly, lo.SumBy(monthly, sumUsage), month.AddDate(0, 1, 0)),
+		Projects: lo.Map(monthly, loadUsageProject),
+	}, nil
+}
+
+// VerifyUsageQuota assess that the given user has tokens left to spend on AI generation. The check happens before
+// generating, so a single generation may still exceed the quota.
+func VerifyUsageQuota(
+	ctx context.Context,
+	repository UsageSelectRepository,
+	quotas config.UsageQuotas,
+	userID uuid.UUID,
+	roles []string,
+) error {
+	quota := ResolveUsageQuota(quotas, roles)
+	// Skip the queries when there is nothing to check.
+	if quota.Daily == 0 && quota.Monthly == 0 {
+		return nil
+	}
+
+	usage, err := LoadUsage(ctx, repository, quota, userID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if usage.Daily.Exceeded() {
+		return fmt.Errorf("%w: daily quota resets at %s", ErrUsageQuotaExceeded, usage.Daily.ResetsAt.Format(time.RFC3339))
+	}
+
+	if usage.Monthly.Exceeded() {
+		return fmt.Errorf(
+			"%w: monthly quota resets at %s", ErrUsageQuotaExceeded, usage.Monthly.ResetsAt.Format(time.RFC3339),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/a-novel-kit/golib/otel"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+)
+
+type UsageSelectRepository interface {
+	Exec(ctx context.Context, request *dao.TokenUsageListRequest) ([]*dao.TokenUsageProject, error)
+}
+
+type UsageSelectRequest struct {
+	UserID uuid.UUID `validate:"required"`
+	// Roles of the user, which set their quota.
+	Roles []string
+}
+
+type UsageSelect struct {
+	usageSelectRepository UsageSelectRepository
+	quotas                config.UsageQuotas
+}
+
+func NewUsageSelect(usageSelectRepository UsageSelectRepository, quotas config.UsageQuotas) *UsageSelect {
+	return &UsageSelect{
+		usageSelectRepository: usageSelectRepository,
+		quotas:                quotas,
+	}
+}
+
+// Exec returns the tokens spent by a user on AI generation, and how many they have left.
+func (service *UsageSelect) Exec(ctx context.Context, request *UsageSelectRequest) (*Usage, error) {
+	ctx, span := otel.Tracer().Start(ctx, "service.UsageSelect")
+	defer span.End()
+
+	err := validate.Struct(request)
+	if err != nil {
+		return nil, otel.ReportError(span, errors.Join(err, ErrInvalidRequest))
+	}
+
+	usage, err := LoadUsage(
+		ctx, service.usageSelectRepository, ResolveUsageQuota(service.quotas, request.Roles), request.UserID, time.Now(),
+	)
+	if err != nil {
+		return nil, otel.ReportError(span, err)
+	}
+
+	return otel.ReportSuccess(span, usage), nil
+}
@@ -0,0 +1,234 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel-kit/golib/postgres"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/dao"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+	servicesmocks "github.com/a-novel/service-narrative-engine/internal/services/mocks"
+)
+
+func TestUsageSelect(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000100")
+	otherProjectID := uuid.MustParse("00000000-0000-0000-0000-000000000101")
+
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	quotas := config.UsageQuotas{
+		Roles: map[string]config.UsageQuotasRole{
+			"user": {Quota: &config.UsageQuota{Daily: 1000, Monthly: 10000}},
+		},
+	}
+
+	type usageListMock struct {
+		resp []*dao.TokenUsageProject
+		err  error
+	}
+
+	testCases := []struct {
+		name string
+
+		request *services.UsageSelectRequest
+
+		monthlyUsageMock *usageListMock
+		dailyUsageMock   *usageListMock
+
+		expect    *services.Usage
+		expectErr error
+	}{
+		{
+			name: "Success",
+
+			request: &services.UsageSelectRequest{
+				UserID: userID,
+				Roles:  []string{"user"},
+			},
+
+			monthlyUsageMock: &usageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 3000, CompletionTokens: 1000},
+					{ProjectID: otherProjectID, PromptTokens: 500, CompletionTokens: 100},
+				},
+			},
+			dailyUsageMock: &usageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 200, CompletionTokens: 100},
+				},
+			},
+
+			expect: &services.Usage{
+				Daily: services.UsageWindow{
+					Limit:     lo.ToPtr[int64](1000),
+					Used:      300,
+					Remaining: lo.ToPtr[int64](700),
+					ResetsAt:  day.AddDate(0, 0, 1),
+				},
+				Monthly: services.UsageWindow{
+					Limit:     lo.ToPtr[int64](10000),
+					Used:      4600,
+					Remaining: lo.ToPtr[int64](5400),
+					ResetsAt:  month.AddDate(0, 1, 0),
+				},
+				Projects: []*services.UsageProject{
+					{ProjectID: projectID, PromptTokens: 3000, CompletionTokens: 1000},
+					{ProjectID: otherProjectID, PromptTokens: 500, CompletionTokens: 100},
+				},
+			},
+		},
+		{
+			name: "Success/Exceeded",
+
+			request: &services.UsageSelectRequest{
+				UserID: userID,
+				Roles:  []string{"user"},
+			},
+
+			monthlyUsageMock: &usageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 1000, CompletionTokens: 500},
+				},
+			},
+			dailyUsageMock: &usageListMock{
+				resp: []*dao.TokenUsageProject{
+					{ProjectID: projectID, PromptTokens: 1000, CompletionTokens: 500},
+				},
+			},
+
+			expect: &services.Usage{
+				Daily: services.UsageWindow{
+					Limit:     lo.ToPtr[int64](1000),
+					Used:      1500,
+					Remaining: lo.ToPtr[int64](0),
+					ResetsAt:  day.AddDate(0, 0, 1),
+				},
+				Monthly: services.UsageWindow{
+					Limit:     lo.ToPtr[int64](10000),
+					Used:      1500,
+					Remaining: lo.ToPtr[int64](8500),
+					ResetsAt:  month.AddDate(0, 1, 0),
+				},
+				Projects: []*services.UsageProject{
+					{ProjectID: projectID, PromptTokens: 1000, CompletionTokens: 500},
+				},
+			},
+		},
+		{
+			name: "Success/NoQuota",
+
+			request: &services.UsageSelectRequest{
+				UserID: userID,
+			},
+
+			monthlyUsageMock: &usageListMock{
+				resp: []*dao.TokenUsageProject{},
+			},
+			dailyUsageMock: &usageListMock{
+				resp: []*dao.TokenUsageProject{},
+			},
+
+			expect: &services.Usage{
+				Daily: services.UsageWindow{
+					ResetsAt: day.AddDate(0, 0, 1),
+				},
+				Monthly: services.UsageWindow{
+					ResetsAt: month.AddDate(0, 1, 0),
+				},
+				Projects: []*services.UsageProject{},
+			},
+		},
+		{
+			name: "Error/InvalidRequest",
+
+			request: &services.UsageSelectRequest{},
+
+			expectErr: services.ErrInvalidRequest,
+		},
+		{
+			name: "Error/MonthlyUsage",
+
+			request: &services.UsageSelectRequest{
+				UserID: userID,
+				Roles:  []string{"user"},
+			},
+
+			monthlyUsageMock: &usageListMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+		{
+			name: "Error/DailyUsage",
+
+			request: &services.UsageSelectRequest{
+				UserID: userID,
+				Roles:  []string{"user"},
+			},
+
+			monthlyUsageMock: &usageListMock{
+				resp: []*dao.TokenUsageProject{},
+			},
+			dailyUsageMock: &usageListMock{
+				err: errFoo,
+			},
+
+			expectErr: errFoo,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			postgres.RunTransactionalTest(t, config.PostgresPresetTest, func(ctx context.Context, t *testing.T) {
+				t.Helper()
+
+				repository := servicesmocks.NewMockUsageSelectRepository(t)
+
+				if testCase.monthlyUsageMock != nil {
+					repository.EXPECT().
+						Exec(mock.Anything, &dao.TokenUsageListRequest{
+							UserID: testCase.request.UserID,
+							Since:  month,
+						}).
+						Return(testCase.monthlyUsageMock.resp, testCase.monthlyUsageMock.err)
+				}
+
+				if testCase.dailyUsageMock != nil {
+					repository.EXPECT().
+						Exec(mock.Anything, &dao.TokenUsageListRequest{
+							UserID: testCase.request.UserID,
+							Since:  day,
+						}).
+						Return(testCase.dailyUsageMock.resp, testCase.dailyUsageMock.err)
+				}
+
+				service := services.NewUsageSelect(repository, quotas)
+
+				resp, err := service.Exec(ctx, testCase.request)
+				require.ErrorIs(t, err, testCase.expectErr)
+				require.Equal(t, testCase.expect, resp)
+
+				repository.AssertExpectations(t)
+			})
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/a-novel/service-narrative-engine/internal/config"
+	"github.com/a-novel/service-narrative-engine/internal/services"
+)
+
+func TestResolveUsageQuota(t *testing.T) {
+	t.Parallel()
+
+	quotas := config.UsageQuotas{
+		Roles: map[string]config.UsageQuotasRole{
+			"user": {
+				Quota: &config.UsageQuota{Daily: 1000, Monthly: 10000},
+			},
+			"writer": {
+				Inherits: []string{"user"},
+			},
+			"premium": {
+				Inherits: []string{"user"},
+				Quota:    &config.UsageQuota{Daily: 5000, Monthly: 5000},
+			},
+			"unlimited": {
+				Quota: &config.UsageQuota{},
+			},
+			"loop-a": {
+				Inherits: []string{"loop-b"},
+			},
+			"loop-b": {
+				Inherits: []string{"loop-a"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name string
+
+		roles []string
+
+		expect config.UsageQuota
+	}{
+		{
+			name: "Own",
+
+			roles: []string{"user"},
+
+			expect: config.UsageQuota{Daily: 1000, Monthly: 10000},
+		},
+		{
+			name: "Inherited",
+
+			roles: []string{"writer"},
+
+			expect: config.UsageQuota{Daily: 1000, Monthly: 10000},
+		},
+		{
+			name: "Override",
+
+			roles: []string{"premium"},
+
+			expect: config.UsageQuota{Daily: 5000, Monthly: 5000},
+		},
+		{
+			name: "MostGenerous",
+
+			roles: []string{"user", "premium"},
+
+			expect: config.UsageQuota{Daily: 5000, Monthly: 10000},
+		},
+		{
+			name: "Unlimited",
+
+			roles: []string{"user", "unlimited"},
+
+			expect: config.UsageQuota{},
+		},
+		{
+			name: "UnknownRole",
+
+			roles: []string{"unknown"},
+
+			expect: config.UsageQuota{},
+		},
+		{
+			name: "UnknownRoleWithKnownRole",
+
+			roles: []string{"unknown", "user"},
+
+			expect: config.UsageQuota{Daily: 1000, Monthly: 10000},
+		},
+		{
+			name: "CircularInheritance",
+
+			roles: []string{"loop-a"},
+
+			expect: config.UsageQuota{},
+		},
+		{
+			name: "NoRoles",
+
+			expect: config.UsageQuota{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expect, services.ResolveUsageQuota(quotas, testCase.roles))
+		})
+	}
+}
@@ -34,6 +34,21 @@ func ValidateModule(fl validator.FieldLevel) bool {
 	return lib.ModuleStringRegexp.MatchString(val)
 }
 
+// ValidateWorkflowModule accepts the module strings allowed in a project workflow: either an exact module version,
+// or a version range that is resolved against the published versions of the module.
+func ValidateWorkflowModule(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+
+	return lib.ModuleStringRegexp.MatchString(val) || lib.ModuleRangeStringRegexp.MatchString(val)
+}
+
+// ValidateVersionlessModule accepts module strings without a version, in the "namespace:module" format.
+func ValidateVersionlessModule(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+
+	return lib.VersionlessModuleStringRegexp.MatchString(val)
+}
+
 func ValidateModuleName(fl validator.FieldLevel) bool {
 	val := fl.Field().String()
 
@@ -46,6 +61,12 @@ func ValidateModuleVersion(fl validator.FieldLevel) bool {
 	return lib.ModuleVersionRegexp.MatchString(val)
 }
 
+func ValidateJSONPointer(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+
+	return lib.JSONPointerRegexp.MatchString(val)
+}
+
 func ValidateSource(fl validator.FieldLevel) bool {
 	val := fl.Field().String()
 
@@ -58,6 +79,30 @@ func ValidateSource(fl validator.FieldLevel) bool {
 	return false
 }
 
+// ValidateModulePrompt accepts empty prompts, and prompts that parse as a module prompt template.
+func ValidateModulePrompt(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+	if val == "" {
+		return true
+	}
+
+	_, err := lib.ParseModulePrompt(val)
+
+	return err == nil
+}
+
+func ValidateProjectMemberRole(fl validator.FieldLevel) bool {
+	val := fl.Field().String()
+
+	for _, role := range models.KnownProjectMemberRoles {
+		if val == string(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func init() {
 	err := validate.RegisterValidation("langs", ValidateLang)
 	if err != nil {
@@ -69,6 +114,16 @@ func init() {
 		panic(err)
 	}
 
+	err = validate.RegisterValidation("workflowModule", ValidateWorkflowModule)
+	if err != nil {
+		panic(err)
+	}
+
+	err = validate.RegisterValidation("versionlessModule", ValidateVersionlessModule)
+	if err != nil {
+		panic(err)
+	}
+
 	err = validate.RegisterValidation("schemaSource", ValidateSource)
 	if err != nil {
 		panic(err)
@@ -83,4 +138,19 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	err = validate.RegisterValidation("jsonPointer", ValidateJSONPointer)
+	if err != nil {
+		panic(err)
+	}
+
+	err = validate.RegisterValidation("modulePrompt", ValidateModulePrompt)
+	if err != nil {
+		panic(err)
+	}
+
+	err = validate.RegisterValidation("projectMemberRole", ValidateProjectMemberRole)
+	if err != nil {
+		panic(err)
+	}
 }
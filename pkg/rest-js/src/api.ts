@@ -28,6 +28,16 @@ export class NarrativeEngineApi {
       .then(validator ? decodeHttpResponse(validator) : decodeRawHttpResponse<T>);
   }
 
+  async stream(input: string, init?: RequestInit): Promise<ReadableStream<Uint8Array>> {
+    const response = await fetch(`${this._baseUrl}${input}`, init).then(handleHttpResponse);
+
+    if (!response.body) {
+      throw new Error(`empty response body for ${input}`);
+    }
+
+    return response.body;
+  }
+
   async ping(): Promise<void> {
     await this.fetchVoid("/ping", { method: "GET" });
   }
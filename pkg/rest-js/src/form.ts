@@ -19,6 +19,8 @@ export type ModuleVersion = z.infer<typeof ModuleVersionSchema>;
 const ModuleNameRegex = "[a-z0-9]+(-[a-z0-9]+)*";
 const ModuleVersionRegex = "[0-9]+\\.[0-9]+\\.[0-9]+";
 const ModulePreversionRegex = "(-[a-z0-9]+)*";
+// Matches Go patterns from internal/lib/moduleRange.go
+const ModuleRangeRegex = "[\\^~][0-9]+(\\.[0-9]+(\\.[0-9]+)?)?";
 
 export const ModulePreversionSchema = z
   .string()
@@ -31,6 +33,20 @@ export const ModuleStringSchema = z
   .regex(new RegExp(`^${ModuleNameRegex}:${ModuleNameRegex}@v${ModuleVersionRegex}${ModulePreversionRegex}$`));
 export type ModuleString = z.infer<typeof ModuleStringSchema>;
 
+export const ModuleRangeStringSchema = z
+  .string()
+  .regex(new RegExp(`^${ModuleNameRegex}:${ModuleNameRegex}@${ModuleRangeRegex}$`));
+export type ModuleRangeString = z.infer<typeof ModuleRangeStringSchema>;
+
+// A module referenced without version ("agora:idea").
+export const VersionlessModuleStringSchema = z.string().regex(new RegExp(`^${ModuleNameRegex}:${ModuleNameRegex}$`));
+export type VersionlessModuleString = z.infer<typeof VersionlessModuleStringSchema>;
+
+// A module referenced by a project workflow: either an exact module version ("agora:idea@v1.2.0"), or a version
+// range resolved to the newest matching stable version ("agora:idea@^1.2", "agora:idea@~1.2.0").
+export const WorkflowModuleSchema = z.union([ModuleStringSchema, ModuleRangeStringSchema]);
+export type WorkflowModule = z.infer<typeof WorkflowModuleSchema>;
+
 export const UUIDSchema = z.uuid();
 export type UUID = z.infer<typeof UUIDSchema>;
 
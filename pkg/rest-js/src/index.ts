@@ -1,5 +1,7 @@
 export * from "./api";
 export * from "./form";
+export * from "./job";
 export * from "./module";
 export * from "./project";
 export * from "./schema";
+export * from "./usage";
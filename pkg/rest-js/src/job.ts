@@ -0,0 +1,56 @@
+import type { NarrativeEngineApi } from "./api";
+import { LangSchema, UUIDSchema } from "./form";
+
+import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
+
+import { z } from "zod";
+
+export const GenerationJobStatusSchema = z.enum(["QUEUED", "RUNNING", "SUCCEEDED", "FAILED"]);
+
+export type GenerationJobStatus = z.infer<typeof GenerationJobStatusSchema>;
+
+export const GenerationJobSchema = z.object({
+  id: UUIDSchema,
+  projectID: UUIDSchema,
+  owner: UUIDSchema,
+  module: z.string(),
+  lang: LangSchema,
+  paths: z.array(z.string()).optional(),
+  instructions: z.string().optional(),
+  status: GenerationJobStatusSchema,
+  attempts: z.number().int(),
+  schemaID: UUIDSchema.nullable(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  updatedAt: z.iso.datetime().transform((value) => new Date(value)),
+  startedAt: z.iso
+    .datetime()
+    .nullable()
+    .transform((value) => (value ? new Date(value) : null)),
+  finishedAt: z.iso
+    .datetime()
+    .nullable()
+    .transform((value) => (value ? new Date(value) : null)),
+});
+
+export type GenerationJob = z.infer<typeof GenerationJobSchema>;
+
+export const GenerationJobSelectRequestSchema = z.object({
+  id: UUIDSchema,
+});
+
+export type GenerationJobSelectRequest = z.infer<typeof GenerationJobSelectRequestSchema>;
+
+export async function generationJobSelect(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: GenerationJobSelectRequest
+): Promise<GenerationJob> {
+  const params = new URLSearchParams();
+
+  params.set("id", form.id);
+
+  return await api.fetch(`/jobs?${params.toString()}`, GenerationJobSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
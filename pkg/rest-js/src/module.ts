@@ -7,6 +7,7 @@ import {
   ModuleStringSchema,
   ModuleVersionSchema,
   OffsetSchema,
+  VersionlessModuleStringSchema,
 } from "./form";
 
 import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
@@ -21,6 +22,40 @@ export const ModuleUiSchema = z.object({
 
 export type ModuleUi = z.infer<typeof ModuleUiSchema>;
 
+export const ModuleMigrationSchema = z.object({
+  op: z.enum(["rename", "move", "default", "drop"]),
+  path: z.string(),
+  to: z.string().optional(),
+  value: z.unknown().optional(),
+});
+
+export type ModuleMigration = z.infer<typeof ModuleMigrationSchema>;
+
+export const ModuleDependencySchema = z.object({
+  module: VersionlessModuleStringSchema,
+  paths: z.array(z.string()).optional(),
+});
+
+export type ModuleDependency = z.infer<typeof ModuleDependencySchema>;
+
+export const ModuleGenerationSchema = z.object({
+  prompt: z.string().max(8192).optional(),
+  instructions: z.string().max(8192).optional(),
+  model: z.string().max(128).optional(),
+  temperature: z.number().min(0).max(2).optional(),
+  maxTokens: z.number().int().min(1).max(32768).optional(),
+});
+
+export type ModuleGeneration = z.infer<typeof ModuleGenerationSchema>;
+
+export const ModuleLLMChangeSchema = z.object({
+  path: z.string(),
+  kind: z.enum(["dropped", "rewritten"]),
+  message: z.string(),
+});
+
+export type ModuleLLMChange = z.infer<typeof ModuleLLMChangeSchema>;
+
 export const ModuleSchema = z.object({
   id: ModuleIDSchema,
   namespace: ModuleNamespaceSchema,
@@ -29,11 +64,24 @@ export const ModuleSchema = z.object({
   description: z.string(),
   schema: z.record(z.string(), z.unknown()),
   ui: ModuleUiSchema,
+  migrations: z.array(ModuleMigrationSchema).optional(),
+  dependsOn: z.array(ModuleDependencySchema).optional(),
+  generation: ModuleGenerationSchema.optional(),
   createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  // Only returned when publishing a module.
+  llmReport: z.array(ModuleLLMChangeSchema).optional(),
 });
 
 export type Module = z.infer<typeof ModuleSchema>;
 
+export const ModuleLLMSchemaSchema = z.object({
+  schema: z.record(z.string(), z.unknown()).nullable(),
+  usable: z.boolean(),
+  report: z.array(ModuleLLMChangeSchema),
+});
+
+export type ModuleLLMSchema = z.infer<typeof ModuleLLMSchemaSchema>;
+
 export const ModuleVersionEntrySchema = z.object({
   version: ModuleVersionSchema,
   preversion: ModulePreversionSchema,
@@ -42,12 +90,50 @@ export const ModuleVersionEntrySchema = z.object({
 
 export type ModuleVersionEntry = z.infer<typeof ModuleVersionEntrySchema>;
 
+export const ModuleSummarySchema = z.object({
+  id: ModuleIDSchema,
+  namespace: ModuleNamespaceSchema,
+  version: ModuleVersionSchema,
+  description: z.string(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type ModuleSummary = z.infer<typeof ModuleSummarySchema>;
+
 export const ModuleSelectRequestSchema = z.object({
   module: ModuleStringSchema,
 });
 
 export type ModuleSelectRequest = z.infer<typeof ModuleSelectRequestSchema>;
 
+export const ModuleCreateRequestSchema = z.object({
+  module: ModuleStringSchema,
+  description: z.string().min(32).max(512),
+  schema: z.record(z.string(), z.unknown()),
+  ui: ModuleUiSchema,
+  migrations: z.array(ModuleMigrationSchema).max(128).optional(),
+  dependsOn: z.array(ModuleDependencySchema).max(32).optional(),
+  generation: ModuleGenerationSchema.optional(),
+  overwrite: z.boolean().optional(),
+});
+
+export type ModuleCreateRequest = z.infer<typeof ModuleCreateRequestSchema>;
+
+export const ModuleDeleteRequestSchema = z.object({
+  module: ModuleStringSchema,
+});
+
+export type ModuleDeleteRequest = z.infer<typeof ModuleDeleteRequestSchema>;
+
+export const ModuleListRequestSchema = z.object({
+  namespace: ModuleNamespaceSchema.optional(),
+  query: z.string().max(256).optional(),
+  limit: LimitSchema,
+  offset: OffsetSchema,
+});
+
+export type ModuleListRequest = z.infer<typeof ModuleListRequestSchema>;
+
 export const ModuleListVersionsRequestSchema = z.object({
   id: ModuleIDSchema.optional(),
   namespace: ModuleNamespaceSchema.optional(),
@@ -73,6 +159,62 @@ export async function moduleSelect(
   });
 }
 
+export async function moduleSelectLLMSchema(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ModuleSelectRequest
+): Promise<ModuleLLMSchema> {
+  const params = new URLSearchParams();
+  params.set("module", form.module);
+
+  return await api.fetch(`/modules/llm-schema?${params.toString()}`, ModuleLLMSchemaSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+export async function moduleCreate(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ModuleCreateRequest
+): Promise<Module> {
+  return await api.fetch("/modules", ModuleSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function moduleDelete(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ModuleDeleteRequest
+): Promise<Module> {
+  return await api.fetch("/modules", ModuleSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "DELETE",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function moduleList(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ModuleListRequest
+): Promise<ModuleSummary[]> {
+  const params = new URLSearchParams();
+  params.set("limit", `${form.limit || 100}`);
+  params.set("offset", `${form.offset || 0}`);
+
+  if (form.namespace) params.set("namespace", form.namespace);
+  if (form.query) params.set("query", form.query);
+
+  return await api.fetch(`/modules/catalog?${params.toString()}`, z.array(ModuleSummarySchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
 export async function moduleListVersions(
   api: NarrativeEngineApi,
   accessToken: string,
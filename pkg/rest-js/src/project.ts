@@ -1,5 +1,6 @@
 import type { NarrativeEngineApi } from "./api";
-import { LangSchema, LimitSchema, ModuleStringSchema, OffsetSchema, UUIDSchema } from "./form";
+import { LangSchema, LimitSchema, OffsetSchema, SchemaSourceSchema, UUIDSchema, WorkflowModuleSchema } from "./form";
+import { type Schema, SchemaSchema } from "./schema";
 
 import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
 
@@ -13,6 +14,7 @@ export const ProjectSchema = z.object({
   workflow: z.array(z.string()),
   createdAt: z.iso.datetime().transform((value) => new Date(value)),
   updatedAt: z.iso.datetime().transform((value) => new Date(value)),
+  forkedFrom: UUIDSchema.optional(),
 });
 
 export type Project = z.infer<typeof ProjectSchema>;
@@ -27,7 +29,7 @@ export type ProjectListRequest = z.infer<typeof ProjectListRequestSchema>;
 export const ProjectInitRequestSchema = z.object({
   lang: LangSchema,
   title: z.string(),
-  workflow: z.array(ModuleStringSchema),
+  workflow: z.array(WorkflowModuleSchema),
 });
 
 export type ProjectInitRequest = z.infer<typeof ProjectInitRequestSchema>;
@@ -35,17 +37,198 @@ export type ProjectInitRequest = z.infer<typeof ProjectInitRequestSchema>;
 export const ProjectUpdateRequestSchema = z.object({
   id: UUIDSchema,
   title: z.string(),
-  workflow: z.array(ModuleStringSchema),
+  workflow: z.array(WorkflowModuleSchema),
 });
 
 export type ProjectUpdateRequest = z.infer<typeof ProjectUpdateRequestSchema>;
 
+export const ProjectUpgradeModuleRequestSchema = z.object({
+  projectID: UUIDSchema,
+  module: WorkflowModuleSchema,
+});
+
+export type ProjectUpgradeModuleRequest = z.infer<typeof ProjectUpgradeModuleRequestSchema>;
+
+export const ProjectTranslateRequestSchema = z.object({
+  projectID: UUIDSchema,
+  lang: LangSchema,
+  copy: z.boolean().optional(),
+});
+
+export type ProjectTranslateRequest = z.infer<typeof ProjectTranslateRequestSchema>;
+
+export const ProjectForkRequestSchema = z.object({
+  projectID: UUIDSchema,
+});
+
+export type ProjectForkRequest = z.infer<typeof ProjectForkRequestSchema>;
+
+export const ProjectStateRequestSchema = z.object({
+  projectID: UUIDSchema,
+  at: z.date(),
+});
+
+export type ProjectStateRequest = z.infer<typeof ProjectStateRequestSchema>;
+
 export const ProjectDeleteRequestSchema = z.object({
   id: UUIDSchema,
 });
 
 export type ProjectDeleteRequest = z.infer<typeof ProjectDeleteRequestSchema>;
 
+export const ProjectMemberRoleSchema = z.enum(["VIEWER", "EDITOR", "GENERATOR", "ADMIN"]);
+
+export type ProjectMemberRole = z.infer<typeof ProjectMemberRoleSchema>;
+
+export const ProjectMemberSchema = z.object({
+  projectID: UUIDSchema,
+  userID: UUIDSchema,
+  role: ProjectMemberRoleSchema,
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  updatedAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type ProjectMember = z.infer<typeof ProjectMemberSchema>;
+
+export const ProjectMemberListRequestSchema = z.object({
+  projectID: UUIDSchema,
+});
+
+export type ProjectMemberListRequest = z.infer<typeof ProjectMemberListRequestSchema>;
+
+export const ProjectMemberUpsertRequestSchema = z.object({
+  projectID: UUIDSchema,
+  userID: UUIDSchema,
+  role: ProjectMemberRoleSchema,
+});
+
+export type ProjectMemberUpsertRequest = z.infer<typeof ProjectMemberUpsertRequestSchema>;
+
+export const ProjectMemberDeleteRequestSchema = z.object({
+  projectID: UUIDSchema,
+  userID: UUIDSchema,
+});
+
+export type ProjectMemberDeleteRequest = z.infer<typeof ProjectMemberDeleteRequestSchema>;
+
+export const ProjectShareSchema = z.object({
+  id: UUIDSchema,
+  projectID: UUIDSchema,
+  // Only returned when the share is created.
+  token: z.string().optional(),
+  modules: z.array(z.string()).optional(),
+  expiresAt: z.iso
+    .datetime()
+    .transform((value) => new Date(value))
+    .optional(),
+  revokedAt: z.iso
+    .datetime()
+    .transform((value) => new Date(value))
+    .optional(),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type ProjectShare = z.infer<typeof ProjectShareSchema>;
+
+// Share links do not expose owners, forks or generation instructions.
+export const SharedProjectInfoSchema = z.object({
+  id: UUIDSchema,
+  lang: LangSchema,
+  title: z.string(),
+  workflow: z.array(z.string()),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  updatedAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type SharedProjectInfo = z.infer<typeof SharedProjectInfoSchema>;
+
+export const SharedSchemaSchema = z.object({
+  id: UUIDSchema,
+  module: z.string(),
+  source: SchemaSourceSchema,
+  data: z.record(z.string(), z.unknown()),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type SharedSchema = z.infer<typeof SharedSchemaSchema>;
+
+export const SharedProjectSchema = z.object({
+  project: SharedProjectInfoSchema,
+  schemas: z.array(SharedSchemaSchema),
+});
+
+export type SharedProject = z.infer<typeof SharedProjectSchema>;
+
+export const ProjectShareListRequestSchema = z.object({
+  projectID: UUIDSchema,
+});
+
+export type ProjectShareListRequest = z.infer<typeof ProjectShareListRequestSchema>;
+
+export const ProjectShareCreateRequestSchema = z.object({
+  projectID: UUIDSchema,
+  modules: z.array(z.string()).optional(),
+  expiresAt: z.date().optional(),
+});
+
+export type ProjectShareCreateRequest = z.infer<typeof ProjectShareCreateRequestSchema>;
+
+export const ProjectShareRevokeRequestSchema = z.object({
+  id: UUIDSchema,
+  projectID: UUIDSchema,
+});
+
+export type ProjectShareRevokeRequest = z.infer<typeof ProjectShareRevokeRequestSchema>;
+
+export const ProjectShareSelectRequestSchema = z.object({
+  token: z.string(),
+});
+
+export type ProjectShareSelectRequest = z.infer<typeof ProjectShareSelectRequestSchema>;
+
+export const ProjectSnapshotSchema = z.object({
+  id: UUIDSchema,
+  projectID: UUIDSchema,
+  owner: UUIDSchema,
+  name: z.string(),
+  schemaIDs: z.array(UUIDSchema),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type ProjectSnapshot = z.infer<typeof ProjectSnapshotSchema>;
+
+export const ProjectSnapshotContentSchema = z.object({
+  snapshot: ProjectSnapshotSchema,
+  schemas: z.array(SchemaSchema),
+});
+
+export type ProjectSnapshotContent = z.infer<typeof ProjectSnapshotContentSchema>;
+
+export const ProjectSnapshotListRequestSchema = z.object({
+  projectID: UUIDSchema,
+});
+
+export type ProjectSnapshotListRequest = z.infer<typeof ProjectSnapshotListRequestSchema>;
+
+export const ProjectSnapshotCreateRequestSchema = z.object({
+  projectID: UUIDSchema,
+  name: z.string().min(1).max(256),
+});
+
+export type ProjectSnapshotCreateRequest = z.infer<typeof ProjectSnapshotCreateRequestSchema>;
+
+export const ProjectSnapshotSelectRequestSchema = z.object({
+  id: UUIDSchema,
+});
+
+export type ProjectSnapshotSelectRequest = z.infer<typeof ProjectSnapshotSelectRequestSchema>;
+
+export const ProjectSnapshotRestoreRequestSchema = z.object({
+  id: UUIDSchema,
+});
+
+export type ProjectSnapshotRestoreRequest = z.infer<typeof ProjectSnapshotRestoreRequestSchema>;
+
 export async function projectList(
   api: NarrativeEngineApi,
   accessToken: string,
@@ -85,6 +268,57 @@ export async function projectUpdate(
   });
 }
 
+export async function projectUpgradeModule(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectUpgradeModuleRequest
+): Promise<Project> {
+  return await api.fetch("/projects/upgrade-module", ProjectSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectTranslate(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectTranslateRequest
+): Promise<Project> {
+  return await api.fetch("/projects/translate", ProjectSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectFork(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectForkRequest
+): Promise<Project> {
+  return await api.fetch("/projects/fork", ProjectSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectState(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectStateRequest
+): Promise<Schema[]> {
+  const params = new URLSearchParams();
+  params.set("projectID", form.projectID);
+  params.set("at", form.at.toISOString());
+
+  return await api.fetch(`/projects/state?${params.toString()}`, z.array(SchemaSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
 export async function projectDelete(
   api: NarrativeEngineApi,
   accessToken: string,
@@ -96,3 +330,142 @@ export async function projectDelete(
     body: JSON.stringify(form),
   });
 }
+
+export async function projectMemberList(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectMemberListRequest
+): Promise<ProjectMember[]> {
+  const params = new URLSearchParams();
+  params.set("projectID", form.projectID);
+
+  return await api.fetch(`/projects/members?${params.toString()}`, z.array(ProjectMemberSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+export async function projectMemberUpsert(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectMemberUpsertRequest
+): Promise<ProjectMember> {
+  return await api.fetch("/projects/members", ProjectMemberSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectMemberDelete(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectMemberDeleteRequest
+): Promise<ProjectMember> {
+  return await api.fetch("/projects/members", ProjectMemberSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "DELETE",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectShareList(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectShareListRequest
+): Promise<ProjectShare[]> {
+  const params = new URLSearchParams();
+  params.set("projectID", form.projectID);
+
+  return await api.fetch(`/projects/shares?${params.toString()}`, z.array(ProjectShareSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+export async function projectShareCreate(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectShareCreateRequest
+): Promise<ProjectShare> {
+  return await api.fetch("/projects/shares", ProjectShareSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectShareRevoke(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectShareRevokeRequest
+): Promise<ProjectShare> {
+  return await api.fetch("/projects/shares", ProjectShareSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "DELETE",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectShareSelect(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectShareSelectRequest
+): Promise<SharedProject> {
+  return await api.fetch("/shares", SharedProjectSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}`, "X-Share-Token": form.token },
+    method: "GET",
+  });
+}
+
+export async function projectSnapshotList(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectSnapshotListRequest
+): Promise<ProjectSnapshot[]> {
+  const params = new URLSearchParams();
+  params.set("projectID", form.projectID);
+
+  return await api.fetch(`/projects/snapshots?${params.toString()}`, z.array(ProjectSnapshotSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+export async function projectSnapshotCreate(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectSnapshotCreateRequest
+): Promise<ProjectSnapshot> {
+  return await api.fetch("/projects/snapshots", ProjectSnapshotSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function projectSnapshotSelect(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectSnapshotSelectRequest
+): Promise<ProjectSnapshotContent> {
+  const params = new URLSearchParams();
+  params.set("id", form.id);
+
+  return await api.fetch(`/projects/snapshots/view?${params.toString()}`, ProjectSnapshotContentSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+export async function projectSnapshotRestore(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: ProjectSnapshotRestoreRequest
+): Promise<Schema[]> {
+  return await api.fetch("/projects/snapshots/restore", z.array(SchemaSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
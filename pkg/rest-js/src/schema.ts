@@ -4,39 +4,88 @@ import {
   LimitSchema,
   ModuleIDSchema,
   ModuleNamespaceSchema,
-  ModuleStringSchema,
   OffsetSchema,
   SchemaSourceSchema,
   UUIDSchema,
+  WorkflowModuleSchema,
 } from "./form";
+import { type GenerationJob, GenerationJobSchema } from "./job";
 
 import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
 
 import { z } from "zod";
 
+export const SchemaGenerationContextCutSchema = z.object({
+  schemaID: UUIDSchema,
+  module: z.string(),
+  action: z.enum(["truncated", "dropped"]),
+  tokens: z.number().int(),
+  keptTokens: z.number().int(),
+});
+
+export type SchemaGenerationContextCut = z.infer<typeof SchemaGenerationContextCutSchema>;
+
+export const SchemaGenerationSchema = z.object({
+  model: z.string(),
+  messages: z
+    .array(
+      z.object({
+        role: z.enum(["system", "user", "assistant"]),
+        content: z.string(),
+      })
+    )
+    .optional(),
+  templateVersion: z.string(),
+  promptTokens: z.number().int(),
+  completionTokens: z.number().int(),
+  latencyMs: z.number().int(),
+  contextSchemaIDs: z.array(UUIDSchema),
+  contextCuts: z.array(SchemaGenerationContextCutSchema).optional(),
+  baseSchemaID: UUIDSchema.optional(),
+});
+
+export type SchemaGeneration = z.infer<typeof SchemaGenerationSchema>;
+
 export const SchemaSchema = z.object({
   id: UUIDSchema,
   projectID: UUIDSchema,
   owner: UUIDSchema.nullable(),
   module: z.string(),
   source: SchemaSourceSchema,
+  instructions: z.string().optional(),
   data: z.record(z.string(), z.unknown()),
+  forkedFrom: UUIDSchema.optional(),
   createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  generation: SchemaGenerationSchema.optional(),
 });
 
 export type Schema = z.infer<typeof SchemaSchema>;
 
 export const SchemaVersionEntrySchema = z.object({
   id: UUIDSchema,
+  instructions: z.string().optional(),
   createdAt: z.iso.datetime().transform((value) => new Date(value)),
+  generation: SchemaGenerationSchema.optional(),
 });
 
 export type SchemaVersionEntry = z.infer<typeof SchemaVersionEntrySchema>;
 
+export const SchemaCandidateSchema = z.object({
+  id: UUIDSchema,
+  projectID: UUIDSchema,
+  owner: UUIDSchema,
+  module: z.string(),
+  instructions: z.string().optional(),
+  data: z.record(z.string(), z.unknown()),
+  createdAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type SchemaCandidate = z.infer<typeof SchemaCandidateSchema>;
+
 export const SchemaSelectRequestSchema = z.object({
   id: UUIDSchema.optional(),
   projectID: UUIDSchema,
-  module: ModuleStringSchema.optional(),
+  module: WorkflowModuleSchema.optional(),
 });
 
 export type SchemaSelectRequest = z.infer<typeof SchemaSelectRequestSchema>;
@@ -44,9 +93,10 @@ export type SchemaSelectRequest = z.infer<typeof SchemaSelectRequestSchema>;
 export const SchemaCreateRequestSchema = z.object({
   id: UUIDSchema,
   projectID: UUIDSchema,
-  module: ModuleStringSchema,
+  module: WorkflowModuleSchema,
   source: SchemaSourceSchema,
   data: z.record(z.string(), z.unknown()),
+  draft: z.boolean().optional(),
 });
 
 export type SchemaCreateRequest = z.infer<typeof SchemaCreateRequestSchema>;
@@ -54,6 +104,7 @@ export type SchemaCreateRequest = z.infer<typeof SchemaCreateRequestSchema>;
 export const SchemaRewriteRequestSchema = z.object({
   id: UUIDSchema,
   data: z.record(z.string(), z.unknown()),
+  draft: z.boolean().optional(),
 });
 
 export type SchemaRewriteRequest = z.infer<typeof SchemaRewriteRequestSchema>;
@@ -70,12 +121,71 @@ export type SchemaListVersionsRequest = z.infer<typeof SchemaListVersionsRequest
 
 export const SchemaGenerateRequestSchema = z.object({
   projectID: UUIDSchema,
-  module: ModuleStringSchema,
+  module: WorkflowModuleSchema,
   lang: LangSchema,
+  paths: z.array(z.string().max(1024)).max(64).optional(),
+  instructions: z.string().max(2048).optional(),
 });
 
 export type SchemaGenerateRequest = z.infer<typeof SchemaGenerateRequestSchema>;
 
+export const SchemaGenerateCandidatesRequestSchema = SchemaGenerateRequestSchema.extend({
+  candidates: z.number().int().min(1).max(5),
+});
+
+export type SchemaGenerateCandidatesRequest = z.infer<typeof SchemaGenerateCandidatesRequestSchema>;
+
+export const SchemaCandidateListRequestSchema = z.object({
+  projectID: UUIDSchema,
+  moduleID: ModuleIDSchema,
+  moduleNamespace: ModuleNamespaceSchema,
+});
+
+export type SchemaCandidateListRequest = z.infer<typeof SchemaCandidateListRequestSchema>;
+
+export const SchemaCandidatePromoteRequestSchema = z.object({
+  id: UUIDSchema,
+});
+
+export type SchemaCandidatePromoteRequest = z.infer<typeof SchemaCandidatePromoteRequestSchema>;
+
+export const SchemaGenerateStreamDeltaSchema = z.object({
+  delta: z.string(),
+});
+
+export type SchemaGenerateStreamDelta = z.infer<typeof SchemaGenerateStreamDeltaSchema>;
+
+export const SchemaGenerateStreamErrorSchema = z.object({
+  status: z.number().int(),
+});
+
+export type SchemaGenerateStreamErrorData = z.infer<typeof SchemaGenerateStreamErrorSchema>;
+
+/**
+ * Error sent by the server once a schema generation stream has started. The status is the one the
+ * non-streaming endpoint would have returned.
+ */
+export class SchemaGenerateStreamError extends Error {
+  readonly status: number;
+
+  constructor(status: number) {
+    super(`schema generation failed with status ${status}`);
+    this.name = "SchemaGenerateStreamError";
+    this.status = status;
+  }
+}
+
+export type SchemaGenerateStreamOptions = {
+  /**
+   * Receives each chunk of the raw JSON output of the model, along with the output received so far.
+   */
+  onDelta?: (delta: string, output: string) => void;
+  /**
+   * Aborting the signal closes the stream, which stops the generation on the server.
+   */
+  signal?: AbortSignal;
+};
+
 export async function schemaSelect(
   api: NarrativeEngineApi,
   accessToken: string,
@@ -147,3 +257,124 @@ export async function schemaGenerate(
     body: JSON.stringify(form),
   });
 }
+
+/**
+ * Queues the generation of a schema. The returned job can be polled with `generationJobSelect`, until its status
+ * is either `SUCCEEDED` or `FAILED`.
+ */
+export async function schemaGenerateAsync(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: SchemaGenerateRequest
+): Promise<GenerationJob> {
+  return await api.fetch("/schemas/generate?async=true", GenerationJobSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+/**
+ * Generates alternative contents for a module. They are saved as pending candidates, until one of them is promoted
+ * with `schemaCandidatePromote`.
+ */
+export async function schemaGenerateCandidates(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: SchemaGenerateCandidatesRequest
+): Promise<SchemaCandidate[]> {
+  return await api.fetch("/schemas/generate", z.array(SchemaCandidateSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function schemaCandidateList(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: SchemaCandidateListRequest
+): Promise<SchemaCandidate[]> {
+  const params = new URLSearchParams();
+
+  params.set("projectID", form.projectID);
+  params.set("moduleID", form.moduleID);
+  params.set("moduleNamespace", form.moduleNamespace);
+
+  return await api.fetch(`/schemas/candidates?${params.toString()}`, z.array(SchemaCandidateSchema), {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
+
+/**
+ * Saves a candidate as the latest version of its module. The other pending candidates of the module are discarded.
+ */
+export async function schemaCandidatePromote(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: SchemaCandidatePromoteRequest
+): Promise<Schema> {
+  return await api.fetch("/schemas/candidates/promote", SchemaSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "POST",
+    body: JSON.stringify(form),
+  });
+}
+
+export async function schemaGenerateStream(
+  api: NarrativeEngineApi,
+  accessToken: string,
+  form: SchemaGenerateRequest,
+  options: SchemaGenerateStreamOptions = {}
+): Promise<Schema> {
+  const stream = await api.stream("/schemas/generate/stream", {
+    headers: { ...HTTP_HEADERS.JSON, Accept: "text/event-stream", Authorization: `Bearer ${accessToken}` },
+    method: "PUT",
+    body: JSON.stringify(form),
+    signal: options.signal,
+  });
+
+  const reader = stream.pipeThrough(new TextDecoderStream()).getReader();
+
+  let buffer = "";
+  let output = "";
+
+  try {
+    for (;;) {
+      const { done, value } = await reader.read();
+      if (done) break;
+
+      buffer += value;
+
+      // Events are separated by a blank line.
+      let separator = buffer.indexOf("\n\n");
+      while (separator >= 0) {
+        const block = buffer.slice(0, separator);
+        buffer = buffer.slice(separator + 2);
+        separator = buffer.indexOf("\n\n");
+
+        const lines = block.split("\n");
+        const event = lines.find((line) => line.startsWith("event: "))?.slice("event: ".length);
+        const data = JSON.parse(lines.find((line) => line.startsWith("data: "))?.slice("data: ".length) ?? "null");
+
+        switch (event) {
+          case "delta": {
+            const { delta } = SchemaGenerateStreamDeltaSchema.parse(data);
+            output += delta;
+            options.onDelta?.(delta, output);
+            break;
+          }
+          case "schema":
+            return SchemaSchema.parse(data);
+          case "error":
+            throw new SchemaGenerateStreamError(SchemaGenerateStreamErrorSchema.parse(data).status);
+        }
+      }
+    }
+  } finally {
+    await reader.cancel().catch(() => undefined);
+  }
+
+  throw new Error("schema generation stream ended without a schema");
+}
@@ -0,0 +1,38 @@
+import type { NarrativeEngineApi } from "./api";
+import { UUIDSchema } from "./form";
+
+import { HTTP_HEADERS } from "@a-novel-kit/nodelib-browser/http";
+
+import { z } from "zod";
+
+export const UsageWindowSchema = z.object({
+  limit: z.number().int().nullable(),
+  used: z.number().int(),
+  remaining: z.number().int().nullable(),
+  resetsAt: z.iso.datetime().transform((value) => new Date(value)),
+});
+
+export type UsageWindow = z.infer<typeof UsageWindowSchema>;
+
+export const UsageProjectSchema = z.object({
+  projectID: UUIDSchema,
+  promptTokens: z.number().int(),
+  completionTokens: z.number().int(),
+});
+
+export type UsageProject = z.infer<typeof UsageProjectSchema>;
+
+export const UsageSchema = z.object({
+  daily: UsageWindowSchema,
+  monthly: UsageWindowSchema,
+  projects: z.array(UsageProjectSchema),
+});
+
+export type Usage = z.infer<typeof UsageSchema>;
+
+export async function usageSelect(api: NarrativeEngineApi, accessToken: string): Promise<Usage> {
+  return await api.fetch("/usage", UsageSchema, {
+    headers: { ...HTTP_HEADERS.JSON, Authorization: `Bearer ${accessToken}` },
+    method: "GET",
+  });
+}
@@ -0,0 +1,129 @@
+import { beforeAll, describe, expect, it } from "vitest";
+
+import { expectStatus } from "@a-novel-kit/nodelib-test/http";
+import { AuthenticationApi } from "@a-novel/service-authentication-rest";
+import { preRegisterUser, registerUser } from "@a-novel/service-authentication-rest-test";
+import {
+  type GenerationJob,
+  NarrativeEngineApi,
+  generationJobSelect,
+  moduleListVersions,
+  projectDelete,
+  projectInit,
+  schemaGenerateAsync,
+  schemaSelect,
+} from "@a-novel/service-narrative-engine-rest";
+
+let user: Awaited<ReturnType<typeof registerUser>>;
+let moduleString: string;
+
+const TEST_MODULE_NAMESPACE = "agora";
+const TEST_MODULE_ID = "idea";
+
+beforeAll(async () => {
+  const authApi = new AuthenticationApi(process.env.AUTH_API_URL!);
+  const api = new NarrativeEngineApi(process.env.API_URL!);
+
+  const preRegister = await preRegisterUser(authApi, process.env.MAIL_TEST_HOST!);
+  user = await registerUser(authApi, preRegister);
+
+  const versions = await moduleListVersions(api, user.token.accessToken, {
+    namespace: TEST_MODULE_NAMESPACE,
+    id: TEST_MODULE_ID,
+    limit: 1,
+    offset: 0,
+    preversion: true,
+  });
+
+  expect(versions.length).toBe(1);
+
+  moduleString = `${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@v${versions[0].version}${versions[0].preversion ?? ""}`;
+});
+
+async function waitForJob(api: NarrativeEngineApi, accessToken: string, id: string): Promise<GenerationJob> {
+  for (;;) {
+    const job = await generationJobSelect(api, accessToken, { id });
+    if (job.status === "SUCCEEDED" || job.status === "FAILED") return job;
+
+    await new Promise((resolve) => setTimeout(resolve, 1000));
+  }
+}
+
+describe("schemaGenerateAsync", () => {
+  it("generates a schema in the background", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Job Test Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    const job = await schemaGenerateAsync(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+      lang: "en",
+    });
+
+    expect(job.id).toBeTruthy();
+    expect(job.projectID).toBe(project.id);
+    expect(job.module).toBe(moduleString);
+    expect(job.status).toBe("QUEUED");
+    expect(job.schemaID).toBeNull();
+
+    const finishedJob = await waitForJob(api, user.token.accessToken, job.id);
+
+    expect(finishedJob.status).toBe("SUCCEEDED");
+    expect(finishedJob.schemaID).toBeTruthy();
+    expect(finishedJob.finishedAt).toBeInstanceOf(Date);
+
+    const schema = await schemaSelect(api, user.token.accessToken, {
+      id: finishedJob.schemaID!,
+      projectID: project.id,
+    });
+
+    expect(schema.source).toBe("AI");
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  }, 60000);
+
+  it("returns 404 for non-existent project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      schemaGenerateAsync(api, user.token.accessToken, {
+        projectID: crypto.randomUUID(),
+        module: moduleString,
+        lang: "en",
+      }),
+      404
+    );
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      schemaGenerateAsync(api, "", {
+        projectID: crypto.randomUUID(),
+        module: moduleString,
+        lang: "en",
+      }),
+      401
+    );
+  });
+});
+
+describe("generationJobSelect", () => {
+  it("returns 404 for non-existent job", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(generationJobSelect(api, user.token.accessToken, { id: crypto.randomUUID() }), 404);
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(generationJobSelect(api, "", { id: crypto.randomUUID() }), 401);
+  });
+});
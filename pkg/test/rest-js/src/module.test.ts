@@ -3,7 +3,15 @@ import { beforeAll, describe, expect, it } from "vitest";
 import { expectStatus } from "@a-novel-kit/nodelib-test/http";
 import { AuthenticationApi } from "@a-novel/service-authentication-rest";
 import { preRegisterUser, registerUser } from "@a-novel/service-authentication-rest-test";
-import { NarrativeEngineApi, moduleListVersions, moduleSelect } from "@a-novel/service-narrative-engine-rest";
+import {
+  NarrativeEngineApi,
+  moduleCreate,
+  moduleDelete,
+  moduleList,
+  moduleListVersions,
+  moduleSelect,
+  moduleSelectLLMSchema,
+} from "@a-novel/service-narrative-engine-rest";
 
 let user: Awaited<ReturnType<typeof registerUser>>;
 
@@ -90,6 +98,100 @@ describe("moduleSelect", () => {
   });
 });
 
+describe("moduleSelectLLMSchema", () => {
+  let moduleString: string;
+
+  beforeAll(async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const versions = await moduleListVersions(api, user.token.accessToken, {
+      namespace: TEST_MODULE_NAMESPACE!,
+      id: TEST_MODULE_ID!,
+      limit: 1,
+      offset: 0,
+      preversion: true,
+    });
+
+    expect(versions.length).toBe(1);
+
+    moduleString = `${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@v${versions[0].version}${versions[0].preversion ?? ""}`;
+  });
+
+  it("returns the schema sent to the model", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const llmSchema = await moduleSelectLLMSchema(api, user.token.accessToken, {
+      module: moduleString,
+    });
+
+    expect(llmSchema.usable).toBe(true);
+    expect(llmSchema.schema).toBeTruthy();
+    expect(Array.isArray(llmSchema.report)).toBe(true);
+  });
+
+  it("returns 404 for non-existent module", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      moduleSelectLLMSchema(api, user.token.accessToken, {
+        module: "non-existent:module@v0.0.0",
+      }),
+      404
+    );
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      moduleSelectLLMSchema(api, "", {
+        module: moduleString,
+      }),
+      401
+    );
+  });
+});
+
+describe("moduleList", () => {
+  it("lists the latest stable version of each module", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const modules = await moduleList(api, user.token.accessToken, {
+      namespace: TEST_MODULE_NAMESPACE,
+      limit: 100,
+      offset: 0,
+    });
+
+    const module = modules.find((item) => item.id === TEST_MODULE_ID);
+    expect(module).toBeTruthy();
+    expect(module!.namespace).toBe(TEST_MODULE_NAMESPACE);
+    expect(module!.version).toBeTruthy();
+    expect(module!.description).toBeTruthy();
+    expect(module!.createdAt).toBeInstanceOf(Date);
+
+    // Each module is listed only once.
+    expect(modules.filter((item) => item.id === TEST_MODULE_ID).length).toBe(1);
+  });
+
+  it("searches module descriptions", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const modules = await moduleList(api, user.token.accessToken, {
+      query: "non-existent module description",
+      limit: 10,
+      offset: 0,
+    });
+
+    expect(modules).toEqual([]);
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(moduleList(api, "", { limit: 10, offset: 0 }), 401);
+  });
+});
+
 describe("moduleListVersions", () => {
   it("returns a list of module versions", async () => {
     const api = new NarrativeEngineApi(process.env.API_URL!);
@@ -154,3 +256,48 @@ describe("moduleListVersions", () => {
     );
   });
 });
+
+describe("moduleCreate", () => {
+  const form = {
+    module: "test:module-create@v1.0.0",
+    description: "A module published from the rest-js integration tests.",
+    schema: { type: "object", properties: { title: { type: "string" } } },
+    ui: { component: "text-editor", params: {}, target: "title" },
+  };
+
+  it("returns 403 for users without the modules:create permission", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(moduleCreate(api, user.token.accessToken, form), 403);
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(moduleCreate(api, "", form), 401);
+  });
+});
+
+describe("moduleDelete", () => {
+  it("returns 403 for users without the modules:delete permission", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      moduleDelete(api, user.token.accessToken, {
+        module: `${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@v1.0.0`,
+      }),
+      403
+    );
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      moduleDelete(api, "", {
+        module: `${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@v1.0.0`,
+      }),
+      401
+    );
+  });
+});
@@ -7,9 +7,26 @@ import {
   NarrativeEngineApi,
   moduleListVersions,
   projectDelete,
+  projectFork,
   projectInit,
   projectList,
+  projectMemberDelete,
+  projectMemberList,
+  projectMemberUpsert,
+  projectShareCreate,
+  projectShareList,
+  projectShareRevoke,
+  projectShareSelect,
+  projectSnapshotCreate,
+  projectSnapshotList,
+  projectSnapshotRestore,
+  projectSnapshotSelect,
+  projectState,
+  projectTranslate,
   projectUpdate,
+  projectUpgradeModule,
+  schemaCreate,
+  schemaSelect,
 } from "@a-novel/service-narrative-engine-rest";
 
 let user: Awaited<ReturnType<typeof registerUser>>;
@@ -80,6 +97,35 @@ describe("projectInit", () => {
     await projectDelete(api, user.token.accessToken, { id: project.id });
   });
 
+  it("creates a project with a version range in the workflow", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const moduleRange = `${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@^${version.split(".")[0]}`;
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Test Project ${Date.now()}`,
+      workflow: [moduleRange],
+    });
+
+    expect(project.workflow).toEqual([moduleRange]);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 404 when no version satisfies the workflow range", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      projectInit(api, user.token.accessToken, {
+        lang: "en",
+        title: "Test Project",
+        workflow: [`${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@^999`],
+      }),
+      404
+    );
+  });
+
   it("returns 404 for non-existent module in workflow", async () => {
     const api = new NarrativeEngineApi(process.env.API_URL!);
 
@@ -229,6 +275,434 @@ describe("projectUpdate", () => {
   });
 });
 
+describe("projectUpgradeModule", () => {
+  it("returns 422 when the target version is not newer", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: "Upgrade Module Test",
+      workflow: [moduleString],
+    });
+
+    await expectStatus(
+      projectUpgradeModule(api, user.token.accessToken, {
+        projectID: project.id,
+        module: moduleString,
+      }),
+      422
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 404 when the target version does not exist", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: "Upgrade Module Test",
+      workflow: [moduleString],
+    });
+
+    await expectStatus(
+      projectUpgradeModule(api, user.token.accessToken, {
+        projectID: project.id,
+        module: `${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@v999.0.0`,
+      }),
+      404
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 404 for non-existent project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      projectUpgradeModule(api, user.token.accessToken, {
+        projectID: crypto.randomUUID(),
+        module: moduleString,
+      }),
+      404
+    );
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      projectUpgradeModule(api, "", {
+        projectID: crypto.randomUUID(),
+        module: moduleString,
+      }),
+      401
+    );
+  });
+});
+
+describe("projectTranslate", () => {
+  it("changes the language of the project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: "Translate Test",
+      workflow: [moduleString],
+    });
+
+    const translatedProject = await projectTranslate(api, user.token.accessToken, {
+      projectID: project.id,
+      lang: "fr",
+    });
+
+    expect(translatedProject.id).toBe(project.id);
+    expect(translatedProject.lang).toBe("fr");
+    expect(translatedProject.title).toBe(project.title);
+    expect(translatedProject.workflow).toEqual(project.workflow);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("copies the project in the target language", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: "Translate Copy Test",
+      workflow: [moduleString],
+    });
+
+    const translatedProject = await projectTranslate(api, user.token.accessToken, {
+      projectID: project.id,
+      lang: "fr",
+      copy: true,
+    });
+
+    expect(translatedProject.id).not.toBe(project.id);
+    expect(translatedProject.lang).toBe("fr");
+    expect(translatedProject.title).toBe(project.title);
+    expect(translatedProject.workflow).toEqual(project.workflow);
+
+    // The source project is left untouched.
+    const projects = await projectList(api, user.token.accessToken, { limit: 100, offset: 0 });
+    expect(projects.find((p) => p.id === project.id)?.lang).toBe("en");
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+    await projectDelete(api, user.token.accessToken, { id: translatedProject.id });
+  });
+
+  it("returns 422 when the project is already in the target language", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: "Translate Test",
+      workflow: [moduleString],
+    });
+
+    await expectStatus(
+      projectTranslate(api, user.token.accessToken, {
+        projectID: project.id,
+        lang: "en",
+      }),
+      422
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 404 for non-existent project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      projectTranslate(api, user.token.accessToken, {
+        projectID: crypto.randomUUID(),
+        lang: "fr",
+      }),
+      404
+    );
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      projectTranslate(api, "", {
+        projectID: crypto.randomUUID(),
+        lang: "fr",
+      }),
+      401
+    );
+  });
+});
+
+describe("projectFork", () => {
+  it("copies the project and its schemas", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: "Fork Test",
+      workflow: [moduleString],
+    });
+
+    const schema = await schemaCreate(api, user.token.accessToken, {
+      id: crypto.randomUUID(),
+      projectID: project.id,
+      module: moduleString,
+      source: "USER",
+      data: { test: "data" },
+      draft: true,
+    });
+
+    const forkedProject = await projectFork(api, user.token.accessToken, { projectID: project.id });
+
+    expect(forkedProject.id).not.toBe(project.id);
+    expect(forkedProject.owner).toBe(project.owner);
+    expect(forkedProject.title).toBe(project.title);
+    expect(forkedProject.workflow).toEqual(project.workflow);
+    expect(forkedProject.forkedFrom).toBe(project.id);
+
+    const forkedSchema = await schemaSelect(api, user.token.accessToken, {
+      projectID: forkedProject.id,
+      module: moduleString,
+    });
+
+    expect(forkedSchema.id).not.toBe(schema.id);
+    expect(forkedSchema.source).toBe("FORK");
+    expect(forkedSchema.data).toEqual(schema.data);
+    expect(forkedSchema.forkedFrom).toBe(schema.id);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+    await projectDelete(api, user.token.accessToken, { id: forkedProject.id });
+  });
+
+  it("returns 404 for non-existent project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(projectFork(api, user.token.accessToken, { projectID: crypto.randomUUID() }), 404);
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(projectFork(api, "", { projectID: crypto.randomUUID() }), 401);
+  });
+});
+
+describe("projectState", () => {
+  it("returns the schemas of the project at a point in time", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `State Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    const firstSchema = await schemaCreate(api, user.token.accessToken, {
+      id: crypto.randomUUID(),
+      projectID: project.id,
+      module: moduleString,
+      source: "USER",
+      data: { test: "first draft" },
+      draft: true,
+    });
+
+    // Schema timestamps are precise to the second.
+    await new Promise((resolve) => setTimeout(resolve, 1000));
+
+    const secondSchema = await schemaCreate(api, user.token.accessToken, {
+      id: crypto.randomUUID(),
+      projectID: project.id,
+      module: moduleString,
+      source: "USER",
+      data: { test: "second draft" },
+      draft: true,
+    });
+
+    const past = await projectState(api, user.token.accessToken, {
+      projectID: project.id,
+      at: firstSchema.createdAt,
+    });
+    expect(past.map((s) => s.id)).toEqual([firstSchema.id]);
+
+    const present = await projectState(api, user.token.accessToken, { projectID: project.id, at: new Date() });
+    expect(present.map((s) => s.id)).toEqual([secondSchema.id]);
+
+    const beforeCreation = await projectState(api, user.token.accessToken, {
+      projectID: project.id,
+      at: new Date(project.createdAt.getTime() - 60 * 1000),
+    });
+    expect(beforeCreation).toEqual([]);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 404 for non-existent project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      projectState(api, user.token.accessToken, { projectID: crypto.randomUUID(), at: new Date() }),
+      404
+    );
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(projectState(api, "", { projectID: crypto.randomUUID(), at: new Date() }), 401);
+  });
+});
+
+describe("projectMembers", () => {
+  let member: Awaited<ReturnType<typeof registerUser>>;
+  let memberID: string;
+
+  beforeAll(async () => {
+    const authApi = new AuthenticationApi(process.env.AUTH_API_URL!);
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const preRegister = await preRegisterUser(authApi, process.env.MAIL_TEST_HOST!);
+    member = await registerUser(authApi, preRegister);
+
+    // Retrieve the ID of the member through a project of their own.
+    const memberProject = await projectInit(api, member.token.accessToken, {
+      lang: "en",
+      title: "Member Project",
+      workflow: [moduleString],
+    });
+    memberID = memberProject.owner;
+    await projectDelete(api, member.token.accessToken, { id: memberProject.id });
+  });
+
+  it("shares a project with a member", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Shared Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    // The project is not shared yet.
+    await expectStatus(projectMemberList(api, member.token.accessToken, { projectID: project.id }), 403);
+
+    const projectMember = await projectMemberUpsert(api, user.token.accessToken, {
+      projectID: project.id,
+      userID: memberID,
+      role: "VIEWER",
+    });
+
+    expect(projectMember.projectID).toBe(project.id);
+    expect(projectMember.userID).toBe(memberID);
+    expect(projectMember.role).toBe("VIEWER");
+
+    // The member can see the project, and its members.
+    const memberProjects = await projectList(api, member.token.accessToken, { limit: 100, offset: 0 });
+    expect(memberProjects.some((p) => p.id === project.id)).toBe(true);
+
+    const members = await projectMemberList(api, member.token.accessToken, { projectID: project.id });
+    expect(members.map((m) => m.userID)).toEqual([memberID]);
+
+    // Viewers cannot update the project.
+    await expectStatus(
+      projectUpdate(api, member.token.accessToken, {
+        id: project.id,
+        title: "Updated By Member",
+        workflow: project.workflow,
+      }),
+      403
+    );
+
+    // Admins can.
+    await projectMemberUpsert(api, user.token.accessToken, {
+      projectID: project.id,
+      userID: memberID,
+      role: "ADMIN",
+    });
+
+    const updatedProject = await projectUpdate(api, member.token.accessToken, {
+      id: project.id,
+      title: "Updated By Member",
+      workflow: project.workflow,
+    });
+    expect(updatedProject.title).toBe("Updated By Member");
+
+    // Only the owner can delete the project.
+    await expectStatus(projectDelete(api, member.token.accessToken, { id: project.id }), 403);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("lets members leave a project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Shared Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    await projectMemberUpsert(api, user.token.accessToken, {
+      projectID: project.id,
+      userID: memberID,
+      role: "EDITOR",
+    });
+
+    const removedMember = await projectMemberDelete(api, member.token.accessToken, {
+      projectID: project.id,
+      userID: memberID,
+    });
+    expect(removedMember.userID).toBe(memberID);
+
+    const members = await projectMemberList(api, user.token.accessToken, { projectID: project.id });
+    expect(members).toEqual([]);
+
+    await expectStatus(projectMemberList(api, member.token.accessToken, { projectID: project.id }), 403);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 422 when sharing a project with its owner", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Shared Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    await expectStatus(
+      projectMemberUpsert(api, user.token.accessToken, {
+        projectID: project.id,
+        userID: project.owner,
+        role: "ADMIN",
+      }),
+      422
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(projectMemberList(api, "", { projectID: crypto.randomUUID() }), 401);
+  });
+});
+
 describe("projectDelete", () => {
   it("deletes a project", async () => {
     const api = new NarrativeEngineApi(process.env.API_URL!);
@@ -276,3 +750,182 @@ describe("projectDelete", () => {
     );
   });
 });
+
+describe("projectShares", () => {
+  let reader: Awaited<ReturnType<typeof registerUser>>;
+
+  beforeAll(async () => {
+    const authApi = new AuthenticationApi(process.env.AUTH_API_URL!);
+
+    // The reader has no access to the project: the share token is the only thing granting it.
+    const preRegister = await preRegisterUser(authApi, process.env.MAIL_TEST_HOST!);
+    reader = await registerUser(authApi, preRegister);
+  });
+
+  it("reads a project through a share link", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Shared Link Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    const share = await projectShareCreate(api, user.token.accessToken, { projectID: project.id });
+    expect(share.projectID).toBe(project.id);
+    expect(share.token).toBeTruthy();
+
+    const sharedProject = await projectShareSelect(api, reader.token.accessToken, { token: share.token! });
+    expect(sharedProject.project.id).toBe(project.id);
+    expect(sharedProject.project.workflow).toEqual([moduleString]);
+
+    // Tokens are never listed.
+    const shares = await projectShareList(api, user.token.accessToken, { projectID: project.id });
+    expect(shares.map((s) => s.id)).toEqual([share.id]);
+    expect(shares[0].token).toBeUndefined();
+
+    // Revoked links can no longer be used.
+    const revokedShare = await projectShareRevoke(api, user.token.accessToken, {
+      projectID: project.id,
+      id: share.id,
+    });
+    expect(revokedShare.revokedAt).toBeTruthy();
+
+    await expectStatus(projectShareSelect(api, reader.token.accessToken, { token: share.token! }), 410);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("restricts a share link to some modules", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Shared Link Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    await expectStatus(
+      projectShareCreate(api, user.token.accessToken, { projectID: project.id, modules: ["agora:unknown"] }),
+      422
+    );
+
+    const share = await projectShareCreate(api, user.token.accessToken, {
+      projectID: project.id,
+      modules: [`${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}`],
+      expiresAt: new Date(Date.now() + 60 * 60 * 1000),
+    });
+    expect(share.modules).toEqual([`${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}`]);
+    expect(share.expiresAt).toBeTruthy();
+
+    const sharedProject = await projectShareSelect(api, reader.token.accessToken, { token: share.token! });
+    expect(sharedProject.project.workflow).toEqual([moduleString]);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("only lets the owner share a project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Shared Link Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    await expectStatus(projectShareCreate(api, reader.token.accessToken, { projectID: project.id }), 403);
+    await expectStatus(projectShareList(api, reader.token.accessToken, { projectID: project.id }), 403);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 404 for unknown tokens", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(projectShareSelect(api, reader.token.accessToken, { token: "unknown" }), 404);
+  });
+});
+
+describe("projectSnapshots", () => {
+  it("saves and restores the state of a project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Snapshot Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    const schema = await schemaCreate(api, user.token.accessToken, {
+      id: crypto.randomUUID(),
+      projectID: project.id,
+      module: moduleString,
+      source: "USER",
+      data: { test: "first draft" },
+      draft: true,
+    });
+
+    const snapshot = await projectSnapshotCreate(api, user.token.accessToken, {
+      projectID: project.id,
+      name: "First draft",
+    });
+    expect(snapshot.projectID).toBe(project.id);
+    expect(snapshot.name).toBe("First draft");
+    expect(snapshot.schemaIDs).toEqual([schema.id]);
+
+    // Names are unique within a project.
+    await expectStatus(
+      projectSnapshotCreate(api, user.token.accessToken, { projectID: project.id, name: "First draft" }),
+      409
+    );
+
+    const snapshots = await projectSnapshotList(api, user.token.accessToken, { projectID: project.id });
+    expect(snapshots.map((s) => s.id)).toEqual([snapshot.id]);
+
+    await schemaCreate(api, user.token.accessToken, {
+      id: crypto.randomUUID(),
+      projectID: project.id,
+      module: moduleString,
+      source: "USER",
+      data: { test: "second draft" },
+      draft: true,
+    });
+
+    const content = await projectSnapshotSelect(api, user.token.accessToken, { id: snapshot.id });
+    expect(content.snapshot.id).toBe(snapshot.id);
+    expect(content.schemas.map((s) => s.data)).toEqual([schema.data]);
+
+    // Schema timestamps are precise to the second: wait so the restored version is the latest one.
+    await new Promise((resolve) => setTimeout(resolve, 1000));
+
+    const restored = await projectSnapshotRestore(api, user.token.accessToken, { id: snapshot.id });
+    expect(restored.length).toBe(1);
+    expect(restored[0].id).not.toBe(schema.id);
+    expect(restored[0].data).toEqual(schema.data);
+
+    const latestSchema = await schemaSelect(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+    });
+    expect(latestSchema.id).toBe(restored[0].id);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 404 for non-existent snapshot", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(projectSnapshotSelect(api, user.token.accessToken, { id: crypto.randomUUID() }), 404);
+    await expectStatus(projectSnapshotRestore(api, user.token.accessToken, { id: crypto.randomUUID() }), 404);
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(projectSnapshotList(api, "", { projectID: crypto.randomUUID() }), 401);
+  });
+});
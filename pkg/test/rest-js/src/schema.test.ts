@@ -8,8 +8,12 @@ import {
   moduleListVersions,
   projectDelete,
   projectInit,
+  schemaCandidateList,
+  schemaCandidatePromote,
   schemaCreate,
   schemaGenerate,
+  schemaGenerateCandidates,
+  schemaGenerateStream,
   schemaListVersions,
   schemaRewrite,
   schemaSelect,
@@ -65,6 +69,7 @@ describe("schemaCreate", () => {
       module: moduleString,
       source: "USER",
       data: { test: "data" },
+      draft: true,
     });
 
     expect(schema.id).toBe(schemaId);
@@ -88,6 +93,7 @@ describe("schemaCreate", () => {
       module: moduleString,
       source: "AI",
       data: { generated: true },
+      draft: true,
     });
 
     expect(schema.source).toBe("AI");
@@ -96,6 +102,45 @@ describe("schemaCreate", () => {
     await projectDelete(api, user.token.accessToken, { id: project.id });
   });
 
+  it("returns 422 when data does not match the module schema", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    await expectStatus(
+      schemaCreate(api, user.token.accessToken, {
+        id: crypto.randomUUID(),
+        projectID: project.id,
+        module: moduleString,
+        source: "USER",
+        data: { test: "data" },
+      }),
+      422
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
+  it("returns 422 when draft data has invalid types", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    await expectStatus(
+      schemaCreate(api, user.token.accessToken, {
+        id: crypto.randomUUID(),
+        projectID: project.id,
+        module: moduleString,
+        source: "USER",
+        data: { targets: { target_medium: "RADIO" } },
+        draft: true,
+      }),
+      422
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
   it("returns 404 for non-existent project", async () => {
     const api = new NarrativeEngineApi(process.env.API_URL!);
 
@@ -139,6 +184,7 @@ describe("schemaSelect", () => {
       module: moduleString,
       source: "USER",
       data: { select: "test" },
+      draft: true,
     });
 
     const schema = await schemaSelect(api, user.token.accessToken, {
@@ -163,6 +209,7 @@ describe("schemaSelect", () => {
       module: moduleString,
       source: "USER",
       data: { module: "select" },
+      draft: true,
     });
 
     const schema = await schemaSelect(api, user.token.accessToken, {
@@ -218,11 +265,13 @@ describe("schemaRewrite", () => {
       module: moduleString,
       source: "USER",
       data: { original: "data" },
+      draft: true,
     });
 
     const updatedSchema = await schemaRewrite(api, user.token.accessToken, {
       id: schemaId,
       data: { updated: "data" },
+      draft: true,
     });
 
     expect(updatedSchema.id).toBe(schemaId);
@@ -232,6 +281,32 @@ describe("schemaRewrite", () => {
     await projectDelete(api, user.token.accessToken, { id: project.id });
   });
 
+  it("returns 422 when data does not match the module schema", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    const schemaId = crypto.randomUUID();
+    await schemaCreate(api, user.token.accessToken, {
+      id: schemaId,
+      projectID: project.id,
+      module: moduleString,
+      source: "USER",
+      data: {},
+      draft: true,
+    });
+
+    await expectStatus(
+      schemaRewrite(api, user.token.accessToken, {
+        id: schemaId,
+        data: { targets: { target_medium: 42 } },
+      }),
+      422
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
   it("returns 404 for non-existent schema", async () => {
     const api = new NarrativeEngineApi(process.env.API_URL!);
 
@@ -270,12 +345,14 @@ describe("schemaListVersions", () => {
       module: moduleString,
       source: "USER",
       data: { version: 1 },
+      draft: true,
     });
 
     // Rewrite to create a new version
     await schemaRewrite(api, user.token.accessToken, {
       id: schemaId,
       data: { version: 2 },
+      draft: true,
     });
 
     const versions = await schemaListVersions(api, user.token.accessToken, {
@@ -377,6 +454,120 @@ describe("schemaGenerate", () => {
     await projectDelete(api, user.token.accessToken, { id: project.id });
   }, 60000);
 
+  it("regenerates only the targeted paths", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    const initial = await schemaGenerate(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+      lang: "en",
+    });
+
+    const schema = await schemaGenerate(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+      lang: "en",
+      paths: ["/exploration/what_if"],
+    });
+
+    expect(schema.id).not.toBe(initial.id);
+
+    // Values outside the targeted paths are left untouched.
+    type Exploration = Record<string, unknown>;
+
+    const { exploration: initialExploration, ...initialRest } = initial.data;
+    const { exploration, ...rest } = schema.data;
+
+    expect(rest).toEqual(initialRest);
+    expect((exploration as Exploration).key_images).toEqual((initialExploration as Exploration).key_images);
+    expect((exploration as Exploration).key_conflicts).toEqual((initialExploration as Exploration).key_conflicts);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  }, 120000);
+
+  it("saves the instructions with the generated schema", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+    const instructions = "Set the story in a lighthouse, during a storm.";
+
+    const schema = await schemaGenerate(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+      lang: "en",
+      instructions,
+    });
+
+    expect(schema.instructions).toBe(instructions);
+
+    const versions = await schemaListVersions(api, user.token.accessToken, {
+      projectID: project.id,
+      moduleID: TEST_MODULE_ID,
+      moduleNamespace: TEST_MODULE_NAMESPACE,
+      limit: 10,
+      offset: 0,
+    });
+
+    expect(versions[0].id).toBe(schema.id);
+    expect(versions[0].instructions).toBe(instructions);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  }, 60000);
+
+  it("records how the schema was generated", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    const schema = await schemaGenerate(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+      lang: "en",
+    });
+
+    expect(schema.generation?.model).toBeTruthy();
+    expect(schema.generation?.templateVersion).toMatch(/^(default|module):[0-9a-f]{12}$/);
+    expect(schema.generation?.messages?.length).toBeGreaterThan(0);
+    expect(schema.generation?.baseSchemaID).toBeUndefined();
+
+    const selected = await schemaSelect(api, user.token.accessToken, { id: schema.id, projectID: project.id });
+    expect(selected.generation).toEqual(schema.generation);
+
+    const versions = await schemaListVersions(api, user.token.accessToken, {
+      projectID: project.id,
+      moduleID: TEST_MODULE_ID,
+      moduleNamespace: TEST_MODULE_NAMESPACE,
+      limit: 10,
+      offset: 0,
+    });
+
+    // Messages are left out of listings.
+    expect(versions[0].generation?.model).toBe(schema.generation?.model);
+    expect(versions[0].generation?.messages).toBeUndefined();
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  }, 60000);
+
+  it("returns 422 when regenerating paths of a module without data", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    await expectStatus(
+      schemaGenerate(api, user.token.accessToken, {
+        projectID: project.id,
+        module: moduleString,
+        lang: "en",
+        paths: ["/exploration/what_if"],
+      }),
+      422
+    );
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  });
+
   it("returns 404 for non-existent project", async () => {
     const api = new NarrativeEngineApi(process.env.API_URL!);
 
@@ -403,3 +594,155 @@ describe("schemaGenerate", () => {
     );
   });
 });
+
+describe("schemaGenerateStream", () => {
+  it("streams the generated schema", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    const deltas: string[] = [];
+    let output = "";
+
+    const schema = await schemaGenerateStream(
+      api,
+      user.token.accessToken,
+      {
+        projectID: project.id,
+        module: moduleString,
+        lang: "en",
+      },
+      {
+        onDelta: (delta, current) => {
+          deltas.push(delta);
+          output = current;
+        },
+      }
+    );
+
+    expect(schema.projectID).toBe(project.id);
+    expect(schema.module).toContain(`${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@v`);
+    expect(schema.source).toBe("AI");
+
+    // The streamed output adds up to the saved data.
+    expect(deltas.length).toBeGreaterThan(1);
+    expect(deltas.join("")).toBe(output);
+    expect(JSON.parse(output)).toEqual(schema.data);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  }, 60000);
+
+  it("stops when aborted", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+
+    const controller = new AbortController();
+
+    await expect(
+      schemaGenerateStream(
+        api,
+        user.token.accessToken,
+        {
+          projectID: project.id,
+          module: moduleString,
+          lang: "en",
+        },
+        {
+          onDelta: () => controller.abort(),
+          signal: controller.signal,
+        }
+      )
+    ).rejects.toThrow();
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  }, 60000);
+
+  it("returns 404 for non-existent project", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      schemaGenerateStream(api, user.token.accessToken, {
+        projectID: crypto.randomUUID(),
+        module: moduleString,
+        lang: "en",
+      }),
+      404
+    );
+  });
+});
+
+describe("schemaGenerateCandidates", () => {
+  it("generates candidates and promotes one", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await createTestProject(api, user.token.accessToken);
+    const instructions = "Set the story in a lighthouse, during a storm.";
+
+    const candidates = await schemaGenerateCandidates(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+      lang: "en",
+      instructions,
+      candidates: 2,
+    });
+
+    expect(candidates).toHaveLength(2);
+    expect(candidates[0].instructions).toBe(instructions);
+
+    const pending = await schemaCandidateList(api, user.token.accessToken, {
+      projectID: project.id,
+      moduleID: TEST_MODULE_ID,
+      moduleNamespace: TEST_MODULE_NAMESPACE,
+    });
+
+    expect(pending.map((candidate) => candidate.id).sort()).toEqual(
+      candidates.map((candidate) => candidate.id).sort()
+    );
+
+    const schema = await schemaCandidatePromote(api, user.token.accessToken, { id: candidates[1].id });
+
+    expect(schema.source).toBe("AI");
+    expect(schema.instructions).toBe(instructions);
+    expect(schema.data).toEqual(candidates[1].data);
+
+    const latest = await schemaSelect(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+    });
+
+    expect(latest.id).toBe(schema.id);
+
+    // Other candidates are discarded.
+    const remaining = await schemaCandidateList(api, user.token.accessToken, {
+      projectID: project.id,
+      moduleID: TEST_MODULE_ID,
+      moduleNamespace: TEST_MODULE_NAMESPACE,
+    });
+
+    expect(remaining).toEqual([]);
+
+    await expectStatus(schemaCandidatePromote(api, user.token.accessToken, { id: candidates[0].id }), 404);
+
+    // Cleanup
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+  }, 120000);
+
+  it("returns 404 when promoting a non-existent candidate", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(schemaCandidatePromote(api, user.token.accessToken, { id: crypto.randomUUID() }), 404);
+  });
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(
+      schemaCandidateList(api, "", {
+        projectID: crypto.randomUUID(),
+        moduleID: TEST_MODULE_ID,
+        moduleNamespace: TEST_MODULE_NAMESPACE,
+      }),
+      401
+    );
+  });
+});
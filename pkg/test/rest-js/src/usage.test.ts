@@ -0,0 +1,94 @@
+import { beforeAll, describe, expect, it } from "vitest";
+
+import { expectStatus } from "@a-novel-kit/nodelib-test/http";
+import { AuthenticationApi } from "@a-novel/service-authentication-rest";
+import { preRegisterUser, registerUser } from "@a-novel/service-authentication-rest-test";
+import {
+  NarrativeEngineApi,
+  moduleListVersions,
+  projectDelete,
+  projectInit,
+  schemaGenerate,
+  usageSelect,
+} from "@a-novel/service-narrative-engine-rest";
+
+let user: Awaited<ReturnType<typeof registerUser>>;
+let moduleString: string;
+
+const TEST_MODULE_NAMESPACE = "agora";
+const TEST_MODULE_ID = "idea";
+
+beforeAll(async () => {
+  const authApi = new AuthenticationApi(process.env.AUTH_API_URL!);
+  const api = new NarrativeEngineApi(process.env.API_URL!);
+
+  const preRegister = await preRegisterUser(authApi, process.env.MAIL_TEST_HOST!);
+  user = await registerUser(authApi, preRegister);
+
+  const versions = await moduleListVersions(api, user.token.accessToken, {
+    namespace: TEST_MODULE_NAMESPACE,
+    id: TEST_MODULE_ID,
+    limit: 1,
+    offset: 0,
+    preversion: true,
+  });
+
+  expect(versions.length).toBe(1);
+
+  moduleString = `${TEST_MODULE_NAMESPACE}:${TEST_MODULE_ID}@v${versions[0].version}${versions[0].preversion ?? ""}`;
+});
+
+describe("usageSelect", () => {
+  it("starts with no usage", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    const usage = await usageSelect(api, user.token.accessToken);
+
+    expect(usage.daily.used).toBe(0);
+    expect(usage.monthly.used).toBe(0);
+    expect(usage.daily.remaining).toBe(usage.daily.limit);
+    expect(usage.daily.resetsAt.getTime()).toBeGreaterThan(Date.now());
+    expect(usage.projects).toEqual([]);
+  });
+
+  it("accounts for generated schemas", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+    const project = await projectInit(api, user.token.accessToken, {
+      lang: "en",
+      title: `Usage Test Project ${Date.now()}`,
+      workflow: [moduleString],
+    });
+
+    const schema = await schemaGenerate(api, user.token.accessToken, {
+      projectID: project.id,
+      module: moduleString,
+      lang: "en",
+    });
+
+    const usage = await usageSelect(api, user.token.accessToken);
+    const spent = schema.generation!.promptTokens + schema.generation!.completionTokens;
+
+    expect(usage.daily.used).toBe(spent);
+    expect(usage.monthly.used).toBe(spent);
+    expect(usage.projects).toEqual([
+      {
+        projectID: project.id,
+        promptTokens: schema.generation!.promptTokens,
+        completionTokens: schema.generation!.completionTokens,
+      },
+    ]);
+
+    // Usage is kept once the project is deleted.
+    await projectDelete(api, user.token.accessToken, { id: project.id });
+
+    const usageAfterDelete = await usageSelect(api, user.token.accessToken);
+
+    expect(usageAfterDelete.daily.used).toBe(spent);
+  }, 60000);
+
+  it("returns 401 without access token", async () => {
+    const api = new NarrativeEngineApi(process.env.API_URL!);
+
+    await expectStatus(usageSelect(api, ""), 401);
+  });
+});
@@ -15,6 +15,9 @@ podman build --format docker \
 podman build --format docker \
   -f ./builds/rest.Dockerfile \
   -t ghcr.io/a-novel/service-narrative-engine/rest:local .
+podman build --format docker \
+  -f ./builds/worker.Dockerfile \
+  -t ghcr.io/a-novel/service-narrative-engine/worker:local .
 podman build --format docker \
   -f ./builds/standalone.Dockerfile \
   -t ghcr.io/a-novel/service-narrative-engine/standalone:local .